@@ -74,6 +74,7 @@ type Manager struct {
 	onboarding        *Onboarding
 
 	selectedChatAccount *SelectedExtKey // account that was processed during the last call to SelectAccount()
+	selectedChatKeyUID  string          // key UID the key provider knows the selected chat account by
 	mainAccountAddress  types.Address
 	watchAddresses      []types.Address
 }
@@ -225,6 +226,7 @@ func (m *Manager) SelectAccount(loginParams LoginParams) error {
 	m.watchAddresses = loginParams.WatchAddresses
 	m.mainAccountAddress = loginParams.MainAccount
 	m.selectedChatAccount = selectedChatAccount
+	m.selectedChatKeyUID = generator.KeyUID(&selectedChatAccount.AccountKey.PrivateKey.PublicKey)
 	return nil
 }
 
@@ -255,6 +257,7 @@ func (m *Manager) SetChatAccount(privKey *ecdsa.PrivateKey) error {
 		Address:    address,
 		AccountKey: key,
 	}
+	m.selectedChatKeyUID = generator.KeyUID(&privKey.PublicKey)
 	return nil
 }
 
@@ -289,6 +292,26 @@ func (m *Manager) SelectedChatAccount() (*SelectedExtKey, error) {
 	return m.selectedChatAccount, nil
 }
 
+// SignHash signs hash with the selected chat account. When the accounts
+// generator has a key provider, the provider signs with the key of the key
+// UID stored when the account was selected, otherwise the key unlocked from
+// the keystore at login is used.
+func (m *Manager) SignHash(hash []byte) ([]byte, error) {
+	m.mu.RLock()
+	chatAccount, keyUID := m.selectedChatAccount, m.selectedChatKeyUID
+	m.mu.RUnlock()
+
+	if chatAccount == nil {
+		return nil, ErrNoAccountSelected
+	}
+
+	if kp := m.accountsGenerator.KeyProvider(); kp != nil {
+		return kp.Sign(keyUID, hash)
+	}
+
+	return crypto.Sign(hash, chatAccount.AccountKey.PrivateKey)
+}
+
 // Logout clears selected accounts.
 func (m *Manager) Logout() {
 	m.mu.Lock()
@@ -298,6 +321,7 @@ func (m *Manager) Logout() {
 	m.mainAccountAddress = zeroAddress
 	m.watchAddresses = nil
 	m.selectedChatAccount = nil
+	m.selectedChatKeyUID = ""
 }
 
 // ImportAccount imports the account specified with privateKey.
@@ -9,6 +9,8 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/status-im/status-go/account/generator"
+	"github.com/status-im/status-go/account/generator/generatortest"
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/keystore"
 	"github.com/status-im/status-go/eth-node/types"
@@ -261,6 +263,46 @@ func (s *ManagerTestSuite) testSelectAccount(chat, wallet types.Address, passwor
 	s.accManager.Logout()
 }
 
+func (s *ManagerTestSuite) TestSignHash() {
+	hash := crypto.Keccak256([]byte("hello"))
+	_, err := s.accManager.SignHash(hash)
+	s.Equal(ErrNoAccountSelected, err)
+
+	err = s.accManager.SelectAccount(LoginParams{
+		ChatAddress: types.HexToAddress(s.testAccount.chatAddress),
+		MainAccount: types.HexToAddress(s.testAccount.walletAddress),
+		Password:    s.testAccount.password,
+	})
+	s.Require().NoError(err)
+
+	signature, err := s.accManager.SignHash(hash)
+	s.Require().NoError(err)
+	signer, err := crypto.SigToPub(hash, signature)
+	s.Require().NoError(err)
+	s.Equal(types.HexToAddress(s.testAccount.chatAddress), crypto.PubkeyToAddress(*signer))
+
+	// A key provider signs with the same key
+	kp := generatortest.NewMockKeyProvider()
+	s.accManager.AccountsGenerator().SetKeyProvider(kp)
+	defer s.accManager.AccountsGenerator().SetKeyProvider(nil)
+
+	_, err = s.accManager.SignHash(hash)
+	s.Equal(generator.ErrKeyNotFoundByUID, err)
+
+	chatAccount, err := s.accManager.SelectedChatAccount()
+	s.Require().NoError(err)
+	kp.AddKey(chatAccount.AccountKey.PrivateKey)
+
+	// The provider doesn't need the private key to be unlocked
+	privateKey := chatAccount.AccountKey.PrivateKey
+	chatAccount.AccountKey.PrivateKey = nil
+	defer func() { chatAccount.AccountKey.PrivateKey = privateKey }()
+
+	providerSignature, err := s.accManager.SignHash(hash)
+	s.Require().NoError(err)
+	s.Equal(signature, providerSignature)
+}
+
 func (s *ManagerTestSuite) TestSetChatAccount() {
 	s.accManager.Logout()
 
@@ -391,6 +433,46 @@ func (s *ManagerTestSuite) TestReEncryptKey() {
 	s.Require().NoError(decryptNewError)
 }
 
+func (s *ManagerTestSuite) TestReEncryptKeyStoreDirSeveralKeys() {
+	for i := 0; i < 2; i++ {
+		_, _, _, err := s.accManager.CreateAccount(testPassword)
+		s.Require().NoError(err)
+	}
+
+	files, err := ioutil.ReadDir(s.keydir)
+	s.Require().NoError(err)
+	s.Require().Len(files, 3)
+
+	// a wrong password leaves the key files untouched
+	err = s.accManager.ReEncryptKeyStoreDir(s.keydir, "wrong-password", newTestPassword)
+	s.Require().Error(err)
+
+	for _, f := range files {
+		rawKeyFile, err := ioutil.ReadFile(filepath.Join(s.keydir, f.Name()))
+		s.Require().NoError(err)
+		_, err = keystore.DecryptKey(rawKeyFile, testPassword)
+		s.Require().NoError(err)
+	}
+
+	err = s.accManager.ReEncryptKeyStoreDir(s.keydir, testPassword, newTestPassword)
+	s.Require().NoError(err)
+
+	reEncryptedFiles, err := ioutil.ReadDir(s.keydir)
+	s.Require().NoError(err)
+	s.Require().Len(reEncryptedFiles, 3)
+
+	for _, f := range reEncryptedFiles {
+		rawKeyFile, err := ioutil.ReadFile(filepath.Join(s.keydir, f.Name()))
+		s.Require().NoError(err)
+
+		_, err = keystore.DecryptKey(rawKeyFile, testPassword)
+		s.Require().Error(err)
+
+		_, err = keystore.DecryptKey(rawKeyFile, newTestPassword)
+		s.Require().NoError(err)
+	}
+}
+
 func (s *ManagerTestSuite) TestReEncryptKeyStoreDir() {
 
 	err := s.accManager.ReEncryptKeyStoreDir(s.keydir, testPassword, newTestPassword)
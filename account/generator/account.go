@@ -2,7 +2,6 @@ package generator
 
 import (
 	"crypto/ecdsa"
-	"crypto/sha256"
 	"encoding/json"
 	"time"
 
@@ -43,12 +42,10 @@ func (a *Account) ToAccountInfo() AccountInfo {
 
 func (a *Account) ToIdentifiedAccountInfo(id string) IdentifiedAccountInfo {
 	info := a.ToAccountInfo()
-	keyUID := sha256.Sum256(crypto.FromECDSAPub(&a.privateKey.PublicKey))
-	keyUIDHex := types.EncodeHex(keyUID[:])
 	return IdentifiedAccountInfo{
 		AccountInfo: info,
 		ID:          id,
-		KeyUID:      keyUIDHex,
+		KeyUID:      KeyUID(&a.privateKey.PublicKey),
 	}
 }
 
@@ -31,8 +31,9 @@ type AccountManager interface {
 }
 
 type Generator struct {
-	am       AccountManager
-	accounts map[string]*Account
+	am          AccountManager
+	keyProvider KeyProvider
+	accounts    map[string]*Account
 	sync.Mutex
 }
 
@@ -0,0 +1,54 @@
+// Package generatortest provides utilities for testing the accounts generator.
+package generatortest
+
+import (
+	"crypto/ecdsa"
+	"sync"
+
+	"github.com/status-im/status-go/account/generator"
+	"github.com/status-im/status-go/eth-node/crypto"
+)
+
+// MockKeyProvider is an in-memory KeyProvider for tests.
+type MockKeyProvider struct {
+	mu   sync.Mutex
+	keys map[string]*ecdsa.PrivateKey
+}
+
+func NewMockKeyProvider() *MockKeyProvider {
+	return &MockKeyProvider{
+		keys: make(map[string]*ecdsa.PrivateKey),
+	}
+}
+
+// AddKey adds a key to the provider and returns its key UID.
+func (p *MockKeyProvider) AddKey(privateKey *ecdsa.PrivateKey) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	keyUID := generator.KeyUID(&privateKey.PublicKey)
+	p.keys[keyUID] = privateKey
+	return keyUID
+}
+
+func (p *MockKeyProvider) Sign(keyUID string, hash []byte) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	privateKey, ok := p.keys[keyUID]
+	if !ok {
+		return nil, generator.ErrKeyNotFoundByUID
+	}
+	return crypto.Sign(hash, privateKey)
+}
+
+func (p *MockKeyProvider) PublicKey(keyUID string) (*ecdsa.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	privateKey, ok := p.keys[keyUID]
+	if !ok {
+		return nil, generator.ErrKeyNotFoundByUID
+	}
+	return &privateKey.PublicKey, nil
+}
@@ -0,0 +1,48 @@
+package generatortest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/account/generator"
+)
+
+const (
+	testKey     = "0x62f1d86b246c81bdd8f6c166d56896a4a5e1eddbcaebe06480e5c0bc74c28224"
+	testAddress = "0x9c32F71D4DB8Fb9e1A58B0a80dF79935e7256FA6"
+)
+
+func TestGenerator_KeyProvider(t *testing.T) {
+	g := generator.New(nil)
+	assert.Nil(t, g.KeyProvider())
+
+	info, err := g.ImportPrivateKey(testKey)
+	assert.NoError(t, err)
+
+	privateKey, err := crypto.HexToECDSA(testKey[2:])
+	assert.NoError(t, err)
+	kp := NewMockKeyProvider()
+	keyUID := kp.AddKey(privateKey)
+	assert.Equal(t, info.KeyUID, keyUID)
+
+	g.SetKeyProvider(kp)
+	assert.Equal(t, kp, g.KeyProvider())
+
+	publicKey, err := kp.PublicKey(keyUID)
+	assert.NoError(t, err)
+	assert.Equal(t, privateKey.PublicKey, *publicKey)
+
+	hash := crypto.Keccak256([]byte("hello"))
+	sig, err := kp.Sign(keyUID, hash)
+	assert.NoError(t, err)
+
+	signer, err := crypto.SigToPub(hash, sig)
+	assert.NoError(t, err)
+	assert.Equal(t, testAddress, crypto.PubkeyToAddress(*signer).Hex())
+
+	_, err = kp.Sign("0x01", hash)
+	assert.Equal(t, generator.ErrKeyNotFoundByUID, err)
+}
@@ -0,0 +1,43 @@
+package generator
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+// ErrKeyNotFoundByUID is returned when no key matches the requested key UID.
+var ErrKeyNotFoundByUID = errors.New("key not found")
+
+// KeyProvider gives access to keys which aren't stored in the keystore,
+// e.g. keys kept in a hardware security module.
+type KeyProvider interface {
+	Sign(keyUID string, hash []byte) (sig []byte, err error)
+	PublicKey(keyUID string) (*ecdsa.PublicKey, error)
+}
+
+// SetKeyProvider sets the provider of the keys used to sign. Keys unlocked
+// from the keystore are used when the provider is nil.
+func (g *Generator) SetKeyProvider(kp KeyProvider) {
+	g.Lock()
+	defer g.Unlock()
+
+	g.keyProvider = kp
+}
+
+// KeyProvider returns the key provider set with SetKeyProvider
+func (g *Generator) KeyProvider() KeyProvider {
+	g.Lock()
+	defer g.Unlock()
+
+	return g.keyProvider
+}
+
+// KeyUID returns the key UID identifying publicKey
+func KeyUID(publicKey *ecdsa.PublicKey) string {
+	keyUID := sha256.Sum256(crypto.FromECDSAPub(publicKey))
+	return types.EncodeHex(keyUID[:])
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -19,6 +20,7 @@ import (
 
 	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/status-im/status-go/account/generator/generatortest"
 	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
@@ -537,6 +539,59 @@ func TestVerifyDatabasePassword(t *testing.T) {
 	require.NoError(t, b.VerifyDatabasePassword(main.KeyUID, "test-pass"))
 }
 
+func TestRekeyKeystoreFiles(t *testing.T) {
+	utils.Init()
+
+	b := NewGethStatusBackend()
+	chatKey, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	walletKey, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	keyUIDHex := sha256.Sum256(gethcrypto.FromECDSAPub(&chatKey.PublicKey))
+	keyUID := types.EncodeHex(keyUIDHex[:])
+	main := multiaccounts.Account{
+		KeyUID: keyUID,
+	}
+	tmpdir, err := os.MkdirTemp("", "rekey-keystore-files-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+	conf, err := params.NewNodeConfig(tmpdir, 1777)
+	require.NoError(t, err)
+	keyhex := hex.EncodeToString(gethcrypto.FromECDSA(chatKey))
+
+	require.NoError(t, b.AccountManager().InitKeystore(conf.KeyStoreDir))
+	b.UpdateRootDataDir(conf.DataDir)
+	require.NoError(t, b.OpenAccounts())
+
+	address := crypto.PubkeyToAddress(walletKey.PublicKey)
+	require.NoError(t, b.SaveAccountAndStartNodeWithKey(main, "test-pass", testSettings, conf, []*accounts.Account{{Address: address, Wallet: true}}, keyhex))
+	require.NoError(t, b.Logout())
+	require.NoError(t, b.StopNode())
+
+	require.NoError(t, b.AccountManager().InitKeystore(conf.KeyStoreDir))
+	var addresses []string
+	for i := 0; i < 3; i++ {
+		_, info, _, err := b.AccountManager().CreateAccount("test-pass")
+		require.NoError(t, err)
+		addresses = append(addresses, info.WalletAddress)
+	}
+
+	// A wrong password leaves both the key files and the database untouched
+	require.Error(t, b.RekeyKeystoreFiles(keyUID, "wrong-pass", "new-pass"))
+	for _, address := range addresses {
+		_, err = b.AccountManager().VerifyAccountPassword(conf.KeyStoreDir, address, "test-pass")
+		require.NoError(t, err)
+	}
+	require.NoError(t, b.VerifyDatabasePassword(keyUID, "test-pass"))
+
+	require.NoError(t, b.RekeyKeystoreFiles(keyUID, "test-pass", "new-pass"))
+	for _, address := range addresses {
+		_, err = b.AccountManager().VerifyAccountPassword(conf.KeyStoreDir, address, "new-pass")
+		require.NoError(t, err)
+	}
+	require.NoError(t, b.VerifyDatabasePassword(keyUID, "new-pass"))
+}
+
 func TestDeleteMultiaccount(t *testing.T) {
 	backend := NewGethStatusBackend()
 
@@ -613,6 +668,102 @@ func TestDeleteMultiaccount(t *testing.T) {
 	require.Equal(t, 3, len(files))
 }
 
+func TestDeleteAccount(t *testing.T) {
+	backend := NewGethStatusBackend()
+
+	rootDataDir, err := os.MkdirTemp("", "test-delete-account")
+	require.NoError(t, err)
+	defer os.RemoveAll(rootDataDir)
+
+	backend.UpdateRootDataDir(rootDataDir)
+	require.NoError(t, backend.OpenAccounts())
+
+	accs, err := backend.AccountManager().
+		AccountsGenerator().
+		GenerateAndDeriveAddresses(12, 1, "", []string{"m/44'/60'/0'/0"})
+	require.NoError(t, err)
+	generateAccount := accs[0]
+
+	keyStoreDir := filepath.Join(rootDataDir, keystoreRelativePath, generateAccount.KeyUID)
+	require.NoError(t, backend.AccountManager().InitKeystore(keyStoreDir))
+	accountInfo, err := backend.AccountManager().
+		AccountsGenerator().
+		StoreAccount(generateAccount.ID, "123123")
+	require.NoError(t, err)
+
+	account := multiaccounts.Account{
+		Name:      "foo",
+		Timestamp: 1,
+		KeyUID:    generateAccount.KeyUID,
+	}
+	require.NoError(t, backend.SaveAccount(account))
+
+	require.NoError(t, backend.ensureAppDBOpened(account, "123123"))
+	s := settings.Settings{
+		Address:           types.HexToAddress(accountInfo.Address),
+		CurrentNetwork:    "mainnet_rpc",
+		DappsAddress:      types.HexToAddress(accountInfo.Address),
+		EIP1581Address:    types.HexToAddress(accountInfo.Address),
+		InstallationID:    "d3efcff6-cffa-560e-a547-21d3858cbc51",
+		KeyUID:            account.KeyUID,
+		Name:              "Jittery Cornflowerblue Kingbird",
+		Networks:          &networks,
+		PublicKey:         accountInfo.PublicKey,
+		SigningPhrase:     "yurt joey vibe",
+		WalletRootAddress: types.HexToAddress(accountInfo.Address)}
+	require.NoError(t, backend.saveAccountsAndSettings(s, &params.NodeConfig{}, []*accounts.Account{{Address: types.HexToAddress(accountInfo.Address), Wallet: true}}))
+	require.NoError(t, backend.closeAppDB())
+
+	backend.account = &account
+	require.ErrorIs(t, backend.DeleteAccount(context.Background(), account.KeyUID, "123123"), ErrDeleteActiveAccount)
+	backend.account = nil
+
+	require.Error(t, backend.DeleteAccount(context.Background(), account.KeyUID, "wrong-pass"))
+	multiaccs, err := backend.GetAccounts()
+	require.NoError(t, err)
+	require.Len(t, multiaccs, 1)
+
+	require.NoError(t, backend.DeleteAccount(context.Background(), account.KeyUID, "123123"))
+
+	multiaccs, err = backend.GetAccounts()
+	require.NoError(t, err)
+	require.Empty(t, multiaccs)
+
+	_, err = os.Stat(keyStoreDir)
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(rootDataDir, fmt.Sprintf("%s.db", account.KeyUID)))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestSignHashWithKeyProvider(t *testing.T) {
+	backend := NewGethStatusBackend()
+
+	privateKey, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	require.NoError(t, backend.AccountManager().SetChatAccount(privateKey))
+
+	hash := gethcrypto.Keccak256([]byte("hello"))
+	hexEncodedSignature, err := backend.SignHash(types.EncodeHex(hash))
+	require.NoError(t, err)
+
+	// The key provider signs with the same key as the keystore
+	kp := generatortest.NewMockKeyProvider()
+	backend.AccountManager().AccountsGenerator().SetKeyProvider(kp)
+	_, err = backend.SignHash(types.EncodeHex(hash))
+	require.Error(t, err)
+
+	kp.AddKey(privateKey)
+	providerSignature, err := backend.SignHash(types.EncodeHex(hash))
+	require.NoError(t, err)
+	require.Equal(t, hexEncodedSignature, providerSignature)
+
+	signature, err := types.DecodeHex(providerSignature)
+	require.NoError(t, err)
+	signer, err := gethcrypto.SigToPub(hash, signature)
+	require.NoError(t, err)
+	require.Equal(t, gethcrypto.PubkeyToAddress(privateKey.PublicKey), gethcrypto.PubkeyToAddress(*signer))
+}
+
 func TestConvertAccount(t *testing.T) {
 	const mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
 	const password = "111111"        // represents password for a regular user
@@ -11,6 +11,7 @@ import (
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/multiaccounts/settings"
 	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/identity/alias"
 	"github.com/status-im/status-go/protocol/requests"
 )
@@ -35,7 +36,11 @@ func defaultSettings(generatedAccountInfo generator.GeneratedAccountInfo, derive
 	settings.WalletRootAddress = types.HexToAddress(derivedAddresses[pathWalletRoot].Address)
 
 	// Set chat key & name
-	name, err := alias.GenerateFromPublicKeyString(chatKeyString)
+	chatKey, err := common.HexToPubkey(chatKeyString)
+	if err != nil {
+		return nil, err
+	}
+	name, err := alias.GenerateFromECDSAPublicKey(chatKey)
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +110,13 @@ func defaultNodeConfig(installationID string, request *requests.CreateAccount) (
 
 	nodeConfig.Name = "StatusIM"
 	nodeConfig.Rendezvous = false
-	clusterConfig, err := params.LoadClusterConfigFromFleet("status.prod")
+	var clusterConfig *params.ClusterConfig
+	var err error
+	if request.ClusterConfigFile != nil {
+		clusterConfig, err = params.LoadClusterConfigFromFile(*request.ClusterConfigFile)
+	} else {
+		clusterConfig, err = params.LoadClusterConfigFromFleet("status.prod")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -121,9 +132,12 @@ func defaultNodeConfig(installationID string, request *requests.CreateAccount) (
 	nodeConfig.MailserversConfig = params.MailserversConfig{Enabled: true}
 	nodeConfig.EnableNTPSync = true
 
-	nodes := []string{"enrtree://AOGECG2SPND25EEFMAJ5WF3KSGJNSGV356DSTL2YVLLZWIV6SAYBM@prod.nodes.status.im"}
-	nodeConfig.ClusterConfig.WakuNodes = nodes
-	nodeConfig.ClusterConfig.DiscV5BootstrapNodes = nodes
+	// custom cluster configs provide their own nodes
+	if request.ClusterConfigFile == nil {
+		nodes := []string{"enrtree://AOGECG2SPND25EEFMAJ5WF3KSGJNSGV356DSTL2YVLLZWIV6SAYBM@prod.nodes.status.im"}
+		nodeConfig.ClusterConfig.WakuNodes = nodes
+		nodeConfig.ClusterConfig.DiscV5BootstrapNodes = nodes
+	}
 
 	nodeConfig.WakuV2Config = params.WakuV2Config{
 		Enabled:        true,
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+const testBootNode = "enode://a6a2a9b3a7cbb0a15da74301537ebba549c990e3325ae78e1272a19cf3ed7a32e5a51d2a02fbd72bfd3ef0bf8c4a8d1f7e17c2ec9c71c2a5a26a4feb7d8f1b44@127.0.0.1:30303"
+
+func TestDefaultNodeConfigWithClusterConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	clusterConfigFile := filepath.Join(dir, "fleet.json")
+	err := os.WriteFile(clusterConfigFile, []byte(`{
+		"ClusterConfig": {
+			"Enabled": true,
+			"Fleet": "custom",
+			"BootNodes": ["`+testBootNode+`"]
+		}
+	}`), 0600)
+	require.NoError(t, err)
+
+	nodeConfig, err := defaultNodeConfig("installation-id", &requests.CreateAccount{ClusterConfigFile: &clusterConfigFile})
+	require.NoError(t, err)
+	require.Equal(t, "custom", nodeConfig.ClusterConfig.Fleet)
+	require.Equal(t, clusterConfigFile, nodeConfig.ClusterConfig.ClusterConfigFile)
+	require.Equal(t, []string{testBootNode}, nodeConfig.ClusterConfig.BootNodes)
+	require.Empty(t, nodeConfig.ClusterConfig.WakuNodes)
+
+	nodeConfig, err = defaultNodeConfig("installation-id", &requests.CreateAccount{})
+	require.NoError(t, err)
+	require.Equal(t, "status.prod", nodeConfig.ClusterConfig.Fleet)
+	require.Empty(t, nodeConfig.ClusterConfig.ClusterConfigFile)
+
+	missingFile := filepath.Join(dir, "missing.json")
+	_, err = defaultNodeConfig("installation-id", &requests.CreateAccount{ClusterConfigFile: &missingFile})
+	require.Error(t, err)
+}
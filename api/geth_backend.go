@@ -60,6 +60,8 @@ var (
 	ErrDBNotAvailable = errors.New("DB is unavailable")
 	// ErrConfigNotAvailable is returned if a method is called before the nodeconfig is set
 	ErrConfigNotAvailable = errors.New("NodeConfig is not available")
+	// ErrDeleteActiveAccount is returned when trying to delete the logged in account
+	ErrDeleteActiveAccount = errors.New("can't delete the logged in account")
 )
 
 var _ StatusBackend = (*GethStatusBackend)(nil)
@@ -212,6 +214,84 @@ func (b *GethStatusBackend) DeleteMultiaccount(keyUID string, keyStoreDir string
 		return err
 	}
 
+	err = b.removeAppDBFiles(keyUID)
+	if err != nil {
+		return err
+	}
+
+	if b.account != nil && b.account.KeyUID == keyUID {
+		// reset active account
+		b.account = nil
+	}
+
+	return os.RemoveAll(keyStoreDir)
+}
+
+// DeleteAccount deletes an account along with its keystore and databases,
+// once its password is verified. The logged in account can't be deleted.
+func (b *GethStatusBackend) DeleteAccount(ctx context.Context, keyUID string, password string) error {
+	b.mu.Lock()
+	active := b.account != nil && b.account.KeyUID == keyUID
+	b.mu.Unlock()
+	if active {
+		return ErrDeleteActiveAccount
+	}
+
+	err := b.verifyAppDBPassword(keyUID, password)
+	if err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	err = b.DeleteMultiaccount(keyUID, filepath.Join(b.rootDataDir, keystoreRelativePath, keyUID))
+	if err != nil {
+		return err
+	}
+
+	signal.SendAccountDeleted(keyUID)
+	return nil
+}
+
+// verifyAppDBPassword checks the password of an account by opening its
+// database, without replacing the database of the backend
+func (b *GethStatusBackend) verifyAppDBPassword(keyUID string, password string) error {
+	if b.multiaccountsDB == nil {
+		return errors.New("accounts db wasn't initialized")
+	}
+
+	kdfIterations, err := b.multiaccountsDB.GetAccountKDFIterationsNumber(keyUID)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(b.rootDataDir, fmt.Sprintf("%s.db", keyUID))
+	if _, err := os.Stat(path); err != nil {
+		// accounts which didn't log in since the fix of
+		// https://github.com/status-im/status-go/issues/2027 use the old path
+		path = filepath.Join(b.rootDataDir, fmt.Sprintf("app-%x.sql", keyUID))
+		if _, err := os.Stat(path); err != nil {
+			return errors.New("account database not found")
+		}
+	}
+
+	db, err := appdatabase.InitializeDB(path, password, kdfIterations)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	accountsDB, err := accounts.NewDB(db)
+	if err != nil {
+		return err
+	}
+	_, err = accountsDB.GetWalletAddress()
+	return err
+}
+
+func (b *GethStatusBackend) removeAppDBFiles(keyUID string) error {
 	dbFiles := []string{
 		filepath.Join(b.rootDataDir, fmt.Sprintf("app-%x.sql", keyUID)),
 		filepath.Join(b.rootDataDir, fmt.Sprintf("app-%x.sql-shm", keyUID)),
@@ -228,13 +308,7 @@ func (b *GethStatusBackend) DeleteMultiaccount(keyUID string, keyStoreDir string
 			}
 		}
 	}
-
-	if b.account != nil && b.account.KeyUID == keyUID {
-		// reset active account
-		b.account = nil
-	}
-
-	return os.RemoveAll(keyStoreDir)
+	return nil
 }
 
 func (b *GethStatusBackend) DeleteImportedKey(address, password, keyStoreDir string) error {
@@ -584,8 +658,7 @@ func (b *GethStatusBackend) ChangeDatabasePassword(keyUID string, password strin
 	}
 	err = appdatabase.ChangeDatabasePassword(dbPath, password, kdfIterations, newPassword)
 	if err != nil {
-		if config != nil {
-			keyDir := config.KeyStoreDir
+		if keyDir != "" {
 			// couldn't change db password so undo keystore changes to mainitain consistency
 			_ = b.accountManager.ReEncryptKeyStoreDir(keyDir, newPassword, password)
 		}
@@ -594,6 +667,13 @@ func (b *GethStatusBackend) ChangeDatabasePassword(keyUID string, password strin
 	return nil
 }
 
+// RekeyKeystoreFiles re-encrypts the keystore files of an account with newPassword, along with its database so
+// that both keep the same password. The key files are re-encrypted into a temporary dir replacing the keystore
+// dir, which is restored if the database can't be re-encrypted.
+func (b *GethStatusBackend) RekeyKeystoreFiles(keyUID string, oldPassword string, newPassword string) error {
+	return b.ChangeDatabasePassword(keyUID, oldPassword, newPassword)
+}
+
 func (b *GethStatusBackend) tryToDeleteAccount(address types.Address, password, newPassword string) error {
 	err := b.accountManager.DeleteAccount(address, newPassword)
 	if err != nil {
@@ -1684,12 +1764,12 @@ func (b *GethStatusBackend) SignHash(hexEncodedHash string) (string, error) {
 		return "", fmt.Errorf("SignHash: could not unmarshal the input: %v", err)
 	}
 
-	chatAccount, err := b.accountManager.SelectedChatAccount()
+	_, err = b.accountManager.SelectedChatAccount()
 	if err != nil {
 		return "", fmt.Errorf("SignHash: could not select account: %v", err.Error())
 	}
 
-	signature, err := ethcrypto.Sign(hash, chatAccount.AccountKey.PrivateKey)
+	signature, err := b.accountManager.SignHash(hash)
 	if err != nil {
 		return "", fmt.Errorf("SignHash: could not sign the hash: %v", err)
 	}
@@ -1703,20 +1783,21 @@ func (b *GethStatusBackend) SwitchFleet(fleet string, conf *params.NodeConfig) e
 		return ErrDBNotAvailable
 	}
 
-	accountDB, err := accounts.NewDB(b.appDB)
+	_, err := settings.ValidateFleet(fleet, conf.ClusterConfig.ClusterConfigFile)
 	if err != nil {
 		return err
 	}
 
-	err = accountDB.SaveSetting("fleet", fleet)
+	accountDB, err := accounts.NewDB(b.appDB)
 	if err != nil {
 		return err
 	}
 
+	// The node config goes first, the fleet setting is checked against its cluster config file
 	err = nodecfg.SaveNodeConfig(b.appDB, conf)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return accountDB.SaveSetting("fleet", fleet)
 }
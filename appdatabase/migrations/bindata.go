@@ -51,6 +51,11 @@
 // 1676968197_add_fallback_rpc_to_networks.up.sql (112B)
 // 1677674090_add_chains_ens_istest_to_saved_addresses.up.sql (638B)
 // 1677681143_accounts_table_type_column_update.up.sql (135B)
+// 1679510000_add_communities_settings_join_cooldown.up.sql (93B)
+// 1679510005_add_communities_settings_dnd.up.sql (250B)
+// 1679510006_add_auto_purge_settings.up.sql (148B)
+// 1679510007_add_blacklisted_peers.up.sql (155B)
+// 1679510008_add_cluster_config_file.up.sql (87B)
 // doc.go (74B)
 
 package migrations
@@ -1140,6 +1145,106 @@ func _1677681143_accounts_table_type_column_updateUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1679510000_add_communities_settings_join_cooldownUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x0d\xc3\x41\x0a\x80\x20\x10\x05\xd0\x7d\xa7\xf8\x47\x68\xdf\xca\xd2\x20\x98\x0c\x42\xd7\x2e\x4a\xc2\x28\x87\x72\xa2\xeb\xd7\x83\xa7\xc8\x99\x19\x4e\xb5\x64\xb0\xf0\x79\x3e\x39\x49\x8a\x25\x94\x28\x92\xf2\x56\xa0\xb4\x46\x37\x91\x1f\x2d\xee\x78\x3d\xb1\x48\x10\x0e\x3b\xa7\x1c\x16\xe6\x63\xe5\x37\x63\xb0\x0e\x76\xfa\x7b\x22\x68\xd3\x2b\x4f\x0e\x75\x53\x7d\x4c\xc8\x59\xb2\x5d\x00\x00\x00")
+
+func _1679510000_add_communities_settings_join_cooldownUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510000_add_communities_settings_join_cooldownUpSql,
+		"1679510000_add_communities_settings_join_cooldown.up.sql",
+	)
+}
+
+func _1679510000_add_communities_settings_join_cooldownUpSql() (*asset, error) {
+	bytes, err := _1679510000_add_communities_settings_join_cooldownUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510000_add_communities_settings_join_cooldown.up.sql", size: 93, mode: os.FileMode(0644), modTime: time.Unix(1679510000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x82, 0xb9, 0xc0, 0x38, 0x11, 0xc7, 0x4c, 0x6b, 0x82, 0x3, 0x76, 0xaa, 0xba, 0x3d, 0x2e, 0xe0, 0x8b, 0x4e, 0xdd, 0xd8, 0xe, 0xaf, 0xe6, 0x7a, 0xec, 0xc4, 0x1f, 0x87, 0xbe, 0x70, 0xdd, 0x41}}
+	return a, nil
+}
+
+var __1679510005_add_communities_settings_dndUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\xcc\xb1\x0a\xc2\x30\x14\x05\xd0\xbd\x5f\x71\xe9\x17\x74\xef\xf4\x6c\x22\x0a\xcf\x14\x42\xe2\x1a\xc4\x06\xcd\x90\x04\x9a\xd7\xc5\xaf\xb7\xbb\x53\xc1\xe1\xac\x87\xd8\x69\x0b\x47\x27\xd6\x78\xd6\x9c\xb7\x92\x24\xc5\x16\x5a\x14\x49\xe5\xd5\x40\x4a\x61\x9a\xd9\xdf\x0c\x96\xb2\x84\x26\x8f\x55\xc2\xbb\x6e\x2b\xae\xc6\xc1\xcc\x3b\xcf\x0c\xa5\xcf\xe4\xd9\x61\x18\x3b\x3a\x58\xc6\xdd\x5f\x43\x49\x39\x7e\x6a\x89\xb8\x93\x9d\x2e\x64\x7f\xd3\xbe\x1f\xbb\x2f\xed\x26\x1b\x25\xfa\x00\x00\x00")
+
+func _1679510005_add_communities_settings_dndUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510005_add_communities_settings_dndUpSql,
+		"1679510005_add_communities_settings_dnd.up.sql",
+	)
+}
+
+func _1679510005_add_communities_settings_dndUpSql() (*asset, error) {
+	bytes, err := _1679510005_add_communities_settings_dndUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510005_add_communities_settings_dnd.up.sql", size: 250, mode: os.FileMode(0644), modTime: time.Unix(1679510005, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3b, 0xb1, 0x5a, 0x11, 0x9f, 0xf9, 0xd, 0xf8, 0xc4, 0xef, 0xf9, 0x73, 0x48, 0x22, 0xec, 0xf4, 0x1b, 0x4d, 0x5a, 0x76, 0x9e, 0xba, 0x5c, 0xfa, 0x9b, 0x95, 0x40, 0x63, 0xfb, 0x3e, 0xbd, 0xf8}}
+	return a, nil
+}
+
+var __1679510006_add_auto_purge_settingsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4e\x2d\x29\xc9\xcc\x4b\x2f\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\x2c\x2d\xc9\x8f\x2f\x28\x2d\x4a\x4f\x8d\x4f\xcd\x4b\x4c\xca\x49\x4d\x51\x70\xf2\xf7\xf7\x71\x75\xf4\x53\x70\x71\x75\x73\x0c\xf5\x09\x51\x70\x73\xf4\x09\x76\xb5\xe6\x72\x24\xde\xa4\xa2\xd4\x92\xd4\xbc\x92\xcc\xfc\xbc\xf8\x94\xc4\xca\x62\x05\x4f\xbf\x10\xb8\x61\x96\x06\xd6\x5c\x00\x79\xa9\xee\x50\x94\x00\x00\x00")
+
+func _1679510006_add_auto_purge_settingsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510006_add_auto_purge_settingsUpSql,
+		"1679510006_add_auto_purge_settings.up.sql",
+	)
+}
+
+func _1679510006_add_auto_purge_settingsUpSql() (*asset, error) {
+	bytes, err := _1679510006_add_auto_purge_settingsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510006_add_auto_purge_settings.up.sql", size: 148, mode: os.FileMode(0644), modTime: time.Unix(1792281554, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8f, 0x7b, 0xe3, 0x37, 0x6d, 0x97, 0xcc, 0x71, 0xa6, 0x2, 0x99, 0x41, 0xa1, 0x24, 0x58, 0x7e, 0x88, 0x22, 0x8e, 0x79, 0xb6, 0xaa, 0x33, 0x77, 0xeb, 0x1b, 0x44, 0x89, 0x33, 0x1e, 0xa7, 0xa6}}
+	return a, nil
+}
+
+var __1679510007_add_blacklisted_peersUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4d\xcd\xb1\x0a\xc2\x40\x10\x04\xd0\xfe\xbe\x62\xba\x28\xf8\x07\x56\x6b\xdc\xe0\xe1\x79\xca\x66\x23\x49\x15\x4e\x73\x45\x30\xa8\xe4\xf2\xff\x68\x2c\xd4\x6e\x18\xde\x30\xb9\x30\x29\x43\x69\xe3\x18\xb6\x80\x3f\x2a\xb8\xb6\xa5\x96\xb8\x0c\xe1\x7a\x1b\xfa\x34\xc5\xae\x7d\xc6\x38\x26\x2c\x0c\x30\xa7\xb6\xef\x70\x26\xc9\x77\x24\x38\x89\x3d\x90\x34\xd8\x73\xf3\x19\xfb\xca\xb9\xd5\xdb\x8d\x31\xa4\xc7\x1d\xca\xb5\x7e\x7b\x6c\xb9\xa0\xca\x29\xb2\x6c\x26\xff\x07\x61\x82\xf5\x3f\x69\x96\x6b\xf3\x02\x75\xad\xda\x3d\x9b\x00\x00\x00")
+
+func _1679510007_add_blacklisted_peersUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510007_add_blacklisted_peersUpSql,
+		"1679510007_add_blacklisted_peers.up.sql",
+	)
+}
+
+func _1679510007_add_blacklisted_peersUpSql() (*asset, error) {
+	bytes, err := _1679510007_add_blacklisted_peersUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510007_add_blacklisted_peers.up.sql", size: 155, mode: os.FileMode(0644), modTime: time.Unix(1679510007, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5e, 0x5b, 0xb5, 0x3a, 0x55, 0x5a, 0x73, 0xef, 0x92, 0x80, 0xd9, 0x74, 0x2d, 0x9d, 0x32, 0x16, 0x75, 0x1a, 0x6c, 0xf7, 0xac, 0x32, 0x59, 0x85, 0x7a, 0xed, 0x89, 0x0, 0xf2, 0x36, 0x2e, 0xc4}}
+	return a, nil
+}
+
+var __1679510008_add_cluster_config_fileUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x48\xce\x29\x2d\x2e\x49\x2d\x8a\x4f\xce\xcf\x4b\xcb\x4c\x57\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x43\x93\x89\x4f\xcb\xcc\x49\x55\x08\x73\x0c\x72\xf6\x70\x0c\x52\xf0\xf3\x0f\x51\xf0\x0b\xf5\xf1\x51\x70\x71\x75\x73\x0c\xf5\x09\x51\x50\x57\xb7\xe6\x02\x00\xa3\x4a\xeb\xf8\x57\x00\x00\x00")
+
+func _1679510008_add_cluster_config_fileUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510008_add_cluster_config_fileUpSql,
+		"1679510008_add_cluster_config_file.up.sql",
+	)
+}
+
+func _1679510008_add_cluster_config_fileUpSql() (*asset, error) {
+	bytes, err := _1679510008_add_cluster_config_fileUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510008_add_cluster_config_file.up.sql", size: 87, mode: os.FileMode(0644), modTime: time.Unix(1679510008, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x81, 0xec, 0xee, 0xd, 0x74, 0x95, 0x2, 0x9d, 0x22, 0x11, 0xe2, 0x18, 0xbc, 0x8c, 0xc6, 0x4e, 0xd, 0x44, 0x68, 0x4c, 0xf5, 0x67, 0x84, 0x1d, 0xb3, 0xff, 0xdc, 0xc9, 0xf1, 0x3a, 0x51, 0x1b}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xc9\xb1\x0d\xc4\x20\x0c\x05\xd0\x9e\x29\xfe\x02\xd8\xfd\x6d\xe3\x4b\xac\x2f\x44\x82\x09\x78\x7f\xa5\x49\xfd\xa6\x1d\xdd\xe8\xd8\xcf\x55\x8a\x2a\xe3\x47\x1f\xbe\x2c\x1d\x8c\xfa\x6f\xe3\xb4\x34\xd4\xd9\x89\xbb\x71\x59\xb6\x18\x1b\x35\x20\xa2\x9f\x0a\x03\xa2\xe5\x0d\x00\x00\xff\xff\x60\xcd\x06\xbe\x4a\x00\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -1353,6 +1458,16 @@ var _bindata = map[string]func() (*asset, error){
 
 	"1677681143_accounts_table_type_column_update.up.sql": _1677681143_accounts_table_type_column_updateUpSql,
 
+	"1679510000_add_communities_settings_join_cooldown.up.sql": _1679510000_add_communities_settings_join_cooldownUpSql,
+
+	"1679510005_add_communities_settings_dnd.up.sql": _1679510005_add_communities_settings_dndUpSql,
+
+	"1679510006_add_auto_purge_settings.up.sql": _1679510006_add_auto_purge_settingsUpSql,
+
+	"1679510007_add_blacklisted_peers.up.sql": _1679510007_add_blacklisted_peersUpSql,
+
+	"1679510008_add_cluster_config_file.up.sql": _1679510008_add_cluster_config_fileUpSql,
+
 	"doc.go": docGo,
 }
 
@@ -1448,6 +1563,11 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1676968197_add_fallback_rpc_to_networks.up.sql":                   &bintree{_1676968197_add_fallback_rpc_to_networksUpSql, map[string]*bintree{}},
 	"1677674090_add_chains_ens_istest_to_saved_addresses.up.sql":       &bintree{_1677674090_add_chains_ens_istest_to_saved_addressesUpSql, map[string]*bintree{}},
 	"1677681143_accounts_table_type_column_update.up.sql":              &bintree{_1677681143_accounts_table_type_column_updateUpSql, map[string]*bintree{}},
+	"1679510000_add_communities_settings_join_cooldown.up.sql":         &bintree{_1679510000_add_communities_settings_join_cooldownUpSql, map[string]*bintree{}},
+	"1679510005_add_communities_settings_dnd.up.sql":                   &bintree{_1679510005_add_communities_settings_dndUpSql, map[string]*bintree{}},
+	"1679510006_add_auto_purge_settings.up.sql":                        &bintree{_1679510006_add_auto_purge_settingsUpSql, map[string]*bintree{}},
+	"1679510007_add_blacklisted_peers.up.sql":                          &bintree{_1679510007_add_blacklisted_peersUpSql, map[string]*bintree{}},
+	"1679510008_add_cluster_config_file.up.sql":                        &bintree{_1679510008_add_cluster_config_fileUpSql, map[string]*bintree{}},
 	"doc.go": &bintree{docGo, map[string]*bintree{}},
 }}
 
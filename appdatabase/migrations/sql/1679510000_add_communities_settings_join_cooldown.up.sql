@@ -0,0 +1 @@
+ALTER TABLE communities_settings ADD COLUMN request_to_join_cooldown INT NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+ALTER TABLE communities_settings ADD COLUMN dnd_start_hour INT NOT NULL DEFAULT 0;
+ALTER TABLE communities_settings ADD COLUMN dnd_end_hour INT NOT NULL DEFAULT 0;
+ALTER TABLE communities_settings ADD COLUMN dnd_timezone VARCHAR NOT NULL DEFAULT "";
@@ -0,0 +1,2 @@
+ALTER TABLE settings ADD COLUMN auto_purge_enabled BOOLEAN DEFAULT FALSE;
+ALTER TABLE settings ADD COLUMN auto_purge_retention_days INT DEFAULT 90;
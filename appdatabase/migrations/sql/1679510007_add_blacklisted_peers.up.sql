@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS blacklisted_peers (
+  peer_id VARCHAR PRIMARY KEY NOT NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  blacklisted_at INT NOT NULL
+);
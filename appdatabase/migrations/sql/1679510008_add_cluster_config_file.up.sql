@@ -0,0 +1 @@
+ALTER TABLE cluster_config ADD COLUMN cluster_config_file VARCHAR NOT NULL DEFAULT '';
@@ -88,14 +88,15 @@ func (w *gethPublicWakuV2APIWrapper) GetFilterMessages(id string) ([]*types.Mess
 // returns the hash of the message in case of success.
 func (w *gethPublicWakuV2APIWrapper) Post(ctx context.Context, req types.NewMessage) ([]byte, error) {
 	msg := wakuv2.NewMessage{
-		SymKeyID:   req.SymKeyID,
-		PublicKey:  req.PublicKey,
-		Sig:        req.SigID, // Sig is really a SigID
-		Topic:      wakucommon.TopicType(req.Topic),
-		Payload:    req.Payload,
-		Padding:    req.Padding,
-		TargetPeer: req.TargetPeer,
-		Ephemeral:  req.Ephemeral,
+		SymKeyID:    req.SymKeyID,
+		PublicKey:   req.PublicKey,
+		Sig:         req.SigID, // Sig is really a SigID
+		Topic:       wakucommon.TopicType(req.Topic),
+		Payload:     req.Payload,
+		Padding:     req.Padding,
+		TargetPeer:  req.TargetPeer,
+		Ephemeral:   req.Ephemeral,
+		CommunityID: req.CommunityID,
 	}
 	return w.api.Post(ctx, msg)
 }
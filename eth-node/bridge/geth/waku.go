@@ -92,6 +92,16 @@ func (w *gethWakuWrapper) SubscribeToConnStatusChanges() (*types.ConnStatusSubsc
 	return nil, errors.New("not available in WakuV1")
 }
 
+// SubscribeToCommunityShard is a no-op as WakuV1 has no relay topics
+func (w *gethWakuWrapper) SubscribeToCommunityShard(communityID string) error {
+	return nil
+}
+
+// UnsubscribeFromCommunityShard is a no-op as WakuV1 has no relay topics
+func (w *gethWakuWrapper) UnsubscribeFromCommunityShard(communityID string) error {
+	return nil
+}
+
 // Peers function only added for compatibility with waku V2
 func (w *gethWakuWrapper) Peers() map[string]types.WakuV2Peer {
 	p := make(map[string]types.WakuV2Peer)
@@ -274,6 +274,14 @@ func (w *gethWakuV2Wrapper) SubscribeToConnStatusChanges() (*types.ConnStatusSub
 	return w.waku.SubscribeToConnStatusChanges(), nil
 }
 
+func (w *gethWakuV2Wrapper) SubscribeToCommunityShard(communityID string) error {
+	return w.waku.SubscribeToCommunityShard(communityID)
+}
+
+func (w *gethWakuV2Wrapper) UnsubscribeFromCommunityShard(communityID string) error {
+	return w.waku.UnsubscribeFromCommunityShard(communityID)
+}
+
 func (w *gethWakuV2Wrapper) ConnectionChanged(state connection.State) {
 	w.waku.ConnectionChanged(state)
 }
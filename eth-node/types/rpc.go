@@ -17,6 +17,8 @@ type NewMessage struct {
 	PowTarget  float64   `json:"powTarget"`
 	TargetPeer string    `json:"targetPeer"`
 	Ephemeral  bool      `json:"ephemeral"`
+	// CommunityID is the ID of the community the message is sent to, if any
+	CommunityID string `json:"communityID,omitempty"`
 }
 
 // Message is the RPC representation of a whisper message.
@@ -100,6 +100,12 @@ type Waku interface {
 
 	SubscribeToConnStatusChanges() (*ConnStatusSubscription, error)
 
+	// SubscribeToCommunityShard subscribes to the relay topic the community is sharded to
+	SubscribeToCommunityShard(communityID string) error
+
+	// UnsubscribeFromCommunityShard unsubscribes from the relay topic the community is sharded to
+	UnsubscribeFromCommunityShard(communityID string) error
+
 	// MinPow returns the PoW value required by this node.
 	MinPow() float64
 	// BloomFilter returns the aggregated bloom filter for all the topics of interest.
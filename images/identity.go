@@ -89,6 +89,12 @@ func (i *IdentityImage) FromProtobuf(ii *protobuf.MultiAccount_IdentityImage) {
 	i.Clock = ii.Clock
 }
 
+// IsAnimated reports whether the image is the animated variant of the profile picture,
+// which is only served locally and never sent to peers or paired devices
+func (i IdentityImage) IsAnimated() bool {
+	return i.Name == AnimatedIdentityName
+}
+
 func (i IdentityImage) IsEmpty() bool {
 	return i.KeyUID == "" && i.Name == "" && len(i.Payload) == 0 && i.Width == 0 && i.Height == 0 && i.FileSize == 0 && i.ResizeTarget == 0 && i.Clock == 0
 }
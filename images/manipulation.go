@@ -53,6 +53,25 @@ func Resize(size ResizeDimension, img image.Image) image.Image {
 	return resize.Resize(width, height, img, resize.Bilinear)
 }
 
+// Lanczos is the Lanczos resampling kernel with a support of 3
+var Lanczos = &xdraw.Kernel{
+	Support: 3,
+	At: func(t float64) float64 {
+		if t == 0 {
+			return 1
+		}
+		x := math.Pi * t
+		return 3 * math.Sin(x) * math.Sin(x/3) / (x * x)
+	},
+}
+
+// ResizeExact resizes img to exactly width x height pixels with Lanczos sampling, regardless of its aspect ratio
+func ResizeExact(img image.Image, width, height int) image.Image {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	Lanczos.Scale(dst, dst.Bounds(), img, img.Bounds(), xdraw.Over, nil)
+	return dst
+}
+
 func ResizeTo(percent int, img image.Image) image.Image {
 	width := uint(img.Bounds().Max.X * percent / 100)
 	height := uint(img.Bounds().Max.Y * percent / 100)
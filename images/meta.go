@@ -23,6 +23,12 @@ const (
 	LargeDimName = "large"
 
 	BannerIdentityName = "banner"
+
+	// AnimatedIdentityName is the name of the animated variant of the profile picture
+	AnimatedIdentityName = "animated"
+
+	// MaxAnimatedIdentitySize is the largest animated profile picture payload accepted, in bytes
+	MaxAnimatedIdentitySize = 2 * 1024 * 1024
 )
 
 var (
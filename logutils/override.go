@@ -3,12 +3,20 @@ package logutils
 import (
 	"os"
 	"strings"
+	"sync"
 
 	logging "github.com/ipfs/go-log/v2"
 
 	"github.com/ethereum/go-ethereum/log"
 )
 
+var (
+	// rootHandler is the handler of the root logger before filtering by
+	// level, nil if the root logger is disabled
+	rootHandler   log.Handler
+	rootHandlerMu sync.Mutex
+)
+
 type LogSettings struct {
 	Enabled         bool
 	MobileSystem    bool
@@ -60,7 +68,24 @@ func OverrideRootLog(enabled bool, levelStr string, fileOpts FileOptions, termin
 	return enableRootLog(levelStr, handler)
 }
 
+// OverrideRootLogLevel changes the level of the root logger, if enabled, and
+// of the go-libp2p loggers.
+func OverrideRootLogLevel(levelStr string) error {
+	rootHandlerMu.Lock()
+	handler := rootHandler
+	rootHandlerMu.Unlock()
+
+	if handler == nil {
+		return setLibp2pLogLevel(strings.ToLower(levelStr))
+	}
+	return enableRootLog(levelStr, handler)
+}
+
 func disableRootLog() {
+	rootHandlerMu.Lock()
+	rootHandler = nil
+	rootHandlerMu.Unlock()
+
 	log.Root().SetHandler(log.DiscardHandler())
 }
 
@@ -76,9 +101,17 @@ func enableRootLog(levelStr string, handler log.Handler) error {
 		return err
 	}
 
+	rootHandlerMu.Lock()
+	rootHandler = handler
+	rootHandlerMu.Unlock()
+
 	filteredHandler := log.LvlFilterHandler(level, handler)
 	log.Root().SetHandler(filteredHandler)
 
+	return setLibp2pLogLevel(levelStr)
+}
+
+func setLibp2pLogLevel(levelStr string) error {
 	// go-libp2p logger
 	lvl, err := logging.LevelFromString(levelStr)
 	if err != nil {
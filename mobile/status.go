@@ -1,6 +1,7 @@
 package statusgo
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -378,6 +379,12 @@ func DeleteMultiaccount(keyUID, keyStoreDir string) string {
 	return makeJSONResponse(nil)
 }
 
+// DeleteAccount deletes an account and its data once its password is verified
+func DeleteAccount(keyUID, password string) string {
+	err := statusBackend.DeleteAccount(context.Background(), keyUID, password)
+	return makeJSONResponse(err)
+}
+
 // DeleteImportedKey
 func DeleteImportedKey(address, password, keyStoreDir string) string {
 	err := statusBackend.DeleteImportedKey(address, password, keyStoreDir)
@@ -889,6 +896,12 @@ func ChangeDatabasePassword(KeyUID, password, newPassword string) string {
 	return makeJSONResponse(nil)
 }
 
+// RekeyKeystoreFiles re-encrypts the keystore files and the database of an account with a new password
+func RekeyKeystoreFiles(keyUID, oldPassword, newPassword string) string {
+	err := statusBackend.RekeyKeystoreFiles(keyUID, oldPassword, newPassword)
+	return makeJSONResponse(err)
+}
+
 func ConvertToKeycardAccount(accountData, settingsJSON, password, newPassword string) string {
 	var account multiaccounts.Account
 	err := json.Unmarshal([]byte(accountData), &account)
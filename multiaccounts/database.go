@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/status-im/status-go/images"
@@ -12,6 +13,11 @@ import (
 	"github.com/status-im/status-go/sqlite"
 )
 
+var (
+	ErrNotAnimatedImage      = errors.New("animated image must be a GIF or a WebP")
+	ErrAnimatedImageTooLarge = errors.New("animated image is too large")
+)
+
 type ColorHash [][2]int
 
 type CustomizationColor string
@@ -441,6 +447,27 @@ func (db *Database) StoreIdentityImages(keyUID string, iis []images.IdentityImag
 	return nil
 }
 
+// StoreAnimatedIdentityImage stores the animated variant of the profile picture of an account,
+// next to its static images
+func (db *Database) StoreAnimatedIdentityImage(keyUID string, payload []byte, clock uint64) error {
+	if len(payload) > images.MaxAnimatedIdentitySize {
+		return ErrAnimatedImageTooLarge
+	}
+
+	switch images.GetType(payload) {
+	case images.GIF, images.WEBP:
+	default:
+		return ErrNotAnimatedImage
+	}
+
+	return db.StoreIdentityImages(keyUID, []images.IdentityImage{{
+		Name:     images.AnimatedIdentityName,
+		Payload:  payload,
+		FileSize: len(payload),
+		Clock:    clock,
+	}}, true)
+}
+
 func (db *Database) SubscribeToIdentityImageChanges() chan struct{} {
 	s := make(chan struct{}, 100)
 	db.identityImageSubscriptions = append(db.identityImageSubscriptions, s)
@@ -15,4 +15,8 @@ var (
 	ErrNewClockOlderThanCurrent = errors.New("the new clock value is older than the current clock value")
 	// ErrUnrecognisedSyncSettingProtobufType returned if there is no handler or record of a given protobuf.SyncSetting_Type
 	ErrUnrecognisedSyncSettingProtobufType = errors.New("unrecognised protobuf.SyncSetting_Type")
+	// ErrUnknownFleet returned if a fleet isn't one of the fleets shipped with the node
+	ErrUnknownFleet = errors.New("unknown fleet")
+	// ErrCustomFleetWithoutClusterConfigFile returned if the custom fleet is selected without a cluster config file
+	ErrCustomFleetWithoutClusterConfigFile = errors.New("the custom fleet requires a cluster config file")
 )
@@ -20,6 +20,15 @@ var (
 		dBColumnName:   "auto_message_enabled",
 		valueHandler:   BoolHandler,
 	}
+	AutoPurgeEnabled = SettingField{
+		reactFieldName: "auto-purge-enabled?",
+		dBColumnName:   "auto_purge_enabled",
+		valueHandler:   BoolHandler,
+	}
+	AutoPurgeRetentionDays = SettingField{
+		reactFieldName: "auto-purge-retention-days",
+		dBColumnName:   "auto_purge_retention_days",
+	}
 	BackupEnabled = SettingField{
 		reactFieldName: "backup-enabled?",
 		dBColumnName:   "backup_enabled",
@@ -91,6 +100,7 @@ var (
 	Fleet = SettingField{
 		reactFieldName: "fleet",
 		dBColumnName:   "fleet",
+		valueHandler:   FleetHandler,
 	}
 	GifAPIKey = SettingField{
 		reactFieldName: "gifs/api-key",
@@ -412,6 +422,8 @@ var (
 		AnonMetricsShouldSend,
 		Appearance,
 		AutoMessageEnabled,
+		AutoPurgeEnabled,
+		AutoPurgeRetentionDays,
 		BackupEnabled,
 		BackupFetched,
 		ChaosMode,
@@ -8,6 +8,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/log"
+
 	"github.com/status-im/status-go/appdatabase"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/multiaccounts/errors"
@@ -28,6 +30,14 @@ var (
 type Database struct {
 	db        *sql.DB
 	SyncQueue chan SyncSettingField
+
+	changesSubscriptions   []chan SyncSettingField
+	changesSubscriptionsMu sync.Mutex
+
+	// cache holds the raw values of the settings read through it, by column name.
+	// It is nil for in-memory databases, which aren't singletons.
+	cache   map[string]interface{}
+	cacheMu sync.RWMutex
 }
 
 // MakeNewDB ensures that a singleton instance of Database is returned per sqlite db file
@@ -48,6 +58,9 @@ func MakeNewDB(db *sql.DB) (*Database, error) {
 		return d, nil
 	}
 
+	// Other instances can't write to this database, so its settings can be cached
+	d.cache = make(map[string]interface{})
+
 	// Lock to protect the map from concurrent access
 	mutex.Lock()
 	defer mutex.Unlock()
@@ -80,6 +93,7 @@ func (db *Database) CreateSettings(s Settings, n params.NodeConfig) error {
 	defer func() {
 		if err == nil {
 			err = tx.Commit()
+			db.clearSettingsCache()
 			return
 		}
 		// don't shadow original error
@@ -166,7 +180,11 @@ func (db *Database) getSettingFieldFromReactName(reactName string) (SettingField
 	return SettingField{}, errors.ErrInvalidConfig
 }
 
-func (db *Database) makeSelectRow(setting SettingField) *sql.Row {
+func (db *Database) makeSelectRow(setting SettingField) settingRow {
+	return settingRow{db: db, field: setting}
+}
+
+func (db *Database) selectSetting(setting SettingField) *sql.Row {
 	query := "SELECT %s FROM settings WHERE synthetic_id = 'id'"
 	query = fmt.Sprintf(query, setting.GetDBName())
 	return db.db.QueryRow(query)
@@ -194,7 +212,12 @@ func (db *Database) saveSetting(setting SettingField, value interface{}) error {
 	}
 
 	_, err = update.Exec(value)
-	return err
+	if err != nil {
+		return err
+	}
+
+	db.invalidateCachedSetting(setting)
+	return nil
 }
 
 func (db *Database) parseSaveAndSyncSetting(sf SettingField, value interface{}) (err error) {
@@ -205,6 +228,13 @@ func (db *Database) parseSaveAndSyncSetting(sf SettingField, value interface{})
 		}
 	}
 
+	// The custom fleet needs the cluster config file of the stored node config
+	if Fleet.GetReactName() == sf.GetReactName() {
+		if err = db.validateFleet(value.(string)); err != nil {
+			return err
+		}
+	}
+
 	// TODO(samyoul) this is ugly as hell need a more elegant solution
 	if NodeConfig.GetReactName() == sf.GetReactName() {
 		if err = nodecfg.SaveNodeConfig(db.db, value.(*params.NodeConfig)); err != nil {
@@ -221,9 +251,59 @@ func (db *Database) parseSaveAndSyncSetting(sf SettingField, value interface{})
 	if sf.CanSync(FromInterface) {
 		db.SyncQueue <- SyncSettingField{sf, value}
 	}
+	db.publishOnChangesSubscriptions(SyncSettingField{sf, value})
 	return nil
 }
 
+func (db *Database) validateFleet(fleet string) error {
+	clusterConfigFile, err := nodecfg.GetClusterConfigFile(db.db)
+	if err != nil {
+		return err
+	}
+
+	_, err = ValidateFleet(fleet, clusterConfigFile)
+	return err
+}
+
+// SubscribeToChanges returns a channel the settings are published on each
+// time they are saved
+func (db *Database) SubscribeToChanges() chan SyncSettingField {
+	db.changesSubscriptionsMu.Lock()
+	defer db.changesSubscriptionsMu.Unlock()
+
+	s := make(chan SyncSettingField, 100)
+	db.changesSubscriptions = append(db.changesSubscriptions, s)
+	return s
+}
+
+// UnsubscribeFromChanges stops publishing the changes on a channel returned
+// by SubscribeToChanges
+func (db *Database) UnsubscribeFromChanges(s chan SyncSettingField) {
+	db.changesSubscriptionsMu.Lock()
+	defer db.changesSubscriptionsMu.Unlock()
+
+	for i, subscription := range db.changesSubscriptions {
+		if subscription == s {
+			db.changesSubscriptions = append(db.changesSubscriptions[:i], db.changesSubscriptions[i+1:]...)
+			return
+		}
+	}
+}
+
+func (db *Database) publishOnChangesSubscriptions(change SyncSettingField) {
+	db.changesSubscriptionsMu.Lock()
+	defer db.changesSubscriptionsMu.Unlock()
+
+	// Publish on channels, drop if buffer is full
+	for _, s := range db.changesSubscriptions {
+		select {
+		case s <- change:
+		default:
+			log.Warn("subscription channel full, dropping message")
+		}
+	}
+}
+
 // SaveSetting stores data from any non-sync source
 // If the field requires syncing the field data is pushed on to the SyncQueue
 func (db *Database) SaveSetting(setting string, value interface{}) error {
@@ -258,7 +338,13 @@ func (db *Database) SaveSyncSetting(setting SettingField, value interface{}, clo
 		return err
 	}
 
-	return db.saveSetting(setting, value)
+	err = db.saveSetting(setting, value)
+	if err != nil {
+		return err
+	}
+
+	db.publishOnChangesSubscriptions(SyncSettingField{setting, value})
+	return nil
 }
 
 func (db *Database) GetSettingLastSynced(setting SettingField) (result uint64, err error) {
@@ -287,7 +373,7 @@ func (db *Database) SetSettingLastSynced(setting SettingField, clock uint64) err
 
 func (db *Database) GetSettings() (Settings, error) {
 	var s Settings
-	err := db.db.QueryRow("SELECT address, anon_metrics_should_send, chaos_mode, currency, current_network, custom_bootnodes, custom_bootnodes_enabled, dapps_address, display_name, bio, eip1581_address, fleet, hide_home_tooltip, installation_id, key_uid, keycard_instance_uid, keycard_paired_on, keycard_pairing, last_updated, latest_derived_path, link_preview_request_enabled, link_previews_enabled_sites, log_level, mnemonic, name, networks, notifications_enabled, push_notifications_server_enabled, push_notifications_from_contacts_only, remote_push_notifications_enabled, send_push_notifications, push_notifications_block_mentions, photo_path, pinned_mailservers, preferred_name, preview_privacy, public_key, remember_syncing_choice, signing_phrase, stickers_packs_installed, stickers_packs_pending, stickers_recent_stickers, syncing_on_mobile_network, default_sync_period, use_mailservers, messages_from_contacts_only, usernames, appearance, profile_pictures_show_to, profile_pictures_visibility, wallet_root_address, wallet_set_up_passed, wallet_visible_tokens, waku_bloom_filter_mode, webview_allow_permission_requests, current_user_status, send_status_updates, gif_recents, gif_favorites, opensea_enabled, last_backup, backup_enabled, telemetry_server_url, auto_message_enabled, gif_api_key, test_networks_enabled, mutual_contact_enabled FROM settings WHERE synthetic_id = 'id'").Scan(
+	err := db.db.QueryRow("SELECT address, anon_metrics_should_send, chaos_mode, currency, current_network, custom_bootnodes, custom_bootnodes_enabled, dapps_address, display_name, bio, eip1581_address, fleet, hide_home_tooltip, installation_id, key_uid, keycard_instance_uid, keycard_paired_on, keycard_pairing, last_updated, latest_derived_path, link_preview_request_enabled, link_previews_enabled_sites, log_level, mnemonic, name, networks, notifications_enabled, push_notifications_server_enabled, push_notifications_from_contacts_only, remote_push_notifications_enabled, send_push_notifications, push_notifications_block_mentions, photo_path, pinned_mailservers, preferred_name, preview_privacy, public_key, remember_syncing_choice, signing_phrase, stickers_packs_installed, stickers_packs_pending, stickers_recent_stickers, syncing_on_mobile_network, default_sync_period, use_mailservers, messages_from_contacts_only, usernames, appearance, profile_pictures_show_to, profile_pictures_visibility, wallet_root_address, wallet_set_up_passed, wallet_visible_tokens, waku_bloom_filter_mode, webview_allow_permission_requests, current_user_status, send_status_updates, gif_recents, gif_favorites, opensea_enabled, last_backup, backup_enabled, telemetry_server_url, auto_message_enabled, gif_api_key, test_networks_enabled, mutual_contact_enabled, auto_purge_enabled, auto_purge_retention_days FROM settings WHERE synthetic_id = 'id'").Scan(
 		&s.Address,
 		&s.AnonMetricsShouldSend,
 		&s.ChaosMode,
@@ -355,6 +441,8 @@ func (db *Database) GetSettings() (Settings, error) {
 		&s.GifAPIKey,
 		&s.TestNetworksEnabled,
 		&s.MutualContactEnabled,
+		&s.AutoPurgeEnabled,
+		&s.AutoPurgeRetentionDays,
 	)
 
 	return s, err
@@ -479,6 +567,26 @@ func (db *Database) BackupEnabled() (result bool, err error) {
 	return result, err
 }
 
+// DefaultAutoPurgeRetentionDays is how many days messages are kept for when
+// purging old messages is enabled, it matches the default of the column
+const DefaultAutoPurgeRetentionDays = 90
+
+func (db *Database) AutoPurgeEnabled() (result bool, err error) {
+	err = db.makeSelectRow(AutoPurgeEnabled).Scan(&result)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return result, err
+}
+
+func (db *Database) AutoPurgeRetentionDays() (result int, err error) {
+	err = db.makeSelectRow(AutoPurgeRetentionDays).Scan(&result)
+	if err == sql.ErrNoRows {
+		return DefaultAutoPurgeRetentionDays, nil
+	}
+	return result, err
+}
+
 func (db *Database) AutoMessageEnabled() (result bool, err error) {
 	err = db.makeSelectRow(AutoMessageEnabled).Scan(&result)
 	if err == sql.ErrNoRows {
@@ -548,6 +656,17 @@ func (db *Database) GifFavorites() (favorites json.RawMessage, err error) {
 	return favorites, nil
 }
 
+func (db *Database) LinkPreviewRequestEnabled() (result bool, err error) {
+	err = db.makeSelectRow(LinkPreviewRequestEnabled).Scan(&result)
+	return result, err
+}
+
+// LinkPreviewsEnabledSites returns the addresses of the sites the user enabled link previews for
+func (db *Database) LinkPreviewsEnabledSites() (sites []string, err error) {
+	err = db.makeSelectRow(LinkPreviewsEnabledSites).Scan(&sqlite.JSONBlob{Data: &sites})
+	return sites, err
+}
+
 func (db *Database) GetPreferredUsername() (string, error) {
 	return db.makeSelectString(PreferredName)
 }
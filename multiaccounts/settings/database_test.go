@@ -29,6 +29,7 @@ var (
 		InstallationID:            "d3efcff6-cffa-560e-a547-21d3858cbc51",
 		KeyUID:                    "0x4e8129f3edfc004875be17bf468a784098a9f69b53c095be1f52deff286935ab",
 		BackupEnabled:             true,
+		AutoPurgeRetentionDays:    DefaultAutoPurgeRetentionDays,
 		LatestDerivedPath:         0,
 		Name:                      "Jittery Cornflowerblue Kingbird",
 		Networks:                  &networks,
@@ -46,7 +47,7 @@ var (
 		WalletRootAddress:         types.HexToAddress("0x3B591fd819F86D0A6a2EF2Bcb94f77807a7De1a6")}
 )
 
-func setupTestDB(t *testing.T) (*Database, func()) {
+func setupTestDB(t testing.TB) (*Database, func()) {
 	db, stop, err := appdatabase.SetupTestSQLDB("settings-tests-")
 	if err != nil {
 		require.NoError(t, stop())
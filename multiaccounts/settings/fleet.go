@@ -0,0 +1,60 @@
+package settings
+
+import (
+	"github.com/status-im/status-go/multiaccounts/errors"
+	"github.com/status-im/status-go/params"
+)
+
+// FleetSelector is the name of a fleet known to the node, or FleetCustom for
+// a cluster config loaded from a file
+type FleetSelector string
+
+const (
+	FleetStatusProd    FleetSelector = params.FleetStatusProd
+	FleetStatusStaging FleetSelector = params.FleetStatusStaging
+	FleetStatusTest    FleetSelector = params.FleetStatusTest
+	FleetEthProd       FleetSelector = params.FleetProd
+	FleetEthStaging    FleetSelector = params.FleetStaging
+	FleetEthTest       FleetSelector = params.FleetTest
+	FleetWakuV2Prod    FleetSelector = params.FleetWakuV2Prod
+	FleetWakuV2Test    FleetSelector = params.FleetWakuV2Test
+	FleetWakuSandbox   FleetSelector = params.FleetWakuSandbox
+	FleetCustom        FleetSelector = "custom"
+)
+
+var knownFleets = map[FleetSelector]bool{
+	FleetStatusProd:    true,
+	FleetStatusStaging: true,
+	FleetStatusTest:    true,
+	FleetEthProd:       true,
+	FleetEthStaging:    true,
+	FleetEthTest:       true,
+	FleetWakuV2Prod:    true,
+	FleetWakuV2Test:    true,
+	FleetWakuSandbox:   true,
+	FleetCustom:        true,
+}
+
+// ParseFleet returns the fleet named `s`, or ErrUnknownFleet
+func ParseFleet(s string) (FleetSelector, error) {
+	fleet := FleetSelector(s)
+	if !knownFleets[fleet] {
+		return "", errors.ErrUnknownFleet
+	}
+	return fleet, nil
+}
+
+// ValidateFleet parses a fleet, FleetCustom is only valid along with the
+// cluster config file providing its nodes
+func ValidateFleet(s string, clusterConfigFile string) (FleetSelector, error) {
+	fleet, err := ParseFleet(s)
+	if err != nil {
+		return "", err
+	}
+
+	if fleet == FleetCustom && clusterConfigFile == "" {
+		return "", errors.ErrCustomFleetWithoutClusterConfigFile
+	}
+
+	return fleet, nil
+}
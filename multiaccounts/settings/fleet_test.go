@@ -0,0 +1,69 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/multiaccounts/errors"
+	"github.com/status-im/status-go/nodecfg"
+)
+
+func TestParseFleet(t *testing.T) {
+	for fleet := range knownFleets {
+		parsed, err := ParseFleet(string(fleet))
+		require.NoError(t, err)
+		require.Equal(t, fleet, parsed)
+	}
+
+	for _, invalid := range []string{"", "prod", "STATUS.PROD", " status.prod", "status.sandbox", "wakuv2"} {
+		_, err := ParseFleet(invalid)
+		require.ErrorIs(t, err, errors.ErrUnknownFleet, invalid)
+	}
+}
+
+func TestValidateFleet(t *testing.T) {
+	fleet, err := ValidateFleet(string(FleetStatusProd), "")
+	require.NoError(t, err)
+	require.Equal(t, FleetStatusProd, fleet)
+
+	fleet, err = ValidateFleet(string(FleetCustom), "/path/to/cluster.json")
+	require.NoError(t, err)
+	require.Equal(t, FleetCustom, fleet)
+
+	_, err = ValidateFleet(string(FleetCustom), "")
+	require.ErrorIs(t, err, errors.ErrCustomFleetWithoutClusterConfigFile)
+
+	_, err = ValidateFleet("unknown", "/path/to/cluster.json")
+	require.ErrorIs(t, err, errors.ErrUnknownFleet)
+}
+
+func TestSaveFleetSetting(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+
+	require.NoError(t, db.SaveSetting(Fleet.GetReactName(), string(FleetWakuV2Prod)))
+	fleet, err := db.GetFleet()
+	require.NoError(t, err)
+	require.Equal(t, string(FleetWakuV2Prod), fleet)
+
+	require.ErrorIs(t, db.SaveSetting(Fleet.GetReactName(), "unknown"), errors.ErrUnknownFleet)
+	require.ErrorIs(t, db.SaveSetting(Fleet.GetReactName(), string(FleetCustom)), errors.ErrCustomFleetWithoutClusterConfigFile)
+	require.ErrorIs(t, db.SaveSetting(Fleet.GetReactName(), 1), errors.ErrInvalidConfig)
+
+	fleet, err = db.GetFleet()
+	require.NoError(t, err)
+	require.Equal(t, string(FleetWakuV2Prod), fleet)
+
+	// The custom fleet is accepted once the node config has a cluster config file
+	customConfig := config
+	customConfig.ClusterConfig.ClusterConfigFile = "/path/to/cluster.json"
+	require.NoError(t, nodecfg.SaveNodeConfig(db.db, &customConfig))
+	require.NoError(t, db.SaveSetting(Fleet.GetReactName(), string(FleetCustom)))
+
+	fleet, err = db.GetFleet()
+	require.NoError(t, err)
+	require.Equal(t, string(FleetCustom), fleet)
+}
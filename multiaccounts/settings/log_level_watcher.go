@@ -0,0 +1,54 @@
+package settings
+
+import (
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/logutils"
+)
+
+// LogLevelWatcher applies the log level setting each time it's saved, so
+// that changing it doesn't require a restart.
+type LogLevelWatcher struct {
+	db      *Database
+	changes chan SyncSettingField
+	quit    chan struct{}
+}
+
+func NewLogLevelWatcher(db *Database) *LogLevelWatcher {
+	return &LogLevelWatcher{
+		db:   db,
+		quit: make(chan struct{}),
+	}
+}
+
+func (w *LogLevelWatcher) Start() {
+	w.changes = w.db.SubscribeToChanges()
+
+	go func() {
+		for {
+			select {
+			case change := <-w.changes:
+				if change.GetReactName() != LogLevel.GetReactName() {
+					continue
+				}
+
+				level, ok := change.Value.(string)
+				if !ok || level == "" {
+					continue
+				}
+
+				err := logutils.OverrideRootLogLevel(level)
+				if err != nil {
+					log.Error("failed to apply log level", "level", level, "error", err)
+				}
+			case <-w.quit:
+				return
+			}
+		}
+	}()
+}
+
+func (w *LogLevelWatcher) Stop() {
+	w.db.UnsubscribeFromChanges(w.changes)
+	close(w.quit)
+}
@@ -0,0 +1,50 @@
+package settings
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/logutils"
+)
+
+func TestLogLevelWatcher(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+
+	logFile := filepath.Join(t.TempDir(), "status.log")
+	require.NoError(t, logutils.OverrideRootLog(true, "ERROR", logutils.FileOptions{Filename: logFile}, false))
+	defer func() {
+		require.NoError(t, logutils.OverrideRootLog(false, "", logutils.FileOptions{}, false))
+	}()
+
+	watcher := NewLogLevelWatcher(db)
+	watcher.Start()
+	defer watcher.Stop()
+
+	debugLogged := func(msg string) bool {
+		log.Debug(msg)
+		// the log file is only created once something is logged
+		content, err := os.ReadFile(logFile)
+		if os.IsNotExist(err) {
+			return false
+		}
+		require.NoError(t, err)
+		return strings.Contains(string(content), msg)
+	}
+
+	require.False(t, debugLogged("debug at error level"))
+
+	require.NoError(t, db.SaveSettingField(LogLevel, "DEBUG"))
+	require.Eventually(t, func() bool {
+		return debugLogged("debug at debug level")
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,223 @@
+package settings
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// settingRow reads a single setting through the settings cache, it can be
+// scanned like the *sql.Row of a query selecting the setting's column
+type settingRow struct {
+	db    *Database
+	field SettingField
+}
+
+func (r settingRow) Scan(dest interface{}) error {
+	value, err := r.db.lazyGet(r.field)
+	if err != nil {
+		return err
+	}
+	return convertSettingValue(dest, value)
+}
+
+// LazyGet returns the raw database value of a setting, as scanned by the sql
+// driver except for text and blob values which are returned as strings.
+// Values are only read from the database the first time they are requested,
+// or after being saved.
+func (db *Database) LazyGet(field SettingField) (interface{}, error) {
+	value, err := db.lazyGet(field)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if b, ok := value.([]byte); ok {
+		return string(b), nil
+	}
+	return value, nil
+}
+
+func (db *Database) lazyGet(field SettingField) (value interface{}, err error) {
+	if db.cache == nil {
+		err = db.selectSetting(field).Scan(&value)
+		return value, err
+	}
+
+	db.cacheMu.RLock()
+	value, ok := db.cache[field.GetDBName()]
+	db.cacheMu.RUnlock()
+	if ok {
+		return value, nil
+	}
+
+	// The lock is held while reading so that a concurrent save can't be
+	// overwritten in the cache by the value read before it
+	db.cacheMu.Lock()
+	defer db.cacheMu.Unlock()
+
+	value, ok = db.cache[field.GetDBName()]
+	if ok {
+		return value, nil
+	}
+
+	err = db.selectSetting(field).Scan(&value)
+	if err != nil {
+		return nil, err
+	}
+
+	db.cache[field.GetDBName()] = value
+	return value, nil
+}
+
+// PreloadSettings reads the given settings in a single query, so that
+// the settings needed at startup don't each hit the database
+func (db *Database) PreloadSettings(fields []SettingField) error {
+	if len(fields) == 0 || db.cache == nil {
+		return nil
+	}
+
+	columns := make([]string, len(fields))
+	values := make([]interface{}, len(fields))
+	dest := make([]interface{}, len(fields))
+	for i, field := range fields {
+		columns[i] = field.GetDBName()
+		dest[i] = &values[i]
+	}
+
+	db.cacheMu.Lock()
+	defer db.cacheMu.Unlock()
+
+	query := fmt.Sprintf("SELECT %s FROM settings WHERE synthetic_id = 'id'", strings.Join(columns, ", "))
+	err := db.db.QueryRow(query).Scan(dest...)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for i, column := range columns {
+		db.cache[column] = values[i]
+	}
+
+	return nil
+}
+
+func (db *Database) invalidateCachedSetting(field SettingField) {
+	if db.cache == nil {
+		return
+	}
+
+	db.cacheMu.Lock()
+	defer db.cacheMu.Unlock()
+	delete(db.cache, field.GetDBName())
+}
+
+func (db *Database) clearSettingsCache() {
+	if db.cache == nil {
+		return
+	}
+
+	db.cacheMu.Lock()
+	defer db.cacheMu.Unlock()
+	db.cache = make(map[string]interface{})
+}
+
+// convertSettingValue stores a raw database value in dest, following the
+// conversions database/sql does when scanning the value of a column
+func convertSettingValue(dest interface{}, value interface{}) error {
+	// Cached values are shared, scanners must not retain them
+	if b, ok := value.([]byte); ok {
+		value = append([]byte(nil), b...)
+	}
+
+	if scanner, ok := dest.(sql.Scanner); ok {
+		return scanner.Scan(value)
+	}
+
+	dv := reflect.ValueOf(dest)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() {
+		return fmt.Errorf("destination not a pointer")
+	}
+	dv = dv.Elem()
+
+	if dv.Kind() == reflect.Ptr {
+		if value == nil {
+			dv.Set(reflect.Zero(dv.Type()))
+			return nil
+		}
+		dv.Set(reflect.New(dv.Type().Elem()))
+		return convertSettingValue(dv.Interface(), value)
+	}
+
+	if value == nil {
+		return fmt.Errorf("converting NULL to %s is unsupported", dv.Kind())
+	}
+
+	if dv.Kind() == reflect.Interface {
+		dv.Set(reflect.ValueOf(value))
+		return nil
+	}
+
+	var text string
+	switch v := value.(type) {
+	case []byte:
+		if dv.Kind() == reflect.Slice && dv.Type().Elem().Kind() == reflect.Uint8 {
+			dv.SetBytes(v)
+			return nil
+		}
+		text = string(v)
+	case string:
+		text = v
+	case bool:
+		if dv.Kind() == reflect.Bool {
+			dv.SetBool(v)
+			return nil
+		}
+		text = strconv.FormatBool(v)
+	default:
+		text = fmt.Sprint(v)
+	}
+
+	switch dv.Kind() {
+	case reflect.String:
+		dv.SetString(text)
+	case reflect.Slice:
+		if dv.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unsupported scan, storing %T into %T", value, dest)
+		}
+		dv.SetBytes([]byte(text))
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return fmt.Errorf("converting %q to a bool: %w", text, err)
+		}
+		dv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("converting %q to a %s: %w", text, dv.Kind(), err)
+		}
+		dv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(text, 10, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("converting %q to a %s: %w", text, dv.Kind(), err)
+		}
+		dv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("converting %q to a %s: %w", text, dv.Kind(), err)
+		}
+		dv.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported scan, storing %T into %T", value, dest)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package settings
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLazyGet(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+
+	value, err := db.LazyGet(Name)
+	require.NoError(t, err)
+	require.Equal(t, settings.Name, value)
+
+	// Cached values are returned without hitting the database
+	_, err = db.db.Exec(`UPDATE settings SET name = 'Updated Outside Of The Cache' WHERE synthetic_id = 'id'`)
+	require.NoError(t, err)
+	value, err = db.LazyGet(Name)
+	require.NoError(t, err)
+	require.Equal(t, settings.Name, value)
+
+	// Saving a setting invalidates its cached value
+	require.NoError(t, db.SaveSettingField(Name, "Saved Name"))
+	value, err = db.LazyGet(Name)
+	require.NoError(t, err)
+	require.Equal(t, "Saved Name", value)
+}
+
+func TestPreloadSettings(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+	require.NoError(t, db.PreloadSettings([]SettingField{Name, PublicKey, DefaultSyncPeriod}))
+
+	_, err := db.db.Exec(`UPDATE settings SET name = 'Updated', public_key = 'Updated', default_sync_period = 0, currency = 'eur' WHERE synthetic_id = 'id'`)
+	require.NoError(t, err)
+
+	value, err := db.LazyGet(Name)
+	require.NoError(t, err)
+	require.Equal(t, settings.Name, value)
+
+	value, err = db.LazyGet(PublicKey)
+	require.NoError(t, err)
+	require.Equal(t, settings.PublicKey, value)
+
+	value, err = db.LazyGet(DefaultSyncPeriod)
+	require.NoError(t, err)
+	require.Equal(t, int64(settings.DefaultSyncPeriod), value)
+
+	// Settings which weren't preloaded are read from the database
+	value, err = db.LazyGet(Currency)
+	require.NoError(t, err)
+	require.Equal(t, "eur", value)
+
+	require.Error(t, db.PreloadSettings([]SettingField{{dBColumnName: "a_column_that_does_n0t_exist"}}))
+}
+
+func TestGettersReadThroughCache(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+	require.NoError(t, db.SaveSettingField(LinkPreviewsEnabledSites, []string{"status.im"}))
+	require.NoError(t, db.PreloadSettings([]SettingField{DisplayName, DefaultSyncPeriod, BackupEnabled, LinkPreviewsEnabledSites, DappsAddress}))
+
+	_, err := db.db.Exec(`UPDATE settings SET display_name = 'Updated', default_sync_period = 0, backup_enabled = 0, link_previews_enabled_sites = NULL, dapps_address = '0x0000000000000000000000000000000000000000' WHERE synthetic_id = 'id'`)
+	require.NoError(t, err)
+
+	displayName, err := db.DisplayName()
+	require.NoError(t, err)
+	require.Equal(t, settings.DisplayName, displayName)
+
+	syncPeriod, err := db.GetDefaultSyncPeriod()
+	require.NoError(t, err)
+	require.Equal(t, uint32(settings.DefaultSyncPeriod), syncPeriod)
+
+	backupEnabled, err := db.BackupEnabled()
+	require.NoError(t, err)
+	require.True(t, backupEnabled)
+
+	sites, err := db.LinkPreviewsEnabledSites()
+	require.NoError(t, err)
+	require.Equal(t, []string{"status.im"}, sites)
+
+	dappsAddress, err := db.GetDappsAddress()
+	require.NoError(t, err)
+	require.Equal(t, settings.DappsAddress, dappsAddress)
+
+	// Saved settings are read again from the database
+	require.NoError(t, db.SaveSettingField(DisplayName, "Saved Name"))
+	displayName, err = db.DisplayName()
+	require.NoError(t, err)
+	require.Equal(t, "Saved Name", displayName)
+
+	// Cached NULLs are scanned as they are when read from the database
+	_, err = db.db.Exec(`UPDATE settings SET preferred_name = NULL, send_status_updates = NULL WHERE synthetic_id = 'id'`)
+	require.NoError(t, err)
+	require.NoError(t, db.PreloadSettings([]SettingField{PreferredName, SendStatusUpdates}))
+
+	ensName, err := db.ENSName()
+	require.NoError(t, err)
+	require.Equal(t, "", ensName)
+
+	_, err = db.ShouldBroadcastUserStatus()
+	require.Error(t, err)
+}
+
+// BenchmarkSettingsStartup compares reading a settings DB with 50 entries
+// eagerly with GetSettings, and lazily with PreloadSettings or LazyGet when
+// only a few of them or all of them are needed
+func BenchmarkSettingsStartup(b *testing.B) {
+	db, stop := setupTestDB(b)
+	defer stop()
+
+	require.NoError(b, db.CreateSettings(settings, config))
+	fields := SettingFieldRegister[:50]
+
+	b.Run("GetSettings", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, err := db.GetSettings()
+			require.NoError(b, err)
+		}
+	})
+
+	for _, n := range []int{5, len(fields)} {
+		b.Run(fmt.Sprintf("PreloadSettings/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				db.clearSettingsCache()
+				require.NoError(b, db.PreloadSettings(fields[:n]))
+			}
+		})
+
+		b.Run(fmt.Sprintf("LazyGet/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				db.clearSettingsCache()
+				for _, field := range fields[:n] {
+					_, err := db.LazyGet(field)
+					require.NoError(b, err)
+				}
+			}
+		})
+	}
+}
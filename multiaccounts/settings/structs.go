@@ -185,6 +185,8 @@ type Settings struct {
 	LastBackup                     uint64                        `json:"last-backup,omitempty"`
 	BackupEnabled                  bool                          `json:"backup-enabled?,omitempty"`
 	AutoMessageEnabled             bool                          `json:"auto-message-enabled?,omitempty"`
+	AutoPurgeEnabled               bool                          `json:"auto-purge-enabled?,omitempty"`
+	AutoPurgeRetentionDays         int                           `json:"auto-purge-retention-days,omitempty"`
 	GifAPIKey                      string                        `json:"gifs/api-key"`
 	TestNetworksEnabled            bool                          `json:"test-networks-enabled?,omitempty"`
 }
@@ -49,6 +49,22 @@ func AddressHandler(value interface{}) (interface{}, error) {
 	return value, nil
 }
 
+// FleetHandler only accepts the fleets known to the node, whether the custom
+// fleet has a cluster config file is checked against the stored node config
+func FleetHandler(value interface{}) (interface{}, error) {
+	str, ok := value.(string)
+	if !ok {
+		return value, errors.ErrInvalidConfig
+	}
+
+	fleet, err := ParseFleet(str)
+	if err != nil {
+		return value, err
+	}
+
+	return string(fleet), nil
+}
+
 func NodeConfigHandler(value interface{}) (interface{}, error) {
 	jsonString, err := json.Marshal(value)
 	if err != nil {
@@ -0,0 +1,53 @@
+//go:build testing
+// +build testing
+
+package settings
+
+import (
+	"testing"
+)
+
+// watchFieldBufferSize is the number of values WatchField buffers, further
+// values are dropped until the test reads them
+const watchFieldBufferSize = 10
+
+// WatchField returns a channel delivering the new values of a setting each
+// time it is saved, so that tests can wait for a change instead of polling.
+// The writer is never blocked, values are dropped when the buffer is full.
+// The watch stops when the test completes.
+func (db *Database) WatchField(t *testing.T, field SettingField) <-chan interface{} {
+	changes := db.SubscribeToChanges()
+	values := make(chan interface{}, watchFieldBufferSize)
+	done := make(chan struct{})
+	exited := make(chan struct{})
+
+	go func() {
+		defer close(exited)
+		for {
+			select {
+			case <-done:
+				return
+			case change := <-changes:
+				if change.GetDBName() != field.GetDBName() {
+					continue
+				}
+
+				select {
+				case values <- change.Value:
+				default:
+					t.Logf("settings watch buffer full, dropping %s value", field.GetReactName())
+				}
+			}
+		}
+	}()
+
+	// The goroutine must have exited before the test completes, as it logs
+	// through t
+	t.Cleanup(func() {
+		close(done)
+		<-exited
+		db.UnsubscribeFromChanges(changes)
+	})
+
+	return values
+}
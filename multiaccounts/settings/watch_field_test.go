@@ -0,0 +1,46 @@
+//go:build testing
+// +build testing
+
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestWatchField shows how to wait for a setting to change instead of polling
+func TestWatchField(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+
+	displayNames := db.WatchField(t, DisplayName)
+
+	// Other settings aren't delivered
+	require.NoError(t, db.SaveSettingField(Currency, "eur"))
+	require.NoError(t, db.SaveSettingField(DisplayName, "Alice"))
+
+	select {
+	case value := <-displayNames:
+		require.Equal(t, "Alice", value)
+	case <-time.After(time.Second):
+		require.FailNow(t, "display name change not received")
+	}
+}
+
+func TestWatchFieldDoesNotBlockWriter(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+
+	currencies := db.WatchField(t, Currency)
+	for i := 0; i < 2*watchFieldBufferSize; i++ {
+		require.NoError(t, db.SaveSettingField(Currency, "eur"))
+	}
+
+	require.Eventually(t, func() bool { return len(currencies) == watchFieldBufferSize }, time.Second, 10*time.Millisecond)
+}
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -342,6 +343,62 @@ func (n *StatusNode) StartDiscovery() error {
 	return nil
 }
 
+// StartRendezvous enables the rendezvous discovery of a running node, the
+// peers discovery is restarted with it. It's a no-op if already enabled.
+func (n *StatusNode) StartRendezvous(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if !n.isRunning() {
+		return ErrNoRunningNode
+	}
+
+	if n.config.Rendezvous && n.isDiscoveryRunning() {
+		return nil
+	}
+
+	return n.restartDiscovery(true)
+}
+
+// StopRendezvous disables the rendezvous discovery of a running node, the
+// peers discovery is restarted without it. It's a no-op if already disabled.
+func (n *StatusNode) StopRendezvous() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if !n.isRunning() {
+		return ErrNoRunningNode
+	}
+
+	if !n.config.Rendezvous {
+		return nil
+	}
+
+	return n.restartDiscovery(false)
+}
+
+func (n *StatusNode) restartDiscovery(rendezvous bool) error {
+	if n.isDiscoveryRunning() {
+		if err := n.stopDiscovery(); err != nil {
+			return err
+		}
+		n.register = nil
+		n.peerPool = nil
+		n.discovery = nil
+	}
+
+	n.config.Rendezvous = rendezvous
+
+	if !n.discoveryEnabled() {
+		return nil
+	}
+	return n.startDiscovery()
+}
+
 func (n *StatusNode) startDiscovery() error {
 	if n.isDiscoveryRunning() {
 		return ErrDiscoveryRunning
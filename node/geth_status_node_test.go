@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"math"
 	"net"
 	"os"
@@ -156,6 +157,43 @@ func TestStatusNodeStartDiscoveryManual(t *testing.T) {
 	require.IsType(t, &discovery.Rendezvous{}, n.discovery)
 }
 
+func TestStatusNodeToggleRendezvous(t *testing.T) {
+	config := params.NodeConfig{
+		NoDiscovery: true,
+		ClusterConfig: params.ClusterConfig{
+			Enabled: true,
+			// not necessarily with id, just valid multiaddr
+			RendezvousNodes: []string{"/ip4/127.0.0.1/tcp/34012", "/ip4/127.0.0.1/tcp/34011"},
+		},
+		AdvertiseAddr: "127.0.0.1",
+	}
+	n := New(nil)
+	require.EqualError(t, n.StartRendezvous(context.Background()), ErrNoRunningNode.Error())
+
+	require.NoError(t, n.Start(&config, nil))
+	defer func() { require.NoError(t, n.Stop()) }()
+	require.Nil(t, n.discovery)
+
+	// no-op when already stopped
+	require.NoError(t, n.StopRendezvous())
+	require.Nil(t, n.discovery)
+
+	require.NoError(t, n.StartRendezvous(context.Background()))
+	require.NotNil(t, n.discovery)
+	require.True(t, n.discovery.Running())
+	require.IsType(t, &discovery.Rendezvous{}, n.discovery)
+	require.NotNil(t, n.peerPool)
+
+	// no-op when already started
+	d := n.discovery
+	require.NoError(t, n.StartRendezvous(context.Background()))
+	require.Same(t, d, n.discovery)
+
+	require.NoError(t, n.StopRendezvous())
+	require.Nil(t, n.discovery)
+	require.False(t, d.Running())
+}
+
 func TestStatusNodeDiscoverNode(t *testing.T) {
 	config := params.NodeConfig{
 		NoDiscovery: true,
@@ -307,6 +307,7 @@ func (b *StatusNode) wakuV2Service(nodeConfig *params.NodeConfig, telemetryServe
 			UDPPort:              nodeConfig.WakuV2Config.UDPPort,
 			AutoUpdate:           nodeConfig.WakuV2Config.AutoUpdate,
 			TelemetryServerURL:   telemetryServerURL,
+			CommunityShards:      nodeConfig.WakuV2Config.CommunityShards,
 		}
 
 		if nodeConfig.WakuV2Config.MaxMessageSize > 0 {
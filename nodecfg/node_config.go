@@ -223,6 +223,11 @@ func insertClusterConfig(tx *sql.Tx, c *params.NodeConfig) error {
 	return err
 }
 
+func insertClusterConfigWithFile(tx *sql.Tx, c *params.NodeConfig) error {
+	_, err := tx.Exec(`INSERT OR REPLACE INTO cluster_config (enabled, fleet, cluster_config_file, synthetic_id) VALUES (?, ?, ?, 'id')`, c.ClusterConfig.Enabled, c.ClusterConfig.Fleet, c.ClusterConfig.ClusterConfigFile)
+	return err
+}
+
 func insertUpstreamConfig(tx *sql.Tx, c *params.NodeConfig) error {
 	_, err := tx.Exec(`INSERT OR REPLACE INTO upstream_config (enabled, url, synthetic_id) VALUES (?, ?, 'id')`, c.UpstreamConfig.Enabled, c.UpstreamConfig.URL)
 	return err
@@ -415,7 +420,7 @@ func nodeConfigNormalInserts() []insertFn {
 		insertLogConfig,
 		insertUpstreamConfig,
 		insertNetworkConfigWithChainColorShortName,
-		insertClusterConfig,
+		insertClusterConfigWithFile,
 		insertClusterConfigNodes,
 		insertLightETHConfig,
 		insertLightETHConfigTrustedNodes,
@@ -580,7 +585,7 @@ func loadNodeConfig(tx *sql.Tx) (*params.NodeConfig, error) {
 		nodecfg.Networks = append(nodecfg.Networks, n)
 	}
 
-	err = tx.QueryRow("SELECT enabled, fleet FROM cluster_config WHERE synthetic_id = 'id'").Scan(&nodecfg.ClusterConfig.Enabled, &nodecfg.ClusterConfig.Fleet)
+	err = tx.QueryRow("SELECT enabled, fleet, cluster_config_file FROM cluster_config WHERE synthetic_id = 'id'").Scan(&nodecfg.ClusterConfig.Enabled, &nodecfg.ClusterConfig.Fleet, &nodecfg.ClusterConfig.ClusterConfigFile)
 	if err != nil && err != sql.ErrNoRows {
 		return nil, err
 	}
@@ -823,6 +828,17 @@ func MigrateNodeConfig(db *sql.DB) error {
 	return nil
 }
 
+// GetClusterConfigFile returns the path of the file the stored cluster config
+// was loaded from, empty if it wasn't loaded from a file
+func GetClusterConfigFile(db *sql.DB) (string, error) {
+	var clusterConfigFile string
+	err := db.QueryRow("SELECT cluster_config_file FROM cluster_config WHERE synthetic_id = 'id'").Scan(&clusterConfigFile)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return clusterConfigFile, err
+}
+
 func GetNodeConfigFromDB(db *sql.DB) (*params.NodeConfig, error) {
 	tx, err := db.BeginTx(context.Background(), &sql.TxOptions{})
 	if err != nil {
@@ -10,6 +10,10 @@ const (
 	FleetWakuV2Test = "wakuv2.test"
 	FleetStatusTest = "status.test"
 	FleetStatusProd = "status.prod"
+	// FleetStatusStaging and FleetWakuSandbox have no cluster config shipped
+	// with the node, clients provide their nodes when switching to them
+	FleetStatusStaging = "status.staging"
+	FleetWakuSandbox   = "waku.sandbox"
 )
 
 // Cluster defines a list of Ethereum nodes.
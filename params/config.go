@@ -212,6 +212,9 @@ type WakuV2Config struct {
 
 	// StoreSeconds indicates the maximum number of seconds before a message is removed from the store
 	StoreSeconds int
+
+	// CommunityShards is the number of relay topics community messages are sharded on, 0 disables sharding
+	CommunityShards int
 }
 
 // ----------
@@ -246,6 +249,10 @@ type ClusterConfig struct {
 	// in `ClusterConfig`.
 	Fleet string
 
+	// ClusterConfigFile is the path of the file the cluster config was loaded
+	// from, empty for the fleets shipped with the node.
+	ClusterConfigFile string
+
 	// StaticNodes is a list of static nodes.
 	StaticNodes []string
 
@@ -909,6 +916,19 @@ func LoadClusterConfigFromFleet(fleet string) (*ClusterConfig, error) {
 	return &nodeConfig.ClusterConfig, nil
 }
 
+// LoadClusterConfigFromFile loads the cluster config of a node config JSON
+// file, which has the same format as the fleet configs.
+func LoadClusterConfigFromFile(path string) (*ClusterConfig, error) {
+	nodeConfig := &NodeConfig{}
+	err := loadConfigConfigFromFile(path, nodeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeConfig.ClusterConfig.ClusterConfigFile = path
+	return &nodeConfig.ClusterConfig, nil
+}
+
 func loadConfigFromJSON(configJSON string, nodeConfig *NodeConfig) error {
 	decoder := json.NewDecoder(strings.NewReader(configJSON))
 	// override default configuration with values by JSON input
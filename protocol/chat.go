@@ -232,6 +232,18 @@ func (c *Chat) IsActivePersonalChat() bool {
 	return c.Active && (c.OneToOne() || c.PrivateGroupChat() && c.Public()) && c.CommunityID == ""
 }
 
+func (c *Chat) GetCommunityID() string {
+	return c.CommunityID
+}
+
+func (c *Chat) IsActive() bool {
+	return c.Active
+}
+
+func (c *Chat) UnreadCounts() (uint, uint) {
+	return c.UnviewedMessagesCount, c.UnviewedMentionsCount
+}
+
 func (c *Chat) CommunityChatID() string {
 	if c.ChatType != ChatTypeCommunityChat {
 		return c.ID
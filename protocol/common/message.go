@@ -10,6 +10,7 @@ import (
 	"io/ioutil"
 	"os"
 	"strings"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/status-im/status-go/images"
 	"github.com/status-im/status-go/protocol/audio"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/urls"
 )
 
 // QuotedMessage contains the original text of the message replied to
@@ -122,6 +124,14 @@ func (m Messages) GetClock(i int) uint64 {
 	return m[i].Clock
 }
 
+// LinkPreview is the cached preview of a link
+type LinkPreview struct {
+	URL string `json:"url"`
+	urls.LinkPreviewData
+	// FetchedAt is the unix timestamp in seconds the preview was fetched at
+	FetchedAt int64 `json:"fetchedAt"`
+}
+
 // Message represents a message record in the database,
 // more specifically in user_messages table.
 type Message struct {
@@ -197,6 +207,9 @@ type Message struct {
 	// Links is an array of links within given message
 	Links []string
 
+	// LinkPreviews are the cached previews of the links of the message
+	LinkPreviews []*LinkPreview `json:"linkPreviews,omitempty"`
+
 	// EditedAt indicates the clock value it was edited
 	EditedAt uint64 `json:"editedAt"`
 
@@ -261,6 +274,7 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		Mentioned                bool                             `json:"mentioned,omitempty"`
 		Replied                  bool                             `json:"replied,omitempty"`
 		Links                    []string                         `json:"links,omitempty"`
+		LinkPreviews             []*LinkPreview                   `json:"linkPreviews,omitempty"`
 		EditedAt                 uint64                           `json:"editedAt,omitempty"`
 		Deleted                  bool                             `json:"deleted,omitempty"`
 		DeletedBy                string                           `json:"deletedBy,omitempty"`
@@ -299,6 +313,7 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		Mentioned:                m.Mentioned,
 		Replied:                  m.Replied,
 		Links:                    m.Links,
+		LinkPreviews:             m.LinkPreviews,
 		MessageType:              m.MessageType,
 		CommandParameters:        m.CommandParameters,
 		GapParameters:            m.GapParameters,
@@ -709,3 +724,15 @@ func (m *Message) SetAlbumID(albumID string) error {
 
 	return nil
 }
+
+// HasExpiredLinks returns whether any of the link previews of the message was
+// fetched more than maxAgeDays days ago
+func (m *Message) HasExpiredLinks(maxAgeDays int) bool {
+	expiredBefore := time.Now().AddDate(0, 0, -maxAgeDays).Unix()
+	for _, preview := range m.LinkPreviews {
+		if preview.FetchedAt < expiredBefore {
+			return true
+		}
+	}
+	return false
+}
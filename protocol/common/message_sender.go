@@ -159,8 +159,8 @@ func (s *MessageSender) SendPrivate(
 	// Currently we don't support sending through datasync and setting custom waku fields,
 	// as the datasync interface is not rich enough to propagate that information, so we
 	// would have to add some complexity to handle this.
-	if rawMessage.ResendAutomatically && (rawMessage.Sender != nil || rawMessage.SkipEncryption || rawMessage.SendOnPersonalTopic) {
-		return nil, errors.New("setting identity, skip-encryption or personal topic and datasync not supported")
+	if rawMessage.ResendAutomatically && (rawMessage.Sender != nil || rawMessage.SkipEncryption || rawMessage.SendOnPersonalTopic || len(rawMessage.InstallationIDs) > 0) {
+		return nil, errors.New("setting identity, skip-encryption, personal topic or installations and datasync not supported")
 	}
 
 	// Set sender identity if not specified
@@ -389,7 +389,12 @@ func (s *MessageSender) sendPrivate(
 		s.transport.Track(messageIDs, hash, newMessage)
 
 	} else {
-		messageSpec, err := s.protocol.BuildEncryptedMessage(rawMessage.Sender, recipient, wrappedMessage)
+		var messageSpec *encryption.ProtocolMessageSpec
+		if len(rawMessage.InstallationIDs) > 0 {
+			messageSpec, err = s.protocol.BuildEncryptedMessageForInstallations(rawMessage.Sender, recipient, rawMessage.InstallationIDs, wrappedMessage)
+		} else {
+			messageSpec, err = s.protocol.BuildEncryptedMessage(rawMessage.Sender, recipient, wrappedMessage)
+		}
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to encrypt message")
 		}
@@ -504,10 +509,11 @@ func (s *MessageSender) EncodeAbridgedMembershipUpdate(
 func (s *MessageSender) dispatchCommunityChatMessage(ctx context.Context, rawMessage *RawMessage, wrappedMessage []byte) ([]byte, *types.NewMessage, error) {
 
 	newMessage := &types.NewMessage{
-		TTL:       whisperTTL,
-		Payload:   wrappedMessage,
-		PowTarget: calculatePoW(wrappedMessage),
-		PowTime:   whisperPoWTime,
+		TTL:         whisperTTL,
+		Payload:     wrappedMessage,
+		PowTarget:   calculatePoW(wrappedMessage),
+		PowTime:     whisperPoWTime,
+		CommunityID: types.EncodeHex(rawMessage.CommunityID),
 	}
 
 	// notify before dispatching
@@ -560,6 +566,9 @@ func (s *MessageSender) SendPublic(
 	}
 
 	newMessage.Ephemeral = rawMessage.Ephemeral
+	if len(rawMessage.CommunityID) != 0 {
+		newMessage.CommunityID = types.EncodeHex(rawMessage.CommunityID)
+	}
 
 	messageID := v1protocol.MessageID(&rawMessage.Sender.PublicKey, wrappedMessage)
 	rawMessage.ID = types.EncodeHex(messageID)
@@ -855,10 +864,11 @@ func (s *MessageSender) sendPrivateRawMessage(ctx context.Context, rawMessage *R
 // to a community
 func (s *MessageSender) dispatchCommunityMessage(ctx context.Context, publicKey *ecdsa.PublicKey, payload []byte, messageIDs [][]byte) ([]byte, *types.NewMessage, error) {
 	newMessage := &types.NewMessage{
-		TTL:       whisperTTL,
-		Payload:   payload,
-		PowTarget: calculatePoW(payload),
-		PowTime:   whisperPoWTime,
+		TTL:         whisperTTL,
+		Payload:     payload,
+		PowTarget:   calculatePoW(payload),
+		PowTime:     whisperPoWTime,
+		CommunityID: types.EncodeHex(crypto.CompressPubkey(publicKey)),
 	}
 
 	hash, err := s.transport.SendCommunityMessage(ctx, newMessage, publicKey)
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -146,3 +147,20 @@ func TestMarshalMessageJSON(t *testing.T) {
 	require.True(t, strings.Contains(string(encodedMessage), "compressedKey\":\"zQ"))
 	require.True(t, strings.Contains(string(encodedMessage), "emojiHash"))
 }
+
+func TestHasExpiredLinks(t *testing.T) {
+	message := &Message{}
+	require.False(t, message.HasExpiredLinks(1))
+
+	now := time.Now()
+	message.Links = []string{"https://status.im", "https://github.com"}
+	message.LinkPreviews = []*LinkPreview{
+		{URL: "https://status.im", FetchedAt: now.Unix()},
+		{URL: "https://github.com", FetchedAt: now.Add(-time.Hour).Unix()},
+	}
+	require.False(t, message.HasExpiredLinks(1))
+
+	message.LinkPreviews[1].FetchedAt = now.AddDate(0, 0, -2).Unix()
+	require.True(t, message.HasExpiredLinks(1))
+	require.False(t, message.HasExpiredLinks(3))
+}
@@ -17,18 +17,20 @@ const (
 // RawMessage represent a sent or received message, kept for being able
 // to re-send/propagate
 type RawMessage struct {
-	ID                    string
-	LocalChatID           string
-	LastSent              uint64
-	SendCount             int
-	Sent                  bool
-	ResendAutomatically   bool
-	SkipEncryption        bool
-	SendPushNotification  bool
-	MessageType           protobuf.ApplicationMetadataMessage_Type
-	Payload               []byte
-	Sender                *ecdsa.PrivateKey
-	Recipients            []*ecdsa.PublicKey
+	ID                   string
+	LocalChatID          string
+	LastSent             uint64
+	SendCount            int
+	Sent                 bool
+	ResendAutomatically  bool
+	SkipEncryption       bool
+	SendPushNotification bool
+	MessageType          protobuf.ApplicationMetadataMessage_Type
+	Payload              []byte
+	Sender               *ecdsa.PrivateKey
+	Recipients           []*ecdsa.PublicKey
+	// InstallationIDs restricts a private message to these installations of the recipient
+	InstallationIDs       []string
 	SkipGroupMessageWrap  bool
 	SendOnPersonalTopic   bool
 	CommunityID           []byte
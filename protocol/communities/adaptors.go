@@ -31,6 +31,7 @@ func (o *Community) ToSyncCommunityProtobuf(clock uint64, communitySettings *Com
 
 	if communitySettings != nil {
 		settings.HistoryArchiveSupportEnabled = communitySettings.HistoryArchiveSupportEnabled
+		communitySettings.DNDSchedule.SetSyncProtobufFields(settings)
 	}
 
 	return &protobuf.SyncCommunity{
@@ -6,7 +6,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
@@ -42,6 +45,9 @@ type Config struct {
 type Community struct {
 	config *Config
 	mutex  sync.Mutex
+	// bannedMembers is built from the ban list on first lookup and
+	// reset whenever the ban list changes
+	bannedMembers map[string]struct{}
 }
 
 func New(config Config) (*Community, error) {
@@ -67,16 +73,17 @@ type CommunityAdminSettings struct {
 }
 
 type CommunityChat struct {
-	ID          string                               `json:"id"`
-	Name        string                               `json:"name"`
-	Color       string                               `json:"color"`
-	Emoji       string                               `json:"emoji"`
-	Description string                               `json:"description"`
-	Members     map[string]*protobuf.CommunityMember `json:"members"`
-	Permissions *protobuf.CommunityPermissions       `json:"permissions"`
-	CanPost     bool                                 `json:"canPost"`
-	Position    int                                  `json:"position"`
-	CategoryID  string                               `json:"categoryID"`
+	ID                string                               `json:"id"`
+	Name              string                               `json:"name"`
+	Color             string                               `json:"color"`
+	Emoji             string                               `json:"emoji"`
+	Description       string                               `json:"description"`
+	Members           map[string]*protobuf.CommunityMember `json:"members"`
+	Permissions       *protobuf.CommunityPermissions       `json:"permissions"`
+	CanPost           bool                                 `json:"canPost"`
+	Position          int                                  `json:"position"`
+	CategoryID        string                               `json:"categoryID"`
+	MaxPinnedMessages int                                  `json:"maxPinnedMessages"`
 }
 
 type CommunityCategory struct {
@@ -85,6 +92,26 @@ type CommunityCategory struct {
 	Position int    `json:"position"` // Position is used to sort the categories
 }
 
+const maxCategoryNameLength = 48
+
+// DefaultMaxPinnedMessages is the number of messages that can be pinned in a community
+// chat, unless the admin sets a different limit, up to MaxPinnedMessagesLimit
+const DefaultMaxPinnedMessages = 100
+const MaxPinnedMessagesLimit = 500
+
+func (c CommunityCategory) Validate() error {
+	nameLength := utf8.RuneCountInString(c.Name)
+	if nameLength == 0 || nameLength > maxCategoryNameLength {
+		return ErrInvalidCategoryNameLength
+	}
+
+	if c.Position < 0 {
+		return ErrInvalidCategoryPosition
+	}
+
+	return nil
+}
+
 type CommunityTag struct {
 	Name  string `json:"name"`
 	Emoji string `json:"emoji"`
@@ -137,16 +164,17 @@ func (o *Community) MarshalPublicAPIJSON() ([]byte, error) {
 				return nil, err
 			}
 			chat := CommunityChat{
-				ID:          id,
-				Name:        c.Identity.DisplayName,
-				Color:       c.Identity.Color,
-				Emoji:       c.Identity.Emoji,
-				Description: c.Identity.Description,
-				Permissions: c.Permissions,
-				Members:     c.Members,
-				CanPost:     canPost,
-				CategoryID:  c.CategoryId,
-				Position:    int(c.Position),
+				ID:                id,
+				Name:              c.Identity.DisplayName,
+				Color:             c.Identity.Color,
+				Emoji:             c.Identity.Emoji,
+				Description:       c.Identity.Description,
+				Permissions:       c.Permissions,
+				Members:           c.Members,
+				CanPost:           canPost,
+				CategoryID:        c.CategoryId,
+				Position:          int(c.Position),
+				MaxPinnedMessages: maxPinnedMessages(c),
 			}
 			communityItem.Chats[id] = chat
 		}
@@ -251,16 +279,17 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 				return nil, err
 			}
 			chat := CommunityChat{
-				ID:          id,
-				Name:        c.Identity.DisplayName,
-				Emoji:       c.Identity.Emoji,
-				Color:       c.Identity.Color,
-				Description: c.Identity.Description,
-				Permissions: c.Permissions,
-				Members:     c.Members,
-				CanPost:     canPost,
-				CategoryID:  c.CategoryId,
-				Position:    int(c.Position),
+				ID:                id,
+				Name:              c.Identity.DisplayName,
+				Emoji:             c.Identity.Emoji,
+				Color:             c.Identity.Color,
+				Description:       c.Identity.Description,
+				Permissions:       c.Permissions,
+				Members:           c.Members,
+				CanPost:           canPost,
+				CategoryID:        c.CategoryId,
+				Position:          int(c.Position),
+				MaxPinnedMessages: maxPinnedMessages(c),
 			}
 			communityItem.Chats[id] = chat
 		}
@@ -432,10 +461,25 @@ type CommunityToken struct {
 	Base64Image        string                      `json:"image"`
 }
 
+// DefaultRequestToJoinCooldown is the minimum time between two requests to join
+// from the same user, used when the community settings don't specify one
+const DefaultRequestToJoinCooldown = 24 * time.Hour
+
 type CommunitySettings struct {
-	CommunityID                  string `json:"communityId"`
-	HistoryArchiveSupportEnabled bool   `json:"historyArchiveSupportEnabled"`
-	Clock                        uint64 `json:"clock"`
+	CommunityID                  string        `json:"communityId"`
+	HistoryArchiveSupportEnabled bool          `json:"historyArchiveSupportEnabled"`
+	Clock                        uint64        `json:"clock"`
+	RequestToJoinCooldown        time.Duration `json:"requestToJoinCooldown"`
+	DNDSchedule                  *DNDSchedule  `json:"dndSchedule,omitempty"`
+}
+
+// RequestToJoinCooldownOrDefault returns the configured cooldown, falling back to
+// DefaultRequestToJoinCooldown if the settings are missing or don't set one
+func (s *CommunitySettings) RequestToJoinCooldownOrDefault() time.Duration {
+	if s == nil || s.RequestToJoinCooldown <= 0 {
+		return DefaultRequestToJoinCooldown
+	}
+	return s.RequestToJoinCooldown
 }
 
 type CommunityChatChanges struct {
@@ -692,14 +736,43 @@ func (o *Community) IsBanned(pk *ecdsa.PublicKey) bool {
 }
 
 func (o *Community) isBanned(pk *ecdsa.PublicKey) bool {
-	key := common.PubkeyToHex(pk)
+	return o.isMemberBanned(common.PubkeyToHex(pk))
+}
+
+// IsMemberBanned returns whether the hex encoded public key is in the ban list
+func (o *Community) IsMemberBanned(pubKey string) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.isMemberBanned(pubKey)
+}
+
+func (o *Community) isMemberBanned(pubKey string) bool {
+	if o.bannedMembers == nil {
+		o.bannedMembers = make(map[string]struct{}, len(o.config.CommunityDescription.BanList))
+		for _, k := range o.config.CommunityDescription.BanList {
+			o.bannedMembers[k] = struct{}{}
+		}
+	}
+	_, ok := o.bannedMembers[pubKey]
+	return ok
+}
+
+// GetBannedMembers returns the sorted public keys in the ban list, without duplicates
+func (o *Community) GetBannedMembers() []string {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
 
+	seen := make(map[string]bool)
+	banned := make([]string, 0, len(o.config.CommunityDescription.BanList))
 	for _, k := range o.config.CommunityDescription.BanList {
-		if k == key {
-			return true
+		if !seen[k] {
+			seen[k] = true
+			banned = append(banned, k)
 		}
 	}
-	return false
+	sort.Strings(banned)
+
+	return banned
 }
 
 func (o *Community) hasMemberPermission(member *protobuf.CommunityMember, permissions map[protobuf.CommunityMember_Roles]bool) bool {
@@ -834,6 +907,7 @@ func (o *Community) UnbanUserFromCommunity(pk *ecdsa.PublicKey) (*protobuf.Commu
 			break
 		}
 	}
+	o.bannedMembers = nil
 
 	o.increaseClock()
 
@@ -866,6 +940,7 @@ func (o *Community) BanUserFromCommunity(pk *ecdsa.PublicKey) (*protobuf.Communi
 	}
 	if !found {
 		o.config.CommunityDescription.BanList = append(o.config.CommunityDescription.BanList, key)
+		o.bannedMembers = nil
 	}
 
 	o.increaseClock()
@@ -1008,7 +1083,7 @@ func (o *Community) UpdateCommunityDescription(signer *ecdsa.PublicKey, descript
 
 	response := o.emptyCommunityChanges()
 
-	if description.Clock <= o.config.CommunityDescription.Clock {
+	if !o.supersededBy(description) {
 		return response, nil
 	}
 
@@ -1164,6 +1239,7 @@ func (o *Community) UpdateCommunityDescription(signer *ecdsa.PublicKey, descript
 
 	o.config.CommunityDescription = description
 	o.config.MarshaledCommunityDescription = rawMessage
+	o.bannedMembers = nil
 
 	return response, nil
 }
@@ -1219,7 +1295,73 @@ func (o *Community) IsAdmin() bool {
 }
 
 func (o *Community) IsMemberAdmin(publicKey *ecdsa.PublicKey) bool {
-	return o.hasPermission(publicKey, adminRolePermissions())
+	return o.HasPermission(publicKey, CommunityPermissionAdmin)
+}
+
+// IsOwner returns whether we have the owner role in the community
+func (o *Community) IsOwner() bool {
+	return o.IsMemberOwner(o.config.MemberIdentity)
+}
+
+// IsMemberOwner returns whether the member has the owner role in the community
+func (o *Community) IsMemberOwner(publicKey *ecdsa.PublicKey) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if publicKey == nil {
+		return false
+	}
+
+	// Communities created before the owner role don't give it to their creator
+	if o.config.PrivateKey != nil && o.config.MemberIdentity != nil && common.IsPubKeyEqual(publicKey, o.config.MemberIdentity) {
+		return true
+	}
+
+	member := o.getMember(publicKey)
+	if member == nil {
+		return false
+	}
+
+	return o.hasMemberPermission(member, ownerRolePermissions())
+}
+
+// CommunityPermission is an action a member may be allowed to perform in a community
+type CommunityPermission int
+
+const (
+	// CommunityPermissionAdmin is held by the owner and members with ROLE_ALL
+	CommunityPermissionAdmin CommunityPermission = iota + 1
+	// CommunityPermissionManageUsers allows accepting, kicking and banning members
+	CommunityPermissionManageUsers
+	// CommunityPermissionModerateContent allows deleting messages for everyone
+	CommunityPermissionModerateContent
+)
+
+// HasPermission returns whether the member with the given key holds `permission`.
+// When this node controls the community, it's allowed to manage users and moderate content.
+func (o *Community) HasPermission(pk *ecdsa.PublicKey, permission CommunityPermission) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return o.hasCommunityPermission(pk, permission)
+}
+
+func (o *Community) hasCommunityPermission(pk *ecdsa.PublicKey, permission CommunityPermission) bool {
+	switch permission {
+	case CommunityPermissionAdmin:
+		return o.hasPermission(pk, adminRolePermissions())
+	case CommunityPermissionManageUsers:
+		if o.IsAdmin() {
+			return true
+		}
+		return o.hasMember(pk) && o.hasPermission(pk, canManageUsersRolePermissions())
+	case CommunityPermissionModerateContent:
+		if o.IsAdmin() {
+			return true
+		}
+		return o.hasMember(pk) && o.hasPermission(pk, canDeleteMessageForEveryonePermissions())
+	}
+	return false
 }
 
 func canManageUsersRolePermissions() map[protobuf.CommunityMember_Roles]bool {
@@ -1229,11 +1371,17 @@ func canManageUsersRolePermissions() map[protobuf.CommunityMember_Roles]bool {
 }
 
 func adminRolePermissions() map[protobuf.CommunityMember_Roles]bool {
-	roles := make(map[protobuf.CommunityMember_Roles]bool)
+	roles := ownerRolePermissions()
 	roles[protobuf.CommunityMember_ROLE_ALL] = true
 	return roles
 }
 
+func ownerRolePermissions() map[protobuf.CommunityMember_Roles]bool {
+	roles := make(map[protobuf.CommunityMember_Roles]bool)
+	roles[protobuf.CommunityMember_ROLE_OWNER] = true
+	return roles
+}
+
 func canDeleteMessageForEveryonePermissions() map[protobuf.CommunityMember_Roles]bool {
 	roles := adminRolePermissions()
 	roles[protobuf.CommunityMember_ROLE_MODERATE_CONTENT] = true
@@ -1331,6 +1479,13 @@ func (o *Community) Description() *protobuf.CommunityDescription {
 	return o.config.CommunityDescription
 }
 
+// CopyDescription returns a deep copy of the current description
+func (o *Community) CopyDescription() *protobuf.CommunityDescription {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return proto.Clone(o.config.CommunityDescription).(*protobuf.CommunityDescription)
+}
+
 func (o *Community) marshaledDescription() ([]byte, error) {
 	return proto.Marshal(o.config.CommunityDescription)
 }
@@ -1370,6 +1525,21 @@ func (o *Community) ToBytes() ([]byte, error) {
 	return o.toBytes()
 }
 
+// MaxPinnedMessages returns how many messages can be pinned in the given chat
+func (o *Community) MaxPinnedMessages(chatID string) int {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return maxPinnedMessages(o.config.CommunityDescription.Chats[chatID])
+}
+
+func maxPinnedMessages(chat *protobuf.CommunityChat) int {
+	if chat == nil || chat.MaxPinnedMessages == 0 {
+		return DefaultMaxPinnedMessages
+	}
+	return int(chat.MaxPinnedMessages)
+}
+
 func (o *Community) Chats() map[string]*protobuf.CommunityChat {
 	response := make(map[string]*protobuf.CommunityChat)
 
@@ -1683,6 +1853,47 @@ func (o *Community) buildGrant(key *ecdsa.PublicKey, chatID string) ([]byte, err
 
 func (o *Community) increaseClock() {
 	o.config.CommunityDescription.Clock = o.nextClock()
+	o.config.CommunityDescription.EventSequence++
+}
+
+// supersededBy tells whether the description replaces the current one, either
+// because its clock is greater or because it wins a clock tie
+func (o *Community) supersededBy(description *protobuf.CommunityDescription) bool {
+	if description.Clock != o.config.CommunityDescription.Clock {
+		return description.Clock > o.config.CommunityDescription.Clock
+	}
+	return winsClockTie(description, o.config.CommunityDescription)
+}
+
+// winsClockTie tells whether a description should replace the current one
+// with the same clock. This happens when two admins edit the community
+// concurrently, the description with the greatest encoding is kept so that
+// every device ends up with the same one.
+func winsClockTie(description *protobuf.CommunityDescription, current *protobuf.CommunityDescription) bool {
+	encoded, err := marshalDeterministic(description)
+	if err != nil {
+		return false
+	}
+
+	currentEncoded, err := marshalDeterministic(current)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Compare(encoded, currentEncoded) > 0
+}
+
+func marshalDeterministic(description *protobuf.CommunityDescription) ([]byte, error) {
+	buffer := proto.NewBuffer(nil)
+	buffer.SetDeterministic(true)
+	if err := buffer.Marshal(description); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func (o *Community) EventSequence() uint64 {
+	return o.config.CommunityDescription.EventSequence
 }
 
 func (o *Community) Clock() uint64 {
@@ -1706,35 +1917,11 @@ func (o *Community) CanRequestAccess(pk *ecdsa.PublicKey) bool {
 }
 
 func (o *Community) CanManageUsers(pk *ecdsa.PublicKey) bool {
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
-
-	if o.IsAdmin() {
-		return true
-	}
-
-	if !o.hasMember(pk) {
-		return false
-	}
-
-	roles := canManageUsersRolePermissions()
-	return o.hasPermission(pk, roles)
-
+	return o.HasPermission(pk, CommunityPermissionManageUsers)
 }
 
 func (o *Community) CanDeleteMessageForEveryone(pk *ecdsa.PublicKey) bool {
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
-
-	if o.IsAdmin() {
-		return true
-	}
-
-	if !o.hasMember(pk) {
-		return false
-	}
-	roles := canDeleteMessageForEveryonePermissions()
-	return o.hasPermission(pk, roles)
+	return o.HasPermission(pk, CommunityPermissionModerateContent)
 }
 
 func (o *Community) isMember() bool {
@@ -1787,6 +1974,27 @@ func (o *Community) CanManageUsersPublicKeys() ([]*ecdsa.PublicKey, error) {
 	return response, nil
 }
 
+// CanModerateContentPublicKeys returns the members allowed to moderate the
+// content of the community
+func (o *Community) CanModerateContentPublicKeys() ([]*ecdsa.PublicKey, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	var response []*ecdsa.PublicKey
+	roles := canDeleteMessageForEveryonePermissions()
+	for pkString, member := range o.config.CommunityDescription.Members {
+		if o.hasMemberPermission(member, roles) {
+			pk, err := common.HexToPubkey(pkString)
+			if err != nil {
+				return nil, err
+			}
+
+			response = append(response, pk)
+		}
+	}
+	return response, nil
+}
+
 func (o *Community) AddRequestToJoin(request *RequestToJoin) {
 	o.config.RequestsToJoin = append(o.config.RequestsToJoin, request)
 }
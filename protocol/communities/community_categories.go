@@ -37,6 +37,15 @@ func (o *Community) CreateCategory(categoryID string, categoryName string, chatI
 		return nil, ErrCategoryAlreadyExists
 	}
 
+	category := CommunityCategory{
+		ID:       categoryID,
+		Name:     categoryName,
+		Position: len(o.config.CommunityDescription.Categories),
+	}
+	if err := category.Validate(); err != nil {
+		return nil, err
+	}
+
 	for _, cid := range chatIDs {
 		c, exists := o.config.CommunityDescription.Chats[cid]
 		if !exists {
@@ -51,9 +60,9 @@ func (o *Community) CreateCategory(categoryID string, categoryName string, chatI
 	changes := o.emptyCommunityChanges()
 
 	o.config.CommunityDescription.Categories[categoryID] = &protobuf.CommunityCategory{
-		CategoryId: categoryID,
-		Name:       categoryName,
-		Position:   int32(len(o.config.CommunityDescription.Categories)),
+		CategoryId: category.ID,
+		Name:       category.Name,
+		Position:   int32(category.Position),
 	}
 
 	for i, cid := range chatIDs {
@@ -89,10 +98,20 @@ func (o *Community) EditCategory(categoryID string, categoryName string, chatIDs
 	if o.config.CommunityDescription.Categories == nil {
 		o.config.CommunityDescription.Categories = make(map[string]*protobuf.CommunityCategory)
 	}
-	if _, ok := o.config.CommunityDescription.Categories[categoryID]; !ok {
+	existingCategory, ok := o.config.CommunityDescription.Categories[categoryID]
+	if !ok {
 		return nil, ErrCategoryNotFound
 	}
 
+	category := CommunityCategory{
+		ID:       categoryID,
+		Name:     categoryName,
+		Position: int(existingCategory.Position),
+	}
+	if err := category.Validate(); err != nil {
+		return nil, err
+	}
+
 	for _, cid := range chatIDs {
 		c, exists := o.config.CommunityDescription.Chats[cid]
 		if !exists {
@@ -277,6 +296,99 @@ func (o *Community) ReorderChat(categoryID string, chatID string, newPosition in
 	return changes, nil
 }
 
+// ReorderChannels moves several chats at once within their categories.
+// Within each category the chats are placed at the requested positions, the
+// other chats keep their relative order, and positions are then compacted.
+func (o *Community) ReorderChannels(channelPositions map[string]int32) (*CommunityChanges, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.PrivateKey == nil {
+		return nil, ErrNotAdmin
+	}
+
+	categoryIDs := make(map[string]bool)
+	for chatID := range channelPositions {
+		chat, exists := o.config.CommunityDescription.Chats[chatID]
+		if !exists {
+			return nil, ErrChatNotFound
+		}
+		categoryIDs[chat.CategoryId] = true
+	}
+
+	changes := o.emptyCommunityChanges()
+
+	for categoryID := range categoryIDs {
+		var moved, others sortSlice
+		for chatID, chat := range o.config.CommunityDescription.Chats {
+			if chat.CategoryId != categoryID {
+				continue
+			}
+
+			if position, ok := channelPositions[chatID]; ok {
+				moved = append(moved, sorterHelperIdx{pos: position, chatID: chatID})
+			} else {
+				others = append(others, sorterHelperIdx{pos: chat.Position, chatID: chatID})
+			}
+		}
+		sort.Stable(byPositionAndChatID(moved))
+		sort.Stable(byPositionAndChatID(others))
+
+		// Fill the positions left by the moved chats with the other chats
+		sortedChatIDs := make([]string, 0, len(moved)+len(others))
+		for len(moved) > 0 || len(others) > 0 {
+			if len(moved) > 0 && (len(others) == 0 || moved[0].pos <= int32(len(sortedChatIDs))) {
+				sortedChatIDs = append(sortedChatIDs, moved[0].chatID)
+				moved = moved[1:]
+			} else {
+				sortedChatIDs = append(sortedChatIDs, others[0].chatID)
+				others = others[1:]
+			}
+		}
+
+		for i, chatID := range sortedChatIDs {
+			chat := o.config.CommunityDescription.Chats[chatID]
+			if chat.Position == int32(i) {
+				continue
+			}
+
+			chat.Position = int32(i)
+			changes.ChatsModified[chatID] = &CommunityChatChanges{
+				PositionModified: i,
+				MembersAdded:     make(map[string]*protobuf.CommunityMember),
+				MembersRemoved:   make(map[string]*protobuf.CommunityMember),
+			}
+		}
+	}
+
+	if len(changes.ChatsModified) == 0 {
+		return nil, ErrNoChangeInChatPosition
+	}
+
+	o.increaseClock()
+
+	return changes, nil
+}
+
+// byPositionAndChatID sorts chats by position, breaking ties by chat ID so
+// that all the devices end up with the same order
+type byPositionAndChatID sortSlice
+
+func (d byPositionAndChatID) Len() int {
+	return len(d)
+}
+
+func (d byPositionAndChatID) Swap(i, j int) {
+	d[i], d[j] = d[j], d[i]
+}
+
+func (d byPositionAndChatID) Less(i, j int) bool {
+	if d[i].pos != d[j].pos {
+		return d[i].pos < d[j].pos
+	}
+	return d[i].chatID < d[j].chatID
+}
+
 func (o *Community) SortCategoryChats(changes *CommunityChanges, categoryID string) {
 	var catChats []string
 	for k, c := range o.config.CommunityDescription.Chats {
@@ -1,6 +1,10 @@
 package communities
 
 import (
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
 	"github.com/status-im/status-go/protocol/protobuf"
 )
 
@@ -276,3 +280,125 @@ func (s *CommunitySuite) TestDeleteChatOrder() {
 	_, err = org.DeleteChat(testChatID3)
 	s.Require().NoError(err)
 }
+
+// buildCommunityWithChannels builds a community whose chats are in the given
+// order, outside of any category
+func (s *CommunitySuite) buildCommunityWithChannels(chatIDs ...string) *Community {
+	description := s.buildCommunityDescription()
+	description.Chats = make(map[string]*protobuf.CommunityChat)
+	for i, chatID := range chatIDs {
+		description.Chats[chatID] = &protobuf.CommunityChat{
+			Position:    int32(i),
+			Identity:    &protobuf.ChatIdentity{DisplayName: chatID},
+			Permissions: &protobuf.CommunityPermissions{Access: protobuf.CommunityPermissions_NO_MEMBERSHIP},
+			Members:     make(map[string]*protobuf.CommunityMember),
+		}
+	}
+
+	config := s.config()
+	config.ID = &s.identity.PublicKey
+	config.CommunityDescription = description
+
+	org, err := New(config)
+	s.Require().NoError(err)
+	return org
+}
+
+func (s *CommunitySuite) channelsOrder(org *Community) []string {
+	chatIDs := make([]string, len(org.config.CommunityDescription.Chats))
+	for chatID, chat := range org.config.CommunityDescription.Chats {
+		chatIDs[chat.Position] = chatID
+	}
+	return chatIDs
+}
+
+func (s *CommunitySuite) TestReorderChannels() {
+	org := s.buildCommunityWithChannels("a", "b", "c", "d", "e")
+
+	org.config.PrivateKey = nil
+	_, err := org.ReorderChannels(map[string]int32{"a": 1})
+	s.Require().Equal(ErrNotAdmin, err)
+	org.config.PrivateKey = s.identity
+
+	_, err = org.ReorderChannels(map[string]int32{"a": 1, "unknown": 0})
+	s.Require().Equal(ErrChatNotFound, err)
+	s.Require().Equal([]string{"a", "b", "c", "d", "e"}, s.channelsOrder(org))
+
+	_, err = org.ReorderChannels(map[string]int32{"a": 0, "b": 1})
+	s.Require().Equal(ErrNoChangeInChatPosition, err)
+
+	clock := org.Clock()
+	eventSequence := org.EventSequence()
+
+	changes, err := org.ReorderChannels(map[string]int32{"e": 0, "a": 2, "b": 10})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"e", "c", "a", "d", "b"}, s.channelsOrder(org))
+	s.Require().Len(changes.ChatsModified, 4)
+	s.Require().Nil(changes.ChatsModified["d"])
+	s.Require().Equal(clock+1, org.Clock())
+	s.Require().Equal(eventSequence+1, org.EventSequence())
+}
+
+func (s *CommunitySuite) TestReorderChannelsConcurrently() {
+	admin1 := s.buildCommunityWithChannels("a", "b", "c")
+	admin2 := s.buildCommunityWithChannels("a", "b", "c")
+
+	// Both admins reorder the channels starting from the same clock
+	_, err := admin1.ReorderChannels(map[string]int32{"c": 0})
+	s.Require().NoError(err)
+	_, err = admin2.ReorderChannels(map[string]int32{"a": 2})
+	s.Require().NoError(err)
+	s.Require().Equal(admin1.Clock(), admin2.Clock())
+
+	description1 := proto.Clone(admin1.config.CommunityDescription).(*protobuf.CommunityDescription)
+	description2 := proto.Clone(admin2.config.CommunityDescription).(*protobuf.CommunityDescription)
+
+	_, err = admin1.UpdateCommunityDescription(&s.identity.PublicKey, description2, []byte{})
+	s.Require().NoError(err)
+	_, err = admin2.UpdateCommunityDescription(&s.identity.PublicKey, description1, []byte{})
+	s.Require().NoError(err)
+
+	// Both admins keep the same order
+	s.Require().Equal(s.channelsOrder(admin1), s.channelsOrder(admin2))
+
+	// The next reorder wins as it has a higher clock
+	_, err = admin2.ReorderChannels(map[string]int32{s.channelsOrder(admin2)[0]: 2})
+	s.Require().NoError(err)
+	order := s.channelsOrder(admin2)
+
+	_, err = admin1.UpdateCommunityDescription(&s.identity.PublicKey, proto.Clone(admin2.config.CommunityDescription).(*protobuf.CommunityDescription), []byte{})
+	s.Require().NoError(err)
+	s.Require().Equal(order, s.channelsOrder(admin1))
+
+	// An older reorder doesn't override it
+	_, err = admin1.UpdateCommunityDescription(&s.identity.PublicKey, description1, []byte{})
+	s.Require().NoError(err)
+	s.Require().Equal(order, s.channelsOrder(admin1))
+}
+
+func (s *CommunitySuite) TestValidateCategory() {
+	category := CommunityCategory{ID: "category-id", Name: strings.Repeat("a", 48)}
+	s.Require().NoError(category.Validate())
+
+	category.Name = ""
+	s.Require().Equal(ErrInvalidCategoryNameLength, category.Validate())
+
+	category.Name = strings.Repeat("a", 49)
+	s.Require().Equal(ErrInvalidCategoryNameLength, category.Validate())
+
+	category.Name = "category-name"
+	category.Position = -1
+	s.Require().Equal(ErrInvalidCategoryPosition, category.Validate())
+
+	org := s.buildCommunity(&s.identity.PublicKey)
+	org.config.PrivateKey = s.identity
+
+	_, err := org.CreateCategory("new-category-id", "", []string{})
+	s.Require().Equal(ErrInvalidCategoryNameLength, err)
+
+	_, err = org.CreateCategory("new-category-id", "new-category-name", []string{})
+	s.Require().NoError(err)
+
+	_, err = org.EditCategory("new-category-id", strings.Repeat("a", 49), []string{})
+	s.Require().Equal(ErrInvalidCategoryNameLength, err)
+}
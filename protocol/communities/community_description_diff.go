@@ -0,0 +1,193 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// The clock is carried by the diff itself, so it's never part of the field mask
+const clockFieldName = "clock"
+
+// DescriptionDiff returns a signed diff that brings `base` to the current
+// description of the community. Only the fields that differ are included.
+func (o *Community) DescriptionDiff(base *protobuf.CommunityDescription) (*protobuf.CommunityDescriptionDiff, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.PrivateKey == nil {
+		return nil, ErrNotAdmin
+	}
+
+	if base == nil {
+		return nil, ErrNilCommunityDescription
+	}
+
+	current := o.config.CommunityDescription
+	if current.Clock <= base.Clock {
+		return nil, ErrInvalidCommunityDescriptionDiff
+	}
+
+	payload, err := marshalDescriptionDeterministic(current)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.Sign(crypto.Keccak256(payload), o.config.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &protobuf.CommunityDescriptionDiff{
+		Clock:       current.Clock,
+		BaseClock:   base.Clock,
+		Description: &protobuf.CommunityDescription{},
+		Signature:   signature,
+	}
+
+	baseReflect := proto.MessageReflect(base)
+	currentReflect := proto.MessageReflect(current)
+	diffReflect := proto.MessageReflect(diff.Description)
+
+	fields := currentReflect.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.Name() == clockFieldName {
+			continue
+		}
+
+		if fieldEqual(baseReflect, currentReflect, field) {
+			continue
+		}
+
+		diff.FieldMask = append(diff.FieldMask, string(field.Name()))
+		if currentReflect.Has(field) {
+			diffReflect.Set(field, currentReflect.Get(field))
+		}
+	}
+
+	// Make sure the diff doesn't share any state with the community
+	diff.Description = proto.Clone(diff.Description).(*protobuf.CommunityDescription)
+
+	return diff, nil
+}
+
+// ApplyDiff applies a diff received from the community owner on top of the
+// current description. The diff must have been computed against the current
+// clock and its signature must match the resulting description.
+func (o *Community) ApplyDiff(diff *protobuf.CommunityDescriptionDiff) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	description, rawMessage, err := applyDescriptionDiff(o.config.ID, o.config.CommunityDescription, diff)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateCommunityDescription(description); err != nil {
+		return err
+	}
+
+	o.config.CommunityDescription = description
+	o.config.MarshaledCommunityDescription = rawMessage
+	// The ban list might have changed
+	o.bannedMembers = nil
+
+	return nil
+}
+
+// applyDescriptionDiff returns the description obtained by applying `diff` on
+// top of `base`, along with the signed & wrapped message as it would have been
+// received if the owner had published the full description
+func applyDescriptionDiff(id *ecdsa.PublicKey, base *protobuf.CommunityDescription, diff *protobuf.CommunityDescriptionDiff) (*protobuf.CommunityDescription, []byte, error) {
+	if base == nil {
+		return nil, nil, ErrNilCommunityDescription
+	}
+
+	if diff == nil || diff.Clock <= diff.BaseClock {
+		return nil, nil, ErrInvalidCommunityDescriptionDiff
+	}
+
+	if diff.BaseClock != base.Clock {
+		return nil, nil, ErrCommunityDescriptionDiffBaseMismatch
+	}
+
+	description := proto.Clone(base).(*protobuf.CommunityDescription)
+	changes := &protobuf.CommunityDescription{}
+	if diff.Description != nil {
+		changes = proto.Clone(diff.Description).(*protobuf.CommunityDescription)
+	}
+
+	descriptionReflect := proto.MessageReflect(description)
+	changesReflect := proto.MessageReflect(changes)
+	fields := descriptionReflect.Descriptor().Fields()
+
+	for _, name := range diff.FieldMask {
+		field := fields.ByName(protoreflect.Name(name))
+		if field == nil || field.Name() == clockFieldName {
+			return nil, nil, ErrInvalidCommunityDescriptionDiff
+		}
+
+		if changesReflect.Has(field) {
+			descriptionReflect.Set(field, changesReflect.Get(field))
+		} else {
+			descriptionReflect.Clear(field)
+		}
+	}
+
+	description.Clock = diff.Clock
+
+	payload, err := marshalDescriptionDeterministic(description)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signer, err := crypto.SigToPub(crypto.Keccak256(payload), diff.Signature)
+	if err != nil {
+		return nil, nil, ErrInvalidCommunityDescriptionDiffSignature
+	}
+
+	if !common.IsPubKeyEqual(signer, id) {
+		return nil, nil, ErrInvalidCommunityDescriptionDiffSignature
+	}
+
+	rawMessage, err := proto.Marshal(&protobuf.ApplicationMetadataMessage{
+		Signature: diff.Signature,
+		Type:      protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION,
+		Payload:   payload,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return description, rawMessage, nil
+}
+
+// marshalDescriptionDeterministic serializes the description so that the
+// receiver of a diff can reconstruct the exact bytes signed by the owner
+func marshalDescriptionDeterministic(description *protobuf.CommunityDescription) ([]byte, error) {
+	buffer := proto.NewBuffer(nil)
+	buffer.SetDeterministic(true)
+	if err := buffer.Marshal(description); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// fieldEqual compares a single field of two messages of the same type
+func fieldEqual(a, b protoreflect.Message, field protoreflect.FieldDescriptor) bool {
+	left := a.New()
+	if a.Has(field) {
+		left.Set(field, a.Get(field))
+	}
+	right := b.New()
+	if b.Has(field) {
+		right.Set(field, b.Get(field))
+	}
+	return proto.Equal(proto.MessageV1(left.Interface()), proto.MessageV1(right.Interface()))
+}
@@ -0,0 +1,98 @@
+package communities
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func (s *CommunitySuite) TestApplyDescriptionDiff() {
+	owner := s.buildCommunity(&s.identity.PublicKey)
+
+	member := s.buildCommunity(&s.identity.PublicKey)
+	member.config.PrivateKey = nil
+
+	_, err := member.DescriptionDiff(member.Description())
+	s.Require().Equal(ErrNotAdmin, err)
+
+	base := owner.CopyDescription()
+
+	owner.config.CommunityDescription.Identity = &protobuf.ChatIdentity{DisplayName: "new-name"}
+	owner.increaseClock()
+
+	diff, err := owner.DescriptionDiff(base)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"identity", "event_sequence"}, diff.FieldMask)
+	s.Require().Equal(base.Clock, diff.BaseClock)
+	s.Require().Equal(owner.Clock(), diff.Clock)
+	s.Require().Nil(diff.Description.Members)
+	s.Require().Nil(diff.Description.Chats)
+
+	// Tampering with the diff invalidates the signature
+	tampered := proto.Clone(diff).(*protobuf.CommunityDescriptionDiff)
+	tampered.Description.Identity.DisplayName = "tampered"
+	s.Require().Equal(ErrInvalidCommunityDescriptionDiffSignature, member.ApplyDiff(tampered))
+
+	unknownField := proto.Clone(diff).(*protobuf.CommunityDescriptionDiff)
+	unknownField.FieldMask = append(unknownField.FieldMask, "unknown")
+	s.Require().Equal(ErrInvalidCommunityDescriptionDiff, member.ApplyDiff(unknownField))
+
+	s.Require().NoError(member.ApplyDiff(diff))
+	s.Require().Equal("new-name", member.Name())
+	s.Require().Equal(owner.Clock(), member.Clock())
+	s.Require().True(proto.Equal(owner.Description(), member.Description()))
+
+	// The rebuilt message can be shared as if the full description was received
+	rawMessage, err := member.ToBytes()
+	s.Require().NoError(err)
+	wrapped := &protobuf.ApplicationMetadataMessage{}
+	s.Require().NoError(proto.Unmarshal(rawMessage, wrapped))
+	s.Require().Equal(protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION, wrapped.Type)
+	received := &protobuf.CommunityDescription{}
+	s.Require().NoError(proto.Unmarshal(wrapped.Payload, received))
+	s.Require().True(proto.Equal(owner.Description(), received))
+
+	// The diff doesn't apply anymore, as the base has changed
+	s.Require().Equal(ErrCommunityDescriptionDiffBaseMismatch, member.ApplyDiff(diff))
+}
+
+func (s *CommunitySuite) TestDescriptionDiffClearsFields() {
+	owner := s.buildCommunity(&s.identity.PublicKey)
+
+	member := s.buildCommunity(&s.identity.PublicKey)
+	member.config.PrivateKey = nil
+
+	base := owner.CopyDescription()
+
+	delete(owner.config.CommunityDescription.Members, s.member2Key)
+	owner.config.CommunityDescription.Chats = nil
+	owner.increaseClock()
+
+	diff, err := owner.DescriptionDiff(base)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"members", "chats", "event_sequence"}, diff.FieldMask)
+
+	s.Require().NoError(member.ApplyDiff(diff))
+	s.Require().Len(member.Description().Members, 1)
+	s.Require().Empty(member.Description().Chats)
+	s.Require().True(proto.Equal(owner.Description(), member.Description()))
+}
+
+func (s *CommunitySuite) TestApplyDescriptionDiffBanList() {
+	owner := s.buildCommunity(&s.identity.PublicKey)
+
+	member := s.buildCommunity(&s.identity.PublicKey)
+	member.config.PrivateKey = nil
+
+	s.Require().False(member.IsBanned(&s.member1.PublicKey))
+
+	base := owner.CopyDescription()
+	_, err := owner.BanUserFromCommunity(&s.member1.PublicKey)
+	s.Require().NoError(err)
+
+	diff, err := owner.DescriptionDiff(base)
+	s.Require().NoError(err)
+
+	s.Require().NoError(member.ApplyDiff(diff))
+	s.Require().True(member.IsBanned(&s.member1.PublicKey))
+}
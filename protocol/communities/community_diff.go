@@ -0,0 +1,84 @@
+package communities
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+var ErrNilCommunityDescription = errors.New("community description is nil")
+
+// CommunityDiff lists what changed between two community descriptions
+type CommunityDiff struct {
+	AddedMembers   []string `json:"addedMembers"`
+	RemovedMembers []string `json:"removedMembers"`
+	AddedChats     []string `json:"addedChats"`
+	RemovedChats   []string `json:"removedChats"`
+	// DescriptionChanged is set when the identity, tags, permissions or the
+	// intro/outro messages of the community changed
+	DescriptionChanged bool `json:"descriptionChanged"`
+}
+
+func (d CommunityDiff) Empty() bool {
+	return len(d.AddedMembers) == 0 && len(d.RemovedMembers) == 0 &&
+		len(d.AddedChats) == 0 && len(d.RemovedChats) == 0 &&
+		!d.DescriptionChanged
+}
+
+// DiffDescription compares the current description of the community with `other`,
+// returning what `other` adds, removes or changes.
+// Unknown tags are ignored, as they are dropped when the description is applied
+func (o *Community) DiffDescription(other *protobuf.CommunityDescription) (CommunityDiff, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if other == nil {
+		return CommunityDiff{}, ErrNilCommunityDescription
+	}
+
+	current := o.config.CommunityDescription
+	if current == nil {
+		current = &protobuf.CommunityDescription{}
+	}
+
+	var diff CommunityDiff
+	diff.AddedMembers = missingKeys(other.Members, current.Members)
+	diff.RemovedMembers = missingKeys(current.Members, other.Members)
+	diff.AddedChats = missingKeys(other.Chats, current.Chats)
+	diff.RemovedChats = missingKeys(current.Chats, other.Chats)
+	diff.DescriptionChanged = !proto.Equal(current.Identity, other.Identity) ||
+		!proto.Equal(current.Permissions, other.Permissions) ||
+		current.IntroMessage != other.IntroMessage ||
+		current.OutroMessage != other.OutroMessage ||
+		!equalTags(current.Tags, requests.RemoveUnknownAndDeduplicateTags(other.Tags))
+
+	return diff, nil
+}
+
+// missingKeys returns the sorted keys of `from` that are not in `in`
+func missingKeys[T any](from map[string]T, in map[string]T) []string {
+	var keys []string
+	for key := range from {
+		if _, ok := in[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func equalTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
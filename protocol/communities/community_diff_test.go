@@ -0,0 +1,39 @@
+package communities
+
+import (
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func (s *CommunitySuite) TestDiffDescription() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+
+	_, err := org.DiffDescription(nil)
+	s.Require().Equal(ErrNilCommunityDescription, err)
+
+	diff, err := org.DiffDescription(s.identicalCommunityDescription(org))
+	s.Require().NoError(err)
+	s.Require().True(diff.Empty())
+
+	description := s.identicalCommunityDescription(org)
+	delete(description.Members, s.member1Key)
+	description.Members[s.member3Key] = &protobuf.CommunityMember{}
+	delete(description.Chats, testChatID1)
+	description.Chats["new-chat-id"] = &protobuf.CommunityChat{}
+
+	diff, err = org.DiffDescription(description)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{s.member3Key}, diff.AddedMembers)
+	s.Require().Equal([]string{s.member1Key}, diff.RemovedMembers)
+	s.Require().Equal([]string{"new-chat-id"}, diff.AddedChats)
+	s.Require().Equal([]string{testChatID1}, diff.RemovedChats)
+	s.Require().False(diff.DescriptionChanged)
+
+	description = s.identicalCommunityDescription(org)
+	description.Identity = &protobuf.ChatIdentity{DisplayName: "new-name"}
+
+	diff, err = org.DiffDescription(description)
+	s.Require().NoError(err)
+	s.Require().True(diff.DescriptionChanged)
+	s.Require().Empty(diff.AddedMembers)
+	s.Require().Empty(diff.RemovedChats)
+}
@@ -2,6 +2,7 @@ package communities
 
 import (
 	"crypto/ecdsa"
+	"sort"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -1011,3 +1012,93 @@ func (s *CommunitySuite) removedChatCommunityDescription(org *Community) *protob
 
 	return description
 }
+
+func (s *CommunitySuite) TestHasPermission() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	org.config.PrivateKey = nil
+
+	admin := &s.member1.PublicKey
+	moderator := &s.member2.PublicKey
+	member := &s.member3.PublicKey
+	usersManager, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	nonMember, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	members := org.config.CommunityDescription.Members
+	members[s.member1Key] = &protobuf.CommunityMember{Roles: []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ALL}}
+	members[s.member2Key] = &protobuf.CommunityMember{Roles: []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_MODERATE_CONTENT}}
+	members[s.member3Key] = &protobuf.CommunityMember{}
+	members[common.PubkeyToHex(&usersManager.PublicKey)] = &protobuf.CommunityMember{Roles: []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_MANAGE_USERS}}
+
+	testCases := []struct {
+		name            string
+		pk              *ecdsa.PublicKey
+		admin           bool
+		manageUsers     bool
+		moderateContent bool
+	}{
+		{"owner", &s.identity.PublicKey, true, false, false},
+		{"admin", admin, true, true, true},
+		{"moderator", moderator, false, false, true},
+		{"users manager", &usersManager.PublicKey, false, true, false},
+		{"member", member, false, false, false},
+		{"non member", &nonMember.PublicKey, false, false, false},
+	}
+
+	for _, tc := range testCases {
+		s.Require().Equal(tc.admin, org.HasPermission(tc.pk, CommunityPermissionAdmin), tc.name)
+		s.Require().Equal(tc.admin, org.IsMemberAdmin(tc.pk), tc.name)
+		s.Require().Equal(tc.manageUsers, org.HasPermission(tc.pk, CommunityPermissionManageUsers), tc.name)
+		s.Require().Equal(tc.manageUsers, org.CanManageUsers(tc.pk), tc.name)
+		s.Require().Equal(tc.moderateContent, org.HasPermission(tc.pk, CommunityPermissionModerateContent), tc.name)
+		s.Require().Equal(tc.moderateContent, org.CanDeleteMessageForEveryone(tc.pk), tc.name)
+		s.Require().False(org.HasPermission(tc.pk, CommunityPermission(0)), tc.name)
+	}
+
+	// Controlling the community grants user management and moderation
+	org.config.PrivateKey = s.identity
+	s.Require().True(org.HasPermission(member, CommunityPermissionManageUsers))
+	s.Require().True(org.HasPermission(member, CommunityPermissionModerateContent))
+	s.Require().False(org.HasPermission(member, CommunityPermissionAdmin))
+}
+
+func (s *CommunitySuite) TestBannedMembers() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	org.config.PrivateKey = s.identity
+
+	s.Require().Empty(org.GetBannedMembers())
+	s.Require().False(org.IsMemberBanned(s.member1Key))
+
+	_, err := org.BanUserFromCommunity(&s.member2.PublicKey)
+	s.Require().NoError(err)
+	_, err = org.BanUserFromCommunity(&s.member1.PublicKey)
+	s.Require().NoError(err)
+	_, err = org.BanUserFromCommunity(&s.member1.PublicKey)
+	s.Require().NoError(err)
+
+	s.Require().True(org.IsMemberBanned(s.member1Key))
+	s.Require().True(org.IsMemberBanned(s.member2Key))
+	s.Require().False(org.IsMemberBanned(s.member3Key))
+
+	expected := []string{s.member1Key, s.member2Key}
+	sort.Strings(expected)
+	s.Require().Equal(expected, org.GetBannedMembers())
+
+	_, err = org.UnbanUserFromCommunity(&s.member1.PublicKey)
+	s.Require().NoError(err)
+
+	s.Require().False(org.IsMemberBanned(s.member1Key))
+	s.Require().True(org.IsMemberBanned(s.member2Key))
+	s.Require().Equal([]string{s.member2Key}, org.GetBannedMembers())
+
+	// A newer description received from the owner replaces the ban list
+	description := s.identicalCommunityDescription(org)
+	description.BanList = []string{s.member3Key, s.member3Key}
+	_, err = org.UpdateCommunityDescription(&s.identity.PublicKey, description, []byte{})
+	s.Require().NoError(err)
+
+	s.Require().False(org.IsMemberBanned(s.member2Key))
+	s.Require().True(org.IsMemberBanned(s.member3Key))
+	s.Require().Equal([]string{s.member3Key}, org.GetBannedMembers())
+}
@@ -0,0 +1,25 @@
+package communities
+
+// ChatUnreadCounter is implemented by chats that can be aggregated into the
+// unread counts of a community
+type ChatUnreadCounter interface {
+	GetCommunityID() string
+	IsActive() bool
+	UnreadCounts() (messages uint, mentions uint)
+}
+
+// TotalUnreadCount sums the unread messages and mentions of the active chats
+// belonging to the community. Chats of other communities are ignored.
+func (o *Community) TotalUnreadCount(chats []ChatUnreadCounter) (messages, mentions uint) {
+	communityID := o.IDString()
+	for _, chat := range chats {
+		if chat.GetCommunityID() != communityID || !chat.IsActive() {
+			continue
+		}
+
+		chatMessages, chatMentions := chat.UnreadCounts()
+		messages += chatMessages
+		mentions += chatMentions
+	}
+	return messages, mentions
+}
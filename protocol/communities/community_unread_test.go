@@ -0,0 +1,47 @@
+package communities
+
+type testUnreadChat struct {
+	communityID string
+	active      bool
+	messages    uint
+	mentions    uint
+}
+
+func (c *testUnreadChat) GetCommunityID() string {
+	return c.communityID
+}
+
+func (c *testUnreadChat) IsActive() bool {
+	return c.active
+}
+
+func (c *testUnreadChat) UnreadCounts() (uint, uint) {
+	return c.messages, c.mentions
+}
+
+func (s *CommunitySuite) TestTotalUnreadCount() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	communityID := org.IDString()
+
+	messages, mentions := org.TotalUnreadCount(nil)
+	s.Require().Equal(uint(0), messages)
+	s.Require().Equal(uint(0), mentions)
+
+	chats := []ChatUnreadCounter{
+		// unread
+		&testUnreadChat{communityID: communityID, active: true, messages: 3, mentions: 1},
+		&testUnreadChat{communityID: communityID, active: true, messages: 5, mentions: 2},
+		// read
+		&testUnreadChat{communityID: communityID, active: true},
+		// inactive
+		&testUnreadChat{communityID: communityID, active: false, messages: 7, mentions: 7},
+		// other community
+		&testUnreadChat{communityID: "0x1234", active: true, messages: 11, mentions: 11},
+		// personal chat
+		&testUnreadChat{active: true, messages: 13, mentions: 13},
+	}
+
+	messages, mentions = org.TotalUnreadCount(chats)
+	s.Require().Equal(uint(8), messages)
+	s.Require().Equal(uint(3), mentions)
+}
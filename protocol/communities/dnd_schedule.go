@@ -0,0 +1,75 @@
+package communities
+
+import (
+	"time"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// DNDSchedule is a daily window, in the given timezone, during which notifications
+// for a community are not shown. The window wraps around midnight when StartHour
+// is greater than EndHour, and is empty when both are equal
+type DNDSchedule struct {
+	StartHour int    `json:"startHour"`
+	EndHour   int    `json:"endHour"`
+	Timezone  string `json:"timezone"`
+}
+
+func (s *DNDSchedule) Validate() error {
+	if s.StartHour < 0 || s.StartHour > 23 || s.EndHour < 0 || s.EndHour > 23 {
+		return ErrInvalidDNDScheduleHour
+	}
+
+	if s.Timezone == "" {
+		return ErrInvalidDNDScheduleTimezone
+	}
+
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return ErrInvalidDNDScheduleTimezone
+	}
+
+	return nil
+}
+
+// Active returns whether t falls within the do not disturb window
+func (s *DNDSchedule) Active(t time.Time) bool {
+	if s == nil || s.StartHour == s.EndHour {
+		return false
+	}
+
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return false
+	}
+
+	hour := t.In(location).Hour()
+	if s.StartHour < s.EndHour {
+		return hour >= s.StartHour && hour < s.EndHour
+	}
+	return hour >= s.StartHour || hour < s.EndHour
+}
+
+// DNDScheduleFromSyncProtobuf returns the schedule carried by the sync message, or nil if none is set
+func DNDScheduleFromSyncProtobuf(settings *protobuf.SyncCommunitySettings) *DNDSchedule {
+	if settings.DndTimezone == "" {
+		return nil
+	}
+	return &DNDSchedule{
+		StartHour: int(settings.DndStartHour),
+		EndHour:   int(settings.DndEndHour),
+		Timezone:  settings.DndTimezone,
+	}
+}
+
+// SetSyncProtobufFields copies the schedule into the sync message, a nil schedule clears it
+func (s *DNDSchedule) SetSyncProtobufFields(settings *protobuf.SyncCommunitySettings) {
+	if s == nil {
+		settings.DndStartHour = 0
+		settings.DndEndHour = 0
+		settings.DndTimezone = ""
+		return
+	}
+	settings.DndStartHour = int32(s.StartHour)
+	settings.DndEndHour = int32(s.EndHour)
+	settings.DndTimezone = s.Timezone
+}
@@ -0,0 +1,59 @@
+package communities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestDNDScheduleValidate(t *testing.T) {
+	require.NoError(t, (&DNDSchedule{StartHour: 22, EndHour: 7, Timezone: "Europe/Berlin"}).Validate())
+	require.Equal(t, ErrInvalidDNDScheduleHour, (&DNDSchedule{StartHour: -1, EndHour: 7, Timezone: "UTC"}).Validate())
+	require.Equal(t, ErrInvalidDNDScheduleHour, (&DNDSchedule{StartHour: 22, EndHour: 24, Timezone: "UTC"}).Validate())
+	require.Equal(t, ErrInvalidDNDScheduleTimezone, (&DNDSchedule{StartHour: 22, EndHour: 7}).Validate())
+	require.Equal(t, ErrInvalidDNDScheduleTimezone, (&DNDSchedule{StartHour: 22, EndHour: 7, Timezone: "Mars/Olympus"}).Validate())
+}
+
+func TestDNDScheduleActive(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2023, 3, 22, hour, 30, 0, 0, time.UTC)
+	}
+
+	daytime := &DNDSchedule{StartHour: 9, EndHour: 17, Timezone: "UTC"}
+	require.False(t, daytime.Active(at(8)))
+	require.True(t, daytime.Active(at(9)))
+	require.True(t, daytime.Active(at(16)))
+	require.False(t, daytime.Active(at(17)))
+
+	overnight := &DNDSchedule{StartHour: 22, EndHour: 7, Timezone: "UTC"}
+	require.True(t, overnight.Active(at(23)))
+	require.True(t, overnight.Active(at(0)))
+	require.True(t, overnight.Active(at(6)))
+	require.False(t, overnight.Active(at(7)))
+	require.False(t, overnight.Active(at(21)))
+
+	// 20:30 UTC is 22:30 in Berlin during summer time
+	berlin := &DNDSchedule{StartHour: 22, EndHour: 7, Timezone: "Europe/Berlin"}
+	require.True(t, berlin.Active(time.Date(2023, 7, 1, 20, 30, 0, 0, time.UTC)))
+	require.False(t, berlin.Active(time.Date(2023, 7, 1, 19, 30, 0, 0, time.UTC)))
+
+	require.False(t, (&DNDSchedule{StartHour: 5, EndHour: 5, Timezone: "UTC"}).Active(at(5)))
+
+	var none *DNDSchedule
+	require.False(t, none.Active(at(5)))
+}
+
+func TestDNDScheduleSyncProtobuf(t *testing.T) {
+	schedule := &DNDSchedule{StartHour: 22, EndHour: 7, Timezone: "Europe/Berlin"}
+
+	settings := &protobuf.SyncCommunitySettings{}
+	schedule.SetSyncProtobufFields(settings)
+	require.Equal(t, schedule, DNDScheduleFromSyncProtobuf(settings))
+
+	var none *DNDSchedule
+	none.SetSyncProtobufFields(settings)
+	require.Nil(t, DNDScheduleFromSyncProtobuf(settings))
+}
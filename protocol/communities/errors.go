@@ -5,10 +5,14 @@ import "errors"
 var ErrChatNotFound = errors.New("chat not found")
 var ErrCategoryNotFound = errors.New("category not found")
 var ErrNoChangeInPosition = errors.New("no change in category position")
+var ErrNoChangeInChatPosition = errors.New("no change in chat positions")
 var ErrChatAlreadyAssigned = errors.New("chat already assigned to a category")
 var ErrOrgNotFound = errors.New("community not found")
+var ErrCommunityEventReplayed = errors.New("community event sequence is older than the last seen")
 var ErrChatAlreadyExists = errors.New("chat already exists")
 var ErrCategoryAlreadyExists = errors.New("category already exists")
+var ErrInvalidCategoryNameLength = errors.New("category name must be between 1 and 48 characters")
+var ErrInvalidCategoryPosition = errors.New("category position can't be negative")
 var ErrCantRequestAccess = errors.New("can't request access")
 var ErrInvalidCommunityDescription = errors.New("invalid community description")
 var ErrInvalidCommunityDescriptionNoOrgPermissions = errors.New("invalid community description no org permissions")
@@ -18,10 +22,14 @@ var ErrInvalidCommunityDescriptionUnknownOrgAccess = errors.New("invalid communi
 var ErrInvalidCommunityDescriptionMemberInChatButNotInOrg = errors.New("invalid community description member in chat but not in org")
 var ErrInvalidCommunityDescriptionCategoryNoID = errors.New("invalid community category id")
 var ErrInvalidCommunityDescriptionCategoryNoName = errors.New("invalid community category name")
+var ErrInvalidMaxPinnedMessages = errors.New("invalid max pinned messages")
 var ErrInvalidCommunityDescriptionChatIdentity = errors.New("invalid community chat name, missing")
 var ErrInvalidCommunityDescriptionDuplicatedName = errors.New("invalid community chat name, duplicated")
 var ErrInvalidCommunityDescriptionUnknownChatCategory = errors.New("invalid community category in chat")
 var ErrInvalidCommunityTags = errors.New("invalid community tags")
+var ErrUnknownCommunityTag = errors.New("unknown community tag")
+var ErrDuplicatedCommunityTag = errors.New("duplicated community tag")
+var ErrTooManyCommunityTags = errors.New("too many community tags")
 var ErrNotAdmin = errors.New("no admin privileges for this community")
 var ErrInvalidGrant = errors.New("invalid grant")
 var ErrNotAuthorized = errors.New("not authorized")
@@ -34,3 +42,13 @@ var ErrTokenPermissionNotFound = errors.New("token permission not found")
 var ErrNoPermissionToJoin = errors.New("member has no permission to join")
 var ErrMemberWalletAlreadyExists = errors.New("member wallet already exists")
 var ErrMemberWalletNotFound = errors.New("member wallet not found")
+var ErrWebhookNotFound = errors.New("webhook not found")
+var ErrInvalidWebhookURL = errors.New("invalid webhook url, must be an http or https url")
+var ErrInvalidWebhookSecret = errors.New("invalid webhook secret, can't be empty")
+var ErrUnknownWebhookEvent = errors.New("unknown webhook event")
+var ErrInvalidDNDScheduleHour = errors.New("invalid do not disturb hour, must be between 0 and 23")
+var ErrInvalidDNDScheduleTimezone = errors.New("invalid do not disturb timezone")
+var ErrInvalidCommunityDescriptionDiff = errors.New("invalid community description diff")
+var ErrInvalidCommunityDescriptionDiffSignature = errors.New("invalid community description diff signature")
+var ErrCommunityDescriptionDiffBaseMismatch = errors.New("community description diff doesn't apply to the current description")
+var ErrInvalidInviteDeepLink = errors.New("invalid community invite deep link")
@@ -0,0 +1,49 @@
+package communities
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+)
+
+const inviteDeepLinkScheme = "status-app"
+const inviteDeepLinkHost = "join-community"
+
+// ExportInviteDeepLink returns a link to share with the users we want to invite
+// to the community, in the form status-app://join-community/<base64 id>?name=<name>
+func (o *Community) ExportInviteDeepLink() string {
+	link := url.URL{
+		Scheme:   inviteDeepLinkScheme,
+		Host:     inviteDeepLinkHost,
+		Path:     "/" + base64.RawURLEncoding.EncodeToString(o.ID()),
+		RawQuery: url.Values{"name": []string{o.Name()}}.Encode(),
+	}
+	return link.String()
+}
+
+// ParseInviteDeepLink returns the community ID and name of a link created by
+// ExportInviteDeepLink
+func ParseInviteDeepLink(link string) (communityID []byte, name string, err error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, "", ErrInvalidInviteDeepLink
+	}
+
+	if u.Scheme != inviteDeepLinkScheme || u.Host != inviteDeepLinkHost {
+		return nil, "", ErrInvalidInviteDeepLink
+	}
+
+	communityID, err = base64.RawURLEncoding.DecodeString(strings.TrimPrefix(u.Path, "/"))
+	if err != nil {
+		return nil, "", ErrInvalidInviteDeepLink
+	}
+
+	// Community IDs are compressed public keys
+	if _, err := crypto.DecompressPubkey(communityID); err != nil {
+		return nil, "", ErrInvalidInviteDeepLink
+	}
+
+	return communityID, u.Query().Get("name"), nil
+}
@@ -0,0 +1,54 @@
+package communities
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestInviteDeepLinkRoundTrip(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	community, err := New(Config{
+		ID:             &key.PublicKey,
+		MemberIdentity: &key.PublicKey,
+		CommunityDescription: &protobuf.CommunityDescription{
+			Identity: &protobuf.ChatIdentity{DisplayName: "Status & friends / 100%"},
+		},
+	})
+	require.NoError(t, err)
+
+	link := community.ExportInviteDeepLink()
+	require.Contains(t, link, "status-app://join-community/")
+
+	communityID, name, err := ParseInviteDeepLink(link)
+	require.NoError(t, err)
+	require.Equal(t, []byte(community.ID()), communityID)
+	require.Equal(t, "Status & friends / 100%", name)
+}
+
+func TestParseInviteDeepLinkMalformed(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	encodedID := base64.RawURLEncoding.EncodeToString(crypto.CompressPubkey(&key.PublicKey))
+
+	links := []string{
+		"",
+		"://join-community",
+		"https://join-community/" + encodedID,
+		"status-app://join-chat/" + encodedID,
+		"status-app://join-community/",
+		"status-app://join-community/not*base64",
+		"status-app://join-community/" + base64.RawURLEncoding.EncodeToString([]byte("not a key")),
+	}
+
+	for _, link := range links {
+		_, _, err := ParseInviteDeepLink(link)
+		require.Equal(t, ErrInvalidInviteDeepLink, err, link)
+	}
+}
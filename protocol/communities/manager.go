@@ -55,6 +55,7 @@ var memberPermissionsCheckInterval = 1 * time.Hour
 var (
 	ErrTorrentTimedout                 = errors.New("torrent has timed out")
 	ErrCommunityRequestAlreadyRejected = errors.New("that user was already rejected from the community")
+	ErrRequestToJoinCooldown           = errors.New("request to join sent too soon after the previous one")
 )
 
 type Manager struct {
@@ -77,6 +78,7 @@ type Manager struct {
 	periodicMemberPermissionsTasks map[string]chan struct{}
 	torrentTasks                   map[string]metainfo.Hash
 	historyArchiveDownloadTasks    map[string]*HistoryArchiveDownloadTask
+	webhookDeliveries              chan *webhookDelivery
 }
 
 type HistoryArchiveDownloadTask struct {
@@ -152,6 +154,7 @@ func NewManager(identity *ecdsa.PrivateKey, db *sql.DB, encryptor *encryption.Pr
 		periodicMemberPermissionsTasks: make(map[string]chan struct{}),
 		torrentTasks:                   make(map[string]metainfo.Hash),
 		historyArchiveDownloadTasks:    make(map[string]*HistoryArchiveDownloadTask),
+		webhookDeliveries:              make(chan *webhookDelivery, webhookDeliveriesQueueSize),
 		persistence: &Persistence{
 			logger: logger,
 			db:     db,
@@ -217,6 +220,8 @@ type Subscription struct {
 type CommunityResponse struct {
 	Community *Community        `json:"community"`
 	Changes   *CommunityChanges `json:"changes"`
+	// Diff is set when an already known community receives a newer description
+	Diff *CommunityDiff `json:"diff,omitempty"`
 }
 
 func (m *Manager) Subscribe() chan *Subscription {
@@ -230,6 +235,9 @@ func (m *Manager) Start() error {
 		m.runENSVerificationLoop()
 	}
 
+	m.runMemberActivityPruneLoop()
+	m.runWebhookDispatcher()
+
 	if m.torrentConfig != nil && m.torrentConfig.Enabled {
 		err := m.StartTorrentClient()
 		if err != nil {
@@ -439,6 +447,12 @@ func (m *Manager) Created() ([]*Community, error) {
 	return m.persistence.CreatedCommunities(&m.identity.PublicKey)
 }
 
+// ownerRoles are the roles given to the owner of a community. ROLE_ALL is
+// kept so that clients unaware of ROLE_OWNER still see the owner as an admin
+func ownerRoles() []protobuf.CommunityMember_Roles {
+	return []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_OWNER, protobuf.CommunityMember_ROLE_ALL}
+}
+
 // CreateCommunity takes a description, generates an ID for it, saves it and return it
 func (m *Manager) CreateCommunity(request *requests.CreateCommunity, publish bool) (*Community, error) {
 
@@ -448,7 +462,12 @@ func (m *Manager) CreateCommunity(request *requests.CreateCommunity, publish boo
 	}
 
 	description.Members = make(map[string]*protobuf.CommunityMember)
-	description.Members[common.PubkeyToHex(&m.identity.PublicKey)] = &protobuf.CommunityMember{Roles: []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ALL}}
+	description.Members[common.PubkeyToHex(&m.identity.PublicKey)] = &protobuf.CommunityMember{Roles: ownerRoles()}
+
+	err = ValidateTags(description.Tags)
+	if err != nil {
+		return nil, err
+	}
 
 	err = ValidateCommunityDescription(description)
 	if err != nil {
@@ -456,6 +475,7 @@ func (m *Manager) CreateCommunity(request *requests.CreateCommunity, publish boo
 	}
 
 	description.Clock = 1
+	description.EventSequence = 1
 
 	key, err := crypto.GenerateKey()
 	if err != nil {
@@ -707,6 +727,11 @@ func (m *Manager) EditCommunity(request *requests.EditCommunity) (*Community, er
 	}
 	// TODO: handle delete image (if needed)
 
+	err = ValidateTags(newDescription.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	err = ValidateCommunityDescription(newDescription)
 	if err != nil {
 		return nil, err
@@ -1025,6 +1050,37 @@ func (m *Manager) ReorderChat(request *requests.ReorderCommunityChat) (*Communit
 	return community, changes, nil
 }
 
+func (m *Manager) ReorderChannels(request *requests.ReorderCommunityChannels) (*Community, *CommunityChanges, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if community == nil {
+		return nil, nil, ErrOrgNotFound
+	}
+
+	// Remove communityID prefix from chatIDs if exists
+	channelPositions := make(map[string]int32, len(request.ChannelPositions))
+	for chatID, position := range request.ChannelPositions {
+		channelPositions[strings.TrimPrefix(chatID, request.CommunityID.String())] = position
+	}
+
+	changes, err := community.ReorderChannels(channelPositions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Advertise changes
+	m.publish(&Subscription{Community: community})
+
+	return community, changes, nil
+}
+
 func (m *Manager) DeleteCategory(request *requests.DeleteCommunityCategory) (*Community, *CommunityChanges, error) {
 	community, err := m.GetByID(request.CommunityID)
 	if err != nil {
@@ -1057,6 +1113,7 @@ func (m *Manager) HandleCommunityDescriptionMessage(signer *ecdsa.PublicKey, des
 		return nil, err
 	}
 
+	var diff *CommunityDiff
 	if community == nil {
 		config := Config{
 			CommunityDescription:          description,
@@ -1070,6 +1127,18 @@ func (m *Manager) HandleCommunityDescriptionMessage(signer *ecdsa.PublicKey, des
 		if err != nil {
 			return nil, err
 		}
+	} else {
+		if err := m.checkCommunityEventSequence(community, description); err != nil {
+			return nil, err
+		}
+
+		if community.supersededBy(description) {
+			d, err := community.DiffDescription(description)
+			if err != nil {
+				return nil, err
+			}
+			diff = &d
+		}
 	}
 
 	changes, err := community.UpdateCommunityDescription(signer, description, payload)
@@ -1136,9 +1205,31 @@ func (m *Manager) HandleCommunityDescriptionMessage(signer *ecdsa.PublicKey, des
 	return &CommunityResponse{
 		Community: community,
 		Changes:   changes,
+		Diff:      diff,
 	}, nil
 }
 
+// HandleCommunityDescriptionDiff applies a diff published by the owner to the
+// stored community description. Diffs computed against a description we don't
+// have are rejected, we will catch up when the full description is republished.
+func (m *Manager) HandleCommunityDescriptionDiff(signer *ecdsa.PublicKey, diff *protobuf.CommunityDescriptionDiff) (*CommunityResponse, error) {
+	id := crypto.CompressPubkey(signer)
+	community, err := m.persistence.GetByID(&m.identity.PublicKey, id)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	description, rawMessage, err := applyDescriptionDiff(signer, community.Description(), diff)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.HandleCommunityDescriptionMessage(signer, description, rawMessage)
+}
+
 // TODO: This is not fully implemented, we want to save the grant passed at
 // this stage and make sure it's used when publishing.
 func (m *Manager) HandleCommunityInvitation(signer *ecdsa.PublicKey, invitation *protobuf.CommunityInvitation, payload []byte) (*CommunityResponse, error) {
@@ -1154,11 +1245,60 @@ func (m *Manager) HandleCommunityInvitation(signer *ecdsa.PublicKey, invitation
 	return community, nil
 }
 
+// checkCommunityEventSequence rejects descriptions with an event sequence lower than
+// the last one applied, as they are replays of older events.
+// Equal sequences are left to the clock check, since owners running older versions
+// keep the last sequence they have seen while updating the clock.
+// Descriptions without a sequence come from older versions and are always let through.
+func (m *Manager) checkCommunityEventSequence(community *Community, description *protobuf.CommunityDescription) error {
+	if description.EventSequence == 0 {
+		return nil
+	}
+
+	lastEventSequence, err := m.persistence.GetCommunityEventSequence(community.ID())
+	if err != nil {
+		return err
+	}
+
+	if description.EventSequence < lastEventSequence {
+		return ErrCommunityEventReplayed
+	}
+
+	return nil
+}
+
+// checkRequestToJoinCooldown rejects requests to join sent before the cooldown
+// since the previous request from the same user has passed, and records the new one otherwise
+func (m *Manager) checkRequestToJoinCooldown(signer *ecdsa.PublicKey, community *Community) error {
+	pkString := common.PubkeyToHex(signer)
+
+	settings, err := m.persistence.GetCommunitySettingsByID(community.ID())
+	if err != nil {
+		return err
+	}
+
+	lastReceivedAt, err := m.persistence.GetRequestToJoinReceivedAt(community.ID(), pkString)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if lastReceivedAt != 0 && now.Before(time.Unix(int64(lastReceivedAt), 0).Add(settings.RequestToJoinCooldownOrDefault())) {
+		return ErrRequestToJoinCooldown
+	}
+
+	return m.persistence.SetRequestToJoinReceivedAt(community.ID(), pkString, uint64(now.Unix()))
+}
+
 // markRequestToJoin marks all the pending requests to join as completed
-// if we are members
+// if we are members, resetting the request to join cooldown
 func (m *Manager) markRequestToJoin(pk *ecdsa.PublicKey, community *Community) error {
 	if community.HasMember(pk) {
-		return m.persistence.SetRequestToJoinState(common.PubkeyToHex(pk), community.ID(), RequestToJoinStateAccepted)
+		pkString := common.PubkeyToHex(pk)
+		if err := m.persistence.DeleteRequestToJoinReceivedAt(community.ID(), pkString); err != nil {
+			return err
+		}
+		return m.persistence.SetRequestToJoinState(pkString, community.ID(), RequestToJoinStateAccepted)
 	}
 	return nil
 }
@@ -1263,6 +1403,8 @@ func (m *Manager) AcceptRequestToJoin(request *requests.AcceptRequestToJoinCommu
 
 	m.publish(&Subscription{Community: community})
 
+	m.DispatchWebhookEvent(community, WebhookEventMemberJoined, map[string]string{"publicKey": dbRequest.PublicKey})
+
 	return community, nil
 }
 
@@ -1344,6 +1486,10 @@ func (m *Manager) HandleCommunityRequestToJoin(signer *ecdsa.PublicKey, request
 		return nil, err
 	}
 
+	if err := m.checkRequestToJoinCooldown(signer, community); err != nil {
+		return nil, err
+	}
+
 	requestToJoin := &RequestToJoin{
 		PublicKey:         common.PubkeyToHex(signer),
 		Clock:             request.Clock,
@@ -1693,6 +1839,16 @@ func (m *Manager) GetRequestToJoinIDByPkAndCommunityID(pk *ecdsa.PublicKey, comm
 	return m.persistence.GetRequestToJoinIDByPkAndCommunityID(common.PubkeyToHex(pk), communityID)
 }
 
+// GetRequestToJoinByPkAndCommunityID returns the request to join the community
+// made by `pk`, nil if there is none
+func (m *Manager) GetRequestToJoinByPkAndCommunityID(pk *ecdsa.PublicKey, communityID []byte) (*RequestToJoin, error) {
+	request, err := m.persistence.GetRequestToJoinByPkAndCommunityID(common.PubkeyToHex(pk), communityID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return request, err
+}
+
 func (m *Manager) UpdateCommunityDescriptionMagnetlinkMessageClock(communityID types.HexBytes, clock uint64) error {
 	community, err := m.GetByIDString(communityID.String())
 	if err != nil {
@@ -1779,7 +1935,7 @@ func (m *Manager) AddMemberOwnerToCommunity(communityID types.HexBytes, pk *ecds
 		return nil, ErrOrgNotFound
 	}
 
-	err = community.AddMember(pk, []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ALL})
+	err = community.AddMember(pk, ownerRoles())
 	if err != nil {
 		return nil, err
 	}
@@ -2062,6 +2218,7 @@ func (m *Manager) HandleSyncCommunitySettings(syncCommunitySettings *protobuf.Sy
 			CommunityID:                  syncCommunitySettings.CommunityId,
 			HistoryArchiveSupportEnabled: syncCommunitySettings.HistoryArchiveSupportEnabled,
 			Clock:                        syncCommunitySettings.Clock,
+			DNDSchedule:                  DNDScheduleFromSyncProtobuf(syncCommunitySettings),
 		}
 	}
 
@@ -2069,6 +2226,7 @@ func (m *Manager) HandleSyncCommunitySettings(syncCommunitySettings *protobuf.Sy
 		settings.CommunityID = syncCommunitySettings.CommunityId
 		settings.HistoryArchiveSupportEnabled = syncCommunitySettings.HistoryArchiveSupportEnabled
 		settings.Clock = syncCommunitySettings.Clock
+		settings.DNDSchedule = DNDScheduleFromSyncProtobuf(syncCommunitySettings)
 	}
 
 	err = m.persistence.SaveCommunitySettings(*settings)
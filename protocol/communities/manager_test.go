@@ -6,6 +6,8 @@ import (
 	"image/png"
 	"io/ioutil"
 	"os"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -13,8 +15,10 @@ import (
 	"github.com/status-im/status-go/eth-node/types"
 	userimages "github.com/status-im/status-go/images"
 	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/requests"
 	"github.com/status-im/status-go/protocol/transport"
+	"github.com/status-im/status-go/protocol/tt"
 
 	"github.com/golang/protobuf/proto"
 	_ "github.com/mutecomm/go-sqlcipher" // require go-sqlcipher that overrides default implementation
@@ -36,6 +40,10 @@ type ManagerSuite struct {
 }
 
 func (s *ManagerSuite) SetupTest() {
+	s.manager = s.buildManager()
+}
+
+func (s *ManagerSuite) buildManager() *Manager {
 	dbPath, err := ioutil.TempFile("", "")
 	s.NoError(err, "creating temp file for db")
 	db, err := appdatabase.InitializeDB(dbPath.Name(), "", sqlite.ReducedKDFIterationsNumber)
@@ -45,11 +53,10 @@ func (s *ManagerSuite) SetupTest() {
 
 	key, err := crypto.GenerateKey()
 	s.Require().NoError(err)
-	s.Require().NoError(err)
 	m, err := NewManager(key, db, nil, nil, nil, nil, nil)
 	s.Require().NoError(err)
 	s.Require().NoError(m.Start())
-	s.manager = m
+	return m
 }
 
 func (s *ManagerSuite) TestCreateCommunity() {
@@ -79,6 +86,98 @@ func (s *ManagerSuite) TestCreateCommunity() {
 	s.Require().True(proto.Equal(community.config.CommunityDescription, actualCommunity.config.CommunityDescription))
 }
 
+func (s *ManagerSuite) TestCommunityOwner() {
+	request := &requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+	}
+
+	community, err := s.manager.CreateCommunity(request, true)
+	s.Require().NoError(err)
+	s.Require().True(community.IsOwner())
+	s.Require().True(community.IsMemberAdmin(&s.manager.identity.PublicKey))
+
+	admin, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	s.Require().NoError(community.AddMember(&admin.PublicKey, []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ALL}))
+
+	s.Require().True(community.IsMemberAdmin(&admin.PublicKey))
+	s.Require().False(community.IsMemberOwner(&admin.PublicKey))
+
+	// Seen from the regular admin, who doesn't have the community key
+	privateKey := community.config.PrivateKey
+	community.config.MemberIdentity = &admin.PublicKey
+	community.config.PrivateKey = nil
+	s.Require().False(community.IsOwner())
+	community.config.MemberIdentity = &s.manager.identity.PublicKey
+	community.config.PrivateKey = privateKey
+
+	// The owner role can't be granted to other members
+	addRole := &requests.AddRoleToMember{
+		CommunityID: community.ID(),
+		User:        crypto.FromECDSAPub(&admin.PublicKey),
+		Role:        protobuf.CommunityMember_ROLE_OWNER,
+	}
+	s.Require().Equal(requests.ErrAddRoleToMemberInvalidRole, addRole.Validate())
+}
+
+func (s *ManagerSuite) TestCommunityOwnerWithoutOwnerRole() {
+	request := &requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+	}
+
+	community, err := s.manager.CreateCommunity(request, true)
+	s.Require().NoError(err)
+
+	// Communities saved before the owner role only gave ROLE_ALL to their creator
+	ownerKey := common.PubkeyToHex(&s.manager.identity.PublicKey)
+	community.config.CommunityDescription.Members[ownerKey].Roles = []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ALL}
+	s.Require().NoError(s.manager.persistence.SaveCommunity(community))
+
+	community, err = s.manager.GetByID(community.ID())
+	s.Require().NoError(err)
+	s.Require().False(community.hasMemberPermission(community.getMember(&s.manager.identity.PublicKey), ownerRolePermissions()))
+	s.Require().True(community.IsOwner())
+	s.Require().True(community.IsMemberOwner(&s.manager.identity.PublicKey))
+}
+
+func (s *ManagerSuite) TestGetRequestToJoinByPkAndCommunityID() {
+	request := &requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_ON_REQUEST,
+	}
+
+	community, err := s.manager.CreateCommunity(request, true)
+	s.Require().NoError(err)
+
+	requester, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	requestToJoin, err := s.manager.GetRequestToJoinByPkAndCommunityID(&requester.PublicKey, community.ID())
+	s.Require().NoError(err)
+	s.Require().Nil(requestToJoin)
+
+	saved := &RequestToJoin{
+		PublicKey:   common.PubkeyToHex(&requester.PublicKey),
+		Clock:       10,
+		CommunityID: community.ID(),
+		State:       RequestToJoinStatePending,
+	}
+	saved.CalculateID()
+	s.Require().NoError(s.manager.SaveRequestToJoin(saved))
+
+	requestToJoin, err = s.manager.GetRequestToJoinByPkAndCommunityID(&requester.PublicKey, community.ID())
+	s.Require().NoError(err)
+	s.Require().NotNil(requestToJoin)
+	s.Require().Equal(saved.ID, requestToJoin.ID)
+	s.Require().Equal(RequestToJoinStatePending, requestToJoin.State)
+	s.Require().Equal(uint64(10), requestToJoin.Clock)
+}
+
 func (s *ManagerSuite) TestCreateCommunity_WithBanner() {
 	// Generate test image bigger than BannerDim
 	testImage := image.NewRGBA(image.Rect(0, 0, 20, 10))
@@ -198,10 +297,7 @@ func (s *ManagerSuite) TestStartHistoryArchiveTasksInterval() {
 
 	interval := 10 * time.Second
 	go s.manager.StartHistoryArchiveTasksInterval(community, interval)
-	// Due to async exec we need to wait a bit until we check
-	// the task count.
-	time.Sleep(5 * time.Second)
-	s.Require().Len(s.manager.historyArchiveTasks, 1)
+	s.waitForHistoryArchiveTasks(1)
 
 	// We wait another 5 seconds to ensure the first tick has kicked in
 	time.Sleep(5 * time.Second)
@@ -229,8 +325,7 @@ func (s *ManagerSuite) TestStopHistoryArchiveTasksIntervals() {
 	interval := 10 * time.Second
 	go s.manager.StartHistoryArchiveTasksInterval(community, interval)
 
-	time.Sleep(2 * time.Second)
-	s.Require().Len(s.manager.historyArchiveTasks, 1)
+	s.waitForHistoryArchiveTasks(1)
 	s.manager.StopHistoryArchiveTasksIntervals()
 	s.Require().Len(s.manager.historyArchiveTasks, 0)
 }
@@ -248,10 +343,7 @@ func (s *ManagerSuite) TestStopTorrentClient_ShouldStopHistoryArchiveTasks() {
 
 	interval := 10 * time.Second
 	go s.manager.StartHistoryArchiveTasksInterval(community, interval)
-	// Due to async exec we need to wait a bit until we check
-	// the task count.
-	time.Sleep(2 * time.Second)
-	s.Require().Len(s.manager.historyArchiveTasks, 1)
+	s.waitForHistoryArchiveTasks(1)
 
 	errs := s.manager.StopTorrentClient()
 	s.Require().Len(errs, 0)
@@ -687,6 +779,14 @@ func buildMessage(timestamp time.Time, topic types.TopicType, hash []byte) types
 	return message
 }
 
+// waitForHistoryArchiveTasks waits for the history archive tasks, started
+// asynchronously, to reach the given count
+func (s *ManagerSuite) waitForHistoryArchiveTasks(count int) {
+	tt.WaitForCondition(s.T(), 10*time.Second, func() bool {
+		return len(s.manager.historyArchiveTasks) == count
+	}, "timed out waiting for %d history archive tasks", count)
+}
+
 func (s *ManagerSuite) buildCommunityWithChat() (*Community, string, error) {
 	createRequest := &requests.CreateCommunity{
 		Name:        "status",
@@ -719,3 +819,320 @@ func (s *ManagerSuite) buildCommunityWithChat() (*Community, string, error) {
 	}
 	return community, chatID, nil
 }
+
+func (s *ManagerSuite) TestHandleCommunityRequestToJoin_Cooldown() {
+	createRequest := &requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_ON_REQUEST,
+	}
+	community, err := s.manager.CreateCommunity(createRequest, true)
+	s.Require().NoError(err)
+
+	requester, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	requesterPk := common.PubkeyToHex(&requester.PublicKey)
+
+	request := &protobuf.CommunityRequestToJoin{
+		Clock:       uint64(time.Now().Unix()),
+		CommunityId: community.ID(),
+	}
+
+	_, err = s.manager.HandleCommunityRequestToJoin(&requester.PublicKey, request)
+	s.Require().NoError(err)
+
+	// A second request within the cooldown is rejected
+	request.Clock++
+	_, err = s.manager.HandleCommunityRequestToJoin(&requester.PublicKey, request)
+	s.Require().Equal(ErrRequestToJoinCooldown, err)
+
+	// Once the default cooldown has passed, the request is accepted
+	receivedAt := time.Now().Add(-DefaultRequestToJoinCooldown - time.Minute).Unix()
+	s.Require().NoError(s.manager.persistence.SetRequestToJoinReceivedAt(community.ID(), requesterPk, uint64(receivedAt)))
+
+	request.Clock++
+	_, err = s.manager.HandleCommunityRequestToJoin(&requester.PublicKey, request)
+	s.Require().NoError(err)
+
+	// A custom cooldown from the community settings is honoured
+	s.Require().NoError(s.manager.persistence.SaveCommunitySettings(CommunitySettings{
+		CommunityID:           community.IDString(),
+		RequestToJoinCooldown: time.Hour,
+	}))
+	receivedAt = time.Now().Add(-2 * time.Hour).Unix()
+	s.Require().NoError(s.manager.persistence.SetRequestToJoinReceivedAt(community.ID(), requesterPk, uint64(receivedAt)))
+
+	request.Clock++
+	_, err = s.manager.HandleCommunityRequestToJoin(&requester.PublicKey, request)
+	s.Require().NoError(err)
+}
+
+func (s *ManagerSuite) TestHandleCommunityDescriptionMessage_ReplayedEvent() {
+	ownerKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	owner, err := New(Config{
+		PrivateKey:     ownerKey,
+		ID:             &ownerKey.PublicKey,
+		MemberIdentity: &ownerKey.PublicKey,
+		CommunityDescription: &protobuf.CommunityDescription{
+			Clock:         1,
+			EventSequence: 1,
+			Permissions:   &protobuf.CommunityPermissions{Access: protobuf.CommunityPermissions_NO_MEMBERSHIP},
+			Identity:      &protobuf.ChatIdentity{DisplayName: "status"},
+		},
+	})
+	s.Require().NoError(err)
+
+	snapshot := func() (*protobuf.CommunityDescription, []byte) {
+		payload, err := owner.ToBytes()
+		s.Require().NoError(err)
+		return proto.Clone(owner.Description()).(*protobuf.CommunityDescription), payload
+	}
+
+	banned, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	oldDescription, oldPayload := snapshot()
+
+	_, err = owner.BanUserFromCommunity(&banned.PublicKey)
+	s.Require().NoError(err)
+	description, payload := snapshot()
+	s.Require().Equal(uint64(2), description.EventSequence)
+
+	_, err = s.manager.HandleCommunityDescriptionMessage(&ownerKey.PublicKey, description, payload)
+	s.Require().NoError(err)
+
+	// Re-submitting the older signed description is rejected
+	_, err = s.manager.HandleCommunityDescriptionMessage(&ownerKey.PublicKey, oldDescription, oldPayload)
+	s.Require().Equal(ErrCommunityEventReplayed, err)
+
+	community, err := s.manager.GetByID(owner.ID())
+	s.Require().NoError(err)
+	s.Require().True(community.IsBanned(&banned.PublicKey))
+
+	// A newer one is accepted
+	_, err = owner.UnbanUserFromCommunity(&banned.PublicKey)
+	s.Require().NoError(err)
+	description, payload = snapshot()
+
+	response, err := s.manager.HandleCommunityDescriptionMessage(&ownerKey.PublicKey, description, payload)
+	s.Require().NoError(err)
+	s.Require().False(response.Community.IsBanned(&banned.PublicKey))
+	s.Require().Equal(uint64(3), response.Community.EventSequence())
+}
+
+func (s *ManagerSuite) TestHandleCommunityDescriptionDiff() {
+	community, err := s.manager.CreateCommunity(&requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+	}, true)
+	s.Require().NoError(err)
+
+	receiver := s.buildManager()
+	payload, err := community.ToBytes()
+	s.Require().NoError(err)
+	_, err = receiver.HandleCommunityDescriptionMessage(community.PublicKey(), community.Description(), payload)
+	s.Require().NoError(err)
+
+	base := community.CopyDescription()
+
+	_, err = s.manager.EditCommunity(&requests.EditCommunity{
+		CommunityID: community.ID(),
+		CreateCommunity: requests.CreateCommunity{
+			Name:        "statusEdited",
+			Description: "status community description",
+			Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+		},
+	})
+	s.Require().NoError(err)
+
+	banned, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	community, err = s.manager.BanUserFromCommunity(&requests.BanUserFromCommunity{
+		CommunityID: community.ID(),
+		User:        crypto.FromECDSAPub(&banned.PublicKey),
+	})
+	s.Require().NoError(err)
+
+	// The diff goes through the wire
+	diff, err := community.DescriptionDiff(base)
+	s.Require().NoError(err)
+	diffPayload, err := proto.Marshal(diff)
+	s.Require().NoError(err)
+	received := &protobuf.CommunityDescriptionDiff{}
+	s.Require().NoError(proto.Unmarshal(diffPayload, received))
+
+	response, err := receiver.HandleCommunityDescriptionDiff(community.PublicKey(), received)
+	s.Require().NoError(err)
+	s.Require().Equal("statusEdited", response.Community.Name())
+	s.Require().True(response.Community.IsBanned(&banned.PublicKey))
+
+	stored, err := receiver.GetByID(community.ID())
+	s.Require().NoError(err)
+	s.Require().Equal(community.Clock(), stored.Clock())
+	s.Require().Equal("statusEdited", stored.Name())
+	s.Require().True(stored.IsBanned(&banned.PublicKey))
+	s.Require().True(proto.Equal(community.Description(), stored.Description()))
+
+	// Diffs against an outdated base are rejected
+	_, err = receiver.HandleCommunityDescriptionDiff(community.PublicKey(), received)
+	s.Require().Equal(ErrCommunityDescriptionDiffBaseMismatch, err)
+}
+
+func (s *ManagerSuite) TestReorderChannelsConcurrently() {
+	community, err := s.manager.CreateCommunity(&requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+	}, true)
+	s.Require().NoError(err)
+
+	for _, name := range []string{"a", "b", "c"} {
+		community, _, err = s.manager.CreateChat(community.ID(), &protobuf.CommunityChat{
+			Identity:    &protobuf.ChatIdentity{DisplayName: name},
+			Permissions: &protobuf.CommunityPermissions{Access: protobuf.CommunityPermissions_NO_MEMBERSHIP},
+		}, false, "")
+		s.Require().NoError(err)
+	}
+	// Pick the channels by their current position, so that both reorders change it
+	chats := community.Chats()
+	chatIDs := make([]string, 0, len(chats))
+	for id := range chats {
+		chatIDs = append(chatIDs, id)
+	}
+	sort.Slice(chatIDs, func(i, j int) bool {
+		return chats[chatIDs[i]].Position < chats[chatIDs[j]].Position
+	})
+	for i, id := range chatIDs {
+		chatIDs[i] = community.IDString() + id
+	}
+
+	// A second admin device starts from the same description
+	admin2 := s.buildManager()
+	payload, err := community.ToBytes()
+	s.Require().NoError(err)
+	_, err = admin2.HandleCommunityDescriptionMessage(community.PublicKey(), community.Description(), payload)
+	s.Require().NoError(err)
+	_, err = admin2.ImportCommunity(community.PrivateKey())
+	s.Require().NoError(err)
+
+	// Both admins reorder the channels at the same time
+	var (
+		wg           sync.WaitGroup
+		community1   *Community
+		community2   *Community
+		err1, err2   error
+		communityID  = community.ID()
+		firstChatID  = chatIDs[0]
+		secondChatID = chatIDs[1]
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		community1, _, err1 = s.manager.ReorderChannels(&requests.ReorderCommunityChannels{
+			CommunityID:      communityID,
+			ChannelPositions: map[string]int32{firstChatID: 2},
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		community2, _, err2 = admin2.ReorderChannels(&requests.ReorderCommunityChannels{
+			CommunityID:      communityID,
+			ChannelPositions: map[string]int32{secondChatID: 0},
+		})
+	}()
+	wg.Wait()
+	s.Require().NoError(err1)
+	s.Require().NoError(err2)
+	s.Require().Equal(community1.Clock(), community2.Clock())
+	s.Require().False(proto.Equal(community1.Description(), community2.Description()))
+
+	// Each admin receives the description of the other one
+	description1 := community1.CopyDescription()
+	description2 := community2.CopyDescription()
+	payload1, err := community1.ToBytes()
+	s.Require().NoError(err)
+	payload2, err := community2.ToBytes()
+	s.Require().NoError(err)
+
+	response1, err := s.manager.HandleCommunityDescriptionMessage(community.PublicKey(), description2, payload2)
+	s.Require().NoError(err)
+	response2, err := admin2.HandleCommunityDescriptionMessage(community.PublicKey(), description1, payload1)
+	s.Require().NoError(err)
+
+	// Both converge on the same description, and the one that changed gets the diff
+	stored1, err := s.manager.GetByID(communityID)
+	s.Require().NoError(err)
+	stored2, err := admin2.GetByID(communityID)
+	s.Require().NoError(err)
+	s.Require().True(proto.Equal(stored1.Description(), stored2.Description()))
+
+	if proto.Equal(stored1.Description(), description2) {
+		s.Require().NotNil(response1.Diff)
+		s.Require().Nil(response2.Diff)
+	} else {
+		s.Require().True(proto.Equal(stored2.Description(), description1))
+		s.Require().Nil(response1.Diff)
+		s.Require().NotNil(response2.Diff)
+	}
+}
+
+func (s *ManagerSuite) TestMemberActivityReport() {
+	createRequest := &requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+	}
+	community, err := s.manager.CreateCommunity(createRequest, true)
+	s.Require().NoError(err)
+
+	now := time.Unix(time.Now().Unix(), 0)
+	communityID := community.IDString()
+
+	s.Require().NoError(s.manager.UpdateMemberActivity(communityID, "0x01", now.Add(-48*time.Hour)))
+	s.Require().NoError(s.manager.UpdateMemberActivity(communityID, "0x02", now.Add(-2*time.Hour)))
+	s.Require().NoError(s.manager.UpdateMemberActivity(communityID, "0x03", now.Add(-time.Hour)))
+	// An older activity doesn't overwrite a more recent one
+	s.Require().NoError(s.manager.UpdateMemberActivity(communityID, "0x03", now.Add(-72*time.Hour)))
+	// Activity in other communities is not reported
+	s.Require().NoError(s.manager.UpdateMemberActivity("0xdeadbeef", "0x04", now))
+
+	report, err := s.manager.GetMemberActivityReport(communityID, now.Add(-24*time.Hour))
+	s.Require().NoError(err)
+	s.Require().Equal([]MemberActivity{
+		{PublicKey: "0x03", LastActivityAt: now.Add(-time.Hour)},
+		{PublicKey: "0x02", LastActivityAt: now.Add(-2 * time.Hour)},
+	}, report)
+
+	report, err = s.manager.GetMemberActivityReport(communityID, time.Time{})
+	s.Require().NoError(err)
+	s.Require().Len(report, 3)
+
+	_, err = s.manager.GetMemberActivityReport(types.EncodeHex([]byte("unknown")), time.Time{})
+	s.Require().Equal(ErrOrgNotFound, err)
+}
+
+func (s *ManagerSuite) TestPruneStaleMemberActivity() {
+	createRequest := &requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+	}
+	community, err := s.manager.CreateCommunity(createRequest, true)
+	s.Require().NoError(err)
+
+	now := time.Unix(time.Now().Unix(), 0)
+	communityID := community.IDString()
+
+	s.Require().NoError(s.manager.UpdateMemberActivity(communityID, "0x01", now.Add(-memberActivityRetention-time.Hour)))
+	s.Require().NoError(s.manager.UpdateMemberActivity(communityID, "0x02", now.Add(-memberActivityRetention+time.Hour)))
+
+	s.Require().NoError(s.manager.pruneStaleMemberActivity(now))
+
+	report, err := s.manager.GetMemberActivityReport(communityID, time.Time{})
+	s.Require().NoError(err)
+	s.Require().Len(report, 1)
+	s.Require().Equal("0x02", report[0].PublicKey)
+}
@@ -0,0 +1,64 @@
+package communities
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// memberActivityRetention is how long member activity is kept before being pruned
+var memberActivityRetention = 90 * 24 * time.Hour
+
+var memberActivityPruneInterval = 24 * time.Hour
+
+type MemberActivity struct {
+	PublicKey      string    `json:"publicKey"`
+	LastActivityAt time.Time `json:"lastActivityAt"`
+}
+
+// UpdateMemberActivity records that the member has been active in the community at the given time
+func (m *Manager) UpdateMemberActivity(communityID string, publicKey string, at time.Time) error {
+	return m.persistence.UpdateMemberActivity(communityID, publicKey, at.Unix())
+}
+
+// GetMemberActivityReport returns the members that have been active in the community since the given time,
+// most recently active first. Only admins can access the report
+func (m *Manager) GetMemberActivityReport(communityID string, since time.Time) ([]MemberActivity, error) {
+	community, err := m.GetByIDString(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+	if !community.IsAdmin() {
+		return nil, ErrNotAdmin
+	}
+
+	return m.persistence.GetMemberActivity(community.IDString(), since.Unix())
+}
+
+func (m *Manager) pruneStaleMemberActivity(now time.Time) error {
+	return m.persistence.DeleteMemberActivityBefore(now.Add(-memberActivityRetention).Unix())
+}
+
+func (m *Manager) runMemberActivityPruneLoop() {
+	go func() {
+		ticker := time.NewTicker(memberActivityPruneInterval)
+		defer ticker.Stop()
+
+		for {
+			err := m.pruneStaleMemberActivity(time.Now())
+			if err != nil {
+				m.logger.Error("failed to prune stale member activity", zap.Error(err))
+			}
+
+			select {
+			case <-m.quit:
+				m.logger.Debug("quitting member activity prune loop")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -6,6 +6,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
@@ -35,10 +37,20 @@ func (p *Persistence) SaveCommunity(community *Community) error {
 		return err
 	}
 
-	_, err = p.db.Exec(`INSERT INTO communities_communities (id, private_key, description, joined, spectated, verified) VALUES (?, ?, ?, ?, ?, ?)`, id, crypto.FromECDSA(privateKey), description, community.config.Joined, community.config.Spectated, community.config.Verified)
+	_, err = p.db.Exec(`INSERT INTO communities_communities (id, private_key, description, joined, spectated, verified, event_sequence) VALUES (?, ?, ?, ?, ?, ?, ?)`, id, crypto.FromECDSA(privateKey), description, community.config.Joined, community.config.Spectated, community.config.Verified, community.EventSequence())
 	return err
 }
 
+// GetCommunityEventSequence returns the highest event sequence applied to the community
+func (p *Persistence) GetCommunityEventSequence(id types.HexBytes) (uint64, error) {
+	var eventSequence uint64
+	err := p.db.QueryRow(`SELECT event_sequence FROM communities_communities WHERE id = ?`, id).Scan(&eventSequence)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return eventSequence, err
+}
+
 func (p *Persistence) DeleteCommunity(id types.HexBytes) error {
 	_, err := p.db.Exec("DELETE FROM communities_communities WHERE id = ?", id)
 	return err
@@ -788,7 +800,7 @@ func (p *Persistence) SaveMessageArchiveID(communityID types.HexBytes, hash stri
 }
 
 func (p *Persistence) GetCommunitiesSettings() ([]CommunitySettings, error) {
-	rows, err := p.db.Query("SELECT community_id, message_archive_seeding_enabled, message_archive_fetching_enabled, clock FROM communities_settings")
+	rows, err := p.db.Query("SELECT community_id, message_archive_seeding_enabled, message_archive_fetching_enabled, clock, request_to_join_cooldown, dnd_start_hour, dnd_end_hour, dnd_timezone FROM communities_settings")
 	if err != nil {
 		return nil, err
 	}
@@ -797,10 +809,14 @@ func (p *Persistence) GetCommunitiesSettings() ([]CommunitySettings, error) {
 
 	for rows.Next() {
 		settings := CommunitySettings{}
-		err := rows.Scan(&settings.CommunityID, &settings.HistoryArchiveSupportEnabled, &settings.HistoryArchiveSupportEnabled, &settings.Clock)
+		var cooldown int64
+		dnd := DNDSchedule{}
+		err := rows.Scan(&settings.CommunityID, &settings.HistoryArchiveSupportEnabled, &settings.HistoryArchiveSupportEnabled, &settings.Clock, &cooldown, &dnd.StartHour, &dnd.EndHour, &dnd.Timezone)
 		if err != nil {
 			return nil, err
 		}
+		settings.RequestToJoinCooldown = time.Duration(cooldown) * time.Second
+		settings.DNDSchedule = dndScheduleFromColumns(dnd)
 		communitiesSettings = append(communitiesSettings, settings)
 	}
 	return communitiesSettings, err
@@ -817,12 +833,16 @@ func (p *Persistence) CommunitySettingsExist(communityID types.HexBytes) (bool,
 
 func (p *Persistence) GetCommunitySettingsByID(communityID types.HexBytes) (*CommunitySettings, error) {
 	settings := CommunitySettings{}
-	err := p.db.QueryRow(`SELECT community_id, message_archive_seeding_enabled, message_archive_fetching_enabled, clock FROM communities_settings WHERE community_id = ?`, communityID.String()).Scan(&settings.CommunityID, &settings.HistoryArchiveSupportEnabled, &settings.HistoryArchiveSupportEnabled, &settings.Clock)
+	var cooldown int64
+	dnd := DNDSchedule{}
+	err := p.db.QueryRow(`SELECT community_id, message_archive_seeding_enabled, message_archive_fetching_enabled, clock, request_to_join_cooldown, dnd_start_hour, dnd_end_hour, dnd_timezone FROM communities_settings WHERE community_id = ?`, communityID.String()).Scan(&settings.CommunityID, &settings.HistoryArchiveSupportEnabled, &settings.HistoryArchiveSupportEnabled, &settings.Clock, &cooldown, &dnd.StartHour, &dnd.EndHour, &dnd.Timezone)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
 		return nil, err
 	}
+	settings.RequestToJoinCooldown = time.Duration(cooldown) * time.Second
+	settings.DNDSchedule = dndScheduleFromColumns(dnd)
 	return &settings, nil
 }
 
@@ -832,34 +852,88 @@ func (p *Persistence) DeleteCommunitySettings(communityID types.HexBytes) error
 }
 
 func (p *Persistence) SaveCommunitySettings(communitySettings CommunitySettings) error {
+	dnd := dndScheduleToColumns(communitySettings.DNDSchedule)
 	_, err := p.db.Exec(`INSERT INTO communities_settings (
     community_id,
     message_archive_seeding_enabled,
     message_archive_fetching_enabled,
-    clock
-  ) VALUES (?, ?, ?, ?)`,
+    clock,
+    request_to_join_cooldown,
+    dnd_start_hour,
+    dnd_end_hour,
+    dnd_timezone
+  ) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
 		communitySettings.CommunityID,
 		communitySettings.HistoryArchiveSupportEnabled,
 		communitySettings.HistoryArchiveSupportEnabled,
 		communitySettings.Clock,
+		int64(communitySettings.RequestToJoinCooldown/time.Second),
+		dnd.StartHour,
+		dnd.EndHour,
+		dnd.Timezone,
 	)
 	return err
 }
 
 func (p *Persistence) UpdateCommunitySettings(communitySettings CommunitySettings) error {
+	dnd := dndScheduleToColumns(communitySettings.DNDSchedule)
 	_, err := p.db.Exec(`UPDATE communities_settings SET
     message_archive_seeding_enabled = ?,
     message_archive_fetching_enabled = ?,
-    clock = ?
+    clock = ?,
+    request_to_join_cooldown = ?,
+    dnd_start_hour = ?,
+    dnd_end_hour = ?,
+    dnd_timezone = ?
     WHERE community_id = ?`,
 		communitySettings.HistoryArchiveSupportEnabled,
 		communitySettings.HistoryArchiveSupportEnabled,
 		communitySettings.Clock,
+		int64(communitySettings.RequestToJoinCooldown/time.Second),
+		dnd.StartHour,
+		dnd.EndHour,
+		dnd.Timezone,
 		communitySettings.CommunityID,
 	)
 	return err
 }
 
+// A schedule is stored with an empty timezone when disabled
+func dndScheduleToColumns(schedule *DNDSchedule) DNDSchedule {
+	if schedule == nil {
+		return DNDSchedule{}
+	}
+	return *schedule
+}
+
+func dndScheduleFromColumns(schedule DNDSchedule) *DNDSchedule {
+	if schedule.Timezone == "" {
+		return nil
+	}
+	return &schedule
+}
+
+// GetRequestToJoinReceivedAt returns when we last received a request to join the community
+// from the given public key, as a unix timestamp in seconds, or 0 if none was received
+func (p *Persistence) GetRequestToJoinReceivedAt(communityID types.HexBytes, publicKey string) (uint64, error) {
+	var receivedAt uint64
+	err := p.db.QueryRow(`SELECT received_at FROM communities_requests_to_join_received_at WHERE community_id = ? AND public_key = ?`, communityID, publicKey).Scan(&receivedAt)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return receivedAt, err
+}
+
+func (p *Persistence) SetRequestToJoinReceivedAt(communityID types.HexBytes, publicKey string, receivedAt uint64) error {
+	_, err := p.db.Exec(`INSERT INTO communities_requests_to_join_received_at (community_id, public_key, received_at) VALUES (?, ?, ?)`, communityID, publicKey, receivedAt)
+	return err
+}
+
+func (p *Persistence) DeleteRequestToJoinReceivedAt(communityID types.HexBytes, publicKey string) error {
+	_, err := p.db.Exec(`DELETE FROM communities_requests_to_join_received_at WHERE community_id = ? AND public_key = ?`, communityID, publicKey)
+	return err
+}
+
 func (p *Persistence) GetCommunityChatIDs(communityID types.HexBytes) ([]string, error) {
 	rows, err := p.db.Query(`SELECT id FROM chats WHERE community_id = ?`, communityID.String())
 	if err != nil {
@@ -916,3 +990,91 @@ func (p *Persistence) UpdateCommunityTokenState(contractAddress string, deploySt
 	_, err := p.db.Exec(`UPDATE community_tokens SET deploy_state = ? WHERE address = ?`, deployState, contractAddress)
 	return err
 }
+
+// UpdateMemberActivity records that the member was active in the community at the given
+// unix timestamp (seconds). Older timestamps never overwrite a more recent activity
+func (p *Persistence) UpdateMemberActivity(communityID string, publicKey string, lastActivityAt int64) error {
+	_, err := p.db.Exec(`INSERT INTO communities_member_activity (community_id, public_key, last_activity_at)
+	VALUES (?, ?, MAX(?, COALESCE((SELECT last_activity_at FROM communities_member_activity WHERE community_id = ? AND public_key = ?), 0)))`,
+		communityID, publicKey, lastActivityAt, communityID, publicKey)
+	return err
+}
+
+// GetMemberActivity returns the members that were active in the community at or after
+// the given unix timestamp (seconds), most recently active first
+func (p *Persistence) GetMemberActivity(communityID string, since int64) ([]MemberActivity, error) {
+	rows, err := p.db.Query(`SELECT public_key, last_activity_at FROM communities_member_activity WHERE community_id = ? AND last_activity_at >= ? ORDER BY last_activity_at DESC, public_key`, communityID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var activity []MemberActivity
+	for rows.Next() {
+		var publicKey string
+		var lastActivityAt int64
+		err := rows.Scan(&publicKey, &lastActivityAt)
+		if err != nil {
+			return nil, err
+		}
+		activity = append(activity, MemberActivity{
+			PublicKey:      publicKey,
+			LastActivityAt: time.Unix(lastActivityAt, 0),
+		})
+	}
+
+	return activity, rows.Err()
+}
+
+// DeleteMemberActivityBefore removes activity entries older than the given unix timestamp (seconds)
+func (p *Persistence) DeleteMemberActivityBefore(before int64) error {
+	_, err := p.db.Exec(`DELETE FROM communities_member_activity WHERE last_activity_at < ?`, before)
+	return err
+}
+
+func (p *Persistence) SaveWebhook(webhook *WebhookConfig) error {
+	_, err := p.db.Exec(`INSERT INTO communities_webhooks (id, community_id, url, secret, events) VALUES (?, ?, ?, ?, ?)`,
+		webhook.ID, webhook.CommunityID, webhook.URL, webhook.Secret, strings.Join(webhook.Events, ","))
+	return err
+}
+
+func (p *Persistence) DeleteWebhook(id string) error {
+	_, err := p.db.Exec(`DELETE FROM communities_webhooks WHERE id = ?`, id)
+	return err
+}
+
+func (p *Persistence) GetWebhook(id string) (*WebhookConfig, error) {
+	webhooks, err := p.queryWebhooks(`SELECT id, community_id, url, secret, events FROM communities_webhooks WHERE id = ?`, id)
+	if err != nil || len(webhooks) == 0 {
+		return nil, err
+	}
+	return webhooks[0], nil
+}
+
+func (p *Persistence) GetWebhooks(communityID types.HexBytes) ([]*WebhookConfig, error) {
+	return p.queryWebhooks(`SELECT id, community_id, url, secret, events FROM communities_webhooks WHERE community_id = ? ORDER BY id`, communityID)
+}
+
+func (p *Persistence) queryWebhooks(query string, args ...interface{}) ([]*WebhookConfig, error) {
+	rows, err := p.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []*WebhookConfig
+	for rows.Next() {
+		webhook := &WebhookConfig{}
+		var events string
+		err := rows.Scan(&webhook.ID, &webhook.CommunityID, &webhook.URL, &webhook.Secret, &events)
+		if err != nil {
+			return nil, err
+		}
+		if events != "" {
+			webhook.Events = strings.Split(events, ",")
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, rows.Err()
+}
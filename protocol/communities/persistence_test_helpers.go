@@ -7,14 +7,15 @@ import (
 )
 
 type RawCommunityRow struct {
-	ID          []byte
-	PrivateKey  []byte
-	Description []byte
-	Joined      bool
-	Spectated   bool
-	Verified    bool
-	SyncedAt    uint64
-	Muted       bool
+	ID            []byte
+	PrivateKey    []byte
+	Description   []byte
+	Joined        bool
+	Spectated     bool
+	Verified      bool
+	SyncedAt      uint64
+	Muted         bool
+	EventSequence uint64
 }
 
 func fromSyncCommunityProtobuf(syncCommProto *protobuf.SyncCommunity) RawCommunityRow {
@@ -43,6 +44,7 @@ func (p *Persistence) scanRowToStruct(rowScan func(dest ...interface{}) error) (
 		&rcr.Muted,
 		&syncedAt,
 		&rcr.Spectated,
+		&rcr.EventSequence,
 	)
 	if syncedAt.Valid {
 		rcr.SyncedAt = uint64(syncedAt.Time.Unix())
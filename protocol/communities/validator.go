@@ -1,10 +1,50 @@
 package communities
 
 import (
+	"sort"
+
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/requests"
 )
 
+const maxCommunityTags = 5
+
+// AllowedTags are the tags a community can be created or edited with
+var AllowedTags []string
+
+func init() {
+	for tag := range requests.TagsEmojies {
+		AllowedTags = append(AllowedTags, tag)
+	}
+	sort.Strings(AllowedTags)
+}
+
+// ValidateTags checks that there are at most maxCommunityTags tags, all of them
+// in AllowedTags and with no duplicates
+func ValidateTags(tags []string) error {
+	if len(tags) > maxCommunityTags {
+		return ErrTooManyCommunityTags
+	}
+
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if !isAllowedTag(tag) {
+			return ErrUnknownCommunityTag
+		}
+		if seen[tag] {
+			return ErrDuplicatedCommunityTag
+		}
+		seen[tag] = true
+	}
+
+	return nil
+}
+
+func isAllowedTag(tag string) bool {
+	i := sort.SearchStrings(AllowedTags, tag)
+	return i < len(AllowedTags) && AllowedTags[i] == tag
+}
+
 func validateCommunityChat(desc *protobuf.CommunityDescription, chat *protobuf.CommunityChat) error {
 	if chat == nil {
 		return ErrInvalidCommunityDescription
@@ -26,6 +66,10 @@ func validateCommunityChat(desc *protobuf.CommunityDescription, chat *protobuf.C
 		return ErrInvalidCommunityDescriptionChatIdentity
 	}
 
+	if chat.MaxPinnedMessages < 0 || chat.MaxPinnedMessages > MaxPinnedMessagesLimit {
+		return ErrInvalidMaxPinnedMessages
+	}
+
 	for pk := range chat.Members {
 		if desc.Members == nil {
 			return ErrInvalidCommunityDescriptionMemberInChatButNotInOrg
@@ -0,0 +1,15 @@
+package communities
+
+func (s *CommunitySuite) TestValidateTags() {
+	s.Require().NoError(ValidateTags(nil))
+	s.Require().NoError(ValidateTags([]string{"Art", "Web3", "Privacy"}))
+
+	s.Require().Equal(ErrUnknownCommunityTag, ValidateTags([]string{"Art", "not-a-tag"}))
+	s.Require().Equal(ErrUnknownCommunityTag, ValidateTags([]string{"art"}))
+	s.Require().Equal(ErrDuplicatedCommunityTag, ValidateTags([]string{"Art", "Web3", "Art"}))
+	s.Require().Equal(ErrTooManyCommunityTags, ValidateTags([]string{"Art", "Web3", "Privacy", "Music", "News", "Tech"}))
+
+	for _, tag := range AllowedTags {
+		s.Require().NoError(ValidateTags([]string{tag}))
+	}
+}
@@ -0,0 +1,268 @@
+package communities
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+const (
+	WebhookEventMessageCreated = "message.created"
+	WebhookEventMemberJoined   = "member.joined"
+)
+
+// WebhookSignatureHeader is the header carrying the hex encoded HMAC-SHA256 of the request body,
+// keyed with the webhook secret
+const WebhookSignatureHeader = "X-Status-Signature"
+
+const webhookDeliveriesQueueSize = 100
+
+var webhookRequestTimeout = 10 * time.Second
+
+var webhookEvents = map[string]bool{
+	WebhookEventMessageCreated: true,
+	WebhookEventMemberJoined:   true,
+}
+
+// WebhookConfig describes an external endpoint notified of community events
+type WebhookConfig struct {
+	ID          string         `json:"id"`
+	CommunityID types.HexBytes `json:"communityId"`
+	URL         string         `json:"url"`
+	Secret      string         `json:"secret"`
+	Events      []string       `json:"events"`
+}
+
+func (w *WebhookConfig) Validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidWebhookURL
+	}
+
+	if w.Secret == "" {
+		return ErrInvalidWebhookSecret
+	}
+
+	for _, event := range w.Events {
+		if !webhookEvents[event] {
+			return ErrUnknownWebhookEvent
+		}
+	}
+
+	return nil
+}
+
+func (w *WebhookConfig) Subscribed(event string) bool {
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookPayload is the JSON body posted to the webhook URL
+type WebhookPayload struct {
+	Event       string         `json:"event"`
+	CommunityID types.HexBytes `json:"communityId"`
+	Timestamp   int64          `json:"timestamp"`
+	Data        interface{}    `json:"data"`
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 of the payload keyed with the secret
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (m *Manager) getAdminCommunity(communityID types.HexBytes) (*Community, error) {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+	if !community.IsAdmin() {
+		return nil, ErrNotAdmin
+	}
+	return community, nil
+}
+
+func (m *Manager) CreateWebhook(config *WebhookConfig) (*WebhookConfig, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.getAdminCommunity(config.CommunityID); err != nil {
+		return nil, err
+	}
+
+	webhook := *config
+	webhook.ID = uuid.New().String()
+
+	err := m.persistence.SaveWebhook(&webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func (m *Manager) EditWebhook(config *WebhookConfig) (*WebhookConfig, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing, err := m.persistence.GetWebhook(config.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, ErrWebhookNotFound
+	}
+
+	if _, err := m.getAdminCommunity(existing.CommunityID); err != nil {
+		return nil, err
+	}
+
+	webhook := *config
+	webhook.CommunityID = existing.CommunityID
+
+	err = m.persistence.SaveWebhook(&webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func (m *Manager) DeleteWebhook(id string) error {
+	existing, err := m.persistence.GetWebhook(id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrWebhookNotFound
+	}
+
+	if _, err := m.getAdminCommunity(existing.CommunityID); err != nil {
+		return err
+	}
+
+	return m.persistence.DeleteWebhook(id)
+}
+
+func (m *Manager) GetWebhooks(communityID types.HexBytes) ([]*WebhookConfig, error) {
+	if _, err := m.getAdminCommunity(communityID); err != nil {
+		return nil, err
+	}
+
+	return m.persistence.GetWebhooks(communityID)
+}
+
+// DispatchWebhookEvent queues the event for delivery to the community webhooks subscribed to it.
+// Only admins dispatch events. It never blocks, events are dropped if the queue is full
+func (m *Manager) DispatchWebhookEvent(community *Community, event string, data interface{}) {
+	// Only admins can manage the webhooks, members don't have any to look up
+	if community == nil || !community.IsAdmin() {
+		return
+	}
+
+	webhooks, err := m.persistence.GetWebhooks(community.ID())
+	if err != nil {
+		m.logger.Warn("failed to get webhooks", zap.Error(err))
+		return
+	}
+
+	var subscribed []*WebhookConfig
+	for _, webhook := range webhooks {
+		if webhook.Subscribed(event) {
+			subscribed = append(subscribed, webhook)
+		}
+	}
+	if len(subscribed) == 0 {
+		return
+	}
+
+	delivery := &webhookDelivery{
+		webhooks: subscribed,
+		payload: &WebhookPayload{
+			Event:       event,
+			CommunityID: community.ID(),
+			Timestamp:   time.Now().UnixMilli(),
+			Data:        data,
+		},
+	}
+
+	select {
+	case m.webhookDeliveries <- delivery:
+	default:
+		m.logger.Warn("webhook deliveries queue is full, dropping event", zap.String("event", event))
+	}
+}
+
+type webhookDelivery struct {
+	webhooks []*WebhookConfig
+	payload  *WebhookPayload
+}
+
+func (m *Manager) runWebhookDispatcher() {
+	go func() {
+		client := &http.Client{Timeout: webhookRequestTimeout}
+
+		for {
+			select {
+			case <-m.quit:
+				m.logger.Debug("quitting webhook dispatcher")
+				return
+			case delivery := <-m.webhookDeliveries:
+				body, err := json.Marshal(delivery.payload)
+				if err != nil {
+					m.logger.Warn("failed to marshal webhook payload", zap.Error(err))
+					continue
+				}
+
+				for _, webhook := range delivery.webhooks {
+					err := postWebhook(client, webhook, body)
+					if err != nil {
+						m.logger.Warn("failed to post webhook", zap.String("webhookID", webhook.ID), zap.Error(err))
+					}
+				}
+			}
+		}
+	}()
+}
+
+func postWebhook(client *http.Client, webhook *WebhookConfig, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
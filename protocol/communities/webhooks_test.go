@@ -0,0 +1,111 @@
+package communities
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+func (s *ManagerSuite) TestWebhooks() {
+	createRequest := &requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+	}
+	community, err := s.manager.CreateCommunity(createRequest, true)
+	s.Require().NoError(err)
+
+	secret := "webhook-secret"
+	received := make(chan *WebhookPayload, 10)
+	rejected := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		s.Require().NoError(err)
+
+		if r.Header.Get(WebhookSignatureHeader) != SignWebhookPayload(secret, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			rejected <- struct{}{}
+			return
+		}
+
+		payload := &WebhookPayload{}
+		s.Require().NoError(json.Unmarshal(body, payload))
+		received <- payload
+	}))
+	defer server.Close()
+
+	_, err = s.manager.CreateWebhook(&WebhookConfig{CommunityID: community.ID(), URL: "ftp://example.com", Secret: secret})
+	s.Require().Equal(ErrInvalidWebhookURL, err)
+
+	_, err = s.manager.CreateWebhook(&WebhookConfig{CommunityID: community.ID(), URL: server.URL})
+	s.Require().Equal(ErrInvalidWebhookSecret, err)
+
+	_, err = s.manager.CreateWebhook(&WebhookConfig{CommunityID: community.ID(), URL: server.URL, Secret: secret, Events: []string{"unknown"}})
+	s.Require().Equal(ErrUnknownWebhookEvent, err)
+
+	webhook, err := s.manager.CreateWebhook(&WebhookConfig{
+		CommunityID: community.ID(),
+		URL:         server.URL,
+		Secret:      secret,
+		Events:      []string{WebhookEventMessageCreated},
+	})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(webhook.ID)
+
+	webhooks, err := s.manager.GetWebhooks(community.ID())
+	s.Require().NoError(err)
+	s.Require().Equal([]*WebhookConfig{webhook}, webhooks)
+
+	// Events the webhook is not subscribed to are not delivered
+	s.manager.DispatchWebhookEvent(community, WebhookEventMemberJoined, map[string]string{"publicKey": "0x01"})
+	s.manager.DispatchWebhookEvent(community, WebhookEventMessageCreated, map[string]string{"text": "hello"})
+
+	select {
+	case payload := <-received:
+		s.Require().Equal(WebhookEventMessageCreated, payload.Event)
+		s.Require().Equal(community.ID(), payload.CommunityID)
+		s.Require().Equal(map[string]interface{}{"text": "hello"}, payload.Data)
+	case <-time.After(5 * time.Second):
+		s.FailNow("webhook not delivered")
+	}
+
+	// Members don't dispatch any event, only admins manage the webhooks
+	memberConfig := *community.config
+	memberConfig.PrivateKey = nil
+	member, err := New(memberConfig)
+	s.Require().NoError(err)
+	s.manager.DispatchWebhookEvent(member, WebhookEventMessageCreated, map[string]string{"text": "hello"})
+
+	select {
+	case <-received:
+		s.FailNow("webhook event dispatched by a member")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// A payload signed with another secret is rejected by the server
+	webhook.Events = []string{WebhookEventMemberJoined}
+	webhook.Secret = "another-secret"
+	webhook, err = s.manager.EditWebhook(webhook)
+	s.Require().NoError(err)
+
+	s.manager.DispatchWebhookEvent(community, WebhookEventMemberJoined, map[string]string{"publicKey": "0x01"})
+	select {
+	case <-rejected:
+	case <-received:
+		s.FailNow("webhook signed with a wrong secret was accepted")
+	case <-time.After(5 * time.Second):
+		s.FailNow("webhook not delivered")
+	}
+
+	s.Require().NoError(s.manager.DeleteWebhook(webhook.ID))
+	s.Require().Equal(ErrWebhookNotFound, s.manager.DeleteWebhook(webhook.ID))
+
+	webhooks, err = s.manager.GetWebhooks(community.ID())
+	s.Require().NoError(err)
+	s.Require().Empty(webhooks)
+}
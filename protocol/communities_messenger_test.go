@@ -548,6 +548,27 @@ func (s *MessengerCommunitiesSuite) joinCommunity(community *communities.Communi
 	s.Require().NoError(err)
 }
 
+func (s *MessengerCommunitiesSuite) TestGetContactMutualCommunities() {
+	community := s.createCommunity()
+	s.advertiseCommunityTo(community, s.bob)
+	s.joinCommunity(community, s.bob)
+
+	mutual, err := s.admin.GetContactMutualCommunities(context.Background(), common.PubkeyToHex(&s.bob.identity.PublicKey))
+	s.Require().NoError(err)
+	s.Require().Len(mutual, 1)
+	s.Require().Equal(community.IDString(), mutual[0].IDString())
+
+	mutual, err = s.bob.GetContactMutualCommunities(context.Background(), common.PubkeyToHex(&s.admin.identity.PublicKey))
+	s.Require().NoError(err)
+	s.Require().Len(mutual, 1)
+	s.Require().Equal(community.IDString(), mutual[0].IDString())
+
+	// Alice hasn't joined the community
+	mutual, err = s.admin.GetContactMutualCommunities(context.Background(), common.PubkeyToHex(&s.alice.identity.PublicKey))
+	s.Require().NoError(err)
+	s.Require().Empty(mutual)
+}
+
 func (s *MessengerCommunitiesSuite) TestCommunityContactCodeAdvertisement() {
 	community := s.createCommunity()
 	s.advertiseCommunityTo(community, s.bob)
@@ -754,6 +775,30 @@ func (s *MessengerCommunitiesSuite) TestPostToCommunityChat() {
 		}
 	}
 	s.Require().True(found)
+
+	// The admin tracks the activity of the members
+	activity, err := s.bob.GetMemberActivityReport(community.IDString(), time.Unix(0, 0))
+	s.Require().NoError(err)
+	s.Require().Len(activity, 1)
+	s.Require().Equal(common.PubkeyToHex(&s.alice.identity.PublicKey), activity[0].PublicKey)
+
+	// Other members don't
+	inputMessage = &common.Message{}
+	inputMessage.ChatId = chatID
+	inputMessage.ContentType = protobuf.ChatMessage_TEXT_PLAIN
+	inputMessage.Text = "some reply"
+
+	_, err = s.bob.SendChatMessage(ctx, inputMessage)
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(s.alice, func(r *MessengerResponse) bool {
+		return len(r.Messages()) > 0
+	}, "reply not received")
+	s.Require().NoError(err)
+
+	var count int
+	s.Require().NoError(s.alice.database.QueryRow(`SELECT COUNT(*) FROM communities_member_activity`).Scan(&count))
+	s.Require().Zero(count)
 }
 
 func (s *MessengerCommunitiesSuite) TestImportCommunity() {
@@ -2753,3 +2798,157 @@ func (s *MessengerCommunitiesSuite) TestCommunityTokensMetadata() {
 	s.Require().Equal(tokensMetadata[0].Symbol, newToken.Symbol)
 	s.Require().Equal(tokensMetadata[0].Name, newToken.Name)
 }
+
+func (s *MessengerCommunitiesSuite) TestCommunityDNDScheduleSuppressesNotifications() {
+	community := s.createCommunity()
+	s.advertiseCommunityTo(community, s.bob)
+	s.joinCommunity(community, s.bob)
+
+	err := s.bob.settings.SaveSettingField(settings.NotificationsEnabled, true)
+	s.Require().NoError(err)
+
+	community, err = s.admin.communitiesManager.GetByID(community.ID())
+	s.Require().NoError(err)
+	var chatID string
+	for id := range community.Chats() {
+		chatID = community.IDString() + id
+	}
+
+	mentionBob := func(text string) *MessengerResponse {
+		inputMessage := &common.Message{}
+		inputMessage.ChatId = chatID
+		inputMessage.ContentType = protobuf.ChatMessage_TEXT_PLAIN
+		inputMessage.Text = "@" + common.PubkeyToHex(&s.bob.identity.PublicKey) + " " + text
+
+		_, err := s.admin.SendChatMessage(context.Background(), inputMessage)
+		s.Require().NoError(err)
+
+		var response *MessengerResponse
+		err = tt.RetryWithBackOff(func() error {
+			response, err = s.bob.RetrieveAll()
+			if err != nil {
+				return err
+			}
+			for _, message := range response.Messages() {
+				if message.Text == inputMessage.Text {
+					return nil
+				}
+			}
+			return errors.New("message not received")
+		})
+		s.Require().NoError(err)
+		return response
+	}
+
+	hour := time.Now().UTC().Hour()
+
+	// Within the do not disturb hours the notification is suppressed
+	schedule := &communities.DNDSchedule{StartHour: hour, EndHour: (hour + 2) % 24, Timezone: "UTC"}
+	_, err = s.bob.SetCommunityDNDSchedule(community.ID(), schedule)
+	s.Require().NoError(err)
+
+	storedSchedule, err := s.bob.GetCommunityDNDSchedule(community.ID())
+	s.Require().NoError(err)
+	s.Require().Equal(schedule, storedSchedule)
+
+	response := mentionBob("during dnd")
+	s.Require().Len(response.Notifications(), 0)
+
+	// Outside of them it is shown
+	schedule = &communities.DNDSchedule{StartHour: (hour + 3) % 24, EndHour: (hour + 5) % 24, Timezone: "UTC"}
+	_, err = s.bob.SetCommunityDNDSchedule(community.ID(), schedule)
+	s.Require().NoError(err)
+
+	response = mentionBob("outside dnd")
+	s.Require().Len(response.Notifications(), 1)
+
+	_, err = s.bob.SetCommunityDNDSchedule(community.ID(), &communities.DNDSchedule{StartHour: 24, Timezone: "UTC"})
+	s.Require().Equal(communities.ErrInvalidDNDScheduleHour, err)
+}
+
+func (s *MessengerCommunitiesSuite) TestGetCommunityPublicKey() {
+	community := s.createCommunity()
+
+	publicKey, err := s.admin.GetCommunityPublicKey(community.ID())
+	s.Require().NoError(err)
+	s.Require().True(strings.HasPrefix(publicKey, "0x04"))
+
+	// The community ID is the compressed public key
+	key, err := common.HexToPubkey(publicKey)
+	s.Require().NoError(err)
+	s.Require().Equal(community.ID(), types.HexBytes(crypto.CompressPubkey(key)))
+
+	_, err = s.admin.GetCommunityPublicKey(types.HexBytes("unknown"))
+	s.Require().Equal(communities.ErrOrgNotFound, err)
+}
+
+func (s *MessengerCommunitiesSuite) TestReportMessage() {
+	community := s.createCommunity()
+	s.advertiseCommunityTo(community, s.bob)
+	s.joinCommunity(community, s.bob)
+
+	var chatID string
+	for id := range community.Chats() {
+		chatID = community.IDString() + id
+	}
+
+	inputMessage := &common.Message{}
+	inputMessage.ChatId = chatID
+	inputMessage.ContentType = protobuf.ChatMessage_TEXT_PLAIN
+	inputMessage.Text = "buy my tokens"
+
+	sendResponse, err := s.admin.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.Messages(), 1)
+	messageID := sendResponse.Messages()[0].ID
+
+	_, err = WaitOnMessengerResponse(
+		s.bob,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"no messages",
+	)
+	s.Require().NoError(err)
+
+	s.Require().Equal(ErrEmptyReportReason, s.bob.ReportMessage(context.Background(), chatID, messageID, ""))
+	s.Require().NoError(s.bob.ReportMessage(context.Background(), chatID, messageID, "spam"))
+
+	reports, err := s.bob.GetMessageReports(context.Background(), community.IDString())
+	s.Require().NoError(err)
+	s.Require().Len(reports, 1)
+
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.admin.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		reports, err = s.admin.GetMessageReports(context.Background(), community.IDString())
+		if err != nil {
+			return err
+		}
+		if len(reports) == 0 {
+			return errors.New("report not received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Len(reports, 1)
+	s.Require().Equal(messageID, reports[0].MessageID)
+	s.Require().Equal(chatID, reports[0].ChatID)
+	s.Require().Equal("spam", reports[0].Reason)
+	s.Require().Equal(common.PubkeyToHex(&s.bob.identity.PublicKey), reports[0].ReporterPublicKey)
+	s.Require().NotZero(reports[0].CreatedAt)
+
+	// Reports from users who are not members are rejected
+	err = s.admin.HandleCommunityMessageReport(nil, &s.alice.identity.PublicKey, protobuf.CommunityMessageReport{
+		Clock:       1,
+		CommunityId: community.ID(),
+		ChatId:      chatID,
+		MessageId:   messageID,
+		Reason:      "spam",
+	})
+	s.Require().Equal(ErrReporterNotAMember, err)
+
+	reports, err = s.admin.GetMessageReports(context.Background(), community.IDString())
+	s.Require().NoError(err)
+	s.Require().Len(reports, 1)
+}
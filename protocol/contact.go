@@ -122,6 +122,9 @@ type Contact struct {
 
 	VerificationStatus VerificationStatus       `json:"verificationStatus"`
 	TrustStatus        verification.TrustStatus `json:"trustStatus"`
+
+	// LastSeen is the timestamp in ms of the last message received from the contact
+	LastSeen uint64 `json:"lastSeen"`
 }
 
 func (c Contact) IsVerified() bool {
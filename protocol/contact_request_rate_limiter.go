@@ -0,0 +1,121 @@
+package protocol
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// MaxContactRequestsPerHour is how many contact requests a public key
+	// can send us within a rolling hour before being temporarily blocked
+	MaxContactRequestsPerHour = 5
+
+	contactRequestRateLimitWindow = time.Hour
+
+	// contactRequestBlocklistDuration is how long contact requests from a
+	// key which exceeded the limit are dropped
+	contactRequestBlocklistDuration = 24 * time.Hour
+)
+
+// contactRequestRateLimiter keeps track of the contact requests received from
+// each public key, to drop the ones from keys spamming us.
+// Its state is kept in memory only.
+type contactRequestRateLimiter struct {
+	mutex sync.Mutex
+
+	maxRequests       int
+	window            time.Duration
+	blocklistDuration time.Duration
+
+	// requests are the timestamps of the last requests received from each key
+	requests map[string][]time.Time
+	// blocklist maps the blocked keys to the time they are unblocked at
+	blocklist map[string]time.Time
+	// lastPruned is when the keys without recent requests were last removed
+	lastPruned time.Time
+}
+
+func newContactRequestRateLimiter() *contactRequestRateLimiter {
+	return &contactRequestRateLimiter{
+		maxRequests:       MaxContactRequestsPerHour,
+		window:            contactRequestRateLimitWindow,
+		blocklistDuration: contactRequestBlocklistDuration,
+		requests:          make(map[string][]time.Time),
+		blocklist:         make(map[string]time.Time),
+	}
+}
+
+// allow records a contact request received from `publicKey` at `now` and
+// returns whether it should be handled
+func (l *contactRequestRateLimiter) allow(publicKey string, now time.Time) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.prune(now)
+
+	if l.isBlocked(publicKey, now) {
+		return false
+	}
+
+	recent := l.recentRequests(publicKey, now)
+
+	if len(recent) >= l.maxRequests {
+		delete(l.requests, publicKey)
+		l.blocklist[publicKey] = now.Add(l.blocklistDuration)
+		return false
+	}
+
+	l.requests[publicKey] = append(recent, now)
+	return true
+}
+
+// isBlocked returns whether `publicKey` is in the blocklist, removing it if
+// its block has expired
+func (l *contactRequestRateLimiter) isBlocked(publicKey string, now time.Time) bool {
+	blockedUntil, ok := l.blocklist[publicKey]
+	if !ok {
+		return false
+	}
+
+	if now.Before(blockedUntil) {
+		return true
+	}
+
+	delete(l.blocklist, publicKey)
+	return false
+}
+
+// recentRequests returns the requests received from `publicKey` within the
+// window ending at `now`
+func (l *contactRequestRateLimiter) recentRequests(publicKey string, now time.Time) []time.Time {
+	windowStart := now.Add(-l.window)
+	var recent []time.Time
+	for _, timestamp := range l.requests[publicKey] {
+		if timestamp.After(windowStart) {
+			recent = append(recent, timestamp)
+		}
+	}
+	return recent
+}
+
+// prune removes the keys without requests in the current window and the
+// expired blocks, so that keys which stop sending requests are not kept
+// forever. It runs at most once per window.
+func (l *contactRequestRateLimiter) prune(now time.Time) {
+	if now.Sub(l.lastPruned) < l.window {
+		return
+	}
+	l.lastPruned = now
+
+	for publicKey := range l.requests {
+		if recent := l.recentRequests(publicKey, now); len(recent) > 0 {
+			l.requests[publicKey] = recent
+		} else {
+			delete(l.requests, publicKey)
+		}
+	}
+
+	for publicKey := range l.blocklist {
+		l.isBlocked(publicKey, now)
+	}
+}
@@ -0,0 +1,68 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContactRequestRateLimiter(t *testing.T) {
+	limiter := newContactRequestRateLimiter()
+	now := time.Unix(100000, 0)
+
+	for i := 0; i < MaxContactRequestsPerHour; i++ {
+		require.True(t, limiter.allow("spammer", now.Add(time.Duration(i)*time.Minute)))
+	}
+
+	// Exceeding the limit blocks the key
+	require.False(t, limiter.allow("spammer", now.Add(10*time.Minute)))
+	require.False(t, limiter.allow("spammer", now.Add(2*time.Hour)))
+
+	// Other keys are not affected
+	require.True(t, limiter.allow("other", now.Add(10*time.Minute)))
+
+	// The block expires, and the key starts over with a new window
+	unblockedAt := now.Add(10*time.Minute + contactRequestBlocklistDuration)
+	require.False(t, limiter.allow("spammer", unblockedAt.Add(-time.Second)))
+	for i := 0; i < MaxContactRequestsPerHour; i++ {
+		require.True(t, limiter.allow("spammer", unblockedAt.Add(time.Duration(i)*time.Second)))
+	}
+	require.False(t, limiter.allow("spammer", unblockedAt.Add(time.Minute)))
+}
+
+func TestContactRequestRateLimiterRollingWindow(t *testing.T) {
+	limiter := newContactRequestRateLimiter()
+	now := time.Unix(100000, 0)
+
+	// Requests older than an hour don't count towards the limit
+	for i := 0; i < 3*MaxContactRequestsPerHour; i++ {
+		require.True(t, limiter.allow("sender", now.Add(time.Duration(i)*15*time.Minute)))
+	}
+	require.Empty(t, limiter.blocklist)
+	require.Len(t, limiter.requests["sender"], 4)
+}
+
+func TestContactRequestRateLimiterPrune(t *testing.T) {
+	limiter := newContactRequestRateLimiter()
+	now := time.Unix(100000, 0)
+
+	require.True(t, limiter.allow("once", now))
+	for i := 0; i <= MaxContactRequestsPerHour; i++ {
+		limiter.allow("spammer", now)
+	}
+	require.Len(t, limiter.requests, 1)
+	require.Len(t, limiter.blocklist, 1)
+
+	// Keys without requests in the last hour are removed
+	require.True(t, limiter.allow("sender", now.Add(contactRequestRateLimitWindow)))
+	require.Len(t, limiter.requests, 1)
+	require.Contains(t, limiter.requests, "sender")
+	require.Len(t, limiter.blocklist, 1)
+
+	// So are expired blocks
+	require.True(t, limiter.allow("sender", now.Add(contactRequestBlocklistDuration)))
+	require.Len(t, limiter.requests, 1)
+	require.Contains(t, limiter.requests, "sender")
+	require.Empty(t, limiter.blocklist)
+}
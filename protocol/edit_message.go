@@ -26,6 +26,14 @@ type EditMessage struct {
 	LocalChatID string `json:"localChatId"`
 }
 
+// MessageEdit is a version of a message which has been replaced by an edit
+type MessageEdit struct {
+	Content     string                           `json:"content"`
+	ContentType protobuf.ChatMessage_ContentType `json:"contentType"`
+	// Clock is the clock of the message or of the edit which set the content
+	Clock uint64 `json:"clock"`
+}
+
 // GetSigPubKey returns an ecdsa encoded public key
 // this function is required to implement the ChatEntity interface
 func (e EditMessage) GetSigPubKey() *ecdsa.PublicKey {
@@ -119,6 +119,12 @@ func (s *Multidevice) EnableInstallation(identity *ecdsa.PublicKey, installation
 	return s.persistence.EnableInstallation(identityC, installationID)
 }
 
+// UpdateInstallationTimestamp records that the installation was seen at timestamp, unless it was seen later already
+func (s *Multidevice) UpdateInstallationTimestamp(identity *ecdsa.PublicKey, installationID string, timestamp int64) error {
+	identityC := crypto.CompressPubkey(identity)
+	return s.persistence.UpdateInstallationTimestamp(identityC, installationID, timestamp)
+}
+
 func (s *Multidevice) DisableInstallation(myIdentityKey *ecdsa.PublicKey, installationID string) error {
 	myIdentityKeyC := crypto.CompressPubkey(myIdentityKey)
 	return s.persistence.DisableInstallation(myIdentityKeyC, installationID)
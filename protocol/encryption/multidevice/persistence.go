@@ -226,6 +226,21 @@ func (s *sqlitePersistence) AddInstallations(identity []byte, timestamp int64, i
 
 }
 
+// UpdateInstallationTimestamp updates the timestamp of the installation, if newer
+func (s *sqlitePersistence) UpdateInstallationTimestamp(identity []byte, installationID string, timestamp int64) error {
+	_, err := s.db.Exec(`UPDATE installations
+			     SET timestamp = ?
+			     WHERE identity = ?
+			     AND installation_id = ?
+			     AND timestamp < ?`,
+		timestamp,
+		identity,
+		installationID,
+		timestamp,
+	)
+	return err
+}
+
 // EnableInstallation enables the installation
 func (s *sqlitePersistence) EnableInstallation(identity []byte, installationID string) error {
 	stmt, err := s.db.Prepare(`UPDATE installations
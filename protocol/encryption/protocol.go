@@ -189,6 +189,31 @@ func (p *Protocol) BuildEncryptedMessage(myIdentityKey *ecdsa.PrivateKey, public
 		return nil, err
 	}
 
+	return p.buildEncryptedMessage(myIdentityKey, publicKey, activeInstallations, payload)
+}
+
+// BuildEncryptedMessageForInstallations is like BuildEncryptedMessage, but the payload is only encrypted for the
+// active installations of the recipient with the given IDs
+func (p *Protocol) BuildEncryptedMessageForInstallations(myIdentityKey *ecdsa.PrivateKey, publicKey *ecdsa.PublicKey, installationIDs []string, payload []byte) (*ProtocolMessageSpec, error) {
+	activeInstallations, err := p.multidevice.GetActiveInstallations(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var installations []*multidevice.Installation
+	for _, installation := range activeInstallations {
+		for _, id := range installationIDs {
+			if installation.ID == id {
+				installations = append(installations, installation)
+				break
+			}
+		}
+	}
+
+	return p.buildEncryptedMessage(myIdentityKey, publicKey, installations, payload)
+}
+
+func (p *Protocol) buildEncryptedMessage(myIdentityKey *ecdsa.PrivateKey, publicKey *ecdsa.PublicKey, activeInstallations []*multidevice.Installation, payload []byte) (*ProtocolMessageSpec, error) {
 	// Encrypt payload
 	encryptedMessagesByInstalls, installations, err := p.encryptor.EncryptPayload(publicKey, myIdentityKey, activeInstallations, payload)
 	if err != nil {
@@ -451,6 +476,11 @@ func (p *Protocol) EnableInstallation(myIdentityKey *ecdsa.PublicKey, installati
 	return p.multidevice.EnableInstallation(myIdentityKey, installationID)
 }
 
+// UpdateInstallationTimestamp records that an installation was seen at timestamp.
+func (p *Protocol) UpdateInstallationTimestamp(myIdentityKey *ecdsa.PublicKey, installationID string, timestamp int64) error {
+	return p.multidevice.UpdateInstallationTimestamp(myIdentityKey, installationID, timestamp)
+}
+
 // DisableInstallation disables an installation for multi-device sync.
 func (p *Protocol) DisableInstallation(myIdentityKey *ecdsa.PublicKey, installationID string) error {
 	return p.multidevice.DisableInstallation(myIdentityKey, installationID)
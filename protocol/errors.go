@@ -5,8 +5,29 @@ import (
 )
 
 var (
-	ErrChatIDEmpty     = errors.New("chat ID is empty")
-	ErrChatNotFound    = errors.New("can't find chat")
-	ErrNotImplemented  = errors.New("not implemented")
-	ErrContactNotFound = errors.New("contact not found")
+	ErrChatIDEmpty      = errors.New("chat ID is empty")
+	ErrChatNotFound     = errors.New("can't find chat")
+	ErrNotImplemented   = errors.New("not implemented")
+	ErrContactNotFound  = errors.New("contact not found")
+	ErrEmptySearchQuery = errors.New("search query is empty")
+	ErrDuplicateMessage = errors.New("message already stored")
+
+	ErrChatTypeNotSupported  = errors.New("chat type not supported")
+	ErrMediaTypeNotSupported = errors.New("media type not supported")
+
+	ErrInvalidNotificationLevel = errors.New("invalid notification level")
+
+	ErrEmptyReportReason    = errors.New("report reason can't be empty")
+	ErrReporterNotAMember   = errors.New("reporter is not a member of the community")
+	ErrNoCommunityModerator = errors.New("community has no moderator to report to")
+
+	ErrPinnedMessageLimitReached = errors.New("pinned messages limit reached for this chat")
+
+	ErrInvalidReactionLeaderboardLimit = errors.New("reaction leaderboard limit must be positive")
+
+	ErrInstallationNotFound = errors.New("installation not found")
+	ErrInstallationDisabled = errors.New("installation is not enabled")
+
+	ErrBackupDataTruncated           = errors.New("backup data is truncated")
+	ErrBackupDataVersionNotSupported = errors.New("backup data version not supported")
 )
@@ -3,6 +3,7 @@ package alias
 import (
 	"crypto/ecdsa"
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	"github.com/status-im/status-go/eth-node/crypto"
@@ -10,6 +11,8 @@ import (
 
 const poly uint64 = 0xB8
 
+var ErrNilPublicKey = errors.New("public key is nil")
+
 func generate(seed uint64) string {
 	generator := newLSFR(poly, seed)
 	adjective1Index := generator.next() % uint64(len(adjectives))
@@ -36,6 +39,12 @@ func GenerateFromPublicKeyString(publicKeyString string) (string, error) {
 		return "", err
 	}
 
+	return GenerateFromPublicKeyBytes(publicKeyBytes)
+}
+
+// GenerateFromPublicKeyBytes returns the 3 words name given an uncompressed
+// public key in its raw byte form
+func GenerateFromPublicKeyBytes(publicKeyBytes []byte) (string, error) {
 	publicKey, err := crypto.UnmarshalPubkey(publicKeyBytes)
 	if err != nil {
 		return "", err
@@ -43,3 +52,13 @@ func GenerateFromPublicKeyString(publicKeyString string) (string, error) {
 
 	return GenerateFromPublicKey(publicKey), nil
 }
+
+// GenerateFromECDSAPublicKey returns the 3 words name given an *ecdsa.PublicKey,
+// returning an error instead of panicking when the key is nil
+func GenerateFromECDSAPublicKey(publicKey *ecdsa.PublicKey) (string, error) {
+	if publicKey == nil || publicKey.X == nil {
+		return "", ErrNilPublicKey
+	}
+
+	return GenerateFromPublicKey(publicKey), nil
+}
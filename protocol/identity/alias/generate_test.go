@@ -4,8 +4,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
 )
 
+const testPublicKey = "0x04eedbaafd6adf4a9233a13e7b1c3c14461fffeba2e9054b8d456ce5f6ebeafadcbf3dce3716253fbc391277fa5a086b60b283daf61fb5b1f26895f456c2f31ae3"
+
 func TestGenerate(t *testing.T) {
 	var seed uint64 = 42
 
@@ -22,3 +27,54 @@ func TestGenerateFromPublicKeyString(t *testing.T) {
 	require.NotNil(t, name)
 	require.Equal(t, "Darkorange Blue Bubblefish", name)
 }
+
+func TestGenerateFromPublicKeyBytes(t *testing.T) {
+	name, err := GenerateFromPublicKeyBytes(types.Hex2Bytes(testPublicKey[2:]))
+	require.NoError(t, err)
+	require.Equal(t, "Darkorange Blue Bubblefish", name)
+
+	_, err = GenerateFromPublicKeyBytes([]byte{0x04, 0x01})
+	require.Error(t, err)
+}
+
+func TestGenerateFromECDSAPublicKey(t *testing.T) {
+	publicKey, err := crypto.UnmarshalPubkey(types.Hex2Bytes(testPublicKey[2:]))
+	require.NoError(t, err)
+
+	name, err := GenerateFromECDSAPublicKey(publicKey)
+	require.NoError(t, err)
+	require.Equal(t, "Darkorange Blue Bubblefish", name)
+
+	_, err = GenerateFromECDSAPublicKey(nil)
+	require.Equal(t, ErrNilPublicKey, err)
+}
+
+func BenchmarkGenerateFromPublicKeyString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = GenerateFromPublicKeyString(testPublicKey)
+	}
+}
+
+func BenchmarkGenerateFromPublicKeyBytes(b *testing.B) {
+	publicKeyBytes := types.Hex2Bytes(testPublicKey[2:])
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = GenerateFromPublicKeyBytes(publicKeyBytes)
+	}
+}
+
+func BenchmarkGenerateFromECDSAPublicKey(b *testing.B) {
+	publicKey, err := crypto.UnmarshalPubkey(types.Hex2Bytes(testPublicKey[2:]))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = GenerateFromECDSAPublicKey(publicKey)
+	}
+}
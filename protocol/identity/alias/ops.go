@@ -31,3 +31,38 @@ func IsAlias(alias string) bool {
 	}
 	return false
 }
+
+// IsValidAlias reports whether name has the exact format produced by
+// GenerateFromPublicKeyString: two adjectives and an animal from the
+// dictionaries, separated by single spaces. Words are matched case-insensitively.
+func IsValidAlias(name string) bool {
+	words := strings.Split(name, " ")
+	if len(words) != 3 {
+		return false
+	}
+
+	for _, word := range words {
+		if !isLetters(word) {
+			return false
+		}
+	}
+
+	return IsAdjective(titleWord(words[0])) && IsAdjective(titleWord(words[1])) && IsAnimal(titleWord(words[2]))
+}
+
+func isLetters(word string) bool {
+	if word == "" {
+		return false
+	}
+	for _, r := range word {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func titleWord(word string) string {
+	word = strings.ToLower(word)
+	return strings.ToUpper(word[:1]) + word[1:]
+}
@@ -0,0 +1,39 @@
+package alias
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsValidAlias(t *testing.T) {
+	generated, err := GenerateFromPublicKeyString(testPublicKey)
+	require.NoError(t, err)
+
+	valid := []string{
+		generated,
+		"darkorange blue bubblefish",
+		"Hard Tame Brownbutterfly",
+	}
+	for _, name := range valid {
+		require.True(t, IsValidAlias(name), name)
+	}
+
+	invalid := []string{
+		"",
+		"darkorange blue",
+		"darkorange blue bubblefish bubblefish",
+		"darkorange  blue bubblefish",
+		" darkorange blue bubblefish",
+		"darkorange blue bubblefish1",
+		"darkorange 8lue bubblefish",
+		"darkorange blue bubble-fish",
+		"darkorange blue bubblefish!",
+		"darkorange\tblue bubblefish",
+		"bubblefish blue darkorange",
+		"some random name",
+	}
+	for _, name := range invalid {
+		require.False(t, IsValidAlias(name), name)
+	}
+}
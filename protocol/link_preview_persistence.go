@@ -0,0 +1,56 @@
+package protocol
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// LinkPreview returns the cached preview of the link with the given hash,
+// nil if there is none or if it expired at `now`
+func (db sqlitePersistence) LinkPreview(urlHash string, now time.Time) (*LinkPreview, error) {
+	return db.scanLinkPreview(db.db.QueryRow(`SELECT data, fetched_at FROM link_previews WHERE url_hash = ? AND fetched_at + ttl > ?`, urlHash, now.Unix()))
+}
+
+// CachedLinkPreview returns the cached preview of the link with the given
+// hash whether it expired or not, nil if there is none
+func (db sqlitePersistence) CachedLinkPreview(urlHash string) (*LinkPreview, error) {
+	return db.scanLinkPreview(db.db.QueryRow(`SELECT data, fetched_at FROM link_previews WHERE url_hash = ?`, urlHash))
+}
+
+func (db sqlitePersistence) scanLinkPreview(row *sql.Row) (*LinkPreview, error) {
+	var data []byte
+	var fetchedAt int64
+	err := row.Scan(&data, &fetchedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &LinkPreview{}
+	err = json.Unmarshal(data, preview)
+	if err != nil {
+		return nil, err
+	}
+	preview.FetchedAt = fetchedAt
+	return preview, nil
+}
+
+func (db sqlitePersistence) SaveLinkPreview(urlHash string, preview *LinkPreview, fetchedAt time.Time, ttl time.Duration) error {
+	data, err := json.Marshal(preview)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.db.Exec(`INSERT INTO link_previews(url_hash, url, data, fetched_at, ttl) VALUES (?, ?, ?, ?, ?)`,
+		urlHash, preview.URL, data, fetchedAt.Unix(), int64(ttl.Seconds()))
+	return err
+}
+
+// DeleteLinkPreviewsFetchedBefore removes the cached previews fetched before `t`
+func (db sqlitePersistence) DeleteLinkPreviewsFetchedBefore(t time.Time) error {
+	_, err := db.db.Exec(`DELETE FROM link_previews WHERE fetched_at < ?`, t.Unix())
+	return err
+}
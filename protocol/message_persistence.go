@@ -8,6 +8,8 @@ import (
 	"sort"
 	"strings"
 
+	sqlite3 "github.com/mutecomm/go-sqlcipher"
+
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
 )
@@ -644,7 +646,7 @@ func (db sqlitePersistence) MessageByChatID(chatID string, currCursor string, li
 	// This new column values can also be returned as a cursor for subsequent requests.
 	where := fmt.Sprintf(`
             WHERE
-                NOT(m1.hide) AND m1.local_chat_id = ? %s
+                NOT(m1.hide) AND NOT(m1.hidden_locally) AND m1.local_chat_id = ? %s
             ORDER BY cursor DESC
             LIMIT ?`, cursorWhere)
 
@@ -672,6 +674,160 @@ func (db sqlitePersistence) MessageByChatID(chatID string, currCursor string, li
 	return result, newCursor, nil
 }
 
+// MediaAttachmentsByChatID returns the messages of the given content type in a chat,
+// latest first. The URL of the attachments is not set
+func (db sqlitePersistence) MediaAttachmentsByChatID(chatID string, contentType protobuf.ChatMessage_ContentType, currCursor string, limit int) ([]*MediaAttachment, string, error) {
+	cursorWhere := ""
+	if currCursor != "" {
+		cursorWhere = "AND cursor <= ?" //nolint: goconst
+	}
+	args := []interface{}{chatID, contentType}
+	if currCursor != "" {
+		args = append(args, currCursor)
+	}
+	query := fmt.Sprintf(`
+            SELECT
+                m1.id,
+                m1.timestamp,
+                m1.source,
+                %s
+            FROM user_messages m1
+            WHERE
+                NOT(m1.hide) AND NOT(m1.deleted) AND NOT(m1.deleted_for_me) AND NOT(m1.hidden_locally)
+                AND m1.local_chat_id = ? AND m1.content_type = ? %s
+            ORDER BY cursor DESC
+            LIMIT ?`, cursorField, cursorWhere)
+
+	rows, err := db.db.Query(
+		query,
+		append(args, limit+1)..., // take one more to figure our whether a cursor should be returned
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var result []*MediaAttachment
+	var cursors []string
+	for rows.Next() {
+		attachment := &MediaAttachment{}
+		var cursor string
+		err := rows.Scan(&attachment.MessageID, &attachment.Timestamp, &attachment.AuthorPublicKey, &cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		result = append(result, attachment)
+		cursors = append(cursors, cursor)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var newCursor string
+	if len(result) > limit {
+		newCursor = cursors[limit]
+		result = result[:limit]
+	}
+	return result, newCursor, nil
+}
+
+// GapsDurationByChatID returns the sum in seconds of the gaps in the chat history
+func (db sqlitePersistence) GapsDurationByChatID(chatID string) (uint64, error) {
+	var duration uint64
+	err := db.db.QueryRow(`
+		SELECT COALESCE(SUM(gap_to - gap_from), 0)
+		FROM user_messages
+		WHERE local_chat_id = ? AND content_type = ? AND gap_to > gap_from
+		AND NOT(hide) AND NOT(deleted) AND NOT(deleted_for_me)`,
+		chatID, protobuf.ChatMessage_SYSTEM_MESSAGE_GAP,
+	).Scan(&duration)
+	return duration, err
+}
+
+// MessagesByChatIDInTimeRange returns messages for a given chatID whose timestamp
+// is within [from, to], in ascending order. A zero `to` means no upper bound.
+// Like MessageByChatID, a cursor is returned when more messages are available.
+func (db sqlitePersistence) MessagesByChatIDInTimeRange(chatID string, from, to uint64, currCursor string, limit int) ([]*common.Message, string, error) {
+	args := []interface{}{chatID, from}
+	timeRangeWhere := "AND m1.timestamp >= ?"
+	if to != 0 {
+		timeRangeWhere += " AND m1.timestamp <= ?"
+		args = append(args, to)
+	}
+	cursorWhere := ""
+	if currCursor != "" {
+		cursorWhere = "AND cursor >= ?"
+		args = append(args, currCursor)
+	}
+	where := fmt.Sprintf(`
+            WHERE
+                NOT(m1.hide) AND NOT(m1.hidden_locally) AND m1.local_chat_id = ? %s %s
+            ORDER BY cursor ASC
+            LIMIT ?`, timeRangeWhere, cursorWhere)
+
+	query := db.buildMessagesQueryWithAdditionalFields(cursorField, where)
+
+	rows, err := db.db.Query(
+		query,
+		append(args, limit+1)..., // take one more to figure our whether a cursor should be returned
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	result, cursors, err := getMessagesAndCursorsInRowOrder(db, rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var newCursor string
+	if len(result) > limit {
+		newCursor = cursors[limit]
+		result = result[:limit]
+	}
+	return result, newCursor, nil
+}
+
+// ThreadMessages returns the root message of a thread followed by its replies,
+// in ascending order. Like MessageByChatID, a cursor is returned when more
+// messages are available.
+func (db sqlitePersistence) ThreadMessages(ctx context.Context, chatID string, threadID string, currCursor string, limit int) ([]*common.Message, string, error) {
+	args := []interface{}{chatID, threadID, threadID}
+	cursorWhere := ""
+	if currCursor != "" {
+		cursorWhere = "AND cursor >= ?"
+		args = append(args, currCursor)
+	}
+	where := fmt.Sprintf(`
+            WHERE
+                NOT(m1.hide) AND NOT(m1.hidden_locally) AND m1.local_chat_id = ? AND (m1.id = ? OR m1.response_to = ?) %s
+            ORDER BY cursor ASC
+            LIMIT ?`, cursorWhere)
+
+	rows, err := db.db.QueryContext(
+		ctx,
+		db.buildMessagesQueryWithAdditionalFields(cursorField, where),
+		append(args, limit+1)..., // take one more to figure our whether a cursor should be returned
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	result, cursors, err := getMessagesAndCursorsInRowOrder(db, rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var newCursor string
+	if len(result) > limit {
+		newCursor = cursors[limit]
+		result = result[:limit]
+	}
+	return result, newCursor, nil
+}
+
 func (db sqlitePersistence) FirstUnseenMessageID(chatID string) (string, error) {
 	var id string
 	err := db.db.QueryRow(
@@ -682,7 +838,7 @@ func (db sqlitePersistence) FirstUnseenMessageID(chatID string) (string, error)
 			FROM
 				user_messages m1
 			WHERE
-				m1.local_chat_id = ? AND NOT(m1.seen) AND NOT(m1.hide) AND NOT(m1.deleted) AND NOT(m1.deleted_for_me)
+				m1.local_chat_id = ? AND NOT(m1.seen) AND NOT(m1.hide) AND NOT(m1.deleted) AND NOT(m1.deleted_for_me) AND NOT(m1.hidden_locally)
 			ORDER BY %s ASC
 			LIMIT 1
 		`, cursor),
@@ -697,11 +853,11 @@ func (db sqlitePersistence) FirstUnseenMessageID(chatID string) (string, error)
 	return id, nil
 }
 
-// Get last chat message that is not hide or deleted or deleted_for_me
+// Get last chat message that is not hide or deleted or deleted_for_me or hidden locally
 func (db sqlitePersistence) LatestMessageByChatID(chatID string) ([]*common.Message, error) {
 	args := []interface{}{chatID}
 	where := `WHERE
-                NOT(m1.hide) AND NOT(m1.deleted) AND NOT(m1.deleted_for_me) AND m1.local_chat_id = ?
+                NOT(m1.hide) AND NOT(m1.deleted) AND NOT(m1.deleted_for_me) AND NOT(m1.hidden_locally) AND m1.local_chat_id = ?
             ORDER BY cursor DESC
             LIMIT ?`
 
@@ -867,7 +1023,7 @@ func (db sqlitePersistence) AllMessageByChatIDWhichMatchTerm(chatID string, sear
 
 	where := fmt.Sprintf(`
             WHERE
-                NOT(m1.hide) AND m1.local_chat_id = ? %s
+                NOT(m1.hide) AND NOT(m1.hidden_locally) AND m1.local_chat_id = ? %s
             ORDER BY cursor DESC`, searchCond)
 
 	query := db.buildMessagesQueryWithAdditionalFields(cursorField, where)
@@ -883,6 +1039,51 @@ func (db sqlitePersistence) AllMessageByChatIDWhichMatchTerm(chatID string, sear
 	return getMessagesFromScanRows(db, rows, true)
 }
 
+// SearchMessages returns the messages whose text or author display name match
+// the query using the full-text search index, newest first.
+// If chatID is empty, messages from all chats are searched.
+func (db sqlitePersistence) SearchMessages(ctx context.Context, query string, chatID string, limit int, offset int) ([]*common.Message, error) {
+	matchQuery := fullTextSearchQuery(query)
+	if matchQuery == "" {
+		return nil, ErrEmptySearchQuery
+	}
+
+	args := []interface{}{matchQuery}
+	chatCond := ""
+	if chatID != "" {
+		chatCond = "AND m1.local_chat_id = ?"
+		args = append(args, chatID)
+	}
+
+	where := fmt.Sprintf(`
+            WHERE
+                NOT(m1.hide) AND NOT(m1.deleted) AND NOT(m1.deleted_for_me) AND NOT(m1.hidden_locally)
+                AND m1.rowid IN (SELECT docid FROM user_messages_fts WHERE user_messages_fts MATCH ?) %s
+            ORDER BY cursor DESC
+            LIMIT ? OFFSET ?`, chatCond)
+
+	rows, err := db.db.QueryContext(
+		ctx,
+		db.buildMessagesQueryWithAdditionalFields(cursorField, where),
+		append(args, limit, offset)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return getMessagesFromScanRows(db, rows, true)
+}
+
+// fullTextSearchQuery turns user input into a full-text search query where every
+// term is quoted, so that FTS operators in the input are matched literally
+func fullTextSearchQuery(query string) string {
+	var terms []string
+	for _, term := range strings.Fields(strings.ReplaceAll(query, `"`, " ")) {
+		terms = append(terms, `"`+term+`"`)
+	}
+	return strings.Join(terms, " ")
+}
+
 // AllMessagesFromChatsAndCommunitiesWhichMatchTerm returns all messages which match the search
 // term, if they belong to either any chat from the chatIds array or any channel of any community
 // from communityIds array.
@@ -938,7 +1139,7 @@ func (db sqlitePersistence) AllMessagesFromChatsAndCommunitiesWhichMatchTerm(com
 
 	where := fmt.Sprintf(`
             WHERE
-                NOT(m1.hide) %s
+                NOT(m1.hide) AND NOT(m1.hidden_locally) %s
             ORDER BY cursor DESC`, finalCond)
 
 	finalQuery := db.buildMessagesQueryWithAdditionalFields(cursorField, where)
@@ -1046,7 +1247,7 @@ func (db sqlitePersistence) PinnedMessageByChatIDs(chatIDs []string, currCursor
 
  			WHERE
  				pm.pinned = 1
- 				AND NOT(m1.hide) AND m1.local_chat_id IN %s %s
+ 				AND NOT(m1.hide) AND NOT(m1.hidden_locally) AND m1.local_chat_id IN %s %s
  			ORDER BY cursor DESC
  			%s
  		`, allFields, cursorField, "(?"+strings.Repeat(",?", len(chatIDs)-1)+")", cursorWhere, limitStr),
@@ -1075,6 +1276,23 @@ func (db sqlitePersistence) PinnedMessageByChatID(chatID string, currCursor stri
 	return db.PinnedMessageByChatIDs([]string{chatID}, currCursor, limit)
 }
 
+// GetPinnedMessageCount returns how many messages are currently pinned in the chat
+func (db sqlitePersistence) GetPinnedMessageCount(chatID string) (int, error) {
+	var count int
+	err := db.db.QueryRow(`SELECT COUNT(*) FROM pin_messages WHERE local_chat_id = ? AND pinned = 1`, chatID).Scan(&count)
+	return count, err
+}
+
+// IsMessagePinned returns whether the pin message with the given id is currently pinned
+func (db sqlitePersistence) IsMessagePinned(pinMessageID string) (bool, error) {
+	var pinned bool
+	err := db.db.QueryRow(`SELECT pinned FROM pin_messages WHERE id = ?`, pinMessageID).Scan(&pinned)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return pinned, err
+}
+
 // MessageByChatIDs returns all messages for a given chatIDs in descending order.
 // Ordering is accomplished using two concatenated values: ClockValue and ID.
 // These two values are also used to compose a cursor which is returned to the result.
@@ -1095,7 +1313,7 @@ func (db sqlitePersistence) MessageByChatIDs(chatIDs []string, currCursor string
 	// This new column values can also be returned as a cursor for subsequent requests.
 	where := fmt.Sprintf(`
 			WHERE
-				NOT(m1.hide) AND m1.local_chat_id IN %s %s
+				NOT(m1.hide) AND NOT(m1.hidden_locally) AND m1.local_chat_id IN %s %s
 			ORDER BY cursor DESC
 			LIMIT ?
 		`, "(?"+strings.Repeat(",?", len(chatIDs)-1)+")", cursorWhere)
@@ -1362,12 +1580,116 @@ func (db sqlitePersistence) SaveMessages(messages []*common.Message) (err error)
 
 	allFields := db.tableUserMessagesAllFields()
 	valuesVector := strings.Repeat("?, ", db.tableUserMessagesAllFieldsCount()-1) + "?"
-	query := "INSERT INTO user_messages(" + allFields + ") VALUES (" + valuesVector + ")" // nolint: gosec
+	// Saving a message replaces its previous version, keeping the installation that received it
+	// and whether it was hidden locally
+	query := "INSERT OR REPLACE INTO user_messages(" + allFields + ", installation_id, hidden_locally) VALUES (" + valuesVector + ", COALESCE((SELECT installation_id FROM user_messages WHERE id = ?), ''), COALESCE((SELECT hidden_locally FROM user_messages WHERE id = ?), 0))" // nolint: gosec
+	stmt, err := tx.Prepare(query)
+	if err != nil {
+		return
+	}
+
+	// Replacing a message assigns it a new rowid, so the stale search index entry is removed first
+	deleteSearchIndexStmt, err := tx.Prepare(`DELETE FROM user_messages_fts WHERE docid = (SELECT rowid FROM user_messages WHERE id = ?)`)
+	if err != nil {
+		return
+	}
+
+	insertSearchIndexStmt, err := tx.Prepare(`INSERT INTO user_messages_fts(docid, text, display_name) SELECT rowid, ?, ? FROM user_messages WHERE id = ?`)
+	if err != nil {
+		return
+	}
+
+	// The previous versions of deleted messages are not kept
+	deleteEditHistoryStmt, err := tx.Prepare(`DELETE FROM edit_history WHERE message_id = ?`)
+	if err != nil {
+		return
+	}
+
+	for _, msg := range messages {
+		if msg.Deleted {
+			_, err = deleteEditHistoryStmt.Exec(msg.ID)
+			if err != nil {
+				return
+			}
+		}
+
+		var allValues []interface{}
+		allValues, err = db.tableUserMessagesAllValues(msg)
+		if err != nil {
+			return
+		}
+
+		_, err = deleteSearchIndexStmt.Exec(msg.ID)
+		if err != nil {
+			return
+		}
+
+		_, err = stmt.Exec(append(allValues, msg.ID, msg.ID)...)
+		if err != nil {
+			return
+		}
+
+		_, err = insertSearchIndexStmt.Exec(msg.Text, msg.DisplayName, msg.ID)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// SaveReceivedMessages inserts the messages received by the given installation.
+// Messages already stored by the installation are left untouched, in which case
+// ErrDuplicateMessage is returned once the other messages have been saved
+func (db sqlitePersistence) SaveReceivedMessages(messages []*common.Message, installationID string) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	duplicate, err := db.saveReceivedMessages(messages, installationID)
+	if err != nil {
+		return err
+	}
+	if duplicate {
+		return ErrDuplicateMessage
+	}
+	return nil
+}
+
+func (db sqlitePersistence) saveReceivedMessages(messages []*common.Message, installationID string) (duplicate bool, err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	allFields := db.tableUserMessagesAllFields()
+	valuesVector := strings.Repeat("?, ", db.tableUserMessagesAllFieldsCount()-1) + "?"
+	// The unique index on (id, installation_id) makes the insert fail if the
+	// installation already stored the message
+	query := "INSERT INTO user_messages(" + allFields + ", installation_id) VALUES (" + valuesVector + ", ?)" // nolint: gosec
 	stmt, err := tx.Prepare(query)
 	if err != nil {
 		return
 	}
 
+	// A copy stored by another installation is replaced, so its search index entry is removed first
+	deleteSearchIndexStmt, err := tx.Prepare(`DELETE FROM user_messages_fts WHERE docid = (SELECT rowid FROM user_messages WHERE id = ? AND installation_id != ?)`)
+	if err != nil {
+		return
+	}
+
+	insertSearchIndexStmt, err := tx.Prepare(`INSERT INTO user_messages_fts(docid, text, display_name) SELECT rowid, ?, ? FROM user_messages WHERE id = ?`)
+	if err != nil {
+		return
+	}
+
 	for _, msg := range messages {
 		var allValues []interface{}
 		allValues, err = db.tableUserMessagesAllValues(msg)
@@ -1375,7 +1697,22 @@ func (db sqlitePersistence) SaveMessages(messages []*common.Message) (err error)
 			return
 		}
 
-		_, err = stmt.Exec(allValues...)
+		_, err = deleteSearchIndexStmt.Exec(msg.ID, installationID)
+		if err != nil {
+			return
+		}
+
+		_, err = stmt.Exec(append(allValues, installationID)...)
+		if isUniqueConstraintError(err) {
+			duplicate = true
+			err = nil
+			continue
+		}
+		if err != nil {
+			return
+		}
+
+		_, err = insertSearchIndexStmt.Exec(msg.Text, msg.DisplayName, msg.ID)
 		if err != nil {
 			return
 		}
@@ -1383,6 +1720,11 @@ func (db sqlitePersistence) SaveMessages(messages []*common.Message) (err error)
 	return
 }
 
+func isUniqueConstraintError(err error) bool {
+	sqliteErr, ok := err.(sqlite3.Error)
+	return ok && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+}
+
 func (db sqlitePersistence) SavePinMessages(messages []*common.PinMessage) (err error) {
 	tx, err := db.db.BeginTx(context.Background(), nil)
 	if err != nil {
@@ -1455,20 +1797,41 @@ func (db sqlitePersistence) SavePinMessages(messages []*common.PinMessage) (err
 }
 
 func (db sqlitePersistence) DeleteMessage(id string) error {
-	_, err := db.db.Exec(`DELETE FROM user_messages WHERE id = ?`, id)
-	return err
+	return db.DeleteMessages([]string{id})
 }
 
-func (db sqlitePersistence) DeleteMessages(ids []string) error {
+func (db sqlitePersistence) DeleteMessages(ids []string) (err error) {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
 	idsArgs := make([]interface{}, 0, len(ids))
 	for _, id := range ids {
 		idsArgs = append(idsArgs, id)
 	}
 	inVector := strings.Repeat("?, ", len(ids)-1) + "?"
 
-	_, err := db.db.Exec("DELETE FROM user_messages WHERE id IN ("+inVector+")", idsArgs...) // nolint: gosec
+	_, err = tx.Exec("DELETE FROM edit_history WHERE message_id IN ("+inVector+")", idsArgs...) // nolint: gosec
+	if err != nil {
+		return
+	}
 
-	return err
+	_, err = tx.Exec("DELETE FROM user_messages WHERE id IN ("+inVector+")", idsArgs...) // nolint: gosec
+
+	return
 }
 
 func (db sqlitePersistence) HideMessage(id string) error {
@@ -1476,6 +1839,30 @@ func (db sqlitePersistence) HideMessage(id string) error {
 	return err
 }
 
+// HideMessageLocally hides the message on this device only
+func (db sqlitePersistence) HideMessageLocally(id string) error {
+	_, err := db.db.Exec(`UPDATE user_messages SET hidden_locally = 1 WHERE id = ?`, id)
+	return err
+}
+
+// HiddenLocallyMessagesByChatID returns the messages of the chat hidden on this
+// device, latest first
+func (db sqlitePersistence) HiddenLocallyMessagesByChatID(chatID string) ([]*common.Message, error) {
+	where := `
+            WHERE
+                NOT(m1.hide) AND m1.hidden_locally AND m1.local_chat_id = ?
+            ORDER BY cursor DESC`
+
+	rows, err := db.db.Query(db.buildMessagesQueryWithAdditionalFields(cursorField, where), chatID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result, _, err := getMessagesAndCursorsFromScanRows(db, rows)
+	return result, err
+}
+
 // SetHideOnMessage set the hide flag, but not the seen flag, as it's needed by the client to understand whether the count should be updated
 func (db sqlitePersistence) SetHideOnMessage(id string) error {
 	_, err := db.db.Exec(`UPDATE user_messages SET hide = 1 WHERE id = ?`, id)
@@ -1500,6 +1887,11 @@ func (db sqlitePersistence) deleteMessagesByCommunityID(id string) (err error) {
 		_ = tx.Rollback()
 	}()
 
+	_, err = tx.Exec(`DELETE FROM edit_history WHERE message_id IN (SELECT id FROM user_messages WHERE community_id = ?)`, id)
+	if err != nil {
+		return
+	}
+
 	_, err = tx.Exec(`DELETE FROM user_messages WHERE community_id = ?`, id)
 	if err != nil {
 		return
@@ -1530,6 +1922,11 @@ func (db sqlitePersistence) deleteMessagesByChatID(id string, tx *sql.Tx) (err e
 		}()
 	}
 
+	_, err = tx.Exec(`DELETE FROM edit_history WHERE message_id IN (SELECT id FROM user_messages WHERE local_chat_id = ?)`, id)
+	if err != nil {
+		return
+	}
+
 	_, err = tx.Exec(`DELETE FROM user_messages WHERE local_chat_id = ?`, id)
 	if err != nil {
 		return
@@ -1540,6 +1937,82 @@ func (db sqlitePersistence) deleteMessagesByChatID(id string, tx *sql.Tx) (err e
 	return
 }
 
+// PurgeMessagesOlderThan hard-deletes the messages of the chat sent before
+// `olderThan` (a timestamp in ms), along with their pins, emoji reactions,
+// edit history and discord attachments. Full text search entries are removed by a trigger.
+// It returns how many messages were deleted.
+func (db sqlitePersistence) PurgeMessagesOlderThan(ctx context.Context, chatID string, olderThan uint64) (deleted int, err error) {
+	tx, err := db.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	purged := `SELECT id FROM user_messages WHERE local_chat_id = ? AND timestamp < ?`
+	purgedDiscord := `SELECT discord_message_id FROM user_messages WHERE local_chat_id = ? AND timestamp < ? AND discord_message_id != ''`
+
+	queries := []string{
+		`DELETE FROM pin_messages WHERE local_chat_id = ? AND message_id IN (` + purged + `)`,
+		`DELETE FROM emoji_reactions WHERE local_chat_id = ? AND message_id IN (` + purged + `)`,
+	}
+	for _, query := range queries {
+		_, err = tx.ExecContext(ctx, query, chatID, chatID, olderThan)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	queries = []string{
+		`DELETE FROM edit_history WHERE message_id IN (` + purged + `)`,
+		`DELETE FROM discord_message_attachments WHERE discord_message_id IN (` + purgedDiscord + `)`,
+		`DELETE FROM discord_messages WHERE id IN (` + purgedDiscord + `)`,
+	}
+	for _, query := range queries {
+		_, err = tx.ExecContext(ctx, query, chatID, olderThan)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM user_messages WHERE local_chat_id = ? AND timestamp < ?`, chatID, olderThan)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if count == 0 {
+		return 0, nil
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE chats
+		   SET unviewed_message_count =
+		   (SELECT COUNT(1)
+		   FROM user_messages
+		   WHERE local_chat_id = ? AND seen = 0),
+		   unviewed_mentions_count =
+		   (SELECT COUNT(1)
+		   FROM user_messages
+		   WHERE local_chat_id = ? AND seen = 0 AND (mentioned or replied))
+		WHERE id = ?`, chatID, chatID, chatID)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
 func (db sqlitePersistence) deleteMessagesByChatIDAndClockValueLessThanOrEqual(id string, clock uint64, tx *sql.Tx) (unViewedMessages, unViewedMentions uint, err error) {
 	if tx == nil {
 		tx, err = db.db.BeginTx(context.Background(), &sql.TxOptions{})
@@ -1556,6 +2029,11 @@ func (db sqlitePersistence) deleteMessagesByChatIDAndClockValueLessThanOrEqual(i
 		}()
 	}
 
+	_, err = tx.Exec(`DELETE FROM edit_history WHERE message_id IN (SELECT id FROM user_messages WHERE local_chat_id = ? AND clock_value <= ?)`, id, clock)
+	if err != nil {
+		return
+	}
+
 	_, err = tx.Exec(`DELETE FROM user_messages WHERE local_chat_id = ? AND clock_value <= ?`, id, clock)
 	if err != nil {
 		return
@@ -2063,6 +2541,63 @@ func (db sqlitePersistence) SaveEmojiReaction(emojiReaction *EmojiReaction) (err
 	return
 }
 
+// TopReactions counts the emoji reactions made in the chat since the given
+// clock, most used first
+func (db sqlitePersistence) TopReactions(ctx context.Context, chatID string, sinceClock uint64) ([]ReactionCount, error) {
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT emoji_id, COUNT(*) AS count
+		FROM emoji_reactions
+		WHERE NOT(retracted) AND local_chat_id = ? AND clock_value >= ?
+		GROUP BY emoji_id
+		ORDER BY count DESC, emoji_id ASC`,
+		chatID, sinceClock)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []ReactionCount
+	for rows.Next() {
+		var (
+			emojiType protobuf.EmojiReaction_Type
+			count     int
+		)
+		if err := rows.Scan(&emojiType, &count); err != nil {
+			return nil, err
+		}
+		result = append(result, ReactionCount{Emoji: emojiType.String(), Count: count})
+	}
+	return result, rows.Err()
+}
+
+// ReactionLeaderboard counts the emoji reactions made by each member in the
+// chats of the community since the given clock, most active first
+func (db sqlitePersistence) ReactionLeaderboard(ctx context.Context, communityID string, sinceClock uint64, limit int) ([]ReactionLeader, error) {
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT e.source, COUNT(*) AS count
+		FROM emoji_reactions e
+		JOIN chats c ON c.id = e.local_chat_id
+		WHERE NOT(e.retracted) AND c.community_id = ? AND e.clock_value >= ?
+		GROUP BY e.source
+		ORDER BY count DESC, e.source ASC
+		LIMIT ?`,
+		communityID, sinceClock, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []ReactionLeader
+	for rows.Next() {
+		var leader ReactionLeader
+		if err := rows.Scan(&leader.PublicKey, &leader.Count); err != nil {
+			return nil, err
+		}
+		result = append(result, leader)
+	}
+	return result, rows.Err()
+}
+
 func (db sqlitePersistence) EmojiReactionByID(id string) (*EmojiReaction, error) {
 	row := db.db.QueryRow(
 		`SELECT
@@ -2345,6 +2880,31 @@ func (db sqlitePersistence) GetEdits(messageID string, from string) ([]*EditMess
 	return messages, nil
 }
 
+func (db sqlitePersistence) SaveMessageEdit(messageID string, edit *MessageEdit) error {
+	_, err := db.db.Exec(`INSERT INTO edit_history (message_id, content, content_type, edit_clock) VALUES(?,?,?,?)`, messageID, edit.Content, edit.ContentType, edit.Clock)
+	return err
+}
+
+// MessageEditHistory returns the replaced versions of the message, oldest first
+func (db sqlitePersistence) MessageEditHistory(messageID string) ([]*MessageEdit, error) {
+	rows, err := db.db.Query(`SELECT content, content_type, edit_clock FROM edit_history WHERE message_id = ? ORDER BY edit_clock ASC`, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var edits []*MessageEdit
+	for rows.Next() {
+		e := &MessageEdit{}
+		err := rows.Scan(&e.Content, &e.ContentType, &e.Clock)
+		if err != nil {
+			return nil, err
+		}
+		edits = append(edits, e)
+	}
+	return edits, rows.Err()
+}
+
 func (db sqlitePersistence) clearHistory(chat *Chat, currentClockValue uint64, tx *sql.Tx, deactivate bool) error {
 	// Set deleted at clock value if it's not a public chat so that
 	// old messages will be discarded, or if it's a straight clear history
@@ -2484,6 +3044,37 @@ func getMessagesFromScanRows(db sqlitePersistence, rows *sql.Rows, withCursor bo
 	return messages, nil
 }
 
+// getMessagesAndCursorsInRowOrder is like getMessagesAndCursorsFromScanRows,
+// but keeps messages in the order returned by the query
+func getMessagesAndCursorsInRowOrder(db sqlitePersistence, rows *sql.Rows) ([]*common.Message, []string, error) {
+	var (
+		result  []*common.Message
+		cursors []string
+	)
+	messageIdx := make(map[string]*common.Message)
+	for rows.Next() {
+		var (
+			message common.Message
+			cursor  string
+		)
+		if err := db.tableUserMessagesScanAllFields(rows, &message, &cursor); err != nil {
+			return nil, nil, err
+		}
+
+		if msg, ok := messageIdx[message.ID]; !ok {
+			messageIdx[message.ID] = &message
+			cursors = append(cursors, cursor)
+			result = append(result, &message)
+		} else if discordMessage := msg.GetDiscordMessage(); discordMessage != nil {
+			msg.Payload = getUpdatedChatMessagePayload(discordMessage, message.GetDiscordMessage())
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return result, cursors, nil
+}
+
 func getMessagesAndCursorsFromScanRows(db sqlitePersistence, rows *sql.Rows) ([]*common.Message, []string, error) {
 
 	var cursors []string
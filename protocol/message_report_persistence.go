@@ -0,0 +1,27 @@
+package protocol
+
+func (db sqlitePersistence) SaveMessageReport(report *MessageReport) error {
+	_, err := db.db.Exec(`INSERT INTO message_reports(community_id, chat_id, message_id, reporter, reason, created_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		report.CommunityID, report.ChatID, report.MessageID, report.ReporterPublicKey, report.Reason, report.CreatedAt)
+	return err
+}
+
+// MessageReportsByCommunityID returns the reports of messages of the community, latest first
+func (db sqlitePersistence) MessageReportsByCommunityID(communityID string) ([]*MessageReport, error) {
+	rows, err := db.db.Query(`SELECT community_id, chat_id, message_id, reporter, reason, created_at FROM message_reports WHERE community_id = ? ORDER BY created_at DESC`, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []*MessageReport
+	for rows.Next() {
+		report := &MessageReport{}
+		err := rows.Scan(&report.CommunityID, &report.ChatID, &report.MessageID, &report.ReporterPublicKey, &report.Reason, &report.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, rows.Err()
+}
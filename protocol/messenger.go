@@ -14,6 +14,7 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
@@ -53,6 +54,7 @@ import (
 	"github.com/status-im/status-go/protocol/requests"
 	"github.com/status-im/status-go/protocol/sqlite"
 	"github.com/status-im/status-go/protocol/transport"
+	"github.com/status-im/status-go/protocol/urls"
 	"github.com/status-im/status-go/protocol/verification"
 	"github.com/status-im/status-go/server"
 	"github.com/status-im/status-go/services/browsers"
@@ -83,6 +85,14 @@ const messageResendMaxCount = 3
 
 var communityAdvertiseIntervalSecond int64 = 60 * 60
 
+// Community description diffs are only published when smaller than this
+// fraction of the full description
+const maxCommunityDescriptionDiffRatio = 0.5
+
+// The full community description is published again after this many diffs in
+// a row, so members who missed one of them can apply the next ones
+const maxConsecutiveCommunityDescriptionDiffs = 10
+
 // messageCacheIntervalMs is how long we should keep processed messages in the cache, in ms
 var messageCacheIntervalMs uint64 = 1000 * 60 * 60 * 48
 
@@ -144,6 +154,19 @@ type Messenger struct {
 	requestedCommunitiesLock sync.RWMutex
 	requestedCommunities     map[string]*transport.Filter
 
+	contactRequestRateLimiter *contactRequestRateLimiter
+	typingIndicators          *typingIndicators
+	// receivedMessagesCount is incremented atomically every time incoming
+	// messages are saved
+	receivedMessagesCount uint64
+	// fetchLinkPreview unfurls a link, it is replaced in tests to avoid network requests
+	fetchLinkPreview func(url string) (urls.LinkPreviewData, error)
+	// refreshingLinkPreviews holds the links whose expired previews are being fetched again
+	refreshingLinkPreviews sync.Map
+	// backupRestorationProgress sums up the items of each section applied from
+	// all the backup messages handled so far
+	backupRestorationProgress map[string]int
+
 	connectionState                      connection.State
 	telemetryClient                      *telemetry.Client
 	contractMaker                        *contracts.ContractMaker
@@ -480,6 +503,7 @@ func NewMessenger(
 		contractMaker: &contracts.ContractMaker{
 			RPCClient: c.rpcClient,
 		},
+		contactRequestRateLimiter: newContactRequestRateLimiter(),
 		shutdownTasks: []func() error{
 			ensVerifier.Stop,
 			pushNotificationClient.Stop,
@@ -520,6 +544,10 @@ func NewMessenger(
 		messenger.shutdownTasks = append(messenger.shutdownTasks, anonMetricsServer.Stop)
 	}
 
+	messenger.typingIndicators = newTypingIndicators(TypingIndicatorTTL, messenger.typingIndicatorChanged)
+	messenger.shutdownTasks = append(messenger.shutdownTasks, messenger.typingIndicators.stopAll)
+	messenger.fetchLinkPreview = urls.GetLinkPreviewData
+
 	if c.envelopesMonitorConfig != nil {
 		interceptor := EnvelopeEventsInterceptor{c.envelopesMonitorConfig.EnvelopeEventsHandler, messenger}
 		err := messenger.transport.SetEnvelopeEventsHandler(interceptor)
@@ -639,8 +667,28 @@ func (m *Messenger) ToBackground() {
 	}
 }
 
+// startupSettings are the settings read by the loops started with the messenger
+var startupSettings = []settings.SettingField{
+	settings.AutoMessageEnabled,
+	settings.AutoPurgeEnabled,
+	settings.AutoPurgeRetentionDays,
+	settings.BackupEnabled,
+	settings.Bio,
+	settings.CurrentUserStatus,
+	settings.DefaultSyncPeriod,
+	settings.DisplayName,
+	settings.LastBackup,
+	settings.SendStatusUpdates,
+	settings.UseMailservers,
+}
+
 func (m *Messenger) Start() (*MessengerResponse, error) {
 	m.logger.Info("starting messenger", zap.String("identity", types.EncodeHex(crypto.FromECDSAPub(&m.identity.PublicKey))))
+	// Read the settings used while starting in a single query
+	if err := m.settings.PreloadSettings(startupSettings); err != nil {
+		return nil, err
+	}
+
 	// Start push notification server
 	if m.pushNotificationServer != nil {
 		if err := m.pushNotificationServer.Start(); err != nil {
@@ -700,12 +748,29 @@ func (m *Messenger) Start() (*MessengerResponse, error) {
 	m.broadcastLatestUserStatus()
 	m.timeoutAutomaticStatusUpdates()
 	m.startBackupLoop()
+	m.startAutoPurgeLoop()
+	m.startLinkPreviewsPurgeLoop()
 	err = m.startAutoMessageLoop()
 	if err != nil {
 		return nil, err
 	}
 	m.startSyncSettingsLoop()
 
+	if m.telemetryClient != nil {
+		m.telemetryClient.Start()
+		m.shutdownTasks = append(m.shutdownTasks, func() error {
+			m.telemetryClient.Stop()
+			return nil
+		})
+	}
+
+	logLevelWatcher := settings.NewLogLevelWatcher(m.settings.Database)
+	logLevelWatcher.Start()
+	m.shutdownTasks = append(m.shutdownTasks, func() error {
+		logLevelWatcher.Stop()
+		return nil
+	})
+
 	if err := m.cleanTopics(); err != nil {
 		return nil, err
 	}
@@ -830,12 +895,7 @@ func (m *Messenger) handleConnectionChange(online bool) {
 }
 
 func (m *Messenger) online() bool {
-	switch m.transport.WakuVersion() {
-	case 2:
-		return m.transport.PeerCount() > 0
-	default:
-		return m.node.PeersCount() > 0
-	}
+	return m.PeerCount() > 0
 }
 
 func (m *Messenger) buildContactCodeAdvertisement() (*protobuf.ContactCodeAdvertisement, error) {
@@ -1196,6 +1256,9 @@ func (m *Messenger) attachIdentityImagesToChatIdentity(context chatContext, ci *
 		m.logger.Debug(fmt.Sprintf("%s images.IdentityImage '%s'", context, spew.Sdump(imgs)))
 
 		for _, img := range imgs {
+			if img.IsAnimated() {
+				continue
+			}
 			ciis[img.Name] = m.adaptIdentityImageToProtobuf(img)
 		}
 		m.logger.Debug(fmt.Sprintf("%s protobuf.IdentityImage '%s'", context, spew.Sdump(ciis)))
@@ -1440,6 +1503,10 @@ func (m *Messenger) Init() error {
 		// the org advertise on the public topic derived by the pk
 		publicChatIDs = append(publicChatIDs, org.DefaultFilters()...)
 
+		if err := m.transport.SubscribeToCommunityShard(org.IDString()); err != nil {
+			return err
+		}
+
 		// This is for status-go versions that didn't have `CommunitySettings`
 		// We need to ensure communities that existed before community settings
 		// were introduced will have community settings as well
@@ -1487,6 +1554,10 @@ func (m *Messenger) Init() error {
 
 	for _, org := range spectatedCommunities {
 		publicChatIDs = append(publicChatIDs, org.DefaultFilters()...)
+
+		if err := m.transport.SubscribeToCommunityShard(org.IDString()); err != nil {
+			return err
+		}
 	}
 
 	// Init filters for the communities we are an admin of
@@ -1895,14 +1966,15 @@ func (m *Messenger) dispatchMessage(ctx context.Context, rawMessage common.RawMe
 		if err != nil {
 			return rawMessage, err
 		}
+		rawMessage.CommunityID, err = types.DecodeHex(chat.CommunityID)
+		if err != nil {
+			return rawMessage, err
+		}
+
 		if !isEncrypted {
 			id, err = m.sender.SendPublic(ctx, chat.ID, rawMessage)
 		} else {
-			rawMessage.CommunityID, err = types.DecodeHex(chat.CommunityID)
-
-			if err == nil {
-				id, err = m.sender.SendCommunityMessage(ctx, rawMessage)
-			}
+			id, err = m.sender.SendCommunityMessage(ctx, rawMessage)
 		}
 		if err != nil {
 			return rawMessage, err
@@ -1952,6 +2024,12 @@ func (m *Messenger) dispatchMessage(ctx context.Context, rawMessage common.RawMe
 	rawMessage.ID = types.EncodeHex(id)
 	rawMessage.SendCount++
 	rawMessage.LastSent = m.getTimesource().GetCurrentTime()
+
+	// Ephemeral messages are not resent, no need to keep them
+	if rawMessage.Ephemeral {
+		return rawMessage, nil
+	}
+
 	err = m.persistence.SaveRawMessage(&rawMessage)
 	if err != nil {
 		return rawMessage, err
@@ -2108,6 +2186,14 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 	m.logger.Debug("sent message", zap.String("id", message.ID))
 	m.prepareMessages(response.messages)
 
+	if chat.CommunityChat() {
+		community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+		if err != nil {
+			m.logger.Warn("failed to get community", zap.Error(err))
+		}
+		m.dispatchMessageCreatedWebhookEvent(community, chat, message)
+	}
+
 	return &response, m.saveChat(chat)
 }
 
@@ -2195,9 +2281,12 @@ func (m *Messenger) syncProfilePictures(rawMessageHandler RawMessageHandler) err
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	pictures := make([]*protobuf.SyncProfilePicture, len(images))
+	pictures := make([]*protobuf.SyncProfilePicture, 0, len(images))
 	clock, chat := m.getLastClockWithRelatedChat()
-	for i, image := range images {
+	for _, image := range images {
+		if image.IsAnimated() {
+			continue
+		}
 		p := &protobuf.SyncProfilePicture{}
 		p.Name = image.Name
 		p.Payload = image.Payload
@@ -2210,7 +2299,7 @@ func (m *Messenger) syncProfilePictures(rawMessageHandler RawMessageHandler) err
 		} else {
 			p.Clock = image.Clock
 		}
-		pictures[i] = p
+		pictures = append(pictures, p)
 	}
 
 	message := &protobuf.SyncProfilePictures{}
@@ -2946,6 +3035,10 @@ type ReceivedMessageState struct {
 	AllBookmarks            map[string]*browsers.Bookmark
 	AllVerificationRequests []*verification.Request
 	AllTrustStatus          map[string]verification.TrustStatus
+	// LastSeen is the timestamp of the latest message received from each sender
+	LastSeen map[string]uint64
+	// SeenInstallations are our other installations messages were received from
+	SeenInstallations map[string]bool
 }
 
 func (m *Messenger) markDeliveredMessages(acks [][]byte) {
@@ -2980,7 +3073,7 @@ func (m *Messenger) markDeliveredMessages(acks [][]byte) {
 
 // addNewMessageNotification takes a common.Message and generates a new NotificationBody and appends it to the
 // []Response.Notifications if the message is m.New
-func (r *ReceivedMessageState) addNewMessageNotification(publicKey ecdsa.PublicKey, m *common.Message, responseTo *common.Message, profilePicturesVisibility int) error {
+func (r *ReceivedMessageState) addNewMessageNotification(publicKey ecdsa.PublicKey, m *common.Message, responseTo *common.Message, profilePicturesVisibility int, notificationLevel NotificationLevel) error {
 	if !m.New {
 		return nil
 	}
@@ -3001,7 +3094,7 @@ func (r *ReceivedMessageState) addNewMessageNotification(publicKey ecdsa.PublicK
 		return fmt.Errorf("contact ID '%s' not present", contactID)
 	}
 
-	if !chat.Muted {
+	if !chat.Muted && notificationLevel.allows(m) {
 		if showMessageNotification(publicKey, m, chat, responseTo) {
 			notification, err := NewMessageNotification(m.ID, m, chat, contact, r.AllContacts, profilePicturesVisibility)
 			if err != nil {
@@ -3085,6 +3178,8 @@ func (m *Messenger) buildMessageState() *ReceivedMessageState {
 		Timesource:            m.getTimesource(),
 		AllBookmarks:          make(map[string]*browsers.Bookmark),
 		AllTrustStatus:        make(map[string]verification.TrustStatus),
+		LastSeen:              make(map[string]uint64),
+		SeenInstallations:     make(map[string]bool),
 	}
 }
 
@@ -3374,6 +3469,14 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 					PublicKey:        publicKey,
 				}
 
+				if senderID != m.myHexIdentity() && messageState.CurrentMessageState.WhisperTimestamp > messageState.LastSeen[senderID] {
+					messageState.LastSeen[senderID] = messageState.CurrentMessageState.WhisperTimestamp
+				}
+
+				if senderID == m.myHexIdentity() && msg.InstallationID != "" && msg.InstallationID != m.installationID {
+					messageState.SeenInstallations[msg.InstallationID] = true
+				}
+
 				if msg.ParsedMessage != nil {
 
 					logger.Debug("Handling parsed message")
@@ -4004,6 +4107,10 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 						message := msg.ParsedMessage.Interface().(protobuf.CommunityDescription)
 						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
 						err = m.handleCommunityDescription(messageState, publicKey, message, msg.DecryptedPayload)
+						if err == communities.ErrCommunityEventReplayed {
+							logger.Debug("ignoring replayed CommunityDescription")
+							continue
+						}
 						if err != nil {
 							logger.Warn("failed to handle CommunityDescription", zap.Error(err))
 							allMessagesProcessed = false
@@ -4017,6 +4124,21 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							}
 						}
 
+					case protobuf.CommunityDescriptionDiff:
+						logger.Debug("Handling CommunityDescriptionDiff")
+						message := msg.ParsedMessage.Interface().(protobuf.CommunityDescriptionDiff)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.handleCommunityDescriptionDiff(messageState, publicKey, message)
+						if err == communities.ErrCommunityDescriptionDiffBaseMismatch || err == communities.ErrOrgNotFound || err == communities.ErrCommunityEventReplayed {
+							logger.Debug("ignoring CommunityDescriptionDiff", zap.Error(err))
+							continue
+						}
+						if err != nil {
+							logger.Warn("failed to handle CommunityDescriptionDiff", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
 					case protobuf.RequestContactVerification:
 						logger.Debug("Handling RequestContactVerification")
 						err = m.HandleRequestContactVerification(messageState, msg.ParsedMessage.Interface().(protobuf.RequestContactVerification))
@@ -4072,6 +4194,15 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							logger.Warn("failed to handle CommunityRequestToJoin", zap.Error(err))
 							continue
 						}
+					case protobuf.CommunityMessageReport:
+						logger.Debug("Handling CommunityMessageReport")
+						report := msg.ParsedMessage.Interface().(protobuf.CommunityMessageReport)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, report)
+						err = m.HandleCommunityMessageReport(messageState, publicKey, report)
+						if err != nil {
+							logger.Warn("failed to handle CommunityMessageReport", zap.Error(err))
+							continue
+						}
 					case protobuf.CommunityCancelRequestToJoin:
 						logger.Debug("Handling CommunityCancelRequestToJoin")
 						request := msg.ParsedMessage.Interface().(protobuf.CommunityCancelRequestToJoin)
@@ -4331,7 +4462,7 @@ func (m *Messenger) saveDataAndPrepareResponse(messageState *ReceivedMessageStat
 	messagesCount := len(messagesToSave)
 	if messagesCount > 0 {
 		if messagesCount <= maxChunkSizeMessages {
-			err = m.SaveMessages(messagesToSave)
+			err = m.saveMessages(messagesToSave, messageState.ExistingMessagesMap)
 			if err != nil {
 				return nil, err
 			}
@@ -4339,7 +4470,7 @@ func (m *Messenger) saveDataAndPrepareResponse(messageState *ReceivedMessageStat
 			messageChunks := chunkSlice(messagesToSave, maxChunkSizeMessages)
 			chunksCount := len(messageChunks)
 			for i, msgs := range messageChunks {
-				err := m.SaveMessages(msgs)
+				err := m.saveMessages(msgs, messageState.ExistingMessagesMap)
 				if err != nil {
 					return nil, err
 				}
@@ -4351,6 +4482,7 @@ func (m *Messenger) saveDataAndPrepareResponse(messageState *ReceivedMessageStat
 				}
 			}
 		}
+		atomic.AddUint64(&m.receivedMessagesCount, uint64(messagesCount))
 	}
 
 	for _, emojiReaction := range messageState.EmojiReactions {
@@ -4361,6 +4493,12 @@ func (m *Messenger) saveDataAndPrepareResponse(messageState *ReceivedMessageStat
 		messageState.Response.Invitations = append(messageState.Response.Invitations, groupChatInvitation)
 	}
 
+	for contactID, lastSeen := range messageState.LastSeen {
+		if contact, ok := m.allContacts.Load(contactID); ok && contact.LastSeen < lastSeen {
+			contact.LastSeen = lastSeen
+		}
+	}
+
 	if len(contactsToSave) > 0 {
 		err = m.persistence.SaveContacts(contactsToSave)
 		if err != nil {
@@ -4368,6 +4506,18 @@ func (m *Messenger) saveDataAndPrepareResponse(messageState *ReceivedMessageStat
 		}
 	}
 
+	if len(messageState.LastSeen) > 0 {
+		err = m.persistence.UpdateContactsLastSeen(messageState.LastSeen)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = m.updateInstallationsTimestamp(messageState.SeenInstallations)
+	if err != nil {
+		return nil, err
+	}
+
 	newMessagesIds := map[string]struct{}{}
 	for _, message := range messagesToSave {
 		if message.New {
@@ -4395,15 +4545,21 @@ func (m *Messenger) saveDataAndPrepareResponse(messageState *ReceivedMessageStat
 		return nil, err
 	}
 
+	notificationLevels, err := m.persistence.NotificationLevels()
+	if err != nil {
+		return nil, err
+	}
+
 	m.prepareMessages(messageState.Response.messages)
 
 	for _, message := range messageState.Response.messages {
 		if _, ok := newMessagesIds[message.ID]; ok {
 			message.New = true
 
-			if notificationsEnabled {
+			chat, _ := messageState.AllChats.Load(message.LocalChatID)
+			if notificationsEnabled && !m.inCommunityDNDSchedule(chat, time.Now()) {
 				// Create notification body to be eventually passed to `localnotifications.SendMessageNotifications()`
-				if err = messageState.addNewMessageNotification(m.identity.PublicKey, message, messagesByID[message.ResponseTo], profilePicturesVisibility); err != nil {
+				if err = messageState.addNewMessageNotification(m.identity.PublicKey, message, messagesByID[message.ResponseTo], profilePicturesVisibility, notificationLevels[message.LocalChatID]); err != nil {
 					return nil, err
 				}
 			}
@@ -4504,6 +4660,28 @@ func (m *Messenger) MessageByChatID(chatID, cursor string, limit int) ([]*common
 	return msgs, nextCursor, nil
 }
 
+// GetThreadMessages returns the root message `threadID` and the replies to it,
+// oldest first, `limit` messages at a time
+func (m *Messenger) GetThreadMessages(ctx context.Context, chatID string, threadID string, cursor string, limit int) ([]*common.Message, string, error) {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok || chat == nil {
+		return nil, "", ErrChatNotFound
+	}
+
+	msgs, nextCursor, err := m.persistence.ThreadMessages(ctx, chatID, threadID, cursor, limit)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if m.httpServer != nil {
+		for idx := range msgs {
+			m.prepareMessage(msgs[idx], m.httpServer)
+		}
+	}
+
+	return msgs, nextCursor, nil
+}
+
 func (m *Messenger) prepareMessages(messages map[string]*common.Message) {
 	if m.httpServer != nil {
 		for idx := range messages {
@@ -4513,6 +4691,8 @@ func (m *Messenger) prepareMessages(messages map[string]*common.Message) {
 }
 
 func (m *Messenger) prepareMessage(msg *common.Message, s *server.MediaServer) {
+	m.prepareLinkPreviews(msg)
+
 	if msg.QuotedMessage != nil && msg.QuotedMessage.ContentType == int64(protobuf.ChatMessage_IMAGE) {
 		msg.QuotedMessage.ImageLocalURL = s.MakeImageURL(msg.QuotedMessage.ID)
 	}
@@ -4588,12 +4768,54 @@ func (m *Messenger) AllMessagesFromChatsAndCommunitiesWhichMatchTerm(communityId
 	return m.persistence.AllMessagesFromChatsAndCommunitiesWhichMatchTerm(communityIds, chatIds, searchTerm, caseSensitive)
 }
 
+// SearchMessages returns messages matching `query` in their text or author
+// display name, using the full-text search index. An empty chatID searches all chats.
+func (m *Messenger) SearchMessages(ctx context.Context, query string, chatID string, limit int, offset int) ([]*common.Message, error) {
+	return m.persistence.SearchMessages(ctx, query, chatID, limit, offset)
+}
+
 func (m *Messenger) SaveMessages(messages []*common.Message) error {
 	return m.persistence.SaveMessages(messages)
 }
 
+// saveMessages saves the messages of a response. The ones in `receivedMessageIDs`
+// have just been received, they are skipped if a copy was already stored, which
+// happens when a message is delivered by both the relay and the store node
+func (m *Messenger) saveMessages(messages []*common.Message, receivedMessageIDs map[string]bool) error {
+	var receivedMessages, updatedMessages []*common.Message
+	for _, message := range messages {
+		if receivedMessageIDs[message.ID] {
+			receivedMessages = append(receivedMessages, message)
+		} else {
+			updatedMessages = append(updatedMessages, message)
+		}
+	}
+
+	if len(updatedMessages) > 0 {
+		err := m.persistence.SaveMessages(updatedMessages)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := m.persistence.SaveReceivedMessages(receivedMessages, m.installationID)
+	if err == ErrDuplicateMessage {
+		m.logger.Debug("ignoring duplicate messages", zap.Int("count", len(receivedMessages)))
+		return nil
+	}
+	return err
+}
+
 func (m *Messenger) DeleteMessage(id string) error {
-	return m.persistence.DeleteMessage(id)
+	err := m.persistence.DeleteMessage(id)
+	if err != nil {
+		return err
+	}
+
+	if m.httpServer != nil {
+		m.httpServer.EvictResizedImages(id)
+	}
+	return nil
 }
 
 func (m *Messenger) DeleteMessagesByChatID(id string) error {
@@ -4732,6 +4954,25 @@ func (m *Messenger) MarkAllReadInCommunity(communityID string) ([]string, error)
 	return chatIDs, err
 }
 
+// MarkChatsAsRead marks all the messages of the given chats as read, and
+// emits a single response containing all the updated chats
+func (m *Messenger) MarkChatsAsRead(ctx context.Context, chatIDs []string) (*MessengerResponse, error) {
+	response := &MessengerResponse{}
+	for _, chatID := range chatIDs {
+		err := m.MarkAllRead(chatID)
+		if err != nil {
+			return nil, err
+		}
+		response.AddChat(m.Chat(chatID))
+	}
+
+	if m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.MessengerResponse(response)
+	}
+
+	return response, nil
+}
+
 // MuteChat signals to the messenger that we don't want to be notified
 // on new messages from this chat
 func (m *Messenger) MuteChat(chatID string) error {
@@ -4784,6 +5025,24 @@ func (m *Messenger) muteChat(chat *Chat, contact *Contact) error {
 	return m.reregisterForPushNotifications()
 }
 
+// SetChatHighlight sets whether the chat should be highlighted, and returns the updated chat
+func (m *Messenger) SetChatHighlight(chatID string, highlight bool) (*Chat, error) {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	err := m.persistence.SetChatHighlight(chatID, highlight)
+	if err != nil {
+		return nil, err
+	}
+
+	chat.Highlight = highlight
+	m.allChats.Store(chat.ID, chat)
+
+	return chat, nil
+}
+
 // UnmuteChat signals to the messenger that we want to be notified
 // on new messages from this chat
 func (m *Messenger) UnmuteChat(chatID string) error {
@@ -80,24 +80,70 @@ func (m *Messenger) startBackupLoop() {
 
 func (m *Messenger) BackupData(ctx context.Context) (uint64, error) {
 	clock, chat := m.getLastClockWithRelatedChat()
+	backupMessages, err := m.buildBackupMessages(ctx, clock)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, pb := range backupMessages {
+		err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	chat.LastClockValue = clock
+	err = m.saveChat(chat)
+	if err != nil {
+		return 0, err
+	}
+
+	clockInSeconds := clock / 1000
+	err = m.settings.SetLastBackup(clockInSeconds)
+	if err != nil {
+		return 0, err
+	}
+	if m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.BackupPerformed(clockInSeconds)
+	}
+
+	return clockInSeconds, nil
+}
+
+// ExportBackupData returns the backup messages BackupData would dispatch,
+// encoded with EncodeBackupData so that they can be saved to a file and
+// checked with ValidateBackupData
+func (m *Messenger) ExportBackupData(ctx context.Context) ([]byte, error) {
+	clock, _ := m.getLastClockWithRelatedChat()
+	backupMessages, err := m.buildBackupMessages(ctx, clock)
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeBackupData(backupMessages...)
+}
+
+// buildBackupMessages returns the backup messages of the contacts,
+// communities, profile, settings and keycards, in this order
+func (m *Messenger) buildBackupMessages(ctx context.Context, clock uint64) ([]*protobuf.Backup, error) {
 	contactsToBackup := m.backupContacts(ctx)
 	communitiesToBackup, err := m.backupCommunities(ctx, clock)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	profileToBackup, err := m.backupProfile(ctx, clock)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	_, settings, errors := m.prepareSyncSettingsMessages(clock)
 	if len(errors) != 0 {
 		// return just the first error, the others have been logged
-		return 0, errors[0]
+		return nil, errors[0]
 	}
 
 	keycardsToBackup, err := m.prepareSyncAllKeycardsMessage(clock)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	backupDetailsOnly := func() *protobuf.Backup {
@@ -126,75 +172,47 @@ func (m *Messenger) BackupData(ctx context.Context) (uint64, error) {
 		}
 	}
 
-	// Update contacts messages encode and dispatch
+	var backupMessages []*protobuf.Backup
+
+	// Update contacts messages
 	for i, d := range contactsToBackup {
 		pb := backupDetailsOnly()
 		pb.ContactsDetails.DataNumber = uint32(i + 1)
 		pb.Contacts = d.Contacts
-		err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
-		if err != nil {
-			return 0, err
-		}
+		backupMessages = append(backupMessages, pb)
 	}
 
-	// Update communities messages encode and dispatch
+	// Update communities messages
 	for i, d := range communitiesToBackup {
 		pb := backupDetailsOnly()
 		pb.CommunitiesDetails.DataNumber = uint32(i + 1)
 		pb.Communities = d.Communities
-		err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
-		if err != nil {
-			return 0, err
-		}
+		backupMessages = append(backupMessages, pb)
 	}
 
-	// Update profile messages encode and dispatch
+	// Update profile messages
 	for i, d := range profileToBackup {
 		pb := backupDetailsOnly()
 		pb.ProfileDetails.DataNumber = uint32(i + 1)
 		pb.Profile = d.Profile
-		err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
-		if err != nil {
-			return 0, err
-		}
+		backupMessages = append(backupMessages, pb)
 	}
 
-	// Update settings messages encode and dispatch
+	// Update settings messages
 	for i, d := range settings {
 		pb := backupDetailsOnly()
 		pb.SettingsDetails.DataNumber = uint32(i + 1)
 		pb.Setting = d
-		err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
-		if err != nil {
-			return 0, err
-		}
+		backupMessages = append(backupMessages, pb)
 	}
 
-	// Update keycards message encode and dispatch
+	// Update keycards message
 	pb := backupDetailsOnly()
 	pb.KeycardsDetails.DataNumber = 1
 	pb.Keycards = &keycardsToBackup
-	err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
-	if err != nil {
-		return 0, err
-	}
-
-	chat.LastClockValue = clock
-	err = m.saveChat(chat)
-	if err != nil {
-		return 0, err
-	}
+	backupMessages = append(backupMessages, pb)
 
-	clockInSeconds := clock / 1000
-	err = m.settings.SetLastBackup(clockInSeconds)
-	if err != nil {
-		return 0, err
-	}
-	if m.config.messengerSignalsHandler != nil {
-		m.config.messengerSignalsHandler.BackupPerformed(clockInSeconds)
-	}
-
-	return clockInSeconds, nil
+	return backupMessages, nil
 }
 
 func (m *Messenger) encodeAndDispatchBackupMessage(ctx context.Context, message *protobuf.Backup, chatID string) error {
@@ -335,8 +353,11 @@ func (m *Messenger) backupProfile(ctx context.Context, clock uint64) ([]*protobu
 		return nil, err
 	}
 
-	pictures := make([]*protobuf.SyncProfilePicture, len(images))
-	for i, image := range images {
+	pictures := make([]*protobuf.SyncProfilePicture, 0, len(images))
+	for _, image := range images {
+		if image.IsAnimated() {
+			continue
+		}
 		p := &protobuf.SyncProfilePicture{}
 		p.Name = image.Name
 		p.Payload = image.Payload
@@ -349,7 +370,7 @@ func (m *Messenger) backupProfile(ctx context.Context, clock uint64) ([]*protobu
 		} else {
 			p.Clock = image.Clock
 		}
-		pictures[i] = p
+		pictures = append(pictures, p)
 	}
 
 	backupMessage := &protobuf.Backup{
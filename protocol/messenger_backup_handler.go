@@ -21,33 +21,51 @@ const (
 
 func (m *Messenger) HandleBackup(state *ReceivedMessageState, message protobuf.Backup) []error {
 	var errors []error
+	restoration := wakusync.WakuBackedUpDataResponse{}
 
 	err := m.handleBackedUpProfile(message.Profile, message.Clock)
 	if err != nil {
 		errors = append(errors, err)
+	} else if message.Profile != nil {
+		restoration.AddRestorationProgress(SyncWakuSectionKeyProfile, 1)
 	}
 
 	for _, contact := range message.Contacts {
 		err = m.HandleSyncInstallationContact(state, *contact)
 		if err != nil {
 			errors = append(errors, err)
+			continue
 		}
+		restoration.AddRestorationProgress(SyncWakuSectionKeyContacts, 1)
 	}
 
 	for _, community := range message.Communities {
 		err = m.handleSyncCommunity(state, *community)
 		if err != nil {
 			errors = append(errors, err)
+			continue
 		}
+		restoration.AddRestorationProgress(SyncWakuSectionKeyCommunities, 1)
 	}
 	err = m.handleBackedUpSettings(message.Setting)
 	if err != nil {
 		errors = append(errors, err)
+	} else if message.Setting != nil {
+		restoration.AddRestorationProgress(SyncWakuSectionKeySettings, 1)
 	}
 
 	err = m.handleBackedUpKeycards(message.Keycards)
 	if err != nil {
 		errors = append(errors, err)
+	} else if message.Keycards != nil {
+		restoration.AddRestorationProgress(SyncWakuSectionKeyKeycards, len(message.Keycards.Keycards))
+	}
+
+	if m.backupRestorationProgress == nil {
+		m.backupRestorationProgress = make(map[string]int)
+	}
+	for section, applied := range restoration.RestorationProgress {
+		m.backupRestorationProgress[section] += applied
 	}
 
 	// Send signal about applied backup progress
@@ -61,6 +79,13 @@ func (m *Messenger) HandleBackup(state *ReceivedMessageState, message protobuf.B
 		response.AddFetchingBackedUpDataDetails(SyncWakuSectionKeyKeycards, message.KeycardsDetails)
 
 		m.config.messengerSignalsHandler.SendWakuFetchingBackupProgress(&response)
+
+		// Send signal about the number of items applied to the local database so far
+		progress := wakusync.WakuBackedUpDataResponse{}
+		for section, applied := range m.backupRestorationProgress {
+			progress.AddRestorationProgress(section, applied)
+		}
+		m.config.messengerSignalsHandler.SendBackupRestorationProgress(&progress)
 	}
 
 	state.Response.BackupHandled = true
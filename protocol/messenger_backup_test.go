@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -18,6 +19,7 @@ import (
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/requests"
 	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/protocol/wakusync"
 	"github.com/status-im/status-go/waku"
 )
 
@@ -169,6 +171,11 @@ func (s *MessengerBackupSuite) TestBackupProfile() {
 	s.Require().NoError(err)
 	s.Require().Equal(imagesExpected, string(jBob1Images))
 
+	// The animated variant stays on the device it was set on
+	gif, err := ioutil.ReadFile("../_assets/tests/1.gif")
+	s.Require().NoError(err)
+	s.Require().NoError(bob1.multiAccounts.StoreAnimatedIdentityImage(bob1KeyUID, gif, 1))
+
 	// Check bob2
 	storedBob2DisplayName, err := bob2.settings.DisplayName()
 	s.Require().NoError(err)
@@ -620,3 +627,136 @@ func (s *MessengerBackupSuite) TestBackupKeycards() {
 	s.Require().Equal(len(allKeycardsToSync), len(syncedKeycards))
 	s.Require().True(haveSameElements(syncedKeycards, allKeycardsToSync, sameKeycards))
 }
+
+// backupSignalsHandler records the backup progress signals, the other
+// signals are not expected to be sent
+type backupSignalsHandler struct {
+	MessengerSignalsHandler
+	restorationProgress []*wakusync.WakuBackedUpDataResponse
+}
+
+func (h *backupSignalsHandler) SendWakuFetchingBackupProgress(response *wakusync.WakuBackedUpDataResponse) {
+}
+
+func (h *backupSignalsHandler) SendBackupRestorationProgress(response *wakusync.WakuBackedUpDataResponse) {
+	h.restorationProgress = append(h.restorationProgress, response)
+}
+
+func (s *MessengerBackupSuite) TestBackupRestorationProgress() {
+	handler := &backupSignalsHandler{}
+	s.m.config.messengerSignalsHandler = handler
+
+	chunks := []int{2, 3, 1}
+	for i, contactsCount := range chunks {
+		backup := protobuf.Backup{
+			Clock: uint64(i + 1),
+			ContactsDetails: &protobuf.FetchingBackedUpDataDetails{
+				DataNumber:  uint32(i),
+				TotalNumber: uint32(len(chunks)),
+			},
+		}
+		for j := 0; j < contactsCount; j++ {
+			contactKey, err := crypto.GenerateKey()
+			s.Require().NoError(err)
+
+			backup.Contacts = append(backup.Contacts, &protobuf.SyncInstallationContactV2{
+				Id:                 types.EncodeHex(crypto.FromECDSAPub(&contactKey.PublicKey)),
+				Added:              true,
+				LastUpdatedLocally: uint64(i + 1),
+			})
+		}
+
+		s.Require().Empty(s.m.HandleBackup(s.m.buildMessageState(), backup))
+	}
+
+	// The progress counts the items applied from all the backup messages so far
+	s.Require().Len(handler.restorationProgress, len(chunks))
+	restored := 0
+	for i, contactsCount := range chunks {
+		restored += contactsCount
+		s.Require().Equal(map[string]int{SyncWakuSectionKeyContacts: restored}, handler.restorationProgress[i].RestorationProgress)
+	}
+
+	encoded, err := json.Marshal(handler.restorationProgress[0])
+	s.Require().NoError(err)
+	s.Require().JSONEq(`{"restorationProgress":{"contacts":2}}`, string(encoded))
+}
+
+func (s *MessengerBackupSuite) validBackupData() []byte {
+	contactKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	data, err := EncodeBackupData(
+		&protobuf.Backup{
+			Clock: 1679500000000,
+			Contacts: []*protobuf.SyncInstallationContactV2{
+				{Id: types.EncodeHex(crypto.FromECDSAPub(&contactKey.PublicKey)), Added: true},
+			},
+			Communities: []*protobuf.SyncCommunity{{Id: []byte("community-1")}, {Id: []byte("community-2")}},
+		},
+		&protobuf.Backup{
+			Clock:   1679500001000,
+			Setting: &protobuf.SyncSetting{Type: protobuf.SyncSetting_PREFERRED_NAME, Value: &protobuf.SyncSetting_ValueString{ValueString: "bob"}},
+		},
+	)
+	s.Require().NoError(err)
+	return data
+}
+
+func (s *MessengerBackupSuite) TestValidateBackupData() {
+	result, err := s.m.ValidateBackupData(context.Background(), s.validBackupData())
+	s.Require().NoError(err)
+	s.Require().Equal(&BackupValidationResult{
+		Version:        "1",
+		ContactCount:   1,
+		CommunityCount: 2,
+		SettingCount:   1,
+		CreatedAt:      1679500001,
+		ChecksumValid:  true,
+	}, result)
+
+	// Nothing is restored
+	s.Require().Empty(s.m.Contacts())
+}
+
+func (s *MessengerBackupSuite) TestExportBackupData() {
+	contactKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	_, err = s.m.AddContact(context.Background(), &requests.AddContact{ID: types.EncodeHex(crypto.FromECDSAPub(&contactKey.PublicKey))})
+	s.Require().NoError(err)
+
+	data, err := s.m.ExportBackupData(context.Background())
+	s.Require().NoError(err)
+
+	result, err := s.m.ValidateBackupData(context.Background(), data)
+	s.Require().NoError(err)
+	s.Require().True(result.ChecksumValid)
+	s.Require().Equal(1, result.ContactCount)
+	s.Require().NotZero(result.SettingCount)
+	s.Require().NotZero(result.CreatedAt)
+
+	// Exporting doesn't count as a backup
+	lastBackup, err := s.m.lastBackup()
+	s.Require().NoError(err)
+	s.Require().Zero(lastBackup)
+}
+
+func (s *MessengerBackupSuite) TestValidateTruncatedBackupData() {
+	data := s.validBackupData()
+
+	_, err := s.m.ValidateBackupData(context.Background(), data[:len(data)-10])
+	s.Require().ErrorIs(err, ErrBackupDataTruncated)
+
+	_, err = s.m.ValidateBackupData(context.Background(), data[:backupDataHeaderLength-1])
+	s.Require().ErrorIs(err, ErrBackupDataTruncated)
+}
+
+func (s *MessengerBackupSuite) TestValidateBackupDataBadChecksum() {
+	data := s.validBackupData()
+	data[1] ^= 0xff
+
+	result, err := s.m.ValidateBackupData(context.Background(), data)
+	s.Require().NoError(err)
+	s.Require().False(result.ChecksumValid)
+	s.Require().Equal(1, result.ContactCount)
+}
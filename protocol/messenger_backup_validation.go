@@ -0,0 +1,107 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// BackupDataVersion is the version of the backup data layout written by
+// EncodeBackupData
+const BackupDataVersion = 1
+
+// backupDataHeaderLength is the length of the version byte followed by the
+// sha256 checksum of the backup messages
+const backupDataHeaderLength = 1 + sha256.Size
+
+// BackupValidationResult describes the content of backup data checked with
+// ValidateBackupData
+type BackupValidationResult struct {
+	Version        string `json:"version"`
+	ContactCount   int    `json:"contactCount"`
+	CommunityCount int    `json:"communityCount"`
+	SettingCount   int    `json:"settingCount"`
+	CreatedAt      int64  `json:"createdAt"`
+	ChecksumValid  bool   `json:"checksumValid"`
+}
+
+// EncodeBackupData encodes backup messages as backup data: a version byte,
+// the sha256 checksum of the messages and the length prefixed messages.
+func EncodeBackupData(backups ...*protobuf.Backup) ([]byte, error) {
+	var payload []byte
+	lengthPrefix := make([]byte, binary.MaxVarintLen64)
+	for _, backup := range backups {
+		encoded, err := proto.Marshal(backup)
+		if err != nil {
+			return nil, err
+		}
+		n := binary.PutUvarint(lengthPrefix, uint64(len(encoded)))
+		payload = append(payload, lengthPrefix[:n]...)
+		payload = append(payload, encoded...)
+	}
+
+	checksum := sha256.Sum256(payload)
+
+	data := make([]byte, 0, backupDataHeaderLength+len(payload))
+	data = append(data, BackupDataVersion)
+	data = append(data, checksum[:]...)
+	return append(data, payload...), nil
+}
+
+// ValidateBackupData decodes backup data and reports its content, without
+// restoring anything. An error is returned if the data can't be decoded, a
+// checksum mismatch is reported in the result.
+func (m *Messenger) ValidateBackupData(ctx context.Context, data []byte) (*BackupValidationResult, error) {
+	if len(data) < backupDataHeaderLength {
+		return nil, ErrBackupDataTruncated
+	}
+
+	version := data[0]
+	if version != BackupDataVersion {
+		return nil, ErrBackupDataVersionNotSupported
+	}
+
+	payload := data[backupDataHeaderLength:]
+	checksum := sha256.Sum256(payload)
+
+	result := &BackupValidationResult{
+		Version:       strconv.Itoa(int(version)),
+		ChecksumValid: bytes.Equal(checksum[:], data[1:backupDataHeaderLength]),
+	}
+
+	for len(payload) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		length, n := binary.Uvarint(payload)
+		if n <= 0 || uint64(len(payload)-n) < length {
+			return nil, ErrBackupDataTruncated
+		}
+
+		backup := &protobuf.Backup{}
+		err := proto.Unmarshal(payload[n:n+int(length)], backup)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode backup message")
+		}
+		payload = payload[n+int(length):]
+
+		result.ContactCount += len(backup.Contacts)
+		result.CommunityCount += len(backup.Communities)
+		if backup.Setting != nil {
+			result.SettingCount++
+		}
+		if createdAt := int64(backup.Clock / 1000); createdAt > result.CreatedAt {
+			result.CreatedAt = createdAt
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,196 @@
+package protocol
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/status-im/status-go/protocol/common"
+)
+
+const exportChatHistoryBatchSize = 100
+
+var ErrInvalidExportTimeRange = errors.New("invalid export time range")
+var ErrImportedMessageIDEmpty = errors.New("imported message id is empty")
+var ErrImportedMessageFromEmpty = errors.New("imported message sender is empty")
+var ErrImportedMessageWrongChat = errors.New("imported message belongs to a different chat")
+
+// chatHistoryExportHeader is the first line of a chat history export
+type chatHistoryExportHeader struct {
+	Chat *Chat `json:"chat"`
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
+
+// ExportChatHistory writes the messages of a chat whose timestamp is within
+// [from, to] to w as newline-delimited JSON, oldest first. The first line is a
+// header holding the chat metadata, followed by one line per message.
+// A zero `to` exports everything from `from` onwards.
+// Messages are fetched and encoded in batches so that large chats are streamed.
+func (m *Messenger) ExportChatHistory(ctx context.Context, chatID string, from, to int64, w io.Writer) error {
+	if from < 0 || to < 0 || (to != 0 && to < from) {
+		return ErrInvalidExportTimeRange
+	}
+
+	chat, err := m.persistence.Chat(chatID)
+	if err != nil {
+		return err
+	}
+
+	if chat == nil {
+		return ErrChatNotFound
+	}
+
+	encoder := json.NewEncoder(w)
+	err = encoder.Encode(&chatHistoryExportHeader{Chat: chat, From: from, To: to})
+	if err != nil {
+		return err
+	}
+
+	cursor := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		messages, nextCursor, err := m.persistence.MessagesByChatIDInTimeRange(chatID, uint64(from), uint64(to), cursor, exportChatHistoryBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			if err := encoder.Encode(message); err != nil {
+				return err
+			}
+		}
+
+		if nextCursor == "" {
+			return nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// ImportChatHistory reads messages in the format written by ExportChatHistory
+// from r and saves them into the chat with `chatID`. Messages that already
+// exist are skipped. Lines that can't be parsed or validated don't abort the
+// import, they are collected and returned together with the number of
+// imported messages.
+func (m *Messenger) ImportChatHistory(ctx context.Context, chatID string, r io.Reader) (int, []error) {
+	chat, err := m.persistence.Chat(chatID)
+	if err != nil {
+		return 0, []error{err}
+	}
+
+	if chat == nil {
+		return 0, []error{ErrChatNotFound}
+	}
+
+	var (
+		errs     []error
+		imported int
+		batch    []*common.Message
+	)
+
+	saveBatch := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		defer func() { batch = nil }()
+
+		ids := make([]string, 0, len(batch))
+		for _, message := range batch {
+			ids = append(ids, message.ID)
+		}
+		existing, err := m.persistence.MessagesExist(ids)
+		if err != nil {
+			return err
+		}
+
+		var messages []*common.Message
+		for _, message := range batch {
+			if !existing[message.ID] {
+				messages = append(messages, message)
+			}
+		}
+		if len(messages) == 0 {
+			return nil
+		}
+
+		if err := m.SaveMessages(messages); err != nil {
+			return err
+		}
+		imported += len(messages)
+		return nil
+	}
+
+	reader := bufio.NewReader(r)
+	seen := make(map[string]bool)
+	for lineNumber := 1; ; lineNumber++ {
+		if err := ctx.Err(); err != nil {
+			return imported, append(errs, err)
+		}
+
+		line, readErr := reader.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return imported, append(errs, readErr)
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) != 0 && !(lineNumber == 1 && isChatHistoryExportHeader(line)) {
+			message, err := parseImportedMessage(chatID, line)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("line %d: %w", lineNumber, err))
+			} else if !seen[message.ID] {
+				seen[message.ID] = true
+				batch = append(batch, message)
+			}
+		}
+
+		if len(batch) >= exportChatHistoryBatchSize || readErr == io.EOF {
+			if err := saveBatch(); err != nil {
+				return imported, append(errs, err)
+			}
+		}
+
+		if readErr == io.EOF {
+			return imported, errs
+		}
+	}
+}
+
+func isChatHistoryExportHeader(line []byte) bool {
+	var header struct {
+		Chat json.RawMessage `json:"chat"`
+	}
+	return json.Unmarshal(line, &header) == nil && len(header.Chat) != 0
+}
+
+func parseImportedMessage(chatID string, line []byte) (*common.Message, error) {
+	message := &common.Message{}
+	if err := json.Unmarshal(line, message); err != nil {
+		return nil, err
+	}
+
+	if message.ID == "" {
+		return nil, ErrImportedMessageIDEmpty
+	}
+
+	if message.From == "" {
+		return nil, ErrImportedMessageFromEmpty
+	}
+
+	if message.LocalChatID == "" {
+		message.LocalChatID = chatID
+	}
+
+	if message.LocalChatID != chatID {
+		return nil, ErrImportedMessageWrongChat
+	}
+
+	return message, nil
+}
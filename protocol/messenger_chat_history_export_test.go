@@ -0,0 +1,160 @@
+package protocol
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/protocol/common"
+)
+
+func TestMessengerChatHistoryExportSuite(t *testing.T) {
+	suite.Run(t, new(MessengerChatHistoryExportSuite))
+}
+
+type MessengerChatHistoryExportSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerChatHistoryExportSuite) createChatWithMessages(count int) *Chat {
+	chat := CreatePublicChat("test-export-"+strconv.Itoa(count), s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	var messages []*common.Message
+	for i := 0; i < count; i++ {
+		message := buildTestMessage(*chat)
+		message.ID = chat.ID + "-" + strconv.Itoa(i)
+		message.Clock = uint64(i + 1)
+		message.Timestamp = uint64(i + 1)
+		message.From = common.PubkeyToHex(&s.privateKey.PublicKey)
+		messages = append(messages, message)
+	}
+	if count > 0 {
+		s.Require().NoError(s.m.SaveMessages(messages))
+	}
+
+	return chat
+}
+
+func (s *MessengerChatHistoryExportSuite) exportLines(chatID string, from, to int64) []string {
+	var buf bytes.Buffer
+	s.Require().NoError(s.m.ExportChatHistory(context.Background(), chatID, from, to, &buf))
+
+	var lines []string
+	scanner := bufio.NewScanner(&buf)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	s.Require().NoError(scanner.Err())
+	return lines
+}
+
+func (s *MessengerChatHistoryExportSuite) TestExportNoMessages() {
+	chat := s.createChatWithMessages(0)
+
+	lines := s.exportLines(chat.ID, 0, 0)
+	s.Require().Len(lines, 1)
+
+	var header struct {
+		Chat struct {
+			ID string `json:"id"`
+		} `json:"chat"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(lines[0]), &header))
+	s.Require().Equal(chat.ID, header.Chat.ID)
+}
+
+func (s *MessengerChatHistoryExportSuite) TestExportOneMessage() {
+	chat := s.createChatWithMessages(1)
+
+	lines := s.exportLines(chat.ID, 0, 0)
+	s.Require().Len(lines, 2)
+
+	var message struct {
+		ID string `json:"id"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(lines[1]), &message))
+	s.Require().Equal(chat.ID+"-0", message.ID)
+}
+
+func (s *MessengerChatHistoryExportSuite) TestExportManyMessages() {
+	chat := s.createChatWithMessages(1000)
+
+	lines := s.exportLines(chat.ID, 0, 0)
+	s.Require().Len(lines, 1001)
+
+	var first, last struct {
+		Clock uint64 `json:"clock"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(lines[1]), &first))
+	s.Require().NoError(json.Unmarshal([]byte(lines[1000]), &last))
+	s.Require().Equal(uint64(1), first.Clock)
+	s.Require().Equal(uint64(1000), last.Clock)
+
+	// Only messages within the time range are exported
+	lines = s.exportLines(chat.ID, 101, 200)
+	s.Require().Len(lines, 101)
+}
+
+func (s *MessengerChatHistoryExportSuite) TestExportUnknownChat() {
+	var buf bytes.Buffer
+	err := s.m.ExportChatHistory(context.Background(), "unknown-chat", 0, 0, &buf)
+	s.Require().Equal(ErrChatNotFound, err)
+	s.Require().Zero(buf.Len())
+}
+
+func (s *MessengerChatHistoryExportSuite) TestImportRoundTrip() {
+	chat := s.createChatWithMessages(50)
+
+	var buf bytes.Buffer
+	s.Require().NoError(s.m.ExportChatHistory(context.Background(), chat.ID, 0, 0, &buf))
+
+	original, _, err := s.m.MessageByChatID(chat.ID, "", 100)
+	s.Require().NoError(err)
+	s.Require().Len(original, 50)
+
+	s.Require().NoError(s.m.DeleteMessagesByChatID(chat.ID))
+	messages, _, err := s.m.MessageByChatID(chat.ID, "", 100)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 0)
+
+	imported, errs := s.m.ImportChatHistory(context.Background(), chat.ID, &buf)
+	s.Require().Len(errs, 0)
+	s.Require().Equal(50, imported)
+
+	messages, _, err = s.m.MessageByChatID(chat.ID, "", 100)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 50)
+	for i, message := range messages {
+		s.Require().Equal(original[i].ID, message.ID)
+		s.Require().Equal(original[i].Text, message.Text)
+		s.Require().Equal(original[i].From, message.From)
+		s.Require().Equal(original[i].Clock, message.Clock)
+		s.Require().Equal(original[i].Timestamp, message.Timestamp)
+		s.Require().Equal(original[i].ContentType, message.ContentType)
+	}
+}
+
+func (s *MessengerChatHistoryExportSuite) TestImportSkipsDuplicatesAndCollectsErrors() {
+	chat := s.createChatWithMessages(2)
+
+	var buf bytes.Buffer
+	s.Require().NoError(s.m.ExportChatHistory(context.Background(), chat.ID, 0, 0, &buf))
+	buf.WriteString("{not json\n")
+	buf.WriteString(`{"id":"","from":"0x04"}` + "\n")
+
+	imported, errs := s.m.ImportChatHistory(context.Background(), chat.ID, &buf)
+	s.Require().Equal(0, imported)
+	s.Require().Len(errs, 2)
+	s.Require().ErrorIs(errs[1], ErrImportedMessageIDEmpty)
+
+	messages, _, err := s.m.MessageByChatID(chat.ID, "", 100)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 2)
+}
@@ -3,6 +3,8 @@ package protocol
 import (
 	"context"
 	"errors"
+	"sort"
+	"sync/atomic"
 
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
@@ -46,6 +48,26 @@ func (m *Messenger) Chats() []*Chat {
 	return chats
 }
 
+// GetChatsOrderedByLastActivity returns a page of the active chats, the ones with
+// the most recent message first and the chats of spectated communities last
+func (m *Messenger) GetChatsOrderedByLastActivity(limit int, offset int) ([]*Chat, error) {
+	chatIDs, err := m.persistence.ChatIDsOrderedByLastActivity(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	chats := make([]*Chat, 0, len(chatIDs))
+	for _, chatID := range chatIDs {
+		chat, ok := m.allChats.Load(chatID)
+		if !ok {
+			continue
+		}
+		chats = append(chats, chat)
+	}
+
+	return chats, nil
+}
+
 func (m *Messenger) ChatsPreview() []*ChatPreview {
 	var chats []*ChatPreview
 
@@ -119,6 +141,30 @@ func (m *Messenger) Chat(chatID string) *Chat {
 	return chat
 }
 
+// GetDirectMessageChats returns the one-to-one chats
+func (m *Messenger) GetDirectMessageChats() []*Chat {
+	var chats []*Chat
+
+	m.allChats.Range(func(chatID string, chat *Chat) (shouldContinue bool) {
+		if chat.OneToOne() {
+			chats = append(chats, chat)
+		}
+		return true
+	})
+
+	return chats
+}
+
+// GetDirectMessageChatWithContact returns the one-to-one chat with a contact
+func (m *Messenger) GetDirectMessageChatWithContact(contactID string) (*Chat, error) {
+	chat, ok := m.allChats.Load(contactID)
+	if !ok || !chat.OneToOne() {
+		return nil, ErrChatNotFound
+	}
+
+	return chat, nil
+}
+
 func (m *Messenger) ActiveChats() []*Chat {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
@@ -135,6 +181,66 @@ func (m *Messenger) ActiveChats() []*Chat {
 	return chats
 }
 
+// GetChatsWithUnreadMentions returns the chats having unread mentions, the ones
+// with the most mentions first
+func (m *Messenger) GetChatsWithUnreadMentions() []*Chat {
+	var chats []*Chat
+	m.allChats.Range(func(chatID string, chat *Chat) (shouldContinue bool) {
+		if chat.UnviewedMentionsCount > 0 {
+			chats = append(chats, chat)
+		}
+		return true
+	})
+
+	sort.Slice(chats, func(i, j int) bool {
+		if chats[i].UnviewedMentionsCount != chats[j].UnviewedMentionsCount {
+			return chats[i].UnviewedMentionsCount > chats[j].UnviewedMentionsCount
+		}
+		return chats[i].ID < chats[j].ID
+	})
+
+	return chats
+}
+
+// GetTotalUnreadMentionCount returns the number of unread mentions across all chats
+func (m *Messenger) GetTotalUnreadMentionCount() int {
+	var count int
+	m.allChats.Range(func(chatID string, chat *Chat) (shouldContinue bool) {
+		count += int(chat.UnviewedMentionsCount)
+		return true
+	})
+
+	return count
+}
+
+// ReceivedMessagesCount returns how many incoming messages have been saved
+// since the messenger was created, it can be used to invalidate caches
+func (m *Messenger) ReceivedMessagesCount() uint64 {
+	return atomic.LoadUint64(&m.receivedMessagesCount)
+}
+
+// PersonalChatsStats returns aggregate metrics about the personal chats and contacts
+func (m *Messenger) PersonalChatsStats() (*PersonalChatsStats, error) {
+	return m.persistence.PersonalChatsStats()
+}
+
+// ChatsWithPendingMessages returns the chats having messages that failed to be sent
+func (m *Messenger) ChatsWithPendingMessages() ([]*Chat, error) {
+	chatIDs, err := m.persistence.ChatIDsWithPendingMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	var chats []*Chat
+	for _, chatID := range chatIDs {
+		if chat, ok := m.allChats.Load(chatID); ok {
+			chats = append(chats, chat)
+		}
+	}
+
+	return chats, nil
+}
+
 func (m *Messenger) initChatSyncFields(chat *Chat) error {
 	defaultSyncPeriod, err := m.settings.GetDefaultSyncPeriod()
 	if err != nil {
@@ -0,0 +1,81 @@
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+func TestMessengerChatsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerChatsSuite))
+}
+
+type MessengerChatsSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerChatsSuite) TestGetChatsOrderedByLastActivity() {
+	// Newer than the chats created when starting the messenger
+	now := time.Now().UnixMilli()
+	latest := int64(0)
+	for i := 0; i < 100; i++ {
+		chat := CreatePublicChat(fmt.Sprintf("chat-%d", i), s.m.getTimesource())
+		chat.Timestamp = now + rand.Int63n(1000000) // nolint: gosec
+		if chat.Timestamp > latest {
+			latest = chat.Timestamp
+		}
+		s.Require().NoError(s.m.SaveChat(chat))
+	}
+
+	chats, err := s.m.GetChatsOrderedByLastActivity(len(s.m.Chats()), 0)
+	s.Require().NoError(err)
+	s.Require().Len(chats, len(s.m.Chats()))
+	s.Require().Equal(latest, chats[0].Timestamp)
+	for i := 1; i < len(chats); i++ {
+		s.Require().GreaterOrEqual(chats[i-1].Timestamp, chats[i].Timestamp)
+	}
+
+	page, err := s.m.GetChatsOrderedByLastActivity(10, 10)
+	s.Require().NoError(err)
+	s.Require().Equal(chats[10:20], page)
+}
+
+func (s *MessengerChatsSuite) TestGetDirectMessageChats() {
+	var groupChatIDs []string
+	for i := 0; i < 2; i++ {
+		response, err := s.m.CreateGroupChatWithMembers(context.Background(), fmt.Sprintf("group-chat-%d", i), []string{})
+		s.Require().NoError(err)
+		s.Require().Len(response.Chats(), 1)
+		groupChatIDs = append(groupChatIDs, response.Chats()[0].ID)
+	}
+
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	contactID := common.PubkeyToHex(&key.PublicKey)
+
+	_, err = s.m.GetDirectMessageChatWithContact(contactID)
+	s.Require().ErrorIs(err, ErrChatNotFound)
+
+	_, err = s.m.CreateOneToOneChat(&requests.CreateOneToOneChat{ID: types.HexBytes(crypto.FromECDSAPub(&key.PublicKey))})
+	s.Require().NoError(err)
+
+	chats := s.m.GetDirectMessageChats()
+	s.Require().Len(chats, 1)
+	s.Require().Equal(contactID, chats[0].ID)
+
+	chat, err := s.m.GetDirectMessageChatWithContact(contactID)
+	s.Require().NoError(err)
+	s.Require().Equal(chats[0], chat)
+
+	_, err = s.m.GetDirectMessageChatWithContact(groupChatIDs[0])
+	s.Require().ErrorIs(err, ErrChatNotFound)
+}
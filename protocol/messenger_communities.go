@@ -60,6 +60,47 @@ func (m *Messenger) publishOrg(org *communities.Community) error {
 	return err
 }
 
+// publishOrgUpdate publishes the changes made to the community since `base`
+// was published. A diff is sent when it's small enough compared to the full
+// description, otherwise the full description is published. It returns
+// whether a diff was sent.
+func (m *Messenger) publishOrgUpdate(org *communities.Community, base *protobuf.CommunityDescription) (bool, error) {
+	if base == nil {
+		return false, m.publishOrg(org)
+	}
+
+	diff, err := org.DescriptionDiff(base)
+	if err != nil {
+		m.logger.Debug("can't compute community description diff", zap.String("org-id", org.IDString()), zap.Error(err))
+		return false, m.publishOrg(org)
+	}
+
+	diffPayload, err := proto.Marshal(diff)
+	if err != nil {
+		return false, err
+	}
+
+	payload, err := org.MarshaledDescription()
+	if err != nil {
+		return false, err
+	}
+
+	if float64(len(diffPayload)) >= float64(len(payload))*maxCommunityDescriptionDiffRatio {
+		return false, m.publishOrg(org)
+	}
+
+	m.logger.Debug("publishing org diff", zap.String("org-id", org.IDString()), zap.Strings("fields", diff.FieldMask))
+	rawMessage := common.RawMessage{
+		Payload: diffPayload,
+		Sender:  org.PrivateKey(),
+		// we don't want to wrap in an encryption layer message
+		SkipEncryption: true,
+		MessageType:    protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION_DIFF,
+	}
+	_, err = m.sender.SendPublic(context.Background(), org.IDString(), rawMessage)
+	return true, err
+}
+
 func (m *Messenger) publishOrgInvitation(org *communities.Community, invitation *protobuf.CommunityInvitation) error {
 	m.logger.Debug("publishing org invitation", zap.String("org-id", org.IDString()), zap.Any("org", org))
 	pk, err := crypto.DecompressPubkey(invitation.PublicKey)
@@ -167,6 +208,13 @@ func (m *Messenger) handleCommunitiesSubscription(c chan *communities.Subscripti
 	// We check every 5 minutes if we need to publish
 	ticker := time.NewTicker(5 * time.Minute)
 
+	// Last description published for each community, updates are sent as
+	// diffs against it. Members who miss a diff catch up when the full
+	// description is published again, after a few diffs in a row or at the
+	// next advertisement.
+	published := make(map[string]*protobuf.CommunityDescription)
+	diffsInARow := make(map[string]int)
+
 	go func() {
 		for {
 			select {
@@ -175,9 +223,22 @@ func (m *Messenger) handleCommunitiesSubscription(c chan *communities.Subscripti
 					return
 				}
 				if sub.Community != nil {
-					err := m.publishOrg(sub.Community)
+					communityID := sub.Community.IDString()
+					base := published[communityID]
+					if diffsInARow[communityID] >= maxConsecutiveCommunityDescriptionDiffs {
+						base = nil
+					}
+
+					sentDiff, err := m.publishOrgUpdate(sub.Community, base)
 					if err != nil {
 						m.logger.Warn("failed to publish org", zap.Error(err))
+					} else {
+						published[communityID] = sub.Community.CopyDescription()
+						if sentDiff {
+							diffsInARow[communityID]++
+						} else {
+							diffsInARow[communityID] = 0
+						}
 					}
 				}
 
@@ -212,6 +273,9 @@ func (m *Messenger) handleCommunitiesSubscription(c chan *communities.Subscripti
 						err := m.publishOrg(org)
 						if err != nil {
 							m.logger.Warn("failed to publish org", zap.Error(err))
+						} else {
+							published[org.IDString()] = org.CopyDescription()
+							diffsInARow[org.IDString()] = 0
 						}
 					}
 				}
@@ -295,6 +359,11 @@ func (m *Messenger) initCommunityChats(community *communities.Community) ([]*Cha
 
 	chats := CreateCommunityChats(community, m.getTimesource())
 
+	if err := m.transport.SubscribeToCommunityShard(community.IDString()); err != nil {
+		logger.Debug("m.transport.SubscribeToCommunityShard error", zap.Error(err))
+		return nil, err
+	}
+
 	for _, chat := range chats {
 		chatIDs = append(chatIDs, chat.ID)
 	}
@@ -659,6 +728,24 @@ func (m *Messenger) ReorderCommunityChat(request *requests.ReorderCommunityChat)
 	return &response, nil
 }
 
+// ReorderCommunityChannels moves several chats of a community at once, the
+// update is broadcast as a new community description
+func (m *Messenger) ReorderCommunityChannels(request *requests.ReorderCommunityChannels) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	var response MessengerResponse
+	community, changes, err := m.communitiesManager.ReorderChannels(request)
+	if err != nil {
+		return nil, err
+	}
+	response.AddCommunity(community)
+	response.CommunityChanges = []*communities.CommunityChanges{changes}
+
+	return &response, nil
+}
+
 func (m *Messenger) DeleteCommunityCategory(request *requests.DeleteCommunityCategory) (*MessengerResponse, error) {
 	if err := request.Validate(); err != nil {
 		return nil, err
@@ -926,6 +1013,11 @@ func (m *Messenger) leaveCommunity(communityID types.HexBytes) (*MessengerRespon
 		return nil, err
 	}
 
+	err = m.transport.UnsubscribeFromCommunityShard(communityID.String())
+	if err != nil {
+		return nil, err
+	}
+
 	response.AddCommunity(community)
 	return response, nil
 }
@@ -1163,11 +1255,16 @@ func (m *Messenger) EditCommunity(request *requests.EditCommunity) (*MessengerRe
 		return nil, err
 	}
 
-	communitySettings := communities.CommunitySettings{
-		CommunityID:                  community.IDString(),
-		HistoryArchiveSupportEnabled: request.HistoryArchiveSupportEnabled,
+	// Keep the other settings, like the do not disturb schedule, untouched
+	communitySettings, err := m.communitiesManager.GetCommunitySettingsByID(community.ID())
+	if err != nil {
+		return nil, err
+	}
+	if communitySettings == nil {
+		communitySettings = &communities.CommunitySettings{CommunityID: community.IDString()}
 	}
-	err = m.communitiesManager.UpdateCommunitySettings(communitySettings)
+	communitySettings.HistoryArchiveSupportEnabled = request.HistoryArchiveSupportEnabled
+	err = m.communitiesManager.UpdateCommunitySettings(*communitySettings)
 	if err != nil {
 		return nil, err
 	}
@@ -1186,8 +1283,8 @@ func (m *Messenger) EditCommunity(request *requests.EditCommunity) (*MessengerRe
 
 	response := &MessengerResponse{}
 	response.AddCommunity(community)
-	response.AddCommunitySettings(&communitySettings)
-	err = m.SyncCommunitySettings(context.Background(), &communitySettings)
+	response.AddCommunitySettings(communitySettings)
+	err = m.SyncCommunitySettings(context.Background(), communitySettings)
 	if err != nil {
 		return nil, err
 	}
@@ -1308,6 +1405,143 @@ func (m *Messenger) GetCommunityByID(communityID types.HexBytes) (*communities.C
 	return m.communitiesManager.GetByID(communityID)
 }
 
+// GetCommunityPublicKey returns the public key of the community, hex encoded
+// and uncompressed
+func (m *Messenger) GetCommunityPublicKey(communityID types.HexBytes) (string, error) {
+	community, err := m.communitiesManager.GetByID(communityID)
+	if err != nil {
+		return "", err
+	}
+	if community == nil {
+		return "", communities.ErrOrgNotFound
+	}
+
+	return common.PubkeyToHex(community.PublicKey()), nil
+}
+
+type communityWebhookMessage struct {
+	ID        string `json:"id"`
+	ChatID    string `json:"chatId"`
+	From      string `json:"from"`
+	Text      string `json:"text"`
+	Clock     uint64 `json:"clock"`
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// dispatchMessageCreatedWebhookEvent notifies the community webhooks of a new message in one of the community chats
+func (m *Messenger) dispatchMessageCreatedWebhookEvent(community *communities.Community, chat *Chat, message *common.Message) {
+	m.communitiesManager.DispatchWebhookEvent(community, communities.WebhookEventMessageCreated, &communityWebhookMessage{
+		ID:        message.ID,
+		ChatID:    chat.ID,
+		From:      message.From,
+		Text:      message.Text,
+		Clock:     message.Clock,
+		Timestamp: message.WhisperTimestamp,
+	})
+}
+
+// CreateCommunityWebhook registers a webhook notified of the given community events
+func (m *Messenger) CreateCommunityWebhook(config *communities.WebhookConfig) (*communities.WebhookConfig, error) {
+	return m.communitiesManager.CreateWebhook(config)
+}
+
+func (m *Messenger) EditCommunityWebhook(config *communities.WebhookConfig) (*communities.WebhookConfig, error) {
+	return m.communitiesManager.EditWebhook(config)
+}
+
+func (m *Messenger) DeleteCommunityWebhook(id string) error {
+	return m.communitiesManager.DeleteWebhook(id)
+}
+
+func (m *Messenger) GetCommunityWebhooks(communityID types.HexBytes) ([]*communities.WebhookConfig, error) {
+	return m.communitiesManager.GetWebhooks(communityID)
+}
+
+// SetCommunityDNDSchedule sets the do not disturb schedule of the community, a nil schedule clears it.
+// The schedule is synced to paired devices
+func (m *Messenger) SetCommunityDNDSchedule(communityID types.HexBytes, schedule *communities.DNDSchedule) (*MessengerResponse, error) {
+	if schedule != nil {
+		if err := schedule.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	community, err := m.communitiesManager.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, communities.ErrOrgNotFound
+	}
+
+	communitySettings, err := m.communitiesManager.GetCommunitySettingsByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if communitySettings == nil {
+		communitySettings = &communities.CommunitySettings{
+			CommunityID:                  community.IDString(),
+			HistoryArchiveSupportEnabled: true,
+			DNDSchedule:                  schedule,
+		}
+		err = m.communitiesManager.SaveCommunitySettings(*communitySettings)
+	} else {
+		communitySettings.DNDSchedule = schedule
+		err = m.communitiesManager.UpdateCommunitySettings(*communitySettings)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.SyncCommunitySettings(context.Background(), communitySettings)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunitySettings(communitySettings)
+	return response, nil
+}
+
+// GetCommunityDNDSchedule returns the do not disturb schedule of the community, or nil if none is set
+func (m *Messenger) GetCommunityDNDSchedule(communityID types.HexBytes) (*communities.DNDSchedule, error) {
+	communitySettings, err := m.communitiesManager.GetCommunitySettingsByID(communityID)
+	if err != nil || communitySettings == nil {
+		return nil, err
+	}
+	return communitySettings.DNDSchedule, nil
+}
+
+// inCommunityDNDSchedule returns whether notifications for the chat are suppressed at the given time
+// by the do not disturb schedule of its community
+func (m *Messenger) inCommunityDNDSchedule(chat *Chat, now time.Time) bool {
+	if chat == nil || !chat.CommunityChat() {
+		return false
+	}
+
+	communityID, err := types.DecodeHex(chat.CommunityID)
+	if err != nil {
+		return false
+	}
+
+	communitySettings, err := m.communitiesManager.GetCommunitySettingsByID(communityID)
+	if err != nil {
+		m.logger.Warn("failed to fetch community settings", zap.Error(err))
+		return false
+	}
+	if communitySettings == nil {
+		return false
+	}
+
+	return communitySettings.DNDSchedule.Active(now)
+}
+
+// GetMemberActivityReport returns the members that have been active in the community since the given time
+func (m *Messenger) GetMemberActivityReport(communityID string, since time.Time) ([]communities.MemberActivity, error) {
+	return m.communitiesManager.GetMemberActivityReport(communityID, since)
+}
+
 func (m *Messenger) ShareCommunity(request *requests.ShareCommunity) (*MessengerResponse, error) {
 	if err := request.Validate(); err != nil {
 		return nil, err
@@ -1359,6 +1593,12 @@ func (m *Messenger) MyPendingRequestsToJoin() ([]*communities.RequestToJoin, err
 	return m.communitiesManager.PendingRequestsToJoinForUser(&m.identity.PublicKey)
 }
 
+// MyRequestToJoin returns our request to join the community, nil if we
+// haven't requested to join it
+func (m *Messenger) MyRequestToJoin(communityID types.HexBytes) (*communities.RequestToJoin, error) {
+	return m.communitiesManager.GetRequestToJoinByPkAndCommunityID(&m.identity.PublicKey, communityID)
+}
+
 func (m *Messenger) PendingRequestsToJoinForCommunity(id types.HexBytes) ([]*communities.RequestToJoin, error) {
 	return m.communitiesManager.PendingRequestsToJoinForCommunity(id)
 }
@@ -1732,11 +1972,31 @@ func (m *Messenger) handleCommunityDescription(state *ReceivedMessageState, sign
 		return err
 	}
 
+	return m.handleCommunityResponse(state, communityResponse)
+}
+
+// handleCommunityDescriptionDiff handles a diff of a community description
+func (m *Messenger) handleCommunityDescriptionDiff(state *ReceivedMessageState, signer *ecdsa.PublicKey, diff protobuf.CommunityDescriptionDiff) error {
+	communityResponse, err := m.communitiesManager.HandleCommunityDescriptionDiff(signer, &diff)
+	if err != nil {
+		return err
+	}
+
+	return m.handleCommunityResponse(state, communityResponse)
+}
+
+// handleCommunityResponse updates chats and filters after a community
+// description has been applied
+func (m *Messenger) handleCommunityResponse(state *ReceivedMessageState, communityResponse *communities.CommunityResponse) error {
 	community := communityResponse.Community
 
 	state.Response.AddCommunity(community)
 	state.Response.CommunityChanges = append(state.Response.CommunityChanges, communityResponse.Changes)
 
+	if communityResponse.Diff != nil && !communityResponse.Diff.Empty() && m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.CommunityDescriptionDiff(community.IDString(), *communityResponse.Diff)
+	}
+
 	// If we haven't joined the org, nothing to do
 	if !community.Joined() {
 		return nil
@@ -1869,7 +2129,8 @@ func (m *Messenger) handleSyncCommunity(messageState *ReceivedMessageState, sync
 	}
 
 	err = m.handleCommunityDescription(messageState, orgPubKey, cd, syncCommunity.Description)
-	if err != nil {
+	// The synced description being older than ours is fine, we still sync the rest
+	if err != nil && err != communities.ErrCommunityEventReplayed {
 		logger.Debug("m.handleCommunityDescription error", zap.Error(err))
 		return err
 	}
@@ -2288,6 +2549,7 @@ func (m *Messenger) SyncCommunitySettings(ctx context.Context, settings *communi
 		CommunityId:                  settings.CommunityID,
 		HistoryArchiveSupportEnabled: settings.HistoryArchiveSupportEnabled,
 	}
+	settings.DNDSchedule.SetSyncProtobufFields(syncMessage)
 	encodedMessage, err := proto.Marshal(syncMessage)
 	if err != nil {
 		return err
@@ -3,6 +3,7 @@ package protocol
 import (
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/status-im/status-go/rpc"
 	"github.com/status-im/status-go/server"
@@ -32,6 +33,7 @@ type MessageDeliveredHandler func(string, string)
 type MessengerSignalsHandler interface {
 	MessageDelivered(chatID string, messageID string)
 	CommunityInfoFound(community *communities.Community)
+	CommunityDescriptionDiff(communityID string, diff communities.CommunityDiff)
 	MessengerResponse(response *MessengerResponse)
 	HistoryRequestStarted(numBatches int)
 	HistoryRequestCompleted()
@@ -49,6 +51,7 @@ type MessengerSignalsHandler interface {
 	DownloadingHistoryArchivesFinished(communityID string)
 	ImportingHistoryArchiveMessages(communityID string)
 	StatusUpdatesTimedOut(statusUpdates *[]UserStatus)
+	TypingIndicator(chatID string, publicKey string, typing bool, ttl time.Duration)
 	DiscordCategoriesAndChannelsExtracted(categories []*discord.Category, channels []*discord.Channel, oldestMessageTimestamp int64, errors map[string]*discord.ImportError)
 	DiscordCommunityImportProgress(importProgress *discord.ImportProgress)
 	DiscordCommunityImportFinished(communityID string)
@@ -57,6 +60,8 @@ type MessengerSignalsHandler interface {
 	SendWakuBackedUpProfile(response *wakusync.WakuBackedUpDataResponse)
 	SendWakuBackedUpSettings(response *wakusync.WakuBackedUpDataResponse)
 	SendWakuBackedUpKeycards(response *wakusync.WakuBackedUpDataResponse)
+	SendBackupRestorationProgress(response *wakusync.WakuBackedUpDataResponse)
+	ContactSyncProgress(progress *ContactSyncProgress)
 }
 
 type config struct {
@@ -85,6 +90,8 @@ type config struct {
 	torrentConfig       *params.TorrentConfig
 	httpServer          *server.MediaServer
 	rpcClient           *rpc.Client
+	// keystoreDir is the directory holding the keystores of the accounts, one sub-directory per key UID
+	keystoreDir string
 
 	verifyTransactionClient  EthClient
 	verifyENSURL             string
@@ -309,6 +316,13 @@ func WithRPCClient(r *rpc.Client) Option {
 	}
 }
 
+func WithKeystoreDir(dir string) Option {
+	return func(c *config) error {
+		c.keystoreDir = dir
+		return nil
+	}
+}
+
 func WithMessageCSV(enabled bool) Option {
 	return func(c *config) error {
 		c.outputMessagesCSV = enabled
@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/settings"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/requests"
@@ -605,6 +606,39 @@ func (m *Messenger) BlockedContacts() []*Contact {
 	return contacts
 }
 
+// GetContactsWhoCanSeeMe returns the contacts our profile pictures are shown to,
+// according to the ProfilePicturesShowTo setting
+func (m *Messenger) GetContactsWhoCanSeeMe() ([]*Contact, error) {
+	showTo, err := m.settings.GetProfilePicturesShowTo()
+	if err != nil {
+		return nil, err
+	}
+
+	switch settings.ProfilePicturesShowToType(showTo) {
+	case settings.ProfilePicturesShowToNone:
+		return []*Contact{}, nil
+	case settings.ProfilePicturesShowToContactsOnly:
+		// Images are only encrypted for contacts we have added
+		contacts := []*Contact{}
+		m.allContacts.Range(func(contactID string, contact *Contact) (shouldContinue bool) {
+			if contact.added() {
+				contacts = append(contacts, contact)
+			}
+			return true
+		})
+		return contacts, nil
+	default:
+		contacts := []*Contact{}
+		m.allContacts.Range(func(contactID string, contact *Contact) (shouldContinue bool) {
+			if contactID != m.myHexIdentity() {
+				contacts = append(contacts, contact)
+			}
+			return true
+		})
+		return contacts, nil
+	}
+}
+
 // GetContactByID assumes pubKey includes 0x prefix
 func (m *Messenger) GetContactByID(pubKey string) *Contact {
 	contact, _ := m.allContacts.Load(pubKey)
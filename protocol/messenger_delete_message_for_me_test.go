@@ -194,3 +194,47 @@ func (s *MessengerDeleteMessageForMeSuite) TestDeleteMessageForMe() {
 	s.Require().NoError(err)
 	s.Require().False(otherMessage.DeletedForMe)
 }
+
+func (s *MessengerDeleteMessageForMeSuite) TestDeleteMessageForMeLocally() {
+	chat := CreatePublicChat("test-chat", s.alice1.transport)
+	s.Require().NoError(s.alice1.SaveChat(chat))
+
+	var messageIDs []string
+	for i := 0; i < 2; i++ {
+		response, err := s.alice1.SendChatMessage(context.Background(), buildTestMessage(*chat))
+		s.Require().NoError(err)
+		s.Require().Len(response.Messages(), 1)
+		messageIDs = append(messageIDs, response.Messages()[0].ID)
+	}
+	hiddenID := messageIDs[1]
+
+	s.Require().NoError(s.alice1.DeleteMessageForMe(context.Background(), chat.ID, hiddenID))
+
+	messages, _, err := s.alice1.MessageByChatID(chat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 1)
+	s.Require().Equal(messageIDs[0], messages[0].ID)
+
+	hiddenMessages, err := s.alice1.GetMyHiddenMessages(context.Background(), chat.ID)
+	s.Require().NoError(err)
+	s.Require().Len(hiddenMessages, 1)
+	s.Require().Equal(hiddenID, hiddenMessages[0].ID)
+
+	// The last message of the chat is the latest one still visible
+	savedChat, ok := s.alice1.allChats.Load(chat.ID)
+	s.Require().True(ok)
+	s.Require().NotNil(savedChat.LastMessage)
+	s.Require().Equal(messageIDs[0], savedChat.LastMessage.ID)
+
+	// Saving the message again keeps it hidden
+	hiddenMessage, err := s.alice1.MessageByID(hiddenID)
+	s.Require().NoError(err)
+	s.Require().NoError(s.alice1.persistence.SaveMessages([]*common.Message{hiddenMessage}))
+
+	hiddenMessages, err = s.alice1.GetMyHiddenMessages(context.Background(), chat.ID)
+	s.Require().NoError(err)
+	s.Require().Len(hiddenMessages, 1)
+
+	err = s.alice1.DeleteMessageForMe(context.Background(), "unknown", hiddenID)
+	s.Require().Equal(ErrChatNotFound, err)
+}
@@ -130,6 +130,41 @@ func (s *MessengerEditMessageSuite) TestEditMessage() {
 	s.Require().Equal(ErrInvalidEditOrDeleteAuthor, err)
 }
 
+func (s *MessengerEditMessageSuite) TestEditMessageHistory() {
+	chat := CreatePublicChat("test-chat", s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	sendResponse, err := s.m.SendChatMessage(context.Background(), buildTestMessage(*chat))
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.Messages(), 1)
+
+	ogMessage := sendResponse.Messages()[0]
+	ogText := ogMessage.Text
+
+	messageID, err := types.DecodeHex(ogMessage.ID)
+	s.Require().NoError(err)
+
+	editedTexts := []string{"first edit", "second edit", "third edit"}
+	for _, text := range editedTexts {
+		_, err = s.m.EditMessage(context.Background(), &requests.EditMessage{ID: messageID, Text: text})
+		s.Require().NoError(err)
+	}
+
+	history, err := s.m.GetMessageEditHistory(context.Background(), ogMessage.ID)
+	s.Require().NoError(err)
+	s.Require().Len(history, 3)
+	s.Require().Equal(ogText, history[0].Content)
+	s.Require().Equal(ogMessage.Clock, history[0].Clock)
+	s.Require().Equal(editedTexts[0], history[1].Content)
+	s.Require().Equal(editedTexts[1], history[2].Content)
+	s.Require().Equal(protobuf.ChatMessage_TEXT_PLAIN, history[2].ContentType)
+
+	message, err := s.m.MessageByID(ogMessage.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(editedTexts[2], message.Text)
+	s.Require().Greater(message.EditedAt, history[2].Clock)
+}
+
 func (s *MessengerEditMessageSuite) TestEditMessageEdgeCases() {
 	theirMessenger := s.newMessenger()
 	_, err := theirMessenger.Start()
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/pkg/errors"
@@ -747,6 +748,11 @@ func (m *Messenger) HandlePinMessage(state *ReceivedMessageState, message protob
 		return nil
 	}
 
+	err = m.checkPinnedMessageLimit(chat, pinMessage)
+	if err != nil {
+		return err
+	}
+
 	// Set the LocalChatID for the message
 	pinMessage.LocalChatID = chat.ID
 
@@ -1695,6 +1701,10 @@ func handleContactRequestChatMessage(receivedMessage *common.Message, contact *C
 }
 
 func (m *Messenger) HandleChatMessage(state *ReceivedMessageState) error {
+	if state.CurrentMessageState.Message.ContentType == protobuf.ChatMessage_TYPING {
+		return m.handleTypingIndicator(state)
+	}
+
 	logger := m.logger.With(zap.String("site", "handleChatMessage"))
 	if err := ValidateReceivedChatMessage(&state.CurrentMessageState.Message, state.CurrentMessageState.WhisperTimestamp); err != nil {
 		logger.Warn("failed to validate message", zap.Error(err))
@@ -1717,6 +1727,14 @@ func (m *Messenger) HandleChatMessage(state *ReceivedMessageState) error {
 		receivedMessage.Seen = true
 	}
 
+	if receivedMessage.ContentType == protobuf.ChatMessage_CONTACT_REQUEST && !isSyncMessage {
+		now := time.UnixMilli(int64(m.getTimesource().GetCurrentTime()))
+		if !m.contactRequestRateLimiter.allow(receivedMessage.From, now) {
+			logger.Debug("dropping rate limited contact request", zap.String("from", receivedMessage.From))
+			return nil
+		}
+	}
+
 	err := receivedMessage.PrepareContent(m.myHexIdentity())
 	if err != nil {
 		return fmt.Errorf("failed to prepare message content: %v", err)
@@ -1776,6 +1794,25 @@ func (m *Messenger) HandleChatMessage(state *ReceivedMessageState) error {
 	// Set the LocalChatID for the message
 	receivedMessage.LocalChatID = chat.ID
 
+	// The author is done typing
+	m.typingIndicators.stop(chat.ID, receivedMessage.From)
+
+	var chatCommunity *communities.Community
+	// Member activity is only reported to admins, other members don't need to track it
+	if chat.CommunityChat() {
+		community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+		if err != nil {
+			logger.Warn("failed to get community", zap.Error(err))
+		}
+		chatCommunity = community
+		if community != nil && community.IsAdmin() {
+			err = m.communitiesManager.UpdateMemberActivity(chat.CommunityID, receivedMessage.From, time.UnixMilli(int64(receivedMessage.WhisperTimestamp)))
+			if err != nil {
+				logger.Warn("failed to update member activity", zap.Error(err))
+			}
+		}
+	}
+
 	if err := m.updateChatFirstMessageTimestamp(chat, whisperToUnixTimestamp(receivedMessage.WhisperTimestamp), state.Response); err != nil {
 		return err
 	}
@@ -1926,6 +1963,10 @@ func (m *Messenger) HandleChatMessage(state *ReceivedMessageState) error {
 		state.Response.CommunityChanges = append(state.Response.CommunityChanges, communityResponse.Changes)
 	}
 
+	if !receivedMessage.Deleted && !receivedMessage.DeletedForMe {
+		m.dispatchMessageCreatedWebhookEvent(chatCommunity, chat, receivedMessage)
+	}
+
 	receivedMessage.New = true
 	state.Response.AddMessage(receivedMessage)
 
@@ -1976,6 +2017,11 @@ func (m *Messenger) HandleRequestAddressForTransaction(messageState *ReceivedMes
 }
 
 func (m *Messenger) handleSyncSetting(messageState *ReceivedMessageState, message *protobuf.SyncSetting) error {
+	if message.GetType() == protobuf.SyncSetting_NOTIFICATION_LEVEL {
+		// Notification levels are per chat and not stored with the settings
+		return m.handleSyncNotificationLevel(message)
+	}
+
 	settingField, err := m.extractAndSaveSyncSetting(message)
 	if err != nil {
 		return err
@@ -43,7 +43,7 @@ func ValidateDisplayName(displayName *string) error {
 		return ErrInvalidDisplayNameEthSuffix
 	}
 
-	if alias.IsAlias(name) {
+	if alias.IsValidAlias(strings.Join(strings.Fields(name), " ")) {
 		return ErrInvalidDisplayNameNotAllowed
 	}
 
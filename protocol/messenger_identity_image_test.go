@@ -220,6 +220,51 @@ func (s *MessengerProfilePictureHandlerSuite) TestEncryptDecryptIdentityImagesWi
 	s.Require().True(ci.Images["large"].Encrypted)
 }
 
+func (s *MessengerProfilePictureHandlerSuite) TestGetContactsWhoCanSeeMe() {
+	newContact := func(added bool) *Contact {
+		key, err := crypto.GenerateKey()
+		s.Require().NoError(err)
+
+		contact, err := BuildContactFromPublicKey(&key.PublicKey)
+		s.Require().NoError(err)
+		if added {
+			contact.ContactRequestLocalState = ContactRequestStateSent
+		}
+
+		s.alice.allContacts.Store(contact.ID, contact)
+		return contact
+	}
+
+	added := newContact(true)
+	notAdded := newContact(false)
+
+	contactIDs := func(contacts []*Contact) []string {
+		var ids []string
+		for _, contact := range contacts {
+			ids = append(ids, contact.ID)
+		}
+		return ids
+	}
+
+	err := s.alice.settings.SaveSettingField(settings.ProfilePicturesShowTo, settings.ProfilePicturesShowToEveryone)
+	s.Require().NoError(err)
+	contacts, err := s.alice.GetContactsWhoCanSeeMe()
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]string{added.ID, notAdded.ID}, contactIDs(contacts))
+
+	err = s.alice.settings.SaveSettingField(settings.ProfilePicturesShowTo, settings.ProfilePicturesShowToContactsOnly)
+	s.Require().NoError(err)
+	contacts, err = s.alice.GetContactsWhoCanSeeMe()
+	s.Require().NoError(err)
+	s.Require().Equal([]string{added.ID}, contactIDs(contacts))
+
+	err = s.alice.settings.SaveSettingField(settings.ProfilePicturesShowTo, settings.ProfilePicturesShowToNone)
+	s.Require().NoError(err)
+	contacts, err = s.alice.GetContactsWhoCanSeeMe()
+	s.Require().NoError(err)
+	s.Require().Empty(contacts)
+}
+
 func (s *MessengerProfilePictureHandlerSuite) TestPictureInPrivateChatOneSided() {
 	s.setupTest()
 	err := s.bob.settings.SaveSettingField(settings.ProfilePicturesVisibility, settings.ProfilePicturesShowToEveryone)
@@ -0,0 +1,47 @@
+package protocol
+
+import (
+	"time"
+
+	"github.com/status-im/status-go/protocol/encryption/multidevice"
+)
+
+// GetActiveInstallations returns our installations seen within threshold,
+// along with the current installation.
+func (m *Messenger) GetActiveInstallations(threshold time.Duration) ([]*multidevice.Installation, error) {
+	since := int64(m.getTimesource().GetCurrentTime())*int64(time.Millisecond) - threshold.Nanoseconds()
+
+	var installations []*multidevice.Installation
+	m.allInstallations.Range(func(installationID string, installation *multidevice.Installation) (shouldContinue bool) {
+		if installationID == m.installationID || installation.Timestamp >= since {
+			installations = append(installations, installation)
+		}
+		return true
+	})
+	return installations, nil
+}
+
+// updateInstallationsTimestamp records that messages were just received from
+// the given installations of ours
+func (m *Messenger) updateInstallationsTimestamp(installationIDs map[string]bool) error {
+	// Installation timestamps are in nanoseconds, as the ones of the bundles
+	now := int64(m.getTimesource().GetCurrentTime()) * int64(time.Millisecond)
+
+	for installationID := range installationIDs {
+		installation, ok := m.allInstallations.Load(installationID)
+		if !ok {
+			continue
+		}
+
+		err := m.encryptor.UpdateInstallationTimestamp(&m.identity.PublicKey, installationID, now)
+		if err != nil {
+			return err
+		}
+
+		// Installations are shared with the callers, so they are replaced rather than modified
+		updated := *installation
+		updated.Timestamp = now
+		m.allInstallations.Store(installationID, &updated)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/status-im/status-go/services/browsers"
 
@@ -350,3 +351,55 @@ func (s *MessengerInstallationSuite) TestSyncInstallationNewMessages() {
 	s.Require().NoError(bob2.Shutdown())
 	s.Require().NoError(alice.Shutdown())
 }
+
+func (s *MessengerInstallationSuite) TestGetActiveInstallations() {
+	pairInstallation := func() *Messenger {
+		theirMessenger, err := newMessengerWithKey(s.shh, s.privateKey, s.logger, nil)
+		s.Require().NoError(err)
+
+		_, err = theirMessenger.SendPairInstallation(context.Background(), nil)
+		s.Require().NoError(err)
+
+		// Wait for the installation to be seen
+		err = tt.RetryWithBackOff(func() error {
+			_, err := s.m.RetrieveAll()
+			if err != nil {
+				return err
+			}
+			installation, ok := s.m.allInstallations.Load(theirMessenger.installationID)
+			if !ok || installation.Timestamp == 0 {
+				return errors.New("installation not seen")
+			}
+			return nil
+		})
+		s.Require().NoError(err)
+		return theirMessenger
+	}
+
+	staleMessenger := pairInstallation()
+	time.Sleep(50 * time.Millisecond)
+	cutoff := time.Now().Add(-20 * time.Millisecond)
+	freshMessenger := pairInstallation()
+
+	installations, err := s.m.GetActiveInstallations(time.Since(cutoff))
+	s.Require().NoError(err)
+
+	var ids []string
+	for _, installation := range installations {
+		ids = append(ids, installation.ID)
+	}
+	s.Require().ElementsMatch([]string{s.m.installationID, freshMessenger.installationID}, ids)
+
+	// The timestamps are persisted
+	stored, err := s.m.encryptor.GetOurInstallations(&s.m.identity.PublicKey)
+	s.Require().NoError(err)
+	timestamps := make(map[string]int64)
+	for _, installation := range stored {
+		timestamps[installation.ID] = installation.Timestamp
+	}
+	s.Require().Less(timestamps[staleMessenger.installationID], cutoff.UnixNano())
+	s.Require().GreaterOrEqual(timestamps[freshMessenger.installationID], cutoff.UnixNano())
+
+	s.Require().NoError(staleMessenger.Shutdown())
+	s.Require().NoError(freshMessenger.Shutdown())
+}
@@ -0,0 +1,251 @@
+package protocol
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+)
+
+const (
+	keystoreArchiveSaltLength = 16
+	keystoreArchiveScryptN    = 1 << 15
+	keystoreArchiveScryptR    = 8
+	keystoreArchiveScryptP    = 1
+	keystoreArchiveKeyLength  = 32
+	// keystoreArchiveMaxSize is the maximum size of the keystore files extracted from an archive
+	keystoreArchiveMaxSize = 10 * 1024 * 1024
+)
+
+var ErrKeystoreDirNotSet = errors.New("keystore directory not set")
+var ErrKeystoreNotFound = errors.New("keystore not found")
+var ErrKeystoreAlreadyExists = errors.New("keystore already exists")
+var ErrKeystoreArchivePasswordEmpty = errors.New("keystore archive password is empty")
+var ErrInvalidKeystoreArchive = errors.New("invalid keystore archive")
+var ErrInvalidKeyUID = errors.New("invalid key uid")
+
+// ExportKeystore writes the keystore files of the account with `keyUID` to
+// `destPath` as a tar.gz archive encrypted with AES-256-GCM, using a key
+// derived from `password` with scrypt.
+// The archive is laid out as `<salt><nonce><ciphertext>`.
+func (m *Messenger) ExportKeystore(ctx context.Context, keyUID string, password string, destPath string) error {
+	if password == "" {
+		return ErrKeystoreArchivePasswordEmpty
+	}
+
+	if m.config.keystoreDir == "" {
+		return ErrKeystoreDirNotSet
+	}
+
+	if err := validateKeyUID(keyUID); err != nil {
+		return err
+	}
+
+	keystorePath := filepath.Join(m.config.keystoreDir, keyUID)
+	entries, err := ioutil.ReadDir(keystorePath)
+	if os.IsNotExist(err) {
+		return ErrKeystoreNotFound
+	} else if err != nil {
+		return err
+	}
+
+	var archive bytes.Buffer
+	gzipWriter := gzip.NewWriter(&archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if !entry.Mode().IsRegular() {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(keystorePath, entry.Name()))
+		if err != nil {
+			return err
+		}
+
+		err = tarWriter.WriteHeader(&tar.Header{
+			Name:     filepath.ToSlash(filepath.Join(keyUID, entry.Name())),
+			Mode:     0600,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, err := tarWriter.Write(data); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return err
+	}
+
+	salt := make([]byte, keystoreArchiveSaltLength)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return err
+	}
+
+	key, err := keystoreArchiveKey(password, salt)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := common.Encrypt(archive.Bytes(), key, rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(destPath, append(salt, encrypted...), 0600)
+}
+
+// ImportKeystore decrypts an archive written by ExportKeystore and extracts
+// the keystore files it holds into the keystore directory. Keystores that
+// already exist are never overwritten.
+func (m *Messenger) ImportKeystore(ctx context.Context, archivePath string, password string) error {
+	if password == "" {
+		return ErrKeystoreArchivePasswordEmpty
+	}
+
+	if m.config.keystoreDir == "" {
+		return ErrKeystoreDirNotSet
+	}
+
+	encrypted, err := ioutil.ReadFile(archivePath)
+	if err != nil {
+		return err
+	}
+
+	if len(encrypted) < keystoreArchiveSaltLength {
+		return ErrInvalidKeystoreArchive
+	}
+
+	key, err := keystoreArchiveKey(password, encrypted[:keystoreArchiveSaltLength])
+	if err != nil {
+		return err
+	}
+
+	archive, err := common.Decrypt(encrypted[keystoreArchiveSaltLength:], key)
+	if err != nil {
+		return ErrInvalidKeystoreArchive
+	}
+
+	files, keyUID, err := readKeystoreArchive(ctx, archive)
+	if err != nil {
+		return err
+	}
+
+	keystorePath := filepath.Join(m.config.keystoreDir, keyUID)
+	_, err = os.Stat(keystorePath)
+	if err == nil {
+		return ErrKeystoreAlreadyExists
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	err = os.MkdirAll(keystorePath, 0700)
+	if err != nil {
+		return err
+	}
+
+	for name, data := range files {
+		err := ioutil.WriteFile(filepath.Join(keystorePath, name), data, 0600)
+		if err != nil {
+			// Don't leave a partial keystore behind
+			_ = os.RemoveAll(keystorePath)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readKeystoreArchive returns the keystore files held by a decrypted archive,
+// keyed by file name, and the key UID of the account they belong to
+func readKeystoreArchive(ctx context.Context, archive []byte) (map[string][]byte, string, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, "", ErrInvalidKeystoreArchive
+	}
+	defer gzipReader.Close()
+
+	files := make(map[string][]byte)
+	keyUID := ""
+	size := int64(0)
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, "", err
+		}
+
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, "", ErrInvalidKeystoreArchive
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			return nil, "", ErrInvalidKeystoreArchive
+		}
+
+		// Entries are expected to be `<keyUID>/<file name>`, anything else
+		// could write outside of the keystore directory
+		dir, name := filepath.Split(filepath.FromSlash(header.Name))
+		dir = filepath.Clean(dir)
+		if name == "" || validateKeyUID(dir) != nil || (keyUID != "" && dir != keyUID) {
+			return nil, "", ErrInvalidKeystoreArchive
+		}
+		keyUID = dir
+
+		size += header.Size
+		if size > keystoreArchiveMaxSize {
+			return nil, "", ErrInvalidKeystoreArchive
+		}
+
+		data, err := ioutil.ReadAll(io.LimitReader(tarReader, header.Size))
+		if err != nil {
+			return nil, "", ErrInvalidKeystoreArchive
+		}
+		files[name] = data
+	}
+
+	if keyUID == "" {
+		return nil, "", ErrInvalidKeystoreArchive
+	}
+
+	return files, keyUID, nil
+}
+
+// validateKeyUID checks that keyUID is a hex encoded hash, as it is used as
+// the name of the keystore directory of the account
+func validateKeyUID(keyUID string) error {
+	decoded, err := types.DecodeHex(keyUID)
+	if err != nil || len(decoded) != types.HashLength {
+		return ErrInvalidKeyUID
+	}
+	return nil
+}
+
+func keystoreArchiveKey(password string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(password), salt, keystoreArchiveScryptN, keystoreArchiveScryptR, keystoreArchiveScryptP, keystoreArchiveKeyLength)
+}
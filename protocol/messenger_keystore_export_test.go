@@ -0,0 +1,99 @@
+package protocol
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+)
+
+const keystoreExportKeyUID = "0x7d1c1b0e9f0b2e1d3c4a5b6c7d8e9f00112233445566778899aabbccddeeff00"
+
+func TestMessengerKeystoreExportSuite(t *testing.T) {
+	suite.Run(t, new(MessengerKeystoreExportSuite))
+}
+
+type MessengerKeystoreExportSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerKeystoreExportSuite) SetupTest() {
+	s.MessengerBaseTestSuite.SetupTest()
+	s.m.config.keystoreDir = s.T().TempDir()
+}
+
+// generateKey creates a key file in the keystore of keystoreExportKeyUID
+func (s *MessengerKeystoreExportSuite) generateKey() {
+	ks := keystore.NewKeyStore(filepath.Join(s.m.config.keystoreDir, keystoreExportKeyUID), keystore.LightScryptN, keystore.LightScryptP)
+	_, err := ks.NewAccount("key-password")
+	s.Require().NoError(err)
+}
+
+func (s *MessengerKeystoreExportSuite) readKeystore() map[string][]byte {
+	keystorePath := filepath.Join(s.m.config.keystoreDir, keystoreExportKeyUID)
+	entries, err := ioutil.ReadDir(keystorePath)
+	s.Require().NoError(err)
+
+	files := make(map[string][]byte)
+	for _, entry := range entries {
+		data, err := ioutil.ReadFile(filepath.Join(keystorePath, entry.Name()))
+		s.Require().NoError(err)
+		files[entry.Name()] = data
+	}
+	return files
+}
+
+func (s *MessengerKeystoreExportSuite) TestExportImportRoundTrip() {
+	s.generateKey()
+	keys := s.readKeystore()
+	s.Require().Len(keys, 1)
+
+	archivePath := filepath.Join(s.T().TempDir(), "keystore.bak")
+	s.Require().NoError(s.m.ExportKeystore(context.Background(), keystoreExportKeyUID, "archive-password", archivePath))
+
+	// The archive doesn't leak the key files
+	archive, err := ioutil.ReadFile(archivePath)
+	s.Require().NoError(err)
+	for _, key := range keys {
+		s.Require().NotContains(string(archive), string(key))
+	}
+
+	// The keystore can't be overwritten
+	err = s.m.ImportKeystore(context.Background(), archivePath, "archive-password")
+	s.Require().ErrorIs(err, ErrKeystoreAlreadyExists)
+
+	s.Require().NoError(os.RemoveAll(filepath.Join(s.m.config.keystoreDir, keystoreExportKeyUID)))
+
+	err = s.m.ImportKeystore(context.Background(), archivePath, "wrong-password")
+	s.Require().ErrorIs(err, ErrInvalidKeystoreArchive)
+
+	s.Require().NoError(s.m.ImportKeystore(context.Background(), archivePath, "archive-password"))
+	s.Require().Equal(keys, s.readKeystore())
+}
+
+func (s *MessengerKeystoreExportSuite) TestExportUnknownKeystore() {
+	archivePath := filepath.Join(s.T().TempDir(), "keystore.bak")
+	err := s.m.ExportKeystore(context.Background(), keystoreExportKeyUID, "archive-password", archivePath)
+	s.Require().ErrorIs(err, ErrKeystoreNotFound)
+}
+
+func (s *MessengerKeystoreExportSuite) TestExportEmptyPassword() {
+	s.generateKey()
+
+	archivePath := filepath.Join(s.T().TempDir(), "keystore.bak")
+	err := s.m.ExportKeystore(context.Background(), keystoreExportKeyUID, "", archivePath)
+	s.Require().ErrorIs(err, ErrKeystoreArchivePasswordEmpty)
+}
+
+func (s *MessengerKeystoreExportSuite) TestExportInvalidKeyUID() {
+	archivePath := filepath.Join(s.T().TempDir(), "keystore.bak")
+	for _, keyUID := range []string{"", "0xdeadbeef", "..", "../" + keystoreExportKeyUID, keystoreExportKeyUID[2:]} {
+		err := s.m.ExportKeystore(context.Background(), keyUID, "archive-password", archivePath)
+		s.Require().ErrorIs(err, ErrInvalidKeyUID, keyUID)
+	}
+}
@@ -0,0 +1,167 @@
+package protocol
+
+import (
+	"context"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+)
+
+// linkPreviewTTL is how long an unfurled link is cached for
+const linkPreviewTTL = 24 * time.Hour
+
+// linkPreviewsPurgeInterval is how often the stale link previews are removed
+const linkPreviewsPurgeInterval = time.Hour
+
+// linkPreviewMaxAgeDays is the age in days after which the previews attached
+// to messages are fetched again, it matches linkPreviewTTL
+const linkPreviewMaxAgeDays = 1
+
+type LinkPreview = common.LinkPreview
+
+func linkPreviewURLHash(url string) string {
+	return types.EncodeHex(crypto.Keccak256([]byte(url)))
+}
+
+// linkPreviewSiteEnabled returns whether the user enabled link previews for
+// the site the url points to
+func (m *Messenger) linkPreviewSiteEnabled(url string) (bool, error) {
+	u, err := neturl.Parse(url)
+	if err != nil {
+		return false, err
+	}
+
+	sites, err := m.settings.LinkPreviewsEnabledSites()
+	if err != nil {
+		return false, err
+	}
+
+	hostname := strings.ToLower(u.Hostname())
+	for _, site := range sites {
+		if strings.ToLower(site) == hostname {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// UnfurlLinkPreview returns the preview of a link. As unfurling a link exposes
+// the user's IP to the site, nil is returned unless the user enabled link
+// previews for the site. Previews are cached for linkPreviewTTL
+func (m *Messenger) UnfurlLinkPreview(ctx context.Context, url string) (*LinkPreview, error) {
+	enabled, err := m.settings.LinkPreviewRequestEnabled()
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	enabled, err = m.linkPreviewSiteEnabled(url)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	urlHash := linkPreviewURLHash(url)
+	now := time.Now()
+
+	preview, err := m.persistence.LinkPreview(urlHash, now)
+	if err != nil {
+		return nil, err
+	}
+	if preview != nil {
+		return preview, nil
+	}
+
+	data, err := m.fetchLinkPreview(url)
+	if err != nil {
+		return nil, err
+	}
+
+	preview = &LinkPreview{URL: url, LinkPreviewData: data, FetchedAt: now.Unix()}
+	err = m.persistence.SaveLinkPreview(urlHash, preview, now, linkPreviewTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+// prepareLinkPreviews attaches the cached previews of its links to msg, and
+// fetches again in the background the ones which expired
+func (m *Messenger) prepareLinkPreviews(msg *common.Message) {
+	if len(msg.Links) == 0 {
+		return
+	}
+
+	msg.LinkPreviews = nil
+	for _, link := range msg.Links {
+		preview, err := m.persistence.CachedLinkPreview(linkPreviewURLHash(link))
+		if err != nil {
+			m.logger.Error("failed to load link preview", zap.Error(err))
+			return
+		}
+		if preview != nil {
+			msg.LinkPreviews = append(msg.LinkPreviews, preview)
+		}
+	}
+
+	if msg.HasExpiredLinks(linkPreviewMaxAgeDays) {
+		m.refreshLinkPreviews(msg.LinkPreviews)
+	}
+}
+
+// refreshLinkPreviews fetches again the expired previews which are not
+// already being fetched
+func (m *Messenger) refreshLinkPreviews(previews []*LinkPreview) {
+	expiredBefore := time.Now().AddDate(0, 0, -linkPreviewMaxAgeDays).Unix()
+	for _, preview := range previews {
+		if preview.FetchedAt >= expiredBefore {
+			continue
+		}
+		if _, refreshing := m.refreshingLinkPreviews.LoadOrStore(preview.URL, struct{}{}); refreshing {
+			continue
+		}
+
+		go func(url string) {
+			defer m.refreshingLinkPreviews.Delete(url)
+
+			_, err := m.UnfurlLinkPreview(context.Background(), url)
+			if err != nil {
+				m.logger.Warn("failed to refresh link preview", zap.String("url", url), zap.Error(err))
+			}
+		}(preview.URL)
+	}
+}
+
+// PurgeStaleLinkPreviews removes the link previews fetched more than `age` ago
+func (m *Messenger) PurgeStaleLinkPreviews(age time.Duration) error {
+	return m.persistence.DeleteLinkPreviewsFetchedBefore(time.Now().Add(-age))
+}
+
+func (m *Messenger) startLinkPreviewsPurgeLoop() {
+	ticker := time.NewTicker(linkPreviewsPurgeInterval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				err := m.PurgeStaleLinkPreviews(linkPreviewTTL)
+				if err != nil {
+					m.logger.Error("failed to purge stale link previews", zap.Error(err))
+				}
+			case <-m.quit:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
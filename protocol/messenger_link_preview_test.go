@@ -0,0 +1,126 @@
+package protocol
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/urls"
+)
+
+const testLinkPreviewURL = "https://github.com/status-im/status-go"
+
+func TestMessengerLinkPreviewSuite(t *testing.T) {
+	suite.Run(t, new(MessengerLinkPreviewSuite))
+}
+
+type MessengerLinkPreviewSuite struct {
+	MessengerBaseTestSuite
+	fetched int32
+}
+
+func (s *MessengerLinkPreviewSuite) SetupTest() {
+	s.MessengerBaseTestSuite.SetupTest()
+
+	s.fetched = 0
+	s.m.fetchLinkPreview = func(url string) (urls.LinkPreviewData, error) {
+		atomic.AddInt32(&s.fetched, 1)
+		return urls.LinkPreviewData{Site: "GitHub", Title: "status-go"}, nil
+	}
+
+	s.Require().NoError(s.m.settings.SaveSettingField(settings.LinkPreviewRequestEnabled, true))
+	s.Require().NoError(s.m.settings.SaveSettingField(settings.LinkPreviewsEnabledSites, []string{"github.com"}))
+}
+
+func (s *MessengerLinkPreviewSuite) TestDisabled() {
+	s.Require().NoError(s.m.settings.SaveSettingField(settings.LinkPreviewRequestEnabled, false))
+
+	preview, err := s.m.UnfurlLinkPreview(context.Background(), testLinkPreviewURL)
+	s.Require().NoError(err)
+	s.Require().Nil(preview)
+	s.Require().Zero(s.fetched)
+}
+
+func (s *MessengerLinkPreviewSuite) TestSiteNotEnabled() {
+	preview, err := s.m.UnfurlLinkPreview(context.Background(), "https://twitter.com/ethstatus")
+	s.Require().NoError(err)
+	s.Require().Nil(preview)
+	s.Require().Zero(s.fetched)
+}
+
+func (s *MessengerLinkPreviewSuite) TestCache() {
+	preview, err := s.m.UnfurlLinkPreview(context.Background(), testLinkPreviewURL)
+	s.Require().NoError(err)
+	s.Require().NotNil(preview)
+	s.Require().Equal(testLinkPreviewURL, preview.URL)
+	s.Require().Equal("status-go", preview.Title)
+	s.Require().Equal(int32(1), atomic.LoadInt32(&s.fetched))
+
+	// Served from the cache
+	cached, err := s.m.UnfurlLinkPreview(context.Background(), testLinkPreviewURL)
+	s.Require().NoError(err)
+	s.Require().Equal(preview, cached)
+	s.Require().Equal(int32(1), atomic.LoadInt32(&s.fetched))
+}
+
+func (s *MessengerLinkPreviewSuite) TestPurgeStaleLinkPreviews() {
+	urlHash := linkPreviewURLHash(testLinkPreviewURL)
+	preview := &LinkPreview{URL: testLinkPreviewURL}
+	fetchedAt := time.Now().Add(-2 * time.Hour)
+	s.Require().NoError(s.m.persistence.SaveLinkPreview(urlHash, preview, fetchedAt, linkPreviewTTL))
+
+	// Not stale yet
+	s.Require().NoError(s.m.PurgeStaleLinkPreviews(3 * time.Hour))
+	cached, err := s.m.persistence.LinkPreview(urlHash, time.Now())
+	s.Require().NoError(err)
+	s.Require().NotNil(cached)
+
+	s.Require().NoError(s.m.PurgeStaleLinkPreviews(time.Hour))
+	cached, err = s.m.persistence.LinkPreview(urlHash, time.Now())
+	s.Require().NoError(err)
+	s.Require().Nil(cached)
+
+	// Expired entries are not served either
+	s.Require().NoError(s.m.persistence.SaveLinkPreview(urlHash, preview, fetchedAt, time.Hour))
+	cached, err = s.m.persistence.LinkPreview(urlHash, time.Now())
+	s.Require().NoError(err)
+	s.Require().Nil(cached)
+}
+
+func (s *MessengerLinkPreviewSuite) TestPrepareLinkPreviews() {
+	const staleURL = "https://github.com/status-im/status-desktop"
+
+	preview, err := s.m.UnfurlLinkPreview(context.Background(), testLinkPreviewURL)
+	s.Require().NoError(err)
+
+	stale := &LinkPreview{URL: staleURL, FetchedAt: time.Now().AddDate(0, 0, -2).Unix()}
+	s.Require().NoError(s.m.persistence.SaveLinkPreview(linkPreviewURLHash(staleURL), stale, time.Unix(stale.FetchedAt, 0), linkPreviewTTL))
+
+	// Fresh previews are attached as is
+	message := &common.Message{}
+	message.Links = []string{testLinkPreviewURL, "https://github.com/status-im/status-mobile"}
+	s.m.prepareLinkPreviews(message)
+	s.Require().Equal([]*LinkPreview{preview}, message.LinkPreviews)
+	s.Require().False(message.HasExpiredLinks(linkPreviewMaxAgeDays))
+	s.Require().Equal(int32(1), atomic.LoadInt32(&s.fetched))
+
+	// Expired previews are attached and fetched again
+	message.Links = []string{testLinkPreviewURL, staleURL}
+	s.m.prepareLinkPreviews(message)
+	s.Require().Len(message.LinkPreviews, 2)
+	s.Require().True(message.HasExpiredLinks(linkPreviewMaxAgeDays))
+
+	s.Require().Eventually(func() bool {
+		refreshed, err := s.m.persistence.LinkPreview(linkPreviewURLHash(staleURL), time.Now())
+		return err == nil && refreshed != nil && refreshed.Title == "status-go"
+	}, 5*time.Second, 10*time.Millisecond)
+	s.Require().Equal(int32(2), atomic.LoadInt32(&s.fetched))
+
+	s.m.prepareLinkPreviews(message)
+	s.Require().False(message.HasExpiredLinks(linkPreviewMaxAgeDays))
+}
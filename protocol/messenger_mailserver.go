@@ -265,6 +265,38 @@ func (m *Messenger) defaultSyncPeriodFromNow() (uint32, error) {
 	return uint32(m.getTimesource().GetCurrentTime()/1000) - defaultSyncPeriod, nil
 }
 
+// syncCoverage returns the fraction of the sync period not covered by gaps,
+// clamped to [0, 1]
+func syncCoverage(gapsDuration uint64, syncPeriod uint32) float64 {
+	if syncPeriod == 0 {
+		return 1.0
+	}
+	coverage := 1.0 - float64(gapsDuration)/float64(syncPeriod)
+	if coverage < 0 {
+		return 0
+	}
+	if coverage > 1 {
+		return 1
+	}
+	return coverage
+}
+
+// ChatSyncCoverage returns the fraction of the default sync period of the chat
+// history which has been fetched from the mailservers
+func (m *Messenger) ChatSyncCoverage(chatID string) (float64, error) {
+	defaultSyncPeriod, err := m.settings.GetDefaultSyncPeriod()
+	if err != nil {
+		return 0, err
+	}
+
+	gapsDuration, err := m.persistence.GapsDurationByChatID(chatID)
+	if err != nil {
+		return 0, err
+	}
+
+	return syncCoverage(gapsDuration, defaultSyncPeriod), nil
+}
+
 // capToDefaultSyncPeriod caps the sync period to the default
 func (m *Messenger) capToDefaultSyncPeriod(period uint32) (uint32, error) {
 	d, err := m.defaultSyncPeriodFromNow()
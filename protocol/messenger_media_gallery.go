@@ -0,0 +1,67 @@
+package protocol
+
+import (
+	"context"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+type MediaType int
+
+const (
+	MediaTypeImage MediaType = iota + 1
+	MediaTypeAudio
+	MediaTypeVideo
+)
+
+// contentType returns the content type of the messages carrying media of this type
+func (t MediaType) contentType() (protobuf.ChatMessage_ContentType, error) {
+	switch t {
+	case MediaTypeImage:
+		return protobuf.ChatMessage_IMAGE, nil
+	case MediaTypeAudio:
+		return protobuf.ChatMessage_AUDIO, nil
+	default:
+		// There is no video content type yet
+		return protobuf.ChatMessage_UNKNOWN_CONTENT_TYPE, ErrMediaTypeNotSupported
+	}
+}
+
+type MediaAttachment struct {
+	MessageID string `json:"messageId"`
+	// URL is the local url of the media, only set when the media server is running
+	URL             string `json:"url,omitempty"`
+	Timestamp       uint64 `json:"timestamp"`
+	AuthorPublicKey string `json:"authorPublicKey"`
+}
+
+// GetChatMediaGallery returns the media attachments of the given type in a chat,
+// latest first, along with the cursor of the next page
+func (m *Messenger) GetChatMediaGallery(ctx context.Context, chatID string, mediaType MediaType, cursor string, limit int) ([]*MediaAttachment, string, error) {
+	contentType, err := mediaType.contentType()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, ok := m.allChats.Load(chatID); !ok {
+		return nil, "", ErrChatNotFound
+	}
+
+	attachments, nextCursor, err := m.persistence.MediaAttachmentsByChatID(chatID, contentType, cursor, limit)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if m.httpServer != nil {
+		for _, attachment := range attachments {
+			switch contentType {
+			case protobuf.ChatMessage_IMAGE:
+				attachment.URL = m.httpServer.MakeImageURL(attachment.MessageID)
+			case protobuf.ChatMessage_AUDIO:
+				attachment.URL = m.httpServer.MakeAudioURL(attachment.MessageID)
+			}
+		}
+	}
+
+	return attachments, nextCursor, nil
+}
@@ -0,0 +1,165 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// MessageReport is a community message flagged by a member for review by the
+// community admins
+type MessageReport struct {
+	CommunityID       string `json:"communityId"`
+	ChatID            string `json:"chatId"`
+	MessageID         string `json:"messageId"`
+	ReporterPublicKey string `json:"reporterPublicKey"`
+	Reason            string `json:"reason"`
+	// CreatedAt is the clock of the report, in ms
+	CreatedAt uint64 `json:"createdAt"`
+}
+
+// ReportMessage flags a community message for review by the community admins
+func (m *Messenger) ReportMessage(ctx context.Context, chatID string, messageID string, reason string) error {
+	if reason == "" {
+		return ErrEmptyReportReason
+	}
+
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return ErrChatNotFound
+	}
+
+	if !chat.CommunityChat() {
+		return ErrChatTypeNotSupported
+	}
+
+	message, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return err
+	}
+
+	if message.LocalChatID != chatID {
+		return common.ErrRecordNotFound
+	}
+
+	communityID, err := types.DecodeHex(chat.CommunityID)
+	if err != nil {
+		return err
+	}
+
+	community, err := m.communitiesManager.GetByID(communityID)
+	if err != nil {
+		return err
+	}
+	if community == nil {
+		return communities.ErrOrgNotFound
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+
+	report := &MessageReport{
+		CommunityID:       community.IDString(),
+		ChatID:            chatID,
+		MessageID:         messageID,
+		ReporterPublicKey: common.PubkeyToHex(&m.identity.PublicKey),
+		Reason:            reason,
+		CreatedAt:         clock,
+	}
+
+	err = m.persistence.SaveMessageReport(report)
+	if err != nil {
+		return err
+	}
+
+	payload, err := proto.Marshal(&protobuf.CommunityMessageReport{
+		Clock:       clock,
+		CommunityId: community.ID(),
+		ChatId:      chatID,
+		MessageId:   messageID,
+		Reason:      reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	moderators, err := community.CanModerateContentPublicKeys()
+	if err != nil {
+		return err
+	}
+
+	// Reports are only sent to the moderators, encrypted, so that other
+	// members don't learn who reported what
+	sent := false
+	for _, moderator := range moderators {
+		if common.IsPubKeyEqual(moderator, &m.identity.PublicKey) {
+			continue
+		}
+
+		rawMessage := common.RawMessage{
+			LocalChatID:         common.PubkeyToHex(moderator),
+			Payload:             payload,
+			MessageType:         protobuf.ApplicationMetadataMessage_REPORT,
+			ResendAutomatically: true,
+		}
+		_, err = m.sender.SendPrivate(ctx, moderator, &rawMessage)
+		if err != nil {
+			return err
+		}
+		sent = true
+	}
+
+	if !sent {
+		return ErrNoCommunityModerator
+	}
+
+	return nil
+}
+
+// GetMessageReports returns the reports of messages of the community, latest first
+func (m *Messenger) GetMessageReports(ctx context.Context, communityID string) ([]*MessageReport, error) {
+	return m.persistence.MessageReportsByCommunityID(communityID)
+}
+
+// HandleCommunityMessageReport stores the reports sent by members of the
+// communities we moderate
+func (m *Messenger) HandleCommunityMessageReport(state *ReceivedMessageState, signer *ecdsa.PublicKey, message protobuf.CommunityMessageReport) error {
+	if message.Reason == "" {
+		return ErrEmptyReportReason
+	}
+
+	community, err := m.communitiesManager.GetByID(message.CommunityId)
+	if err != nil {
+		return err
+	}
+	if community == nil {
+		return communities.ErrOrgNotFound
+	}
+
+	if !community.CanDeleteMessageForEveryone(&m.identity.PublicKey) {
+		return nil
+	}
+
+	if !community.HasMember(signer) {
+		return ErrReporterNotAMember
+	}
+
+	if !strings.HasPrefix(message.ChatId, community.IDString()) {
+		return ErrChatNotFound
+	}
+
+	return m.persistence.SaveMessageReport(&MessageReport{
+		CommunityID:       community.IDString(),
+		ChatID:            message.ChatId,
+		MessageID:         message.MessageId,
+		ReporterPublicKey: common.PubkeyToHex(signer),
+		Reason:            message.Reason,
+		CreatedAt:         message.Clock,
+	})
+}
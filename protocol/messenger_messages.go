@@ -272,10 +272,75 @@ func (m *Messenger) DeleteMessageForMeAndSync(ctx context.Context, chatID string
 	return response, nil
 }
 
+// DeleteMessageForMe hides the message on this device only, unlike
+// DeleteMessageForMeAndSync nothing is sent to the chat nor to our paired devices
+func (m *Messenger) DeleteMessageForMe(ctx context.Context, chatID string, messageID string) error {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return ErrChatNotFound
+	}
+
+	message, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return err
+	}
+
+	if message.LocalChatID != chatID {
+		return common.ErrRecordNotFound
+	}
+
+	err = m.persistence.HideMessageLocally(messageID)
+	if err != nil {
+		return err
+	}
+
+	if chat.LastMessage != nil && chat.LastMessage.ID == messageID {
+		return m.updateLastMessage(chat)
+	}
+
+	return nil
+}
+
+// GetMyHiddenMessages returns the messages of the chat hidden with
+// DeleteMessageForMe, latest first
+func (m *Messenger) GetMyHiddenMessages(ctx context.Context, chatID string) ([]*common.Message, error) {
+	if _, ok := m.allChats.Load(chatID); !ok {
+		return nil, ErrChatNotFound
+	}
+
+	messages, err := m.persistence.HiddenLocallyMessagesByChatID(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.httpServer != nil {
+		for _, message := range messages {
+			m.prepareMessage(message, m.httpServer)
+		}
+	}
+
+	return messages, nil
+}
+
 func (m *Messenger) applyEditMessage(editMessage *protobuf.EditMessage, message *common.Message) error {
 	if err := ValidateText(editMessage.Text); err != nil {
 		return err
 	}
+
+	// Keep the content being replaced in the edit history
+	previousClock := message.EditedAt
+	if previousClock == 0 {
+		previousClock = message.Clock
+	}
+	err := m.persistence.SaveMessageEdit(message.ID, &MessageEdit{
+		Content:     message.Text,
+		ContentType: message.ContentType,
+		Clock:       previousClock,
+	})
+	if err != nil {
+		return err
+	}
+
 	message.Text = editMessage.Text
 	message.EditedAt = editMessage.Clock
 	if editMessage.ContentType != protobuf.ChatMessage_UNKNOWN_CONTENT_TYPE {
@@ -297,7 +362,7 @@ func (m *Messenger) applyEditMessage(editMessage *protobuf.EditMessage, message
 		}
 	}
 
-	err := message.PrepareContent(common.PubkeyToHex(&m.identity.PublicKey))
+	err = message.PrepareContent(common.PubkeyToHex(&m.identity.PublicKey))
 	if err != nil {
 		return err
 	}
@@ -305,6 +370,12 @@ func (m *Messenger) applyEditMessage(editMessage *protobuf.EditMessage, message
 	return m.persistence.SaveMessages([]*common.Message{message})
 }
 
+// GetMessageEditHistory returns the versions of the message replaced by edits,
+// oldest first
+func (m *Messenger) GetMessageEditHistory(ctx context.Context, messageID string) ([]*MessageEdit, error) {
+	return m.persistence.MessageEditHistory(messageID)
+}
+
 func (m *Messenger) applyDeleteMessage(messageDeletes []*DeleteMessage, message *common.Message) error {
 	if messageDeletes[0].From != message.From {
 		return ErrInvalidEditOrDeleteAuthor
@@ -0,0 +1,36 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+)
+
+// communitiesWithMember returns the communities `member` belongs to
+func communitiesWithMember(joined []*communities.Community, member *ecdsa.PublicKey) []*communities.Community {
+	mutual := []*communities.Community{}
+	for _, community := range joined {
+		if community.HasMember(member) {
+			mutual = append(mutual, community)
+		}
+	}
+	return mutual
+}
+
+// GetContactMutualCommunities returns the communities both us and the contact
+// are members of
+func (m *Messenger) GetContactMutualCommunities(ctx context.Context, contactPublicKey string) ([]*communities.Community, error) {
+	publicKey, err := common.HexToPubkey(contactPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	joined, err := m.communitiesManager.Joined()
+	if err != nil {
+		return nil, err
+	}
+
+	return communitiesWithMember(joined, publicKey), nil
+}
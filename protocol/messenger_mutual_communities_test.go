@@ -0,0 +1,57 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func buildTestCommunityWithMembers(t *testing.T, identity *ecdsa.PrivateKey, members ...*ecdsa.PublicKey) *communities.Community {
+	owner, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	description := &protobuf.CommunityDescription{
+		Members: make(map[string]*protobuf.CommunityMember),
+	}
+	for _, member := range members {
+		description.Members[common.PubkeyToHex(member)] = &protobuf.CommunityMember{}
+	}
+
+	community, err := communities.New(communities.Config{
+		ID:                   &owner.PublicKey,
+		MemberIdentity:       &identity.PublicKey,
+		CommunityDescription: description,
+		Logger:               zap.NewNop(),
+	})
+	require.NoError(t, err)
+	return community
+}
+
+func TestMutualCommunities(t *testing.T) {
+	identity, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	contact, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	withContact := buildTestCommunityWithMembers(t, identity, &identity.PublicKey, &contact.PublicKey)
+	withoutContact := buildTestCommunityWithMembers(t, identity, &identity.PublicKey)
+	withContact2 := buildTestCommunityWithMembers(t, identity, &contact.PublicKey, &identity.PublicKey)
+	joined := []*communities.Community{withContact, withoutContact, withContact2}
+
+	mutual := communitiesWithMember(joined, &contact.PublicKey)
+	require.Equal(t, []*communities.Community{withContact, withContact2}, mutual)
+
+	// The contact is not a member of any of our communities
+	stranger, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	mutual = communitiesWithMember(joined, &stranger.PublicKey)
+	require.Empty(t, mutual)
+	require.NotNil(t, mutual)
+}
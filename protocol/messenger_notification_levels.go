@@ -0,0 +1,121 @@
+package protocol
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+type NotificationLevel int
+
+const (
+	NotificationLevelAll NotificationLevel = iota
+	NotificationLevelMentionsOnly
+	NotificationLevelMuted
+)
+
+func (l NotificationLevel) valid() bool {
+	return l >= NotificationLevelAll && l <= NotificationLevelMuted
+}
+
+// allows returns whether a notification should be shown for the message
+func (l NotificationLevel) allows(message *common.Message) bool {
+	switch l {
+	case NotificationLevelMentionsOnly:
+		return message.Mentioned
+	case NotificationLevelMuted:
+		return false
+	default:
+		return true
+	}
+}
+
+// syncNotificationLevelValue is the value of the NOTIFICATION_LEVEL sync setting
+type syncNotificationLevelValue struct {
+	ChatID string            `json:"chatId"`
+	Level  NotificationLevel `json:"level"`
+}
+
+// SetChatNotificationLevel sets which messages of the chat we want to be
+// notified of, and syncs it with the paired devices
+func (m *Messenger) SetChatNotificationLevel(ctx context.Context, chatID string, level NotificationLevel) error {
+	if !level.valid() {
+		return ErrInvalidNotificationLevel
+	}
+
+	if _, ok := m.allChats.Load(chatID); !ok {
+		return ErrChatNotFound
+	}
+
+	clock, _ := m.getLastClockWithRelatedChat()
+
+	err := m.persistence.SaveNotificationLevel(chatID, level, clock)
+	if err != nil {
+		return err
+	}
+
+	return m.syncNotificationLevel(ctx, chatID, level, clock)
+}
+
+// GetChatNotificationLevel returns the notification level of the chat,
+// NotificationLevelAll by default
+func (m *Messenger) GetChatNotificationLevel(chatID string) (NotificationLevel, error) {
+	level, _, err := m.persistence.NotificationLevel(chatID)
+	return level, err
+}
+
+func (m *Messenger) syncNotificationLevel(ctx context.Context, chatID string, level NotificationLevel, clock uint64) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+
+	value, err := json.Marshal(syncNotificationLevelValue{ChatID: chatID, Level: level})
+	if err != nil {
+		return err
+	}
+
+	encodedMessage, err := proto.Marshal(&protobuf.SyncSetting{
+		Type:  protobuf.SyncSetting_NOTIFICATION_LEVEL,
+		Value: &protobuf.SyncSetting_ValueBytes{ValueBytes: value},
+		Clock: clock,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, chat := m.getLastClockWithRelatedChat()
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_SETTING,
+		ResendAutomatically: true,
+	})
+	return err
+}
+
+func (m *Messenger) handleSyncNotificationLevel(message *protobuf.SyncSetting) error {
+	var value syncNotificationLevelValue
+	err := json.Unmarshal(message.GetValueBytes(), &value)
+	if err != nil {
+		return err
+	}
+
+	if !value.Level.valid() {
+		return ErrInvalidNotificationLevel
+	}
+
+	_, clock, err := m.persistence.NotificationLevel(value.ChatID)
+	if err != nil {
+		return err
+	}
+
+	if clock >= message.Clock {
+		return nil
+	}
+
+	return m.persistence.SaveNotificationLevel(value.ChatID, value.Level, message.Clock)
+}
@@ -0,0 +1,118 @@
+package protocol
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestMessengerNotificationLevelsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerNotificationLevelsSuite))
+}
+
+type MessengerNotificationLevelsSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerNotificationLevelsSuite) TestDefaultLevel() {
+	chat := CreatePublicChat("test-chat", s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	level, err := s.m.GetChatNotificationLevel(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(NotificationLevelAll, level)
+
+	err = s.m.SetChatNotificationLevel(context.Background(), chat.ID, NotificationLevel(42))
+	s.Require().Equal(ErrInvalidNotificationLevel, err)
+
+	err = s.m.SetChatNotificationLevel(context.Background(), "unknown", NotificationLevelMuted)
+	s.Require().Equal(ErrChatNotFound, err)
+}
+
+func (s *MessengerNotificationLevelsSuite) TestNotificationLevels() {
+	s.Require().NoError(s.m.settings.SaveSettingField(settings.NotificationsEnabled, true))
+
+	bob := s.newMessenger()
+	_, err := bob.Start()
+	s.Require().NoError(err)
+	defer bob.Shutdown() // nolint: errcheck
+
+	bobChat := CreateOneToOneChat("alice", &s.m.identity.PublicKey, bob.transport)
+	s.Require().NoError(bob.SaveChat(bobChat))
+
+	chat := CreateOneToOneChat("bob", &bob.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	testCases := []struct {
+		level    NotificationLevel
+		notified bool
+	}{
+		{NotificationLevelAll, true},
+		{NotificationLevelMentionsOnly, false},
+		{NotificationLevelMuted, false},
+	}
+
+	for _, tc := range testCases {
+		s.Require().NoError(s.m.SetChatNotificationLevel(context.Background(), chat.ID, tc.level))
+
+		level, err := s.m.GetChatNotificationLevel(chat.ID)
+		s.Require().NoError(err)
+		s.Require().Equal(tc.level, level)
+
+		_, err = bob.SendChatMessage(context.Background(), buildTestMessage(*bobChat))
+		s.Require().NoError(err)
+
+		response, err := WaitOnMessengerResponse(
+			s.m,
+			func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+			"no messages",
+		)
+		s.Require().NoError(err)
+
+		if tc.notified {
+			s.Require().Len(response.Notifications(), 1)
+		} else {
+			s.Require().Len(response.Notifications(), 0)
+		}
+	}
+}
+
+func (s *MessengerNotificationLevelsSuite) TestMentionsOnlyAllowsMentions() {
+	s.Require().True(NotificationLevelMentionsOnly.allows(&common.Message{Mentioned: true}))
+	s.Require().False(NotificationLevelMentionsOnly.allows(&common.Message{}))
+	s.Require().False(NotificationLevelMuted.allows(&common.Message{Mentioned: true}))
+	s.Require().True(NotificationLevelAll.allows(&common.Message{}))
+}
+
+func (s *MessengerNotificationLevelsSuite) TestHandleSyncNotificationLevel() {
+	syncSetting := func(level NotificationLevel, clock uint64) *protobuf.SyncSetting {
+		value, err := json.Marshal(syncNotificationLevelValue{ChatID: "test-chat", Level: level})
+		s.Require().NoError(err)
+		return &protobuf.SyncSetting{
+			Type:  protobuf.SyncSetting_NOTIFICATION_LEVEL,
+			Value: &protobuf.SyncSetting_ValueBytes{ValueBytes: value},
+			Clock: clock,
+		}
+	}
+
+	s.Require().NoError(s.m.handleSyncNotificationLevel(syncSetting(NotificationLevelMentionsOnly, 2)))
+
+	level, err := s.m.GetChatNotificationLevel("test-chat")
+	s.Require().NoError(err)
+	s.Require().Equal(NotificationLevelMentionsOnly, level)
+
+	// An older sync message is ignored
+	s.Require().NoError(s.m.handleSyncNotificationLevel(syncSetting(NotificationLevelMuted, 1)))
+
+	level, err = s.m.GetChatNotificationLevel("test-chat")
+	s.Require().NoError(err)
+	s.Require().Equal(NotificationLevelMentionsOnly, level)
+
+	s.Require().Equal(ErrInvalidNotificationLevel, s.m.handleSyncNotificationLevel(syncSetting(NotificationLevel(42), 3)))
+}
@@ -0,0 +1,64 @@
+package protocol
+
+import (
+	"context"
+	"time"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// onlineStatusTimeout is how recent the last status update of a contact must
+// be for them to be considered online
+const onlineStatusTimeout = 5 * time.Minute
+
+type OnlineStatus struct {
+	Online bool `json:"online"`
+	// LastSeenAt is the timestamp in ms of the last message received from
+	// the contact, 0 if none was received
+	LastSeenAt int64 `json:"lastSeenAt"`
+}
+
+// onlineStatus returns the status of a contact, given the timestamp in ms of
+// their last message and their last status update, whose clock is in seconds.
+// Contacts who chose to always appear online are online, automatic ones only
+// if their last update is recent, and do not disturb or inactive ones never are
+func onlineStatus(lastSeen uint64, lastStatusUpdate UserStatus, now time.Time) OnlineStatus {
+	var online bool
+	switch protobuf.StatusUpdate_StatusType(lastStatusUpdate.StatusType) {
+	case protobuf.StatusUpdate_ALWAYS_ONLINE:
+		online = true
+	case protobuf.StatusUpdate_AUTOMATIC:
+		online = int64(lastStatusUpdate.Clock) >= now.Add(-onlineStatusTimeout).Unix()
+	}
+
+	return OnlineStatus{
+		Online:     online,
+		LastSeenAt: int64(lastSeen),
+	}
+}
+
+// GetOnlineStatus returns whether each of the given public keys appears online
+// according to their last status update, and when we last received a message from them
+func (m *Messenger) GetOnlineStatus(ctx context.Context, publicKeys []string) (map[string]OnlineStatus, error) {
+	statusUpdates, err := m.persistence.StatusUpdates()
+	if err != nil {
+		return nil, err
+	}
+
+	lastStatusUpdates := make(map[string]UserStatus, len(statusUpdates))
+	for _, statusUpdate := range statusUpdates {
+		lastStatusUpdates[statusUpdate.PublicKey] = statusUpdate
+	}
+
+	now := time.Now()
+	result := make(map[string]OnlineStatus, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		var lastSeen uint64
+		if contact, ok := m.allContacts.Load(publicKey); ok {
+			lastSeen = contact.LastSeen
+		}
+		result[publicKey] = onlineStatus(lastSeen, lastStatusUpdates[publicKey], now)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,41 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/testutils/mockwaku"
+	"github.com/status-im/status-go/protocol/tt"
+)
+
+// newMessengerPair creates two messengers connected through an in-memory waku,
+// so that messages sent by one of them are received by the other. The messengers
+// are initialized but not started, which is enough to send and retrieve messages.
+// The returned cleanup function shuts down both messengers.
+func newMessengerPair(t *testing.T, opts ...Option) (alice, bob *Messenger, cleanup func()) {
+	logger := tt.MustCreateTestLogger()
+
+	aliceWaku, bobWaku := mockwaku.NewMockWakuPair()
+
+	newMessenger := func(shh *mockwaku.MockWaku) *Messenger {
+		privateKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		messenger, err := newMessengerWithKey(shh, privateKey, logger, opts)
+		require.NoError(t, err)
+		return messenger
+	}
+
+	alice = newMessenger(aliceWaku)
+	bob = newMessenger(bobWaku)
+
+	cleanup = func() {
+		require.NoError(t, alice.Shutdown())
+		require.NoError(t, bob.Shutdown())
+		_ = logger.Sync()
+	}
+
+	return alice, bob, cleanup
+}
@@ -30,6 +30,16 @@ func (m *Messenger) Peers() map[string]types.WakuV2Peer {
 	return m.transport.Peers()
 }
 
+// PeerCount returns the number of waku peers we are connected to
+func (m *Messenger) PeerCount() int {
+	switch m.transport.WakuVersion() {
+	case 2:
+		return m.transport.PeerCount()
+	default:
+		return m.node.PeersCount()
+	}
+}
+
 func (m *Messenger) ListenAddresses() ([]string, error) {
 	return m.transport.ListenAddresses()
 }
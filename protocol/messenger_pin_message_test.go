@@ -12,6 +12,9 @@ import (
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
 	"github.com/status-im/status-go/protocol/tt"
 	"github.com/status-im/status-go/waku"
 )
@@ -106,4 +109,86 @@ func (s *MessengerPinMessageSuite) TestPinMessage() {
 
 	receivedPinMessage := response.PinMessages()[0]
 	s.Require().True(receivedPinMessage.Pinned)
+
+	// The pinner is recorded on both sides
+	theirPublicKey := common.PubkeyToHex(&theirMessenger.identity.PublicKey)
+
+	pinnedMessages, _, err := s.m.PinnedMessageByChatID(ourChat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(pinnedMessages, 1)
+	s.Require().Equal(theirPublicKey, pinnedMessages[0].PinnedBy)
+
+	pinnedMessages, _, err = theirMessenger.PinnedMessageByChatID(theirChat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(pinnedMessages, 1)
+	s.Require().Equal(theirPublicKey, pinnedMessages[0].PinnedBy)
+}
+
+func (s *MessengerPinMessageSuite) TestPinMessageLimitInCommunityChat() {
+	response, err := s.m.CreateCommunity(&requests.CreateCommunity{
+		Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+		Name:        "status",
+		Color:       "#ffffff",
+		Description: "status community description",
+	}, false)
+	s.Require().NoError(err)
+	s.Require().Len(response.Communities(), 1)
+	community := response.Communities()[0]
+
+	communityChat := &protobuf.CommunityChat{
+		Permissions: &protobuf.CommunityPermissions{
+			Access: protobuf.CommunityPermissions_NO_MEMBERSHIP,
+		},
+		Identity: &protobuf.ChatIdentity{
+			DisplayName: "status-core",
+			Description: "status-core community chat",
+		},
+		MaxPinnedMessages: communities.MaxPinnedMessagesLimit + 1,
+	}
+	_, err = s.m.CreateCommunityChat(community.ID(), communityChat)
+	s.Require().Equal(communities.ErrInvalidMaxPinnedMessages, err)
+
+	communityChat.MaxPinnedMessages = 2
+	response, err = s.m.CreateCommunityChat(community.ID(), communityChat)
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats(), 1)
+	chat := response.Chats()[0]
+
+	var messageIDs []string
+	for i := 0; i < 3; i++ {
+		sendResponse, err := s.m.SendChatMessage(context.Background(), buildTestMessage(*chat))
+		s.Require().NoError(err)
+		s.Require().Len(sendResponse.Messages(), 1)
+		messageIDs = append(messageIDs, sendResponse.Messages()[0].ID)
+	}
+
+	pin := func(messageID string, pinned bool) error {
+		pinMessage := &common.PinMessage{LocalChatID: chat.ID}
+		pinMessage.MessageId = messageID
+		pinMessage.Pinned = pinned
+		pinMessage.ChatId = chat.ID
+		_, err := s.m.SendPinMessage(context.Background(), pinMessage)
+		return err
+	}
+
+	s.Require().NoError(pin(messageIDs[0], true))
+	s.Require().NoError(pin(messageIDs[1], true))
+
+	count, err := s.m.persistence.GetPinnedMessageCount(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(2, count)
+
+	// The limit is reached
+	s.Require().Equal(ErrPinnedMessageLimitReached, pin(messageIDs[2], true))
+
+	// Pinning an already pinned message doesn't count towards the limit
+	s.Require().NoError(pin(messageIDs[1], true))
+
+	// Unpinning frees a slot
+	s.Require().NoError(pin(messageIDs[0], false))
+	s.Require().NoError(pin(messageIDs[2], true))
+
+	count, err = s.m.persistence.GetPinnedMessageCount(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(2, count)
 }
@@ -58,6 +58,11 @@ func (m *Messenger) sendPinMessage(ctx context.Context, message *common.PinMessa
 		return nil, err
 	}
 
+	err = m.checkPinnedMessageLimit(chat, message)
+	if err != nil {
+		return nil, err
+	}
+
 	encodedMessage, err := m.encodeChatEntity(chat, message)
 	if err != nil {
 		return nil, err
@@ -85,6 +90,41 @@ func (m *Messenger) sendPinMessage(ctx context.Context, message *common.PinMessa
 	return &response, m.saveChat(chat)
 }
 
+// checkPinnedMessageLimit returns ErrPinnedMessageLimitReached if pinning
+// the message would go over the limit set for the community chat
+func (m *Messenger) checkPinnedMessageLimit(chat *Chat, message *common.PinMessage) error {
+	if !message.Pinned || !chat.CommunityChat() {
+		return nil
+	}
+
+	community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+	if err != nil {
+		return err
+	}
+	if community == nil {
+		return nil
+	}
+
+	alreadyPinned, err := m.persistence.IsMessagePinned(message.ID)
+	if err != nil {
+		return err
+	}
+	if alreadyPinned {
+		return nil
+	}
+
+	count, err := m.persistence.GetPinnedMessageCount(chat.ID)
+	if err != nil {
+		return err
+	}
+
+	if count >= community.MaxPinnedMessages(chat.CommunityChatID()) {
+		return ErrPinnedMessageLimitReached
+	}
+
+	return nil
+}
+
 func (m *Messenger) PinnedMessageByChatID(chatID, cursor string, limit int) ([]*common.PinnedMessage, string, error) {
 	return m.persistence.PinnedMessageByChatID(chatID, cursor, limit)
 }
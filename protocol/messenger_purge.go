@@ -0,0 +1,117 @@
+package protocol
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// autoPurgeTickerInterval is how often we check whether old messages should be purged
+var autoPurgeTickerInterval = time.Hour
+
+// autoPurgeInterval is the time allowed between two purges of old messages
+var autoPurgeInterval = 24 * time.Hour
+
+// PurgeOldMessages hard-deletes the messages of the chat sent before
+// `olderThan`. Unlike clearing the history, purged messages can't be
+// retrieved again from the local database.
+func (m *Messenger) PurgeOldMessages(ctx context.Context, chatID string, olderThan time.Time) (int, error) {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok || chat == nil {
+		return 0, ErrChatNotFound
+	}
+
+	if olderThan.Unix() <= 0 {
+		return 0, nil
+	}
+
+	deleted, err := m.persistence.PurgeMessagesOlderThan(ctx, chatID, uint64(olderThan.UnixMilli()))
+	if err != nil {
+		return 0, err
+	}
+
+	if deleted == 0 {
+		return 0, nil
+	}
+
+	// Purged messages might have been unread
+	updatedChat, err := m.persistence.Chat(chatID)
+	if err != nil {
+		return deleted, err
+	}
+	if updatedChat != nil {
+		chat.UnviewedMessagesCount = updatedChat.UnviewedMessagesCount
+		chat.UnviewedMentionsCount = updatedChat.UnviewedMentionsCount
+	}
+
+	return deleted, nil
+}
+
+// purgeOldMessages purges the messages older than the retention period from all chats
+func (m *Messenger) purgeOldMessages(ctx context.Context) error {
+	enabled, err := m.settings.AutoPurgeEnabled()
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		m.logger.Debug("auto purge not enabled, skipping")
+		return nil
+	}
+
+	retentionDays, err := m.settings.AutoPurgeRetentionDays()
+	if err != nil {
+		return err
+	}
+	if retentionDays <= 0 {
+		m.logger.Warn("invalid auto purge retention, skipping", zap.Int("retentionDays", retentionDays))
+		return nil
+	}
+
+	olderThan := time.Now().AddDate(0, 0, -retentionDays)
+
+	var chatIDs []string
+	m.allChats.Range(func(chatID string, chat *Chat) bool {
+		chatIDs = append(chatIDs, chatID)
+		return true
+	})
+
+	total := 0
+	for _, chatID := range chatIDs {
+		deleted, err := m.PurgeOldMessages(ctx, chatID, olderThan)
+		if err != nil {
+			return err
+		}
+		total += deleted
+	}
+
+	m.logger.Debug("purged old messages", zap.Int("count", total))
+	return nil
+}
+
+func (m *Messenger) startAutoPurgeLoop() {
+	ticker := time.NewTicker(autoPurgeTickerInterval)
+	go func() {
+		var lastPurge time.Time
+		for {
+			select {
+			case <-ticker.C:
+				if time.Since(lastPurge) < autoPurgeInterval {
+					continue
+				}
+
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+				err := m.purgeOldMessages(ctx)
+				cancel()
+				if err != nil {
+					m.logger.Error("failed to purge old messages", zap.Error(err))
+					continue
+				}
+				lastPurge = time.Now()
+			case <-m.quit:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,118 @@
+package protocol
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/common"
+)
+
+func TestMessengerPurgeSuite(t *testing.T) {
+	suite.Run(t, new(MessengerPurgeSuite))
+}
+
+type MessengerPurgeSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerPurgeSuite) saveMessages(chat *Chat, timestamps []time.Time) []*common.Message {
+	var messages []*common.Message
+	for i, timestamp := range timestamps {
+		message := buildTestMessage(*chat)
+		message.ID = chat.ID + "-" + strconv.Itoa(i)
+		message.Clock = uint64(i + 1)
+		message.Timestamp = uint64(timestamp.UnixMilli())
+		message.From = common.PubkeyToHex(&s.privateKey.PublicKey)
+		messages = append(messages, message)
+	}
+	s.Require().NoError(s.m.SaveMessages(messages))
+	return messages
+}
+
+func (s *MessengerPurgeSuite) TestPurgeOldMessages() {
+	chat := CreatePublicChat("test-purge", s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+	otherChat := CreatePublicChat("test-purge-other", s.m.transport)
+	s.Require().NoError(s.m.SaveChat(otherChat))
+
+	threshold := time.Now().Add(-24 * time.Hour)
+	messages := s.saveMessages(chat, []time.Time{
+		threshold.Add(-48 * time.Hour),
+		threshold.Add(-time.Millisecond),
+		threshold,
+		threshold.Add(time.Hour),
+	})
+	otherMessages := s.saveMessages(otherChat, []time.Time{threshold.Add(-48 * time.Hour)})
+
+	// Pins and reactions on purged messages are deleted with them
+	pinMessage := &common.PinMessage{
+		ID:          "pin-id",
+		LocalChatID: chat.ID,
+		From:        messages[0].From,
+	}
+	pinMessage.MessageId = messages[0].ID
+	pinMessage.ChatId = chat.ID
+	pinMessage.Clock = 1
+	pinMessage.Pinned = true
+	s.Require().NoError(s.m.persistence.SavePinMessages([]*common.PinMessage{pinMessage}))
+	pinned, _, err := s.m.PinnedMessageByChatID(chat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(pinned, 1)
+
+	deleted, err := s.m.PurgeOldMessages(context.Background(), chat.ID, threshold)
+	s.Require().NoError(err)
+	s.Require().Equal(2, deleted)
+
+	remaining, _, err := s.m.MessageByChatID(chat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(remaining, 2)
+	s.Require().ElementsMatch([]string{messages[2].ID, messages[3].ID}, []string{remaining[0].ID, remaining[1].ID})
+
+	pinned, _, err = s.m.PinnedMessageByChatID(chat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Empty(pinned)
+
+	// Other chats are left untouched
+	remaining, _, err = s.m.MessageByChatID(otherChat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(remaining, 1)
+	s.Require().Equal(otherMessages[0].ID, remaining[0].ID)
+
+	deleted, err = s.m.PurgeOldMessages(context.Background(), chat.ID, threshold)
+	s.Require().NoError(err)
+	s.Require().Equal(0, deleted)
+
+	_, err = s.m.PurgeOldMessages(context.Background(), "non-existing", threshold)
+	s.Require().Equal(ErrChatNotFound, err)
+}
+
+func (s *MessengerPurgeSuite) TestAutoPurgeUsesRetentionSetting() {
+	chat := CreatePublicChat("test-auto-purge", s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	now := time.Now()
+	messages := s.saveMessages(chat, []time.Time{
+		now.AddDate(0, 0, -10),
+		now.AddDate(0, 0, -2),
+	})
+
+	// Disabled by default
+	s.Require().NoError(s.m.purgeOldMessages(context.Background()))
+	remaining, _, err := s.m.MessageByChatID(chat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(remaining, 2)
+
+	s.Require().NoError(s.m.settings.SaveSettingField(settings.AutoPurgeEnabled, true))
+	s.Require().NoError(s.m.settings.SaveSettingField(settings.AutoPurgeRetentionDays, 5))
+
+	s.Require().NoError(s.m.purgeOldMessages(context.Background()))
+	remaining, _, err = s.m.MessageByChatID(chat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Len(remaining, 1)
+	s.Require().Equal(messages[1].ID, remaining[0].ID)
+}
@@ -0,0 +1,48 @@
+package protocol
+
+import (
+	"context"
+	"time"
+)
+
+// ReactionCount is how many times an emoji was used to react
+type ReactionCount struct {
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"`
+}
+
+// ReactionLeader is how many reactions a member made
+type ReactionLeader struct {
+	PublicKey string `json:"publicKey"`
+	Count     int    `json:"count"`
+}
+
+// reactionClock converts a time to the clock of emoji reactions, which is
+// derived from the timestamp in milliseconds they were sent at
+func reactionClock(since time.Time) uint64 {
+	if since.IsZero() || since.Unix() < 0 {
+		return 0
+	}
+	return uint64(since.UnixMilli())
+}
+
+// GetTopReactions returns the emojis used to react in the chat since the given
+// time, most used first
+func (m *Messenger) GetTopReactions(ctx context.Context, chatID string, since time.Time) ([]ReactionCount, error) {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok || chat == nil {
+		return nil, ErrChatNotFound
+	}
+
+	return m.persistence.TopReactions(ctx, chatID, reactionClock(since))
+}
+
+// GetReactionLeaderboard returns the members who reacted the most in the chats
+// of the community since the given time
+func (m *Messenger) GetReactionLeaderboard(ctx context.Context, communityID string, since time.Time, limit int) ([]ReactionLeader, error) {
+	if limit <= 0 {
+		return nil, ErrInvalidReactionLeaderboardLimit
+	}
+
+	return m.persistence.ReactionLeaderboard(ctx, communityID, reactionClock(since), limit)
+}
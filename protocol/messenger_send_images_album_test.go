@@ -2,23 +2,14 @@ package protocol
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"io/ioutil"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
-	"go.uber.org/zap"
 
-	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
-	"github.com/status-im/status-go/eth-node/crypto"
-	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
-
-	// "github.com/status-im/status-go/protocol/requests"
-	"github.com/status-im/status-go/protocol/tt"
-	"github.com/status-im/status-go/waku"
 )
 
 func TestMessengerSendImagesAlbumSuite(t *testing.T) {
@@ -27,41 +18,17 @@ func TestMessengerSendImagesAlbumSuite(t *testing.T) {
 
 type MessengerSendImagesAlbumSuite struct {
 	suite.Suite
-	m          *Messenger
-	privateKey *ecdsa.PrivateKey // private key for the main instance of Messenger
-	// If one wants to send messages between different instances of Messenger,
-	// a single waku service should be shared.
-	shh    types.Waku
-	logger *zap.Logger
+	m       *Messenger // main instance of Messenger
+	them    *Messenger // messenger receiving the albums
+	cleanup func()
 }
 
 func (s *MessengerSendImagesAlbumSuite) SetupTest() {
-	s.logger = tt.MustCreateTestLogger()
-
-	config := waku.DefaultConfig
-	config.MinimumAcceptedPoW = 0
-	shh := waku.New(&config, s.logger)
-	s.shh = gethbridge.NewGethWakuWrapper(shh)
-	s.Require().NoError(shh.Start())
-
-	s.m = s.newMessenger()
-	s.privateKey = s.m.identity
-	_, err := s.m.Start()
-	s.Require().NoError(err)
-
+	s.m, s.them, s.cleanup = newMessengerPair(s.T())
 }
 
 func (s *MessengerSendImagesAlbumSuite) TearDownTest() {
-	s.Require().NoError(s.m.Shutdown())
-}
-
-func (s *MessengerSendImagesAlbumSuite) newMessenger() *Messenger {
-	privateKey, err := crypto.GenerateKey()
-	s.Require().NoError(err)
-
-	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, nil)
-	s.Require().NoError(err)
-	return messenger
+	s.cleanup()
 }
 
 func buildImageWithoutAlbumIDMessage(s *MessengerSendImagesAlbumSuite, chat Chat) *common.Message {
@@ -93,12 +60,10 @@ func buildImageWithoutAlbumIDMessage(s *MessengerSendImagesAlbumSuite, chat Chat
 }
 
 func (s *MessengerSendImagesAlbumSuite) TestAlbumImageMessagesSend() {
-	theirMessenger := s.newMessenger()
-	_, err := theirMessenger.Start()
-	s.Require().NoError(err)
+	theirMessenger := s.them
 
-	theirChat := CreateOneToOneChat("Their 1TO1", &s.privateKey.PublicKey, s.m.transport)
-	err = theirMessenger.SaveChat(theirChat)
+	theirChat := CreateOneToOneChat("Their 1TO1", &s.m.identity.PublicKey, s.m.transport)
+	err := theirMessenger.SaveChat(theirChat)
 	s.Require().NoError(err)
 
 	ourChat := CreateOneToOneChat("Our 1TO1", &theirMessenger.identity.PublicKey, s.m.transport)
@@ -1,8 +1,11 @@
 package protocol
 
 import (
+	"context"
 	"crypto/ecdsa"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
@@ -10,6 +13,7 @@ import (
 	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/settings"
 	"github.com/status-im/status-go/protocol/protobuf"
 
 	"github.com/status-im/status-go/protocol/tt"
@@ -169,3 +173,83 @@ func (s *MessengerStatusUpdatesSuite) TestDeactivatedStatusUpdates() {
 	//Upper rannge ends at 401 (clock + 1)
 	s.Require().Equal(uint64(401), deactivatedAutomaticStatusUpdates[count-1].Clock)
 }
+
+func (s *MessengerStatusUpdatesSuite) TestGetOnlineStatus() {
+	alice := s.m
+
+	bob := s.newMessenger()
+	defer bob.Shutdown() // nolint: errcheck
+	s.Require().NoError(bob.settings.SaveSettingField(settings.SendStatusUpdates, true))
+
+	carolKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	bobID := bob.myHexIdentity()
+	carolID := types.EncodeHex(crypto.FromECDSAPub(&carolKey.PublicKey))
+
+	for _, id := range []string{bobID, carolID} {
+		contact, err := buildContactFromPkString(id)
+		s.Require().NoError(err)
+		s.Require().NoError(alice.persistence.SaveContact(contact, nil))
+		alice.allContacts.Store(id, contact)
+	}
+
+	// Listen to the status updates of bob
+	_, err = alice.transport.JoinPrivate(&bob.identity.PublicKey)
+	s.Require().NoError(err)
+
+	s.Require().NoError(bob.SetUserStatus(context.Background(), int(protobuf.StatusUpdate_ALWAYS_ONLINE), ""))
+
+	err = tt.RetryWithBackOff(func() error {
+		if _, err := alice.RetrieveAll(); err != nil {
+			return err
+		}
+		statusUpdates, err := alice.StatusUpdates()
+		if err != nil {
+			return err
+		}
+		for _, statusUpdate := range statusUpdates {
+			if statusUpdate.PublicKey == bobID {
+				return nil
+			}
+		}
+		return errors.New("no status update")
+	})
+	s.Require().NoError(err)
+
+	statuses, err := alice.GetOnlineStatus(context.Background(), []string{bobID, carolID})
+	s.Require().NoError(err)
+	s.Require().Len(statuses, 2)
+	s.Require().True(statuses[bobID].Online)
+	s.Require().NotZero(statuses[bobID].LastSeenAt)
+	s.Require().Equal(OnlineStatus{}, statuses[carolID])
+
+	// The last seen timestamp is persisted
+	contacts, err := alice.persistence.Contacts()
+	s.Require().NoError(err)
+	for _, contact := range contacts {
+		if contact.ID == bobID {
+			s.Require().Equal(uint64(statuses[bobID].LastSeenAt), contact.LastSeen)
+		} else {
+			s.Require().Zero(contact.LastSeen)
+		}
+	}
+}
+
+func (s *MessengerStatusUpdatesSuite) TestOnlineStatus() {
+	now := time.Unix(10000, 0)
+
+	automatic := func(clock uint64) UserStatus {
+		return UserStatus{StatusType: int(protobuf.StatusUpdate_AUTOMATIC), Clock: clock}
+	}
+
+	s.Require().Equal(OnlineStatus{Online: true, LastSeenAt: 9000000}, onlineStatus(9000000, automatic(9800), now))
+	s.Require().Equal(OnlineStatus{Online: true}, onlineStatus(0, automatic(9700), now))
+	s.Require().Equal(OnlineStatus{Online: false, LastSeenAt: 9000000}, onlineStatus(9000000, automatic(9699), now))
+	s.Require().Equal(OnlineStatus{}, onlineStatus(0, UserStatus{}, now))
+
+	// Only the automatic status expires
+	s.Require().Equal(OnlineStatus{Online: true}, onlineStatus(0, UserStatus{StatusType: int(protobuf.StatusUpdate_ALWAYS_ONLINE), Clock: 1}, now))
+	s.Require().Equal(OnlineStatus{}, onlineStatus(0, UserStatus{StatusType: int(protobuf.StatusUpdate_DO_NOT_DISTURB), Clock: 9800}, now))
+	s.Require().Equal(OnlineStatus{}, onlineStatus(0, UserStatus{StatusType: int(protobuf.StatusUpdate_INACTIVE), Clock: 9800}, now))
+}
@@ -0,0 +1,76 @@
+package protocol
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ContactSyncProgress is signalled each time a contact is sent to the
+// installation being synced
+type ContactSyncProgress struct {
+	Current int `json:"current"`
+	Total   int `json:"total"`
+}
+
+// SyncContactsWithInstallation sends all the contacts to one of our paired
+// installations right away, instead of waiting for the next backup. It is
+// meant to be used for the initial sync of a newly paired device, so the
+// contacts are only encrypted for that installation and aren't resent
+// automatically.
+func (m *Messenger) SyncContactsWithInstallation(ctx context.Context, installationID string) error {
+	installation, ok := m.allInstallations.Load(installationID)
+	if !ok || installationID == m.installationID {
+		return ErrInstallationNotFound
+	}
+	if !installation.Enabled {
+		return ErrInstallationDisabled
+	}
+
+	myID := contactIDFromPublicKey(&m.identity.PublicKey)
+
+	var contacts []*protobuf.SyncInstallationContactV2
+	m.allContacts.Range(func(contactID string, contact *Contact) (shouldContinue bool) {
+		if contact.ID != myID &&
+			(contact.LocalNickname != "" || contact.added() || contact.Blocked) {
+			contacts = append(contacts, m.buildSyncContactMessage(contact))
+		}
+		return true
+	})
+
+	for i, syncMessage := range contacts {
+		clock, chat := m.getLastClockWithRelatedChat()
+
+		encodedMessage, err := proto.Marshal(syncMessage)
+		if err != nil {
+			return err
+		}
+
+		_, err = m.sender.SendPrivate(ctx, &m.identity.PublicKey, &common.RawMessage{
+			LocalChatID:     chat.ID,
+			Payload:         encodedMessage,
+			MessageType:     protobuf.ApplicationMetadataMessage_SYNC_INSTALLATION_CONTACT,
+			InstallationIDs: []string{installationID},
+		})
+		if err != nil {
+			return err
+		}
+
+		chat.LastClockValue = clock
+		if err = m.saveChat(chat); err != nil {
+			return err
+		}
+
+		if m.config.messengerSignalsHandler != nil {
+			m.config.messengerSignalsHandler.ContactSyncProgress(&ContactSyncProgress{
+				Current: i + 1,
+				Total:   len(contacts),
+			})
+		}
+	}
+
+	return nil
+}
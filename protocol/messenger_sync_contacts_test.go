@@ -0,0 +1,119 @@
+package protocol
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/encryption/multidevice"
+)
+
+func TestMessengerSyncContactsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerSyncContactsSuite))
+}
+
+type MessengerSyncContactsSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerSyncContactsSuite) pairDevice() *Messenger {
+	theirMessenger, err := newMessengerWithKey(s.shh, s.privateKey, s.logger, nil)
+	s.Require().NoError(err)
+
+	err = theirMessenger.SetInstallationMetadata(theirMessenger.installationID, &multidevice.InstallationMetadata{
+		Name:       "their-name",
+		DeviceType: "their-device-type",
+	})
+	s.Require().NoError(err)
+	_, err = theirMessenger.SendPairInstallation(context.Background(), nil)
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool {
+			for _, installation := range r.Installations {
+				if installation.ID == theirMessenger.installationID {
+					return true
+				}
+			}
+			return false
+		},
+		"installation not received",
+	)
+	s.Require().NoError(err)
+
+	// Pair back, so that the new device agrees on a shared secret with us
+	_, err = s.m.SendPairInstallation(context.Background(), nil)
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		theirMessenger,
+		func(r *MessengerResponse) bool { return len(r.Installations) > 0 },
+		"installation not received",
+	)
+	s.Require().NoError(err)
+
+	return theirMessenger
+}
+
+func (s *MessengerSyncContactsSuite) TestSyncContactsWithInstallation() {
+	const contactsCount = 50
+
+	for i := 0; i < contactsCount; i++ {
+		key, err := crypto.GenerateKey()
+		s.Require().NoError(err)
+
+		contact, err := buildContactFromPkString(common.PubkeyToHex(&key.PublicKey))
+		s.Require().NoError(err)
+		contact.ContactRequestSent(uint64(i + 1))
+		contact.LastUpdatedLocally = uint64(i + 1)
+
+		s.Require().NoError(s.m.persistence.SaveContact(contact, nil))
+		s.m.allContacts.Store(contact.ID, contact)
+	}
+
+	theirMessenger := s.pairDevice()
+	defer func() {
+		s.Require().NoError(theirMessenger.Shutdown())
+	}()
+
+	otherMessenger := s.pairDevice()
+	defer func() {
+		s.Require().NoError(otherMessenger.Shutdown())
+	}()
+	s.Require().NoError(s.m.EnableInstallation(otherMessenger.installationID))
+
+	s.Require().Equal(ErrInstallationDisabled, s.m.SyncContactsWithInstallation(context.Background(), theirMessenger.installationID))
+	s.Require().NoError(s.m.EnableInstallation(theirMessenger.installationID))
+
+	s.Require().Equal(ErrInstallationNotFound, s.m.SyncContactsWithInstallation(context.Background(), "unknown"))
+	s.Require().NoError(s.m.SyncContactsWithInstallation(context.Background(), theirMessenger.installationID))
+
+	_, err := WaitOnMessengerResponse(
+		theirMessenger,
+		func(r *MessengerResponse) bool { return len(r.Contacts) == contactsCount },
+		"contacts not synced",
+	)
+	s.Require().NoError(err)
+
+	added := 0
+	theirMessenger.allContacts.Range(func(contactID string, contact *Contact) (shouldContinue bool) {
+		if contact.added() {
+			added++
+		}
+		return true
+	})
+	s.Require().Equal(contactsCount, added)
+
+	// The other paired installations don't receive them
+	for i := 0; i < 3; i++ {
+		response, err := otherMessenger.RetrieveAll()
+		s.Require().NoError(err)
+		s.Require().Empty(response.Contacts)
+		time.Sleep(100 * time.Millisecond)
+	}
+}
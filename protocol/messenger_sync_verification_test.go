@@ -114,11 +114,10 @@ func (s *MessengerSyncVerificationRequests) TestSyncVerificationRequests() {
 
 	s.Require().NoError(err)
 
-	time.Sleep(4 * time.Second)
-
-	requests, err := theirMessenger.verificationDatabase.GetVerificationRequests()
-	s.Require().NoError(err)
-	s.Require().Len(requests, 1)
+	tt.WaitForCondition(s.T(), 5*time.Second, func() bool {
+		requests, err := theirMessenger.verificationDatabase.GetVerificationRequests()
+		return err == nil && len(requests) == 1
+	}, "verification request was not saved")
 
 	s.Require().NoError(theirMessenger.Shutdown())
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	enstypes "github.com/status-im/status-go/eth-node/types/ens"
+	"github.com/status-im/status-go/multiaccounts/settings"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/requests"
@@ -311,6 +312,102 @@ func (s *MessengerSuite) TestMarkAllRead() {
 	}
 }
 
+func (s *MessengerSuite) TestMarkChatsAsRead() {
+	var chatIDs []string
+	for _, name := range []string{"test-chat-1", "test-chat-2"} {
+		chat := CreatePublicChat(name, s.m.transport)
+		chat.UnviewedMessagesCount = 2
+		chat.UnviewedMentionsCount = 1
+		chat.Highlight = true
+		s.Require().NoError(s.m.SaveChat(chat))
+
+		inputMessage1 := buildTestMessage(*chat)
+		inputMessage1.ID = name + "-1"
+		inputMessage1.Seen = false
+		inputMessage2 := buildTestMessage(*chat)
+		inputMessage2.ID = name + "-2"
+		inputMessage2.Seen = false
+		inputMessage2.Mentioned = true
+		s.Require().NoError(s.m.SaveMessages([]*common.Message{inputMessage1, inputMessage2}))
+
+		chatIDs = append(chatIDs, chat.ID)
+	}
+
+	response, err := s.m.MarkChatsAsRead(context.Background(), chatIDs)
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats(), 2)
+
+	for _, chatID := range chatIDs {
+		chat := s.m.Chat(chatID)
+		s.Require().Equal(uint(0), chat.UnviewedMessagesCount)
+		s.Require().Equal(uint(0), chat.UnviewedMentionsCount)
+		s.Require().False(chat.Highlight)
+	}
+}
+
+func (s *MessengerSuite) TestSetChatHighlight() {
+	chat := CreatePublicChat("test-chat", s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	for _, highlight := range []bool{true, false} {
+		updatedChat, err := s.m.SetChatHighlight(chat.ID, highlight)
+		s.Require().NoError(err)
+		s.Require().Equal(highlight, updatedChat.Highlight)
+
+		// Loaded from the database, as after a restart
+		savedChat, err := s.m.persistence.Chat(chat.ID)
+		s.Require().NoError(err)
+		s.Require().Equal(highlight, savedChat.Highlight)
+	}
+
+	_, err := s.m.SetChatHighlight("unknown", true)
+	s.Require().Equal(ErrChatNotFound, err)
+}
+
+func (s *MessengerSuite) TestGetChatsWithUnreadMentions() {
+	mentionsCounts := map[string]uint{
+		"no-mentions":  0,
+		"one-mention":  1,
+		"two-mentions": 2,
+		"tie-mentions": 2,
+	}
+	for name, count := range mentionsCounts {
+		chat := CreatePublicChat(name, s.m.transport)
+		chat.UnviewedMessagesCount = count + 1
+		chat.UnviewedMentionsCount = count
+		s.Require().NoError(s.m.SaveChat(chat))
+	}
+
+	chats := s.m.GetChatsWithUnreadMentions()
+	s.Require().Len(chats, 3)
+	s.Require().Equal("tie-mentions", chats[0].ID)
+	s.Require().Equal("two-mentions", chats[1].ID)
+	s.Require().Equal("one-mention", chats[2].ID)
+
+	s.Require().Equal(5, s.m.GetTotalUnreadMentionCount())
+}
+
+func (s *MessengerSuite) TestChatSyncCoverage() {
+	s.Require().NoError(s.m.settings.SaveSettingField(settings.DefaultSyncPeriod, 86400))
+
+	chat := CreatePublicChat("test-chat", s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	coverage, err := s.m.ChatSyncCoverage(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(1.0, coverage)
+
+	gap := buildTestMessage(*chat)
+	gap.ID = "gap"
+	gap.ContentType = protobuf.ChatMessage_SYSTEM_MESSAGE_GAP
+	gap.GapParameters = &common.GapParameters{From: 1000, To: 1000 + 21600}
+	s.Require().NoError(s.m.persistence.SaveMessages([]*common.Message{gap}))
+
+	coverage, err = s.m.ChatSyncCoverage(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(0.75, coverage)
+}
+
 func (s *MessengerSuite) TestSendPublic() {
 	chat := CreatePublicChat("test-chat", s.m.transport)
 	chat.LastClockValue = uint64(100000000000000)
@@ -2311,12 +2408,12 @@ func (s *MessengerSuite) TestResendExpiredEmojis() {
 	rawMessage.LastSent = rawMessage.LastSent - 35*uint64(time.Second.Milliseconds())
 	err = s.m.persistence.SaveRawMessage(rawMessage)
 	s.NoError(err)
-	time.Sleep(2 * time.Second)
 
 	//make sure it was resent and SendCount incremented
-	rawMessage, err = s.m.persistence.RawMessageByID(emojiID)
-	s.NoError(err)
-	s.True(rawMessage.SendCount >= 2)
+	tt.WaitForCondition(s.T(), 5*time.Second, func() bool {
+		rawMessage, err = s.m.persistence.RawMessageByID(emojiID)
+		return err == nil && rawMessage.SendCount >= 2
+	}, "emoji reaction %s was not resent", emojiID)
 }
 
 type testTimeSource struct{}
@@ -0,0 +1,213 @@
+package protocol
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// TypingIndicatorTTL is how long a member is shown as typing, unless they
+// send another typing indicator
+const TypingIndicatorTTL = 3 * time.Second
+
+// typingIndicators keeps track of who is typing in each chat, expiring the
+// indicators which are not refreshed within the TTL
+type typingIndicators struct {
+	mutex sync.Mutex
+	ttl   time.Duration
+	// timers maps each chat ID to the public keys typing in it
+	timers   map[string]map[string]*time.Timer
+	onChange func(chatID string, publicKey string, typing bool)
+}
+
+func newTypingIndicators(ttl time.Duration, onChange func(chatID string, publicKey string, typing bool)) *typingIndicators {
+	return &typingIndicators{
+		ttl:      ttl,
+		timers:   make(map[string]map[string]*time.Timer),
+		onChange: onChange,
+	}
+}
+
+// start marks `publicKey` as typing in the chat, or refreshes its TTL
+func (t *typingIndicators) start(chatID string, publicKey string) {
+	t.mutex.Lock()
+	if t.timers[chatID] == nil {
+		t.timers[chatID] = make(map[string]*time.Timer)
+	}
+	if timer, ok := t.timers[chatID][publicKey]; ok {
+		timer.Stop()
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(t.ttl, func() {
+		t.expire(chatID, publicKey, timer)
+	})
+	t.timers[chatID][publicKey] = timer
+	t.mutex.Unlock()
+
+	t.onChange(chatID, publicKey, true)
+}
+
+// stop cancels the indicator of `publicKey` in the chat, if any
+func (t *typingIndicators) stop(chatID string, publicKey string) {
+	t.mutex.Lock()
+	timer, ok := t.timers[chatID][publicKey]
+	if ok {
+		timer.Stop()
+		t.remove(chatID, publicKey)
+	}
+	t.mutex.Unlock()
+
+	if ok {
+		t.onChange(chatID, publicKey, false)
+	}
+}
+
+func (t *typingIndicators) expire(chatID string, publicKey string, timer *time.Timer) {
+	t.mutex.Lock()
+	// The indicator was refreshed or stopped in the meantime
+	if t.timers[chatID][publicKey] != timer {
+		t.mutex.Unlock()
+		return
+	}
+	t.remove(chatID, publicKey)
+	t.mutex.Unlock()
+
+	t.onChange(chatID, publicKey, false)
+}
+
+func (t *typingIndicators) remove(chatID string, publicKey string) {
+	delete(t.timers[chatID], publicKey)
+	if len(t.timers[chatID]) == 0 {
+		delete(t.timers, chatID)
+	}
+}
+
+// typing returns the public keys typing in the chat
+func (t *typingIndicators) typing(chatID string) []string {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var publicKeys []string
+	for publicKey := range t.timers[chatID] {
+		publicKeys = append(publicKeys, publicKey)
+	}
+	return publicKeys
+}
+
+// stopAll cancels all the indicators without notifying
+func (t *typingIndicators) stopAll() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for _, timers := range t.timers {
+		for _, timer := range timers {
+			timer.Stop()
+		}
+	}
+	t.timers = make(map[string]map[string]*time.Timer)
+	return nil
+}
+
+func (m *Messenger) typingIndicatorChanged(chatID string, publicKey string, typing bool) {
+	if m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.TypingIndicator(chatID, publicKey, typing, m.typingIndicators.ttl)
+	}
+}
+
+// SendTypingIndicator notifies the other members of the chat that we are typing.
+// The indicator is sent as an ephemeral message, which is neither stored nor resent.
+func (m *Messenger) SendTypingIndicator(ctx context.Context, chatID string) error {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return ErrChatNotFound
+	}
+
+	messageType, err := chatMessageType(chat)
+	if err != nil {
+		return err
+	}
+
+	now := m.getTimesource().GetCurrentTime()
+	message := &protobuf.ChatMessage{
+		Clock:       now,
+		Timestamp:   now,
+		ChatId:      chat.ID,
+		MessageType: messageType,
+		ContentType: protobuf.ChatMessage_TYPING,
+	}
+
+	encodedMessage, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:          chat.ID,
+		Payload:              encodedMessage,
+		MessageType:          protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
+		SkipGroupMessageWrap: true,
+		Ephemeral:            true,
+	})
+	return err
+}
+
+func chatMessageType(chat *Chat) (protobuf.MessageType, error) {
+	switch chat.ChatType {
+	case ChatTypeOneToOne:
+		return protobuf.MessageType_ONE_TO_ONE, nil
+	case ChatTypePublic, ChatTypeProfile:
+		return protobuf.MessageType_PUBLIC_GROUP, nil
+	case ChatTypeCommunityChat:
+		return protobuf.MessageType_COMMUNITY_CHAT, nil
+	case ChatTypePrivateGroupChat:
+		return protobuf.MessageType_PRIVATE_GROUP, nil
+	default:
+		return protobuf.MessageType_UNKNOWN_MESSAGE_TYPE, ErrChatTypeNotSupported
+	}
+}
+
+func (m *Messenger) handleTypingIndicator(state *ReceivedMessageState) error {
+	logger := m.logger.With(zap.String("site", "handleTypingIndicator"))
+	if err := ValidateReceivedChatMessage(&state.CurrentMessageState.Message, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		logger.Warn("failed to validate typing indicator", zap.Error(err))
+		return err
+	}
+
+	// Typing on one of our other devices
+	if common.IsPubKeyEqual(state.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+		return nil
+	}
+
+	message := &common.Message{
+		ChatMessage: state.CurrentMessageState.Message,
+		From:        state.CurrentMessageState.Contact.ID,
+		SigPubKey:   state.CurrentMessageState.PublicKey,
+	}
+
+	chat, err := m.matchChatEntity(message)
+	if err != nil {
+		return err
+	}
+
+	// Don't notify about chats we haven't accepted yet
+	if _, ok := m.allChats.Load(chat.ID); !ok || !chat.Active {
+		return nil
+	}
+
+	allowed, err := m.isMessageAllowedFrom(message.From, chat)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrMessageNotAllowed
+	}
+
+	m.typingIndicators.start(chat.ID, message.From)
+	return nil
+}
@@ -0,0 +1,134 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/protocol/tt"
+)
+
+type typingEvent struct {
+	chatID    string
+	publicKey string
+	typing    bool
+}
+
+func recordTypingEvents(events chan typingEvent) func(chatID string, publicKey string, typing bool) {
+	return func(chatID string, publicKey string, typing bool) {
+		events <- typingEvent{chatID: chatID, publicKey: publicKey, typing: typing}
+	}
+}
+
+func TestTypingIndicatorsExpire(t *testing.T) {
+	events := make(chan typingEvent, 10)
+	indicators := newTypingIndicators(50*time.Millisecond, recordTypingEvents(events))
+
+	indicators.start("chat-id", "alice")
+	require.Equal(t, typingEvent{"chat-id", "alice", true}, <-events)
+	require.Equal(t, []string{"alice"}, indicators.typing("chat-id"))
+
+	select {
+	case event := <-events:
+		require.Equal(t, typingEvent{"chat-id", "alice", false}, event)
+	case <-time.After(time.Second):
+		t.Fatal("typing indicator didn't expire")
+	}
+	require.Empty(t, indicators.typing("chat-id"))
+	require.Empty(t, indicators.timers)
+}
+
+func TestTypingIndicatorsRefresh(t *testing.T) {
+	events := make(chan typingEvent, 10)
+	indicators := newTypingIndicators(200*time.Millisecond, recordTypingEvents(events))
+
+	indicators.start("chat-id", "alice")
+	require.Equal(t, typingEvent{"chat-id", "alice", true}, <-events)
+
+	// Refreshing before the TTL keeps the indicator
+	time.Sleep(150 * time.Millisecond)
+	indicators.start("chat-id", "alice")
+	require.Equal(t, typingEvent{"chat-id", "alice", true}, <-events)
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, []string{"alice"}, indicators.typing("chat-id"))
+	require.Empty(t, events)
+
+	// Stopping notifies right away
+	indicators.stop("chat-id", "alice")
+	require.Equal(t, typingEvent{"chat-id", "alice", false}, <-events)
+	require.Empty(t, indicators.typing("chat-id"))
+
+	// Stopping a member who isn't typing doesn't notify
+	indicators.stop("chat-id", "alice")
+	time.Sleep(250 * time.Millisecond)
+	require.Empty(t, events)
+}
+
+func TestMessengerTypingSuite(t *testing.T) {
+	suite.Run(t, new(MessengerTypingSuite))
+}
+
+type MessengerTypingSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerTypingSuite) TestSendTypingIndicator() {
+	alice := s.m
+	bob := s.newMessenger()
+	_, err := bob.Start()
+	s.Require().NoError(err)
+	defer bob.Shutdown() // nolint: errcheck
+
+	events := make(chan typingEvent, 10)
+	bob.typingIndicators = newTypingIndicators(200*time.Millisecond, recordTypingEvents(events))
+
+	chat := CreatePublicChat("test-typing", alice.transport)
+	s.Require().NoError(alice.SaveChat(chat))
+	_, err = alice.Join(chat)
+	s.Require().NoError(err)
+	bobChat := CreatePublicChat("test-typing", bob.transport)
+	s.Require().NoError(bob.SaveChat(bobChat))
+	_, err = bob.Join(bobChat)
+	s.Require().NoError(err)
+
+	s.Require().Equal(ErrChatNotFound, alice.SendTypingIndicator(context.Background(), "non-existing"))
+	s.Require().NoError(alice.SendTypingIndicator(context.Background(), chat.ID))
+
+	// Typing indicators are not stored
+	chatIDs, err := alice.persistence.ChatIDsWithPendingMessages()
+	s.Require().NoError(err)
+	s.Require().Empty(chatIDs)
+
+	var received typingEvent
+	err = tt.RetryWithBackOff(func() error {
+		response, err := bob.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		s.Require().Empty(response.Messages())
+
+		select {
+		case received = <-events:
+			return nil
+		default:
+			return errors.New("no typing indicator")
+		}
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(typingEvent{chat.ID, alice.myHexIdentity(), true}, received)
+
+	select {
+	case received = <-events:
+		s.Require().Equal(typingEvent{chat.ID, alice.myHexIdentity(), false}, received)
+	case <-time.After(time.Second):
+		s.Fail("typing indicator didn't expire")
+	}
+
+	messages, _, err := bob.MessageByChatID(chat.ID, "", 10)
+	s.Require().NoError(err)
+	s.Require().Empty(messages)
+}
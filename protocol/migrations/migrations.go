@@ -81,6 +81,18 @@
 // 1678800760_add_index_to_raw_messages.up.sql (88B)
 // 1678877478_add_communities_requests_to_join_revealed_addresses_table.up.sql (168B)
 // 1679326850_add_community_token_owners.up.sql (206B)
+// 1679500000_add_user_messages_fts.up.sql (493B)
+// 1679510001_add_communities_requests_to_join_received_at.up.sql (209B)
+// 1679510002_add_communities_event_sequence.up.sql (86B)
+// 1679510003_add_communities_member_activity.up.sql (204B)
+// 1679510004_add_communities_webhooks.up.sql (290B)
+// 1679510007_add_contacts_last_seen.up.sql (66B)
+// 1679510008_add_user_messages_installation_id.up.sql (192B)
+// 1679510009_add_link_previews.up.sql (274B)
+// 1679510010_add_notification_levels.up.sql (156B)
+// 1679510011_add_edit_history.up.sql (216B)
+// 1679510012_add_user_messages_hidden_locally.up.sql (84B)
+// 1679510013_add_message_reports.up.sql (372B)
 // README.md (554B)
 // doc.go (850B)
 
@@ -1771,6 +1783,246 @@ func _1679326850_add_community_token_ownersUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1679500000_add_user_messages_ftsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x90\x4f\x6b\xc3\x30\x0c\xc5\xef\xf9\x14\xba\xb5\x85\x52\x18\x8c\x5d\x4a\x0e\x69\xa2\x64\x86\xcc\x19\x8e\xb3\x1d\x43\xa8\xd5\x12\x96\x26\xa5\x76\xe8\xb6\x4f\x3f\xd7\xdd\xbf\x60\x06\xbb\x09\xe9\xe9\xbd\x9f\x14\x0b\x8c\x24\xc2\x13\x13\xb2\x8a\x72\x90\xd1\x26\x47\x18\x35\x9d\xea\x03\x69\xdd\xec\x49\xd7\x3b\xa3\xa1\x2a\x19\xcf\xc0\x56\xb7\x73\x43\xaf\x66\x09\xaa\xd5\xc7\xae\x79\xab\xfb\xe6\x40\x4b\x30\xc3\x0b\xf5\xed\x3b\x85\x63\xdf\x6e\x07\x45\x77\x37\x8b\x75\x10\x30\x5e\xa2\x90\xc0\xb8\x2c\x7c\xcb\xb9\x1a\xb6\xad\xb2\xab\x9e\xdd\x02\x4a\xcc\x31\x96\x70\x1a\xce\x3f\x8a\xd9\x0c\x52\x51\x3c\x4c\x8d\x6c\x48\x7c\x3d\x40\x0a\x96\x65\x28\xfc\x9c\x5a\x51\x47\x86\x20\x4a\xa5\x1d\x27\xd6\xd9\xaa\x0b\x3e\x15\xc2\x06\x33\xc6\x03\xf8\x9a\xfb\x49\xee\x0b\xcf\xf7\x28\x10\x1c\x38\x84\x30\x74\x6a\xe5\x10\xd7\x01\xf2\xe4\x3f\x28\xe3\x51\x35\xdf\x28\xd5\x63\x72\x51\x17\xa9\x3b\xf0\x6f\xa4\x4f\x9d\x4f\x53\xa2\xbc\xae\x86\xd0\xd3\x79\xe5\xca\x29\xe1\xa5\xfd\x9b\xf0\x03\xcd\x06\x44\x64\xed\x01\x00\x00")
+
+func _1679500000_add_user_messages_ftsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679500000_add_user_messages_ftsUpSql,
+		"1679500000_add_user_messages_fts.up.sql",
+	)
+}
+
+func _1679500000_add_user_messages_ftsUpSql() (*asset, error) {
+	bytes, err := _1679500000_add_user_messages_ftsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679500000_add_user_messages_fts.up.sql", size: 493, mode: os.FileMode(0644), modTime: time.Unix(1679500000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbd, 0xad, 0xfc, 0x64, 0x8d, 0xc6, 0x3a, 0xfa, 0x70, 0x3c, 0x5a, 0xd9, 0x9f, 0x2, 0x9c, 0xb9, 0xa8, 0xd4, 0xa6, 0xe, 0x4c, 0x12, 0xc2, 0x1f, 0xae, 0x98, 0x81, 0x30, 0x58, 0x97, 0xaa, 0xec}}
+	return a, nil
+}
+
+var __1679510001_add_communities_requests_to_join_received_atUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x55\x8d\x31\x0e\xc2\x20\x00\x45\xf7\x9e\xe2\x8f\x6d\xd2\x1b\x38\x51\x82\x91\x88\xd0\x10\x34\xe9\x44\x94\x32\xa0\xb6\x55\x01\x93\xde\xde\x4e\xa6\x5d\xff\xfb\x79\x8f\x6a\x46\x0c\x83\x21\x8d\x60\x70\xd3\x30\xe4\x31\xa4\xe0\xa3\xfd\xf8\x77\xf6\x31\x45\x9b\x26\x7b\x9f\xc2\xb8\x0c\xce\x87\xaf\xef\xed\x35\xa1\x2c\xf0\x3f\xcf\x36\xf4\x68\x84\x6a\x20\x95\x81\x3c\x0b\x51\x2f\xf4\x95\x6f\xcf\xe0\xec\xc3\xcf\xb8\x10\x4d\x0f\x44\x6f\xf0\x5a\xc6\xa5\xd9\xb0\x56\xf3\x13\xd1\x1d\x8e\xac\x43\xb9\xae\xd4\x2b\x6b\x05\x25\x41\x95\xdc\x0b\x4e\x0d\x34\x6b\x05\xa1\xac\xa8\x76\xc5\x0f\xac\x28\xc5\x16\xd1\x00\x00\x00")
+
+func _1679510001_add_communities_requests_to_join_received_atUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510001_add_communities_requests_to_join_received_atUpSql,
+		"1679510001_add_communities_requests_to_join_received_at.up.sql",
+	)
+}
+
+func _1679510001_add_communities_requests_to_join_received_atUpSql() (*asset, error) {
+	bytes, err := _1679510001_add_communities_requests_to_join_received_atUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510001_add_communities_requests_to_join_received_at.up.sql", size: 209, mode: os.FileMode(0644), modTime: time.Unix(1679510001, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7e, 0x22, 0x6d, 0x13, 0xb6, 0x4f, 0xcd, 0x80, 0xba, 0x9d, 0xc3, 0xa9, 0xd2, 0x48, 0x9e, 0xd7, 0xf5, 0xdb, 0xf1, 0x3d, 0x3c, 0x24, 0x6b, 0x9e, 0x7e, 0x7a, 0xa, 0x1d, 0x9f, 0xc3, 0x15, 0x6f}}
+	return a, nil
+}
+
+var __1679510002_add_communities_event_sequenceUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x48\xce\xcf\xcd\x2d\xcd\xcb\x2c\xc9\x4c\x2d\x8e\x47\x62\x2b\x38\xba\xb8\x28\x38\xfb\xfb\x84\xfa\xfa\x29\xa4\x96\xa5\xe6\x95\xc4\x17\xa7\x16\x96\xa6\xe6\x25\xa7\x2a\x78\xfa\x85\x28\xf8\xf9\x03\x71\xa8\x8f\x8f\x82\x8b\xab\x9b\x63\xa8\x4f\x88\x82\x81\x35\x17\x00\x85\x32\xbc\x57\x56\x00\x00\x00")
+
+func _1679510002_add_communities_event_sequenceUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510002_add_communities_event_sequenceUpSql,
+		"1679510002_add_communities_event_sequence.up.sql",
+	)
+}
+
+func _1679510002_add_communities_event_sequenceUpSql() (*asset, error) {
+	bytes, err := _1679510002_add_communities_event_sequenceUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510002_add_communities_event_sequence.up.sql", size: 86, mode: os.FileMode(0644), modTime: time.Unix(1679510002, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xdf, 0xe9, 0x8d, 0x4e, 0xe4, 0x12, 0xb7, 0xae, 0x92, 0x9, 0x8d, 0xe1, 0x1, 0xd8, 0xe3, 0x22, 0x69, 0xa2, 0x3d, 0x5f, 0x57, 0x84, 0x11, 0x9a, 0x54, 0xca, 0xf9, 0xaf, 0x9f, 0x82, 0xd8, 0x94}}
+	return a, nil
+}
+
+var __1679510003_add_communities_member_activityUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6d\x8d\x41\x0b\xc2\x20\x00\x46\xef\xfb\x15\xdf\x71\x83\xfd\x83\x4e\x26\x46\x92\xe9\x10\x0b\x76\x12\x67\x1e\x64\xb3\xa2\xb9\xc0\x7f\x5f\xa7\xb1\xa0\xf3\x7b\xbc\x47\x35\x23\x86\xc1\x90\xbd\x60\xf0\x8f\x94\x96\x7b\xcc\x31\xcc\x36\x85\x34\x84\x97\x75\x3e\xc7\x77\xcc\x05\x75\x85\x95\x17\x1b\x6f\xb8\x12\x4d\x8f\x44\x43\x2a\x03\x79\x11\xa2\xfd\x0a\xcf\x65\x98\xa2\xb7\x63\x28\x7f\xf1\xe4\xe6\xbc\x16\xad\xcb\xe0\xd2\xfc\x08\x9d\xe6\x67\xa2\x7b\x9c\x58\x8f\x7a\x7b\x6b\x37\xe9\x06\x4a\x82\x2a\x79\x10\x9c\x1a\x68\xd6\x09\x42\x59\xd5\xec\xaa\x0f\x1c\x45\xca\x10\xcc\x00\x00\x00")
+
+func _1679510003_add_communities_member_activityUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510003_add_communities_member_activityUpSql,
+		"1679510003_add_communities_member_activity.up.sql",
+	)
+}
+
+func _1679510003_add_communities_member_activityUpSql() (*asset, error) {
+	bytes, err := _1679510003_add_communities_member_activityUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510003_add_communities_member_activity.up.sql", size: 204, mode: os.FileMode(0644), modTime: time.Unix(1679510003, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6e, 0xa9, 0xe2, 0x78, 0x47, 0x71, 0x91, 0x63, 0x49, 0xa6, 0xeb, 0x87, 0x2d, 0x2f, 0x4d, 0xd, 0x60, 0x77, 0x0, 0xa0, 0xf4, 0xf5, 0x87, 0x2, 0x17, 0xd9, 0xcc, 0x7b, 0xe2, 0xf0, 0x10, 0x87}}
+	return a, nil
+}
+
+var __1679510004_add_communities_webhooksUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6d\x8f\xc1\x0a\x82\x40\x14\x45\xf7\x7e\xc5\xa5\x95\x41\x7f\xd0\x6a\x1c\x9f\x24\x4d\x33\x32\x8c\x91\x2b\x21\x1b\x48\x4a\x05\x47\x8b\xfe\xbe\x89\x28\x8c\xdc\xbe\x73\xde\xe5\x5e\xae\x89\x19\x82\x61\x91\x20\x54\x5d\xd3\x8c\x6d\x3d\xd4\xd6\x95\x77\x7b\x3c\x77\xdd\xc5\x21\x0c\x80\xfa\x84\x3d\xd3\x7c\xc3\x34\x32\x9d\xee\x98\x2e\xb0\xa5\x02\x4a\x82\x2b\x99\x88\x94\x1b\x68\xca\x04\xe3\xb4\xf2\xf6\x27\xe6\x51\xfa\xbf\x48\xa8\x08\x52\x19\xc8\x5c\x88\x17\x1d\xfb\xeb\x37\x6c\x7a\x77\xb6\xea\xed\x30\x8b\xec\xcd\xb6\x83\xfb\x43\x88\x29\x61\xb9\x30\x58\x2c\x82\xe5\x3a\x08\xf8\x7b\x4b\x2a\x63\x3a\xcc\x6e\x29\x7f\x9a\xf9\xf6\x73\x52\x38\x95\x7c\xec\x13\x88\xc0\x70\x92\x22\x01\x00\x00")
+
+func _1679510004_add_communities_webhooksUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510004_add_communities_webhooksUpSql,
+		"1679510004_add_communities_webhooks.up.sql",
+	)
+}
+
+func _1679510004_add_communities_webhooksUpSql() (*asset, error) {
+	bytes, err := _1679510004_add_communities_webhooksUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510004_add_communities_webhooks.up.sql", size: 290, mode: os.FileMode(0644), modTime: time.Unix(1679510004, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x54, 0x2f, 0x80, 0x3d, 0xa4, 0x84, 0x23, 0x83, 0x55, 0x7a, 0xf9, 0xcc, 0x24, 0x32, 0x7e, 0xc7, 0x58, 0xc, 0x81, 0xcb, 0x6f, 0xb2, 0x9c, 0x15, 0x5c, 0xf, 0x65, 0x66, 0xda, 0xe5, 0x33, 0xaf}}
+	return a, nil
+}
+
+var __1679510007_add_contacts_last_seenUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x48\xce\xcf\x2b\x49\x4c\x2e\x29\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\xc8\x49\x2c\x2e\x89\x2f\x4e\x4d\xcd\x53\xf0\xf4\x0b\x51\xf0\xf3\x07\xe2\x50\x1f\x1f\x05\x17\x57\x37\xc7\x50\x9f\x10\x05\x03\x6b\x2e\x00\x00\xd6\xb0\x1c\x42\x00\x00\x00")
+
+func _1679510007_add_contacts_last_seenUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510007_add_contacts_last_seenUpSql,
+		"1679510007_add_contacts_last_seen.up.sql",
+	)
+}
+
+func _1679510007_add_contacts_last_seenUpSql() (*asset, error) {
+	bytes, err := _1679510007_add_contacts_last_seenUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510007_add_contacts_last_seen.up.sql", size: 66, mode: os.FileMode(0644), modTime: time.Unix(1679510007, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x44, 0xd8, 0x48, 0x60, 0xdd, 0xb6, 0x66, 0xf5, 0x26, 0x83, 0x9e, 0xdd, 0x99, 0x8d, 0x95, 0x69, 0x3, 0x26, 0x62, 0xee, 0x44, 0x3f, 0xca, 0x8e, 0xeb, 0x7e, 0x34, 0x17, 0xaa, 0x42, 0x40, 0xf1}}
+	return a, nil
+}
+
+var __1679510008_add_user_messages_installation_idUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5d\x8e\x41\x0a\xc2\x30\x14\x05\xf7\x3d\xc5\xdb\x55\xc1\x1b\x74\xf5\x4d\x7e\x31\x10\x13\x4c\x13\xe9\x2e\x04\x5a\x24\xd0\x56\x30\x15\x3c\xbe\xe2\xae\xdd\x0f\x33\x43\xda\xb3\x83\xa7\xb3\x66\xbc\xcb\xf8\x8a\xf3\x58\x4a\x7a\x8c\x05\x24\x25\x84\xd5\xe1\x6a\x90\x97\xb2\xa6\x69\x4a\x6b\x7e\x2e\x31\x0f\xb8\x93\x13\x17\x72\x30\xd6\xc3\x04\xad\x21\xb9\xa5\xa0\x3d\xea\xba\xa9\x84\x63\xf2\x8c\x60\xd4\x2d\x30\x94\x91\xdc\x43\xb5\x7f\x96\x7b\xd5\xf9\x0e\x79\xf8\xc4\x4d\xeb\xe7\x8c\xfb\x86\x35\xdb\x9f\x43\x1e\x4e\xfb\x91\x63\x53\x7d\x01\x1b\xad\xa2\x55\xc0\x00\x00\x00")
+
+func _1679510008_add_user_messages_installation_idUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510008_add_user_messages_installation_idUpSql,
+		"1679510008_add_user_messages_installation_id.up.sql",
+	)
+}
+
+func _1679510008_add_user_messages_installation_idUpSql() (*asset, error) {
+	bytes, err := _1679510008_add_user_messages_installation_idUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510008_add_user_messages_installation_id.up.sql", size: 192, mode: os.FileMode(0644), modTime: time.Unix(1679510008, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfd, 0x65, 0xb4, 0x12, 0x84, 0x12, 0x63, 0x78, 0xf1, 0x49, 0x62, 0x3, 0x61, 0x6e, 0xda, 0x2e, 0x9c, 0xc5, 0xcf, 0xfb, 0xb6, 0xdc, 0x59, 0xfc, 0xdf, 0xd6, 0x94, 0x49, 0xcc, 0xd4, 0x81, 0x3f}}
+	return a, nil
+}
+
+var __1679510009_add_link_previewsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5d\x8e\x4d\x0e\x82\x30\x18\x05\xf7\x9c\xe2\x2d\x31\xf1\x06\xae\x4a\xfd\x88\x8d\xb5\x90\x52\x0d\xac\x1a\x22\x35\x10\x89\x31\x50\x7f\x8e\x2f\xca\x42\xeb\x76\x5e\xde\x64\xb8\x26\x66\x08\x86\x25\x92\x20\x52\xa8\xcc\x80\x4a\x51\x98\x02\x7d\x77\x39\xdb\xeb\xe0\xee\x9d\x7b\x8c\x88\x23\xe0\x36\xf4\xb6\xad\xc7\x16\x07\xa6\xf9\x86\x69\xe4\x5a\xec\x98\xae\xb0\xa5\x0a\x99\x02\xcf\x54\x2a\x05\x37\xd0\x94\x4b\xc6\x69\x39\x7f\x60\xa8\x34\x1f\xb3\xda\x4b\xf9\x86\x4d\xed\x6b\x24\x32\x4b\x02\x7a\x72\xfe\xd8\xba\xc6\xd6\x1e\x42\x85\x07\xef\xfb\x80\x45\x8b\x55\x14\xf1\xb9\x5d\xa8\x35\x95\x7f\xed\x5d\xf3\xb4\x41\xbf\xfd\x91\x4f\xa5\xc1\x16\x7f\xb7\x49\xfb\x02\x31\x60\x22\x0f\x12\x01\x00\x00")
+
+func _1679510009_add_link_previewsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510009_add_link_previewsUpSql,
+		"1679510009_add_link_previews.up.sql",
+	)
+}
+
+func _1679510009_add_link_previewsUpSql() (*asset, error) {
+	bytes, err := _1679510009_add_link_previewsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510009_add_link_previews.up.sql", size: 274, mode: os.FileMode(0644), modTime: time.Unix(1679510009, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1a, 0xb1, 0xd6, 0x62, 0x3a, 0xab, 0x62, 0xc5, 0xc7, 0xd2, 0x81, 0xaf, 0x3f, 0x39, 0x6f, 0xd1, 0x16, 0x97, 0x4d, 0x87, 0x96, 0xb2, 0x5e, 0x20, 0x4e, 0x51, 0xa2, 0xde, 0xf2, 0x52, 0xa1, 0x69}}
+	return a, nil
+}
+
+var __1679510010_add_notification_levelsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x55\xcc\xb1\x0a\xc2\x30\x14\x46\xe1\x3d\x4f\xf1\x8f\x0a\x0e\xee\x4e\xd7\x78\x8b\xc1\x6b\x5a\xd2\x5b\xb1\x53\x29\xb1\x62\xb0\x34\x83\xc5\xe7\x57\xbb\xb9\x1e\xf8\x8e\x0d\x4c\xca\x50\xda\x0b\xc3\x15\xf0\xa5\x82\xaf\xae\xd6\x1a\x53\x9e\xd3\x3d\xc5\x7e\x4e\x79\xea\xc6\xe1\x3d\x8c\x2f\xac\x0c\x10\x1f\xfd\xdc\xa5\x1b\x2e\x14\xec\x91\x02\xaa\xe0\xce\x14\x5a\x9c\xb8\x45\xe9\x61\x4b\x5f\x88\xb3\x8a\xc0\x95\x90\xe5\xcd\x97\x2c\x1a\xce\xeb\xf2\xf7\x8d\xc8\xaf\xc6\x31\xc7\xe7\x5f\xc5\x81\x0b\x6a\x44\xb1\x35\xeb\x9d\xf9\x00\x59\x54\xec\xa8\x9c\x00\x00\x00")
+
+func _1679510010_add_notification_levelsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510010_add_notification_levelsUpSql,
+		"1679510010_add_notification_levels.up.sql",
+	)
+}
+
+func _1679510010_add_notification_levelsUpSql() (*asset, error) {
+	bytes, err := _1679510010_add_notification_levelsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510010_add_notification_levels.up.sql", size: 156, mode: os.FileMode(0644), modTime: time.Unix(1679510010, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbf, 0x7d, 0xd7, 0x8, 0xd7, 0x31, 0xd2, 0xbe, 0x14, 0xc3, 0x3f, 0xf5, 0x78, 0xfe, 0xb0, 0x4d, 0xe3, 0x6f, 0x19, 0xc9, 0x51, 0xd7, 0xf7, 0xad, 0xd0, 0xf2, 0xe2, 0x4, 0x67, 0x71, 0x47, 0xe4}}
+	return a, nil
+}
+
+var __1679510011_add_edit_historyUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6d\xcd\xb1\x0e\x82\x30\x14\x85\xe1\x9d\xa7\x38\x23\x24\xbc\x81\x53\x6d\x2e\xb1\xb1\x16\x52\xae\x06\x26\x62\xa0\x51\xa2\x52\x63\xbb\xf0\xf6\x1a\x16\x35\x3a\x7f\xe7\xe4\x97\x96\x04\x13\x58\xac\x35\x41\x15\x30\x25\x83\x1a\x55\x73\x0d\x37\x8c\xb1\x3b\x8f\x21\xfa\xc7\x8c\x34\x01\x6e\x2e\x84\xe3\xc9\x75\xe3\x80\x83\xb0\x72\x23\xec\x32\x37\x7b\xad\xf3\x17\xf7\x7e\x8a\x6e\x8a\x60\x6a\xf8\x1f\x74\x71\xbe\x3b\x28\xf3\x8d\x4b\xa5\xbf\xfa\xfe\xf2\x43\x95\x55\x3b\x61\x5b\x6c\xa9\x45\xfa\x8e\xe7\x1f\x9f\x0c\xa5\x81\x2c\x4d\xa1\x95\x64\x58\xaa\xb4\x90\x94\x64\xab\xe4\x09\xf5\xa4\x1d\x60\xd8\x00\x00\x00")
+
+func _1679510011_add_edit_historyUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510011_add_edit_historyUpSql,
+		"1679510011_add_edit_history.up.sql",
+	)
+}
+
+func _1679510011_add_edit_historyUpSql() (*asset, error) {
+	bytes, err := _1679510011_add_edit_historyUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510011_add_edit_history.up.sql", size: 216, mode: os.FileMode(0644), modTime: time.Unix(1679510011, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x53, 0x29, 0x34, 0x8e, 0xe2, 0x8, 0xf2, 0xf0, 0x65, 0x56, 0x6, 0x4b, 0x14, 0x56, 0xc9, 0xde, 0x6e, 0x32, 0x1c, 0x7a, 0x71, 0xd1, 0x23, 0x68, 0xa, 0x92, 0x38, 0x50, 0xd, 0xff, 0xa, 0x21}}
+	return a, nil
+}
+
+var __1679510012_add_user_messages_hidden_locallyUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x05\xc1\x41\x0a\x80\x20\x10\x05\xd0\x7d\xa7\xf8\xf7\x68\x35\xe5\xb8\x9a\x14\x6a\x5c\x8b\xa4\x54\x60\x05\x49\x8b\x6e\xdf\x7b\x24\xca\x33\x94\x06\x61\xbc\xad\x3c\xf1\x2c\xad\xa5\xad\x34\x90\x31\x18\xbd\x84\xc9\x61\x3f\x72\x2e\x57\xac\xf7\x9a\x6a\xfd\x30\x78\x2f\x4c\x0e\xce\x2b\x5c\x10\x81\x61\x4b\x41\x14\x96\x64\xe1\xbe\xfb\x01\x6e\x58\x5a\xc7\x54\x00\x00\x00")
+
+func _1679510012_add_user_messages_hidden_locallyUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510012_add_user_messages_hidden_locallyUpSql,
+		"1679510012_add_user_messages_hidden_locally.up.sql",
+	)
+}
+
+func _1679510012_add_user_messages_hidden_locallyUpSql() (*asset, error) {
+	bytes, err := _1679510012_add_user_messages_hidden_locallyUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510012_add_user_messages_hidden_locally.up.sql", size: 84, mode: os.FileMode(0644), modTime: time.Unix(1679510012, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf6, 0x3, 0x4f, 0x4, 0xeb, 0xc8, 0x8d, 0x9c, 0xd, 0x82, 0x2f, 0xfe, 0x40, 0x2b, 0x12, 0x32, 0x0, 0x61, 0x3b, 0x0, 0xda, 0xfb, 0x63, 0xaf, 0x77, 0x3b, 0x3c, 0x3b, 0xc6, 0xa8, 0x7b, 0x50}}
+	return a, nil
+}
+
+var __1679510013_add_message_reportsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x90\xcd\x0e\x82\x30\x10\x06\xef\x3c\xc5\x1e\x21\xe1\x0d\x3c\xd5\xba\xc4\xc6\x5a\x48\xa9\x06\x4e\x4d\x23\x8d\x72\x00\x0c\xad\x89\xbe\xbd\x28\xf1\x07\x12\xce\xb3\xfb\x4d\x32\x54\x22\x51\x08\x8a\xac\x39\x02\x4b\x40\xa4\x0a\xb0\x60\xb9\xca\xa1\xb1\xce\x99\xb3\xd5\xbd\xbd\x76\xbd\x77\x10\x06\x00\xa7\xae\x69\x6e\x6d\xed\x1f\xba\xae\xe0\x48\x24\xdd\x12\xf9\xfe\x11\x07\xce\xe3\xd7\xc1\xc5\xf8\x25\xf6\x19\x5c\xc0\xa3\xc7\xf6\x0b\xd0\xb8\xae\x05\x85\x85\x9a\xfa\x06\xe0\x6d\xa5\x8d\x07\x26\xa6\x28\x93\x6c\x4f\x64\x09\x3b\x2c\x21\xfc\xb9\xe3\xaf\x28\x82\x54\x00\x4d\x45\xc2\x19\x55\x20\x31\xe3\x84\x62\x10\xad\x82\x80\x8e\x55\x98\xd8\x60\x31\xab\x52\x57\x77\x3d\x2b\xa3\x27\x55\x86\xcd\x19\x0f\xff\xf9\x30\xff\x04\x2e\xb8\xcc\x65\x74\x01\x00\x00")
+
+func _1679510013_add_message_reportsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1679510013_add_message_reportsUpSql,
+		"1679510013_add_message_reports.up.sql",
+	)
+}
+
+func _1679510013_add_message_reportsUpSql() (*asset, error) {
+	bytes, err := _1679510013_add_message_reportsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1679510013_add_message_reports.up.sql", size: 372, mode: os.FileMode(0644), modTime: time.Unix(1679510013, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x19, 0x26, 0x7, 0x0, 0x7e, 0xd7, 0x7, 0xd9, 0x79, 0x4c, 0x49, 0x33, 0xd8, 0xb2, 0xe4, 0xb8, 0x76, 0x24, 0x44, 0xfb, 0x65, 0x2d, 0x2, 0xec, 0xe7, 0xf8, 0x97, 0xec, 0xdb, 0xcb, 0x6, 0xe1}}
+	return a, nil
+}
+
 var _readmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\xc1\xce\xd3\x30\x10\x84\xef\x7e\x8a\x91\x7a\x01\xa9\x2a\x8f\xc0\x0d\x71\x82\x03\x48\x1c\xc9\x36\x9e\x36\x96\x1c\x6f\xf0\xae\x93\xe6\xed\x91\xa3\xc2\xdf\xff\x66\xed\xd8\x33\xdf\x78\x4f\xa7\x13\xbe\xea\x06\x57\x6c\x35\x39\x31\xa7\x7b\x15\x4f\x5a\xec\x73\x08\xbf\x08\x2d\x79\x7f\x4a\x43\x5b\x86\x17\xfd\x8c\x21\xea\x56\x5e\x47\x90\x4a\x14\x75\x48\xde\x64\x37\x2c\x6a\x96\xae\x99\x48\x05\xf6\x27\x77\x13\xad\x08\xae\x8a\x51\xe7\x25\xf3\xf1\xa9\x9f\xf9\x58\x58\x2c\xad\xbc\xe0\x8b\x56\xf0\x21\x5d\xeb\x4c\x95\xb3\xae\x84\x60\xd4\xdc\xe6\x82\x5d\x1b\x36\x6d\x39\x62\x92\xf5\xb8\x11\xdb\x92\xd3\x28\xce\xe0\x13\xe1\x72\xcd\x3c\x63\xd4\x65\x87\xae\xac\xe8\xc3\x28\x2e\x67\x44\x66\x3a\x21\x25\xa2\x72\xac\x14\x67\xbc\x84\x9f\x53\x32\x8c\x52\x70\x25\x56\xd6\xfd\x8d\x05\x37\xad\x30\x9d\x9f\xa6\x86\x0f\xcd\x58\x7f\xcf\x34\x93\x3b\xed\x90\x9f\xa4\x1f\xcf\x30\x85\x4d\x07\x58\xaf\x7f\x25\xc4\x9d\xf3\x72\x64\x84\xd0\x7f\xf9\x9b\x3a\x2d\x84\xef\x85\x48\x66\x8d\xd8\x88\x9b\x8c\x8c\x98\x5b\xf6\x74\x14\x4e\x33\x0d\xc9\xe0\x93\x38\xda\x12\xc5\x69\xbd\xe4\xf0\x2e\x7a\x78\x07\x1c\xfe\x13\x9f\x91\x29\x31\x95\x7b\x7f\x62\x59\x37\xb4\xe5\x5e\x25\xfe\x33\xee\xd5\x53\x71\xd6\xda\x3a\xd8\xcb\xde\x2e\xf8\xa1\x90\x55\x53\x0c\xc7\xaa\x0d\xe9\x76\x14\x29\x1c\x7b\x68\xdd\x2f\xe1\x6f\x00\x00\x00\xff\xff\x3c\x0a\xc2\xfe\x2a\x02\x00\x00")
 
 func readmeMdBytes() ([]byte, error) {
@@ -1983,6 +2235,18 @@ var _bindata = map[string]func() (*asset, error){
 	"1678800760_add_index_to_raw_messages.up.sql":                                 _1678800760_add_index_to_raw_messagesUpSql,
 	"1678877478_add_communities_requests_to_join_revealed_addresses_table.up.sql": _1678877478_add_communities_requests_to_join_revealed_addresses_tableUpSql,
 	"1679326850_add_community_token_owners.up.sql":                                _1679326850_add_community_token_ownersUpSql,
+	"1679500000_add_user_messages_fts.up.sql":                                     _1679500000_add_user_messages_ftsUpSql,
+	"1679510001_add_communities_requests_to_join_received_at.up.sql":              _1679510001_add_communities_requests_to_join_received_atUpSql,
+	"1679510002_add_communities_event_sequence.up.sql":                            _1679510002_add_communities_event_sequenceUpSql,
+	"1679510003_add_communities_member_activity.up.sql":                           _1679510003_add_communities_member_activityUpSql,
+	"1679510004_add_communities_webhooks.up.sql":                                  _1679510004_add_communities_webhooksUpSql,
+	"1679510007_add_contacts_last_seen.up.sql":                                    _1679510007_add_contacts_last_seenUpSql,
+	"1679510008_add_user_messages_installation_id.up.sql":                         _1679510008_add_user_messages_installation_idUpSql,
+	"1679510009_add_link_previews.up.sql":                                         _1679510009_add_link_previewsUpSql,
+	"1679510010_add_notification_levels.up.sql":                                   _1679510010_add_notification_levelsUpSql,
+	"1679510011_add_edit_history.up.sql":                                          _1679510011_add_edit_historyUpSql,
+	"1679510012_add_user_messages_hidden_locally.up.sql":                          _1679510012_add_user_messages_hidden_locallyUpSql,
+	"1679510013_add_message_reports.up.sql":                                       _1679510013_add_message_reportsUpSql,
 	"README.md": readmeMd,
 	"doc.go":    docGo,
 }
@@ -2112,6 +2376,18 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1678800760_add_index_to_raw_messages.up.sql": {_1678800760_add_index_to_raw_messagesUpSql, map[string]*bintree{}},
 	"1678877478_add_communities_requests_to_join_revealed_addresses_table.up.sql": {_1678877478_add_communities_requests_to_join_revealed_addresses_tableUpSql, map[string]*bintree{}},
 	"1679326850_add_community_token_owners.up.sql": {_1679326850_add_community_token_ownersUpSql, map[string]*bintree{}},
+	"1679500000_add_user_messages_fts.up.sql": {_1679500000_add_user_messages_ftsUpSql, map[string]*bintree{}},
+	"1679510001_add_communities_requests_to_join_received_at.up.sql": {_1679510001_add_communities_requests_to_join_received_atUpSql, map[string]*bintree{}},
+	"1679510002_add_communities_event_sequence.up.sql": {_1679510002_add_communities_event_sequenceUpSql, map[string]*bintree{}},
+	"1679510003_add_communities_member_activity.up.sql": {_1679510003_add_communities_member_activityUpSql, map[string]*bintree{}},
+	"1679510004_add_communities_webhooks.up.sql": {_1679510004_add_communities_webhooksUpSql, map[string]*bintree{}},
+	"1679510007_add_contacts_last_seen.up.sql": {_1679510007_add_contacts_last_seenUpSql, map[string]*bintree{}},
+	"1679510008_add_user_messages_installation_id.up.sql": {_1679510008_add_user_messages_installation_idUpSql, map[string]*bintree{}},
+	"1679510009_add_link_previews.up.sql": {_1679510009_add_link_previewsUpSql, map[string]*bintree{}},
+	"1679510010_add_notification_levels.up.sql": {_1679510010_add_notification_levelsUpSql, map[string]*bintree{}},
+	"1679510011_add_edit_history.up.sql": {_1679510011_add_edit_historyUpSql, map[string]*bintree{}},
+	"1679510012_add_user_messages_hidden_locally.up.sql": {_1679510012_add_user_messages_hidden_locallyUpSql, map[string]*bintree{}},
+	"1679510013_add_message_reports.up.sql": {_1679510013_add_message_reportsUpSql, map[string]*bintree{}},
 	"README.md": {readmeMd, map[string]*bintree{}},
 	"doc.go": {docGo, map[string]*bintree{}},
 }}
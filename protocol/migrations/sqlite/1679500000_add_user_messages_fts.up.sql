@@ -0,0 +1,11 @@
+CREATE VIRTUAL TABLE user_messages_fts USING fts4(text, display_name, tokenize=unicode61);
+
+INSERT INTO user_messages_fts(docid, text, display_name) SELECT rowid, text, '' FROM user_messages;
+
+CREATE TRIGGER user_messages_fts_delete AFTER DELETE ON user_messages BEGIN
+  DELETE FROM user_messages_fts WHERE docid = old.rowid;
+END;
+
+CREATE TRIGGER user_messages_fts_update AFTER UPDATE OF text ON user_messages BEGIN
+  UPDATE user_messages_fts SET text = new.text WHERE docid = new.rowid;
+END;
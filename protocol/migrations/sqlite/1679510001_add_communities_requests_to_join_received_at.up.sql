@@ -0,0 +1,6 @@
+CREATE TABLE communities_requests_to_join_received_at (
+  community_id BLOB NOT NULL,
+  public_key VARCHAR NOT NULL,
+  received_at INT NOT NULL,
+  PRIMARY KEY (community_id, public_key) ON CONFLICT REPLACE
+);
@@ -0,0 +1 @@
+ALTER TABLE communities_communities ADD COLUMN event_sequence INT NOT NULL DEFAULT 0;
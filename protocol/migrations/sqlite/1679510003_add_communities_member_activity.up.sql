@@ -0,0 +1,6 @@
+CREATE TABLE communities_member_activity (
+  community_id VARCHAR NOT NULL,
+  public_key VARCHAR NOT NULL,
+  last_activity_at INT NOT NULL,
+  PRIMARY KEY (community_id, public_key) ON CONFLICT REPLACE
+);
@@ -0,0 +1,9 @@
+CREATE TABLE communities_webhooks (
+  id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  community_id BLOB NOT NULL,
+  url VARCHAR NOT NULL,
+  secret VARCHAR NOT NULL,
+  events VARCHAR NOT NULL DEFAULT ""
+);
+
+CREATE INDEX communities_webhooks_community_id ON communities_webhooks(community_id);
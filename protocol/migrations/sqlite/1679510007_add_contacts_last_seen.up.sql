@@ -0,0 +1 @@
+ALTER TABLE contacts ADD COLUMN last_seen INT NOT NULL DEFAULT 0;
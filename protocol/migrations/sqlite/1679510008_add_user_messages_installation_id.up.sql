@@ -0,0 +1,2 @@
+ALTER TABLE user_messages ADD COLUMN installation_id VARCHAR NOT NULL DEFAULT '';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_messages_id_installation_id ON user_messages(id, installation_id);
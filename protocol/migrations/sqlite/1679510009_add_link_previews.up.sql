@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS link_previews (
+  url_hash VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  url TEXT NOT NULL,
+  data BLOB NOT NULL,
+  fetched_at INT NOT NULL,
+  ttl INT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_link_previews_fetched_at ON link_previews(fetched_at);
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS notification_levels (
+  chat_id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  level INT NOT NULL,
+  clock INT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS edit_history (
+  message_id VARCHAR NOT NULL,
+  content TEXT NOT NULL,
+  content_type INT NOT NULL,
+  edit_clock INT NOT NULL,
+  PRIMARY KEY (message_id, edit_clock) ON CONFLICT REPLACE
+);
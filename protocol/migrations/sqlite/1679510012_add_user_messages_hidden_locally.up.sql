@@ -0,0 +1 @@
+ALTER TABLE user_messages ADD COLUMN hidden_locally BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS message_reports (
+  community_id VARCHAR NOT NULL,
+  chat_id VARCHAR NOT NULL,
+  message_id VARCHAR NOT NULL,
+  reporter VARCHAR NOT NULL,
+  reason TEXT NOT NULL,
+  created_at INT NOT NULL,
+  PRIMARY KEY (message_id, reporter) ON CONFLICT REPLACE
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_reports_community_id ON message_reports(community_id);
@@ -0,0 +1,42 @@
+package protocol
+
+import (
+	"database/sql"
+)
+
+// NotificationLevel returns the notification level of the chat along with the
+// clock it was set at, NotificationLevelAll if none was set
+func (db sqlitePersistence) NotificationLevel(chatID string) (NotificationLevel, uint64, error) {
+	var level NotificationLevel
+	var clock uint64
+	err := db.db.QueryRow(`SELECT level, clock FROM notification_levels WHERE chat_id = ?`, chatID).Scan(&level, &clock)
+	if err == sql.ErrNoRows {
+		return NotificationLevelAll, 0, nil
+	}
+	return level, clock, err
+}
+
+// NotificationLevels returns the notification levels set, by chat ID
+func (db sqlitePersistence) NotificationLevels() (map[string]NotificationLevel, error) {
+	rows, err := db.db.Query(`SELECT chat_id, level FROM notification_levels`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	levels := make(map[string]NotificationLevel)
+	for rows.Next() {
+		var chatID string
+		var level NotificationLevel
+		if err := rows.Scan(&chatID, &level); err != nil {
+			return nil, err
+		}
+		levels[chatID] = level
+	}
+	return levels, rows.Err()
+}
+
+func (db sqlitePersistence) SaveNotificationLevel(chatID string, level NotificationLevel, clock uint64) error {
+	_, err := db.db.Exec(`INSERT INTO notification_levels(chat_id, level, clock) VALUES (?, ?, ?)`, chatID, level, clock)
+	return err
+}
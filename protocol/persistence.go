@@ -229,6 +229,11 @@ func (db sqlitePersistence) UnmuteChat(chatID string) error {
 	return err
 }
 
+func (db sqlitePersistence) SetChatHighlight(chatID string, highlight bool) error {
+	_, err := db.db.Exec("UPDATE chats SET highlight = ? WHERE id = ?", highlight, chatID)
+	return err
+}
+
 func (db sqlitePersistence) Chats() ([]*Chat, error) {
 	return db.chats(nil)
 }
@@ -547,7 +552,8 @@ func (db sqlitePersistence) Contacts() ([]*Contact, error) {
 			i.payload,
                         i.clock_value,
 			COALESCE(c.verification_status, 0) as verification_status,
-			COALESCE(t.trust_status, 0) as trust_status
+			COALESCE(t.trust_status, 0) as trust_status,
+			c.last_seen
 		FROM contacts c
 		LEFT JOIN chat_identity_contacts i ON c.id = i.contact_id
 		LEFT JOIN ens_verification_records v ON c.id = v.public_key
@@ -602,6 +608,7 @@ func (db sqlitePersistence) Contacts() ([]*Contact, error) {
 			&identityImageClock,
 			&contact.VerificationStatus,
 			&contact.TrustStatus,
+			&contact.LastSeen,
 		)
 		if err != nil {
 			return nil, err
@@ -705,6 +712,74 @@ func (db sqlitePersistence) Contacts() ([]*Contact, error) {
 	return response, nil
 }
 
+// UpdateContactsLastSeen sets the timestamp of the last message received from
+// each contact, unless a later one was already recorded
+func (db sqlitePersistence) UpdateContactsLastSeen(lastSeen map[string]uint64) (err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	stmt, err := tx.Prepare(`UPDATE contacts SET last_seen = ? WHERE id = ? AND last_seen < ?`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for contactID, timestamp := range lastSeen {
+		if _, err = stmt.Exec(timestamp, contactID, timestamp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type PersonalChatsStats struct {
+	Chats                  int
+	Contacts               int
+	Messages               int
+	OldestMessageTimestamp int64
+}
+
+// PersonalChatsStats returns how many active one-to-one chats and added
+// contacts there are, along with the number of messages in those chats and
+// the timestamp of the oldest one
+func (db sqlitePersistence) PersonalChatsStats() (*PersonalChatsStats, error) {
+	stats := &PersonalChatsStats{}
+
+	personalChatsWhere := `c.active AND c.type = ? AND COALESCE(c.community_id, '') = ''`
+
+	err := db.db.QueryRow(`SELECT COUNT(*) FROM chats c WHERE `+personalChatsWhere, ChatTypeOneToOne).Scan(&stats.Chats)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.db.QueryRow(`
+		SELECT COUNT(*), COALESCE(MIN(m.timestamp), 0)
+		FROM user_messages m
+		JOIN chats c ON m.local_chat_id = c.id
+		WHERE NOT(m.hide) AND `+personalChatsWhere, ChatTypeOneToOne).Scan(&stats.Messages, &stats.OldestMessageTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.db.QueryRow(`SELECT COUNT(*) FROM contacts WHERE contact_request_state = ? AND NOT(blocked)`, ContactRequestStateSent).Scan(&stats.Contacts)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
 func (db sqlitePersistence) SaveContactChatIdentity(contactID string, chatIdentity *protobuf.ChatIdentity) (clockUpdated, imagesUpdated bool, err error) {
 	if chatIdentity.Clock == 0 {
 		return false, false, errors.New("clock value unset")
@@ -845,6 +920,36 @@ func (db sqlitePersistence) ExpiredMessagesIDs(maxSendCount int) ([]string, erro
 	return ids, nil
 }
 
+// ChatIDsWithPendingMessages returns the IDs of the chats having chat messages
+// which were sent at least once but never confirmed as sent
+func (db sqlitePersistence) ChatIDsWithPendingMessages() ([]string, error) {
+	rows, err := db.db.Query(`
+			SELECT
+			  local_chat_id
+			FROM
+				raw_messages
+			WHERE
+			message_type = ? AND sent = ? AND send_count > 0
+			GROUP BY local_chat_id`,
+		protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
+		false)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var chatIDs []string
+	for rows.Next() {
+		var chatID string
+		if err := rows.Scan(&chatID); err != nil {
+			return nil, err
+		}
+		chatIDs = append(chatIDs, chatID)
+	}
+
+	return chatIDs, rows.Err()
+}
+
 func (db sqlitePersistence) SaveContact(contact *Contact, tx *sql.Tx) (err error) {
 	if tx == nil {
 		tx, err = db.db.BeginTx(context.Background(), &sql.TxOptions{})
@@ -882,10 +987,11 @@ func (db sqlitePersistence) SaveContact(contact *Contact, tx *sql.Tx) (err error
 			blocked,
 			removed,
 			verification_status,
+			last_seen,
 			name,
 			photo,
 			tribute_to_talk
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`)
 	if err != nil {
 		return
@@ -908,6 +1014,7 @@ func (db sqlitePersistence) SaveContact(contact *Contact, tx *sql.Tx) (err error
 		contact.Blocked,
 		contact.Removed,
 		contact.VerificationStatus,
+		contact.LastSeen,
 		//TODO we need to drop these columns
 		"",
 		"",
@@ -1528,3 +1635,39 @@ func (db *sqlitePersistence) DeleteWalletConnectSession(peerID string) error {
 	_, err = deleteStatement.Exec(peerID)
 	return err
 }
+
+// ChatIDsOrderedByLastActivity returns the IDs of the active chats, the ones
+// with the most recent message first. The chats of spectated communities come after
+// all the other chats.
+func (db sqlitePersistence) ChatIDsOrderedByLastActivity(limit int, offset int) ([]string, error) {
+	rows, err := db.db.Query(`
+			SELECT
+			  chats.id
+			FROM
+				chats
+			LEFT JOIN communities_communities communities ON chats.community_id = '0x' || lower(hex(communities.id))
+			WHERE
+			  chats.active = 1
+			ORDER BY
+			  COALESCE(communities.spectated AND NOT communities.joined, 0),
+			  chats.timestamp DESC,
+			  chats.id
+			LIMIT ? OFFSET ?`,
+		limit,
+		offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var chatIDs []string
+	for rows.Next() {
+		var chatID string
+		if err := rows.Scan(&chatID); err != nil {
+			return nil, err
+		}
+		chatIDs = append(chatIDs, chatID)
+	}
+
+	return chatIDs, rows.Err()
+}
@@ -2,7 +2,9 @@ package protocol
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
+	"fmt"
 	"io/ioutil"
 	"math"
 	"sort"
@@ -560,6 +562,85 @@ func TestMessageReplies(t *testing.T) {
 	require.Equal(t, &common.QuotedMessage{ID: "id-4", Deleted: true}, retrievedMessages[0].QuotedMessage)
 }
 
+func TestThreadMessages(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+	chatID := testPublicChatID
+	threadID := "root"
+	pageSize := 30
+
+	messages := []*common.Message{
+		{
+			ID:          threadID,
+			LocalChatID: chatID,
+			ChatMessage: protobuf.ChatMessage{Clock: 1},
+			From:        testPK,
+		},
+		// Messages from other threads or chats are not returned
+		{
+			ID:          "other-root",
+			LocalChatID: chatID,
+			ChatMessage: protobuf.ChatMessage{Clock: 2},
+			From:        testPK,
+		},
+		{
+			ID:          "other-thread-reply",
+			LocalChatID: chatID,
+			ChatMessage: protobuf.ChatMessage{Clock: 3, ResponseTo: "other-root"},
+			From:        testPK,
+		},
+		{
+			ID:          "other-chat-reply",
+			LocalChatID: "other-chat",
+			ChatMessage: protobuf.ChatMessage{Clock: 3, ResponseTo: threadID},
+			From:        testPK,
+		},
+	}
+
+	// Replies share clocks in pairs, so that the message ID is used to break ties
+	for i := 0; i < 59; i++ {
+		messages = append(messages, &common.Message{
+			ID:          fmt.Sprintf("reply-%02d", i),
+			LocalChatID: chatID,
+			ChatMessage: protobuf.ChatMessage{
+				Clock:      uint64(10 + i/2),
+				ResponseTo: threadID,
+			},
+			From: testPK,
+		})
+	}
+
+	err = p.SaveMessages(messages)
+	require.NoError(t, err)
+
+	firstPage, cursor, err := p.ThreadMessages(context.Background(), chatID, threadID, "", pageSize)
+	require.NoError(t, err)
+	require.Len(t, firstPage, pageSize)
+	require.NotEmpty(t, cursor)
+
+	secondPage, cursor, err := p.ThreadMessages(context.Background(), chatID, threadID, cursor, pageSize)
+	require.NoError(t, err)
+	require.Len(t, secondPage, pageSize)
+	require.Empty(t, cursor)
+
+	result := append(firstPage, secondPage...)
+	require.Equal(t, threadID, result[0].ID)
+
+	seen := make(map[string]bool)
+	for i, message := range result {
+		require.False(t, seen[message.ID], "duplicate message %s", message.ID)
+		seen[message.ID] = true
+
+		if i > 0 {
+			require.Equal(t, threadID, message.ResponseTo)
+			require.Equal(t, fmt.Sprintf("reply-%02d", i-1), message.ID)
+			require.LessOrEqual(t, result[i-1].Clock, message.Clock)
+		}
+	}
+	require.Len(t, seen, 60)
+}
+
 func TestMessageByChatIDWithTheSameClocks(t *testing.T) {
 	db, err := openTestDB()
 	require.NoError(t, err)
@@ -620,6 +701,75 @@ func TestMessageByChatIDWithTheSameClocks(t *testing.T) {
 	require.EqualValues(t, expectedClocks, resultClocks)
 }
 
+func TestSearchMessages(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	var messages []*common.Message
+	for i := 0; i < 100; i++ {
+		text := "just a regular message " + strconv.Itoa(i)
+		if i%40 == 7 {
+			text = "a message about Kangaroos " + strconv.Itoa(i)
+		}
+		messages = append(messages, &common.Message{
+			ID:          strconv.Itoa(i),
+			LocalChatID: testPublicChatID,
+			ChatMessage: protobuf.ChatMessage{
+				Clock: uint64(i),
+				Text:  text,
+			},
+			From: testPK,
+		})
+	}
+	messages = append(messages, &common.Message{
+		ID:          "other-chat-message",
+		LocalChatID: "other-chat",
+		ChatMessage: protobuf.ChatMessage{
+			Text:        "kangaroos in another chat",
+			DisplayName: "skippy",
+		},
+		From: testPK,
+	})
+	require.NoError(t, p.SaveMessages(messages))
+
+	result, err := p.SearchMessages(context.Background(), "kangaroos", testPublicChatID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	require.Equal(t, "87", result[0].ID)
+	require.Equal(t, "47", result[1].ID)
+	require.Equal(t, "7", result[2].ID)
+
+	// Paginates results
+	result, err = p.SearchMessages(context.Background(), "kangaroos", testPublicChatID, 2, 2)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "7", result[0].ID)
+
+	// Searches all chats and author display names
+	result, err = p.SearchMessages(context.Background(), "kangaroos", "", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, result, 4)
+
+	result, err = p.SearchMessages(context.Background(), "skippy", "", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "other-chat-message", result[0].ID)
+
+	// Replaced and deleted messages are removed from the index
+	messages[7].Text = "edited"
+	require.NoError(t, p.SaveMessages([]*common.Message{messages[7]}))
+	require.NoError(t, p.DeleteMessage("47"))
+
+	result, err = p.SearchMessages(context.Background(), "kangaroos", testPublicChatID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "87", result[0].ID)
+
+	_, err = p.SearchMessages(context.Background(), " \"\" ", "", 10, 0)
+	require.Equal(t, ErrEmptySearchQuery, err)
+}
+
 func TestDeleteMessageByID(t *testing.T) {
 	db, err := openTestDB()
 	require.NoError(t, err)
@@ -664,6 +814,44 @@ func TestDeleteMessagesByChatID(t *testing.T) {
 
 }
 
+func TestEditHistoryDeletedWithMessages(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	insertEditedMessage := func(id string) {
+		require.NoError(t, insertMinimalMessage(p, id))
+		require.NoError(t, p.SaveMessageEdit(id, &MessageEdit{Content: "original-text", Clock: 1}))
+	}
+	requireHistoryLen := func(id string, length int) {
+		history, err := p.MessageEditHistory(id)
+		require.NoError(t, err)
+		require.Len(t, history, length)
+	}
+
+	insertEditedMessage("1")
+	insertEditedMessage("2")
+	require.NoError(t, p.DeleteMessage("1"))
+	requireHistoryLen("1", 0)
+	requireHistoryLen("2", 1)
+
+	// Deleted for everyone
+	require.NoError(t, insertMinimalDeletedMessage(p, "2"))
+	requireHistoryLen("2", 0)
+
+	// Chat history cleared
+	insertEditedMessage("3")
+	require.NoError(t, p.DeleteMessagesByChatID(testPublicChatID))
+	requireHistoryLen("3", 0)
+
+	// Purged
+	insertEditedMessage("4")
+	deleted, err := p.PurgeMessagesOlderThan(context.Background(), testPublicChatID, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+	requireHistoryLen("4", 0)
+}
+
 func TestMarkMessageSeen(t *testing.T) {
 	chatID := "test-chat"
 	db, err := openTestDB()
@@ -765,6 +953,50 @@ func TestExpiredMessagesIDs(t *testing.T) {
 	require.Equal(t, 1, len(ids))
 }
 
+func TestChatIDsWithPendingMessages(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	chatIDs, err := p.ChatIDsWithPendingMessages()
+	require.NoError(t, err)
+	require.Empty(t, chatIDs)
+
+	// Not sent yet
+	queued := minimalRawMessage("queued-message-id", protobuf.ApplicationMetadataMessage_CHAT_MESSAGE)
+	require.NoError(t, p.SaveRawMessage(queued))
+
+	// Not a chat message
+	reaction := minimalRawMessage("emoji-message-id", protobuf.ApplicationMetadataMessage_EMOJI_REACTION)
+	reaction.SendCount = 1
+	require.NoError(t, p.SaveRawMessage(reaction))
+
+	chatIDs, err = p.ChatIDsWithPendingMessages()
+	require.NoError(t, err)
+	require.Empty(t, chatIDs)
+
+	stuck := minimalRawMessage("stuck-message-id", protobuf.ApplicationMetadataMessage_CHAT_MESSAGE)
+	stuck.SendCount = 2
+	require.NoError(t, p.SaveRawMessage(stuck))
+
+	stuck2 := minimalRawMessage("stuck-message-id2", protobuf.ApplicationMetadataMessage_CHAT_MESSAGE)
+	stuck2.SendCount = 1
+	require.NoError(t, p.SaveRawMessage(stuck2))
+
+	chatIDs, err = p.ChatIDsWithPendingMessages()
+	require.NoError(t, err)
+	require.Equal(t, []string{"test-chat"}, chatIDs)
+
+	stuck.Sent = true
+	require.NoError(t, p.SaveRawMessage(stuck))
+	stuck2.Sent = true
+	require.NoError(t, p.SaveRawMessage(stuck2))
+
+	chatIDs, err = p.ChatIDsWithPendingMessages()
+	require.NoError(t, err)
+	require.Empty(t, chatIDs)
+}
+
 func TestPersistenceEmojiReactions(t *testing.T) {
 	db, err := openTestDB()
 	require.NoError(t, err)
@@ -1202,6 +1434,93 @@ func TestHideMessage(t *testing.T) {
 	require.True(t, actualSeen)
 }
 
+func TestHideMessageLocally(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+	chatID := testPublicChatID
+
+	var messages []*common.Message
+	for i, id := range []string{"root", "hidden", "reply"} {
+		message := &common.Message{
+			ID:          id,
+			LocalChatID: chatID,
+			ChatMessage: protobuf.ChatMessage{
+				Clock:       uint64(i + 1),
+				Timestamp:   uint64(i + 1),
+				Text:        "kangaroo " + id,
+				ContentType: protobuf.ChatMessage_IMAGE,
+			},
+			From: testPK,
+			Seen: id == "root",
+		}
+		if id != "root" {
+			message.ResponseTo = "root"
+		}
+		messages = append(messages, message)
+	}
+	require.NoError(t, p.SaveMessages(messages))
+
+	var pinMessages []*common.PinMessage
+	for _, id := range []string{"root", "hidden"} {
+		pinMessage := &common.PinMessage{ID: "pin-" + id, LocalChatID: chatID, From: testPK}
+		pinMessage.MessageId = id
+		pinMessage.Clock = 1
+		pinMessage.Pinned = true
+		pinMessages = append(pinMessages, pinMessage)
+	}
+	require.NoError(t, p.SavePinMessages(pinMessages))
+
+	require.NoError(t, p.HideMessageLocally("hidden"))
+
+	requireVisible := func(ids []string) {
+		require.ElementsMatch(t, []string{"root", "reply"}, ids)
+	}
+	messageIDs := func(messages []*common.Message) (ids []string) {
+		for _, m := range messages {
+			ids = append(ids, m.ID)
+		}
+		return ids
+	}
+
+	firstUnseen, err := p.FirstUnseenMessageID(chatID)
+	require.NoError(t, err)
+	require.Equal(t, "reply", firstUnseen)
+
+	result, err := p.SearchMessages(context.Background(), "kangaroo", chatID, 10, 0)
+	require.NoError(t, err)
+	requireVisible(messageIDs(result))
+
+	result, err = p.AllMessageByChatIDWhichMatchTerm(chatID, "kangaroo", false)
+	require.NoError(t, err)
+	requireVisible(messageIDs(result))
+
+	result, err = p.AllMessagesFromChatsAndCommunitiesWhichMatchTerm(nil, []string{chatID}, "kangaroo", false)
+	require.NoError(t, err)
+	requireVisible(messageIDs(result))
+
+	result, _, err = p.MessagesByChatIDInTimeRange(chatID, 0, 0, "", 10)
+	require.NoError(t, err)
+	requireVisible(messageIDs(result))
+
+	result, _, err = p.ThreadMessages(context.Background(), chatID, "root", "", 10)
+	require.NoError(t, err)
+	requireVisible(messageIDs(result))
+
+	attachments, _, err := p.MediaAttachmentsByChatID(chatID, protobuf.ChatMessage_IMAGE, "", 10)
+	require.NoError(t, err)
+	var attachmentIDs []string
+	for _, a := range attachments {
+		attachmentIDs = append(attachmentIDs, a.MessageID)
+	}
+	requireVisible(attachmentIDs)
+
+	pinned, _, err := p.PinnedMessageByChatID(chatID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, pinned, 1)
+	require.Equal(t, "root", pinned[0].Message.ID)
+}
+
 func TestDeactivatePublicChat(t *testing.T) {
 	db, err := openTestDB()
 	require.NoError(t, err)
@@ -1624,3 +1943,294 @@ func TestSaveHashRatchetMessage(t *testing.T) {
 	require.Len(t, fetchedMessages, 1)
 	require.Equal(t, fetchedMessages[0], message1)
 }
+
+func saveTestReactions(t *testing.T, p *sqlitePersistence, chatID string, from string, emoji protobuf.EmojiReaction_Type, count int, clock uint64) {
+	for i := 0; i < count; i++ {
+		require.NoError(t, p.SaveEmojiReaction(&EmojiReaction{
+			EmojiReaction: protobuf.EmojiReaction{
+				Clock:     clock,
+				MessageId: fmt.Sprintf("%s-%s-%d", chatID, from, i),
+				ChatId:    chatID,
+				Type:      emoji,
+			},
+			LocalChatID: chatID,
+			From:        from,
+		}))
+	}
+}
+
+func TestTopReactions(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+	chatID := testPublicChatID
+
+	saveTestReactions(t, p, chatID, "alice", protobuf.EmojiReaction_LOVE, 2, 100)
+	saveTestReactions(t, p, chatID, "bob", protobuf.EmojiReaction_LOVE, 1, 100)
+	saveTestReactions(t, p, chatID, "alice", protobuf.EmojiReaction_THUMBS_UP, 5, 100)
+	saveTestReactions(t, p, chatID, "bob", protobuf.EmojiReaction_SAD, 1, 100)
+	// Too old
+	saveTestReactions(t, p, chatID, "carol", protobuf.EmojiReaction_SAD, 10, 10)
+	// Other chat
+	saveTestReactions(t, p, "other-chat", "alice", protobuf.EmojiReaction_ANGRY, 10, 100)
+	// Retracted
+	require.NoError(t, p.SaveEmojiReaction(&EmojiReaction{
+		EmojiReaction: protobuf.EmojiReaction{
+			Clock:     100,
+			MessageId: "retracted",
+			ChatId:    chatID,
+			Type:      protobuf.EmojiReaction_ANGRY,
+			Retracted: true,
+		},
+		LocalChatID: chatID,
+		From:        "alice",
+	}))
+
+	result, err := p.TopReactions(context.Background(), chatID, 50)
+	require.NoError(t, err)
+	require.Equal(t, []ReactionCount{
+		{Emoji: "THUMBS_UP", Count: 5},
+		{Emoji: "LOVE", Count: 3},
+		{Emoji: "SAD", Count: 1},
+	}, result)
+
+	result, err = p.TopReactions(context.Background(), chatID, 0)
+	require.NoError(t, err)
+	require.Equal(t, ReactionCount{Emoji: "SAD", Count: 11}, result[0])
+}
+
+func TestReactionLeaderboard(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+	communityID := "0x03community"
+
+	require.NoError(t, p.SaveChats([]*Chat{
+		{ID: communityID + "chat-1", Name: "chat-1", ChatType: ChatTypeCommunityChat, CommunityID: communityID},
+		{ID: communityID + "chat-2", Name: "chat-2", ChatType: ChatTypeCommunityChat, CommunityID: communityID},
+		{ID: "0x03other" + "chat-1", Name: "other", ChatType: ChatTypeCommunityChat, CommunityID: "0x03other"},
+	}))
+
+	saveTestReactions(t, p, communityID+"chat-1", "alice", protobuf.EmojiReaction_LOVE, 2, 100)
+	saveTestReactions(t, p, communityID+"chat-2", "alice", protobuf.EmojiReaction_LAUGH, 2, 100)
+	saveTestReactions(t, p, communityID+"chat-1", "bob", protobuf.EmojiReaction_LOVE, 3, 100)
+	saveTestReactions(t, p, communityID+"chat-2", "carol", protobuf.EmojiReaction_LOVE, 1, 100)
+	saveTestReactions(t, p, communityID+"chat-2", "dave", protobuf.EmojiReaction_LOVE, 10, 10)
+	saveTestReactions(t, p, "0x03otherchat-1", "erin", protobuf.EmojiReaction_LOVE, 10, 100)
+
+	result, err := p.ReactionLeaderboard(context.Background(), communityID, 50, 10)
+	require.NoError(t, err)
+	require.Equal(t, []ReactionLeader{
+		{PublicKey: "alice", Count: 4},
+		{PublicKey: "bob", Count: 3},
+		{PublicKey: "carol", Count: 1},
+	}, result)
+
+	result, err = p.ReactionLeaderboard(context.Background(), communityID, 50, 2)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+}
+
+func TestMediaAttachmentsByChatID(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+	chatID := testPublicChatID
+
+	var messages []*common.Message
+	for i := 0; i < 50; i++ {
+		messages = append(messages, &common.Message{
+			ID:          "image-" + strconv.Itoa(i),
+			LocalChatID: chatID,
+			ChatMessage: protobuf.ChatMessage{
+				Clock:       uint64(i),
+				Timestamp:   uint64(1000 + i),
+				ContentType: protobuf.ChatMessage_IMAGE,
+			},
+			From: testPK,
+		})
+	}
+	for i := 0; i < 20; i++ {
+		messages = append(messages, &common.Message{
+			ID:          "audio-" + strconv.Itoa(i),
+			LocalChatID: chatID,
+			ChatMessage: protobuf.ChatMessage{
+				Clock:       uint64(i),
+				Timestamp:   uint64(2000 + i),
+				ContentType: protobuf.ChatMessage_AUDIO,
+			},
+			From: testPK,
+		})
+	}
+	// Neither a text message nor an image in another chat are returned
+	messages = append(messages, &common.Message{
+		ID:          "text",
+		LocalChatID: chatID,
+		ChatMessage: protobuf.ChatMessage{Clock: 1, ContentType: protobuf.ChatMessage_TEXT_PLAIN},
+		From:        testPK,
+	}, &common.Message{
+		ID:          "other-image",
+		LocalChatID: "other-chat",
+		ChatMessage: protobuf.ChatMessage{Clock: 1, ContentType: protobuf.ChatMessage_IMAGE},
+		From:        testPK,
+	})
+
+	err = p.SaveMessages(messages)
+	require.NoError(t, err)
+
+	var (
+		images []*MediaAttachment
+		cursor string
+	)
+	for {
+		var page []*MediaAttachment
+		page, cursor, err = p.MediaAttachmentsByChatID(chatID, protobuf.ChatMessage_IMAGE, cursor, 20)
+		require.NoError(t, err)
+		images = append(images, page...)
+		if cursor == "" {
+			break
+		}
+	}
+	require.Len(t, images, 50)
+	// Latest first
+	require.Equal(t, "image-49", images[0].MessageID)
+	require.Equal(t, uint64(1049), images[0].Timestamp)
+	require.Equal(t, testPK, images[0].AuthorPublicKey)
+	require.Equal(t, "image-0", images[49].MessageID)
+
+	audios, cursor, err := p.MediaAttachmentsByChatID(chatID, protobuf.ChatMessage_AUDIO, "", 50)
+	require.NoError(t, err)
+	require.Empty(t, cursor)
+	require.Len(t, audios, 20)
+	for _, audio := range audios {
+		require.True(t, strings.HasPrefix(audio.MessageID, "audio-"))
+	}
+
+	_, err = MediaTypeVideo.contentType()
+	require.Equal(t, ErrMediaTypeNotSupported, err)
+}
+
+func TestSaveReceivedMessagesDuplicates(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	message := &common.Message{
+		ID:          "1",
+		LocalChatID: testPublicChatID,
+		ChatMessage: protobuf.ChatMessage{Clock: 1, Text: "hello"},
+		From:        testPK,
+	}
+	other := &common.Message{
+		ID:          "2",
+		LocalChatID: testPublicChatID,
+		ChatMessage: protobuf.ChatMessage{Clock: 2, Text: "world"},
+		From:        testPK,
+	}
+
+	require.NoError(t, p.SaveReceivedMessages([]*common.Message{message}, "installation-1"))
+
+	// Delivered a second time, along with a new message
+	err = p.SaveReceivedMessages([]*common.Message{message, other}, "installation-1")
+	require.Equal(t, ErrDuplicateMessage, err)
+
+	countRows := func(id string) int {
+		var count int
+		require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM user_messages WHERE id = ?", id).Scan(&count))
+		return count
+	}
+	require.Equal(t, 1, countRows(message.ID))
+	// The new message is saved anyway
+	require.Equal(t, 1, countRows(other.ID))
+
+	// Updating the message keeps the installation that received it
+	message.Text = "hello edited"
+	require.NoError(t, p.SaveMessages([]*common.Message{message}))
+	require.Equal(t, 1, countRows(message.ID))
+
+	var installationID string
+	require.NoError(t, db.QueryRow("SELECT installation_id FROM user_messages WHERE id = ?", message.ID).Scan(&installationID))
+	require.Equal(t, "installation-1", installationID)
+
+	err = p.SaveReceivedMessages([]*common.Message{message}, "installation-1")
+	require.Equal(t, ErrDuplicateMessage, err)
+
+	saved, err := p.MessageByID(message.ID)
+	require.NoError(t, err)
+	require.Equal(t, "hello edited", saved.Text)
+}
+
+func TestPersonalChatsStats(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	stats, err := p.PersonalChatsStats()
+	require.NoError(t, err)
+	require.Equal(t, &PersonalChatsStats{}, stats)
+
+	oneToOne := &Chat{ID: "one-to-one", ChatType: ChatTypeOneToOne, Active: true}
+	inactive := &Chat{ID: "inactive", ChatType: ChatTypeOneToOne}
+	public := &Chat{ID: "public", ChatType: ChatTypePublic, Active: true}
+	communityChat := &Chat{ID: "community-chat", ChatType: ChatTypeCommunityChat, Active: true, CommunityID: "0x01"}
+	require.NoError(t, p.SaveChats([]*Chat{oneToOne, inactive, public, communityChat}))
+
+	var messages []*common.Message
+	for i, chat := range []*Chat{oneToOne, oneToOne, oneToOne, inactive, public, communityChat} {
+		messages = append(messages, &common.Message{
+			ID:          strconv.Itoa(i),
+			LocalChatID: chat.ID,
+			ChatMessage: protobuf.ChatMessage{Clock: uint64(i), Timestamp: uint64(100 + i)},
+			From:        testPK,
+		})
+	}
+	require.NoError(t, p.SaveMessages(messages))
+
+	require.NoError(t, p.SaveContact(&Contact{ID: "added", ContactRequestLocalState: ContactRequestStateSent}, nil))
+	require.NoError(t, p.SaveContact(&Contact{ID: "blocked", ContactRequestLocalState: ContactRequestStateSent, Blocked: true}, nil))
+	require.NoError(t, p.SaveContact(&Contact{ID: "not-added"}, nil))
+
+	stats, err = p.PersonalChatsStats()
+	require.NoError(t, err)
+	require.Equal(t, &PersonalChatsStats{
+		Chats:                  1,
+		Contacts:               1,
+		Messages:               3,
+		OldestMessageTimestamp: 100,
+	}, stats)
+}
+
+func TestChatIDsOrderedByLastActivity(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	spectatedCommunityID := []byte{0x01}
+	joinedCommunityID := []byte{0x02}
+	_, err = db.Exec(`INSERT INTO communities_communities (id, description, joined, spectated, verified) VALUES (?, ?, 0, 1, 0), (?, ?, 1, 0, 0)`,
+		spectatedCommunityID, []byte("description"), joinedCommunityID, []byte("description"))
+	require.NoError(t, err)
+
+	for i, timestamp := range []int64{2, 5, 1, 4, 3, 6} {
+		chat := CreatePublicChat(fmt.Sprintf("chat-%d", i), &testTimeSource{})
+		chat.Timestamp = timestamp
+		switch i {
+		case 1:
+			chat.CommunityID = types.EncodeHex(spectatedCommunityID)
+		case 2:
+			chat.CommunityID = types.EncodeHex(joinedCommunityID)
+		case 5:
+			// Deleted chats are left out
+			chat.Active = false
+		}
+		require.NoError(t, p.SaveChat(*chat))
+	}
+
+	chatIDs, err := p.ChatIDsOrderedByLastActivity(10, 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"chat-3", "chat-4", "chat-0", "chat-2", "chat-1"}, chatIDs)
+
+	chatIDs, err = p.ChatIDsOrderedByLastActivity(2, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"chat-4", "chat-0"}, chatIDs)
+}
@@ -86,6 +86,8 @@ const (
 	ApplicationMetadataMessage_CANCEL_CONTACT_VERIFICATION             ApplicationMetadataMessage_Type = 61
 	ApplicationMetadataMessage_SYNC_ALL_KEYCARDS                       ApplicationMetadataMessage_Type = 62
 	ApplicationMetadataMessage_SYNC_KEYCARD_ACTION                     ApplicationMetadataMessage_Type = 63
+	ApplicationMetadataMessage_COMMUNITY_DESCRIPTION_DIFF              ApplicationMetadataMessage_Type = 64
+	ApplicationMetadataMessage_REPORT                                  ApplicationMetadataMessage_Type = 65
 )
 
 var ApplicationMetadataMessage_Type_name = map[int32]string{
@@ -152,6 +154,8 @@ var ApplicationMetadataMessage_Type_name = map[int32]string{
 	61: "CANCEL_CONTACT_VERIFICATION",
 	62: "SYNC_ALL_KEYCARDS",
 	63: "SYNC_KEYCARD_ACTION",
+	64: "COMMUNITY_DESCRIPTION_DIFF",
+	65: "REPORT",
 }
 
 var ApplicationMetadataMessage_Type_value = map[string]int32{
@@ -218,6 +222,8 @@ var ApplicationMetadataMessage_Type_value = map[string]int32{
 	"CANCEL_CONTACT_VERIFICATION":             61,
 	"SYNC_ALL_KEYCARDS":                       62,
 	"SYNC_KEYCARD_ACTION":                     63,
+	"COMMUNITY_DESCRIPTION_DIFF":              64,
+	"REPORT":                                  65,
 }
 
 func (x ApplicationMetadataMessage_Type) String() string {
@@ -296,63 +302,64 @@ func init() {
 }
 
 var fileDescriptor_ad09a6406fcf24c7 = []byte{
-	// 928 bytes of a gzipped FileDescriptorProto
+	// 942 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x6b, 0x73, 0x13, 0x37,
-	0x14, 0x6d, 0x20, 0x4d, 0x40, 0x79, 0x29, 0x22, 0x0f, 0xe7, 0x6d, 0x0c, 0x0d, 0x01, 0x5a, 0xd3,
-	0x42, 0xdb, 0x69, 0x4b, 0x69, 0x47, 0x96, 0x6e, 0x6c, 0xe1, 0x5d, 0x69, 0x91, 0xb4, 0x66, 0xdc,
-	0x2f, 0x9a, 0xa5, 0xb8, 0x4c, 0x66, 0x80, 0x78, 0x88, 0xf9, 0x90, 0x7f, 0xd0, 0x5f, 0xd1, 0xdf,
-	0xda, 0xd1, 0x3e, 0x9d, 0xd8, 0x21, 0x9f, 0x92, 0xbd, 0xf7, 0xe8, 0x4a, 0xe7, 0xdc, 0x73, 0xaf,
-	0x51, 0x23, 0x19, 0x0e, 0xdf, 0x9f, 0xfc, 0x9d, 0x8c, 0x4e, 0x4e, 0x3f, 0xba, 0x0f, 0x83, 0x51,
-	0xf2, 0x36, 0x19, 0x25, 0xee, 0xc3, 0xe0, 0xec, 0x2c, 0x79, 0x37, 0x68, 0x0e, 0x3f, 0x9d, 0x8e,
-	0x4e, 0xc9, 0xad, 0xf4, 0xcf, 0x9b, 0xcf, 0xff, 0x34, 0xfe, 0x5b, 0x41, 0xdb, 0xb4, 0x3a, 0x10,
-	0xe6, 0xf8, 0x30, 0x83, 0x93, 0x5d, 0x74, 0xfb, 0xec, 0xe4, 0xdd, 0xc7, 0x64, 0xf4, 0xf9, 0xd3,
-	0xa0, 0x36, 0x53, 0x9f, 0x39, 0x5a, 0xd4, 0x55, 0x80, 0xd4, 0xd0, 0xfc, 0x30, 0x39, 0x7f, 0x7f,
-	0x9a, 0xbc, 0xad, 0xdd, 0x48, 0x73, 0xc5, 0x27, 0x79, 0x81, 0x66, 0x47, 0xe7, 0xc3, 0x41, 0xed,
-	0x66, 0x7d, 0xe6, 0x68, 0xf9, 0xe9, 0xc3, 0x66, 0x71, 0x5f, 0xf3, 0xea, 0xbb, 0x9a, 0xf6, 0x7c,
-	0x38, 0xd0, 0xe9, 0xb1, 0xc6, 0xbf, 0xcb, 0x68, 0xd6, 0x7f, 0x92, 0x05, 0x34, 0x1f, 0xcb, 0xae,
-	0x54, 0xaf, 0x25, 0xfe, 0x8a, 0x60, 0xb4, 0xc8, 0x3a, 0xd4, 0xba, 0x10, 0x8c, 0xa1, 0x6d, 0xc0,
-	0x33, 0x84, 0xa0, 0x65, 0xa6, 0xa4, 0xa5, 0xcc, 0xba, 0x38, 0xe2, 0xd4, 0x02, 0xbe, 0x41, 0xf6,
-	0xd0, 0x56, 0x08, 0x61, 0x0b, 0xb4, 0xe9, 0x88, 0x28, 0x0f, 0x97, 0x47, 0x6e, 0x92, 0x75, 0xb4,
-	0x1a, 0x51, 0xa1, 0x9d, 0x90, 0xc6, 0xd2, 0x20, 0xa0, 0x56, 0x28, 0x89, 0x67, 0x7d, 0xd8, 0xf4,
-	0x25, 0xbb, 0x18, 0xfe, 0x9a, 0xdc, 0x43, 0x07, 0x1a, 0x5e, 0xc5, 0x60, 0xac, 0xa3, 0x9c, 0x6b,
-	0x30, 0xc6, 0x1d, 0x2b, 0xed, 0xac, 0xa6, 0xd2, 0x50, 0x96, 0x82, 0xe6, 0xc8, 0x23, 0x74, 0x48,
-	0x19, 0x83, 0xc8, 0xba, 0xeb, 0xb0, 0xf3, 0xe4, 0x31, 0x7a, 0xc0, 0x81, 0x05, 0x42, 0xc2, 0xb5,
-	0xe0, 0x5b, 0x64, 0x13, 0xdd, 0x29, 0x40, 0xe3, 0x89, 0xdb, 0x64, 0x0d, 0x61, 0x03, 0x92, 0x5f,
-	0x88, 0x22, 0x72, 0x80, 0x76, 0x2e, 0xd7, 0x1e, 0x07, 0x2c, 0x78, 0x69, 0x26, 0x48, 0xba, 0x5c,
-	0x40, 0xbc, 0x38, 0x3d, 0x4d, 0x19, 0x53, 0xb1, 0xb4, 0x78, 0x89, 0xdc, 0x45, 0x7b, 0x93, 0xe9,
-	0x28, 0x6e, 0x05, 0x82, 0x39, 0xdf, 0x17, 0xbc, 0x4c, 0xf6, 0xd1, 0x76, 0xd1, 0x0f, 0xa6, 0x38,
-	0x38, 0xca, 0x7b, 0xa0, 0xad, 0x30, 0x10, 0x82, 0xb4, 0x78, 0x85, 0x34, 0xd0, 0x7e, 0x14, 0x9b,
-	0x8e, 0x93, 0xca, 0x8a, 0x63, 0xc1, 0xb2, 0x12, 0x1a, 0xda, 0xc2, 0x58, 0x9d, 0x49, 0x8e, 0xbd,
-	0x42, 0x5f, 0xc6, 0x38, 0x0d, 0x26, 0x52, 0xd2, 0x00, 0x5e, 0x25, 0x3b, 0x68, 0x73, 0x12, 0xfc,
-	0x2a, 0x06, 0xdd, 0xc7, 0x84, 0xdc, 0x47, 0xf5, 0x2b, 0x92, 0x55, 0x89, 0x3b, 0x9e, 0xf5, 0xb4,
-	0xfb, 0x52, 0xfd, 0xf0, 0x9a, 0xa7, 0x34, 0x2d, 0x9d, 0x1f, 0x5f, 0xf7, 0x16, 0x84, 0x50, 0xbd,
-	0x14, 0x4e, 0x43, 0xae, 0xf3, 0x06, 0xd9, 0x42, 0xeb, 0x6d, 0xad, 0xe2, 0x28, 0x95, 0xc5, 0x09,
-	0xd9, 0x13, 0x36, 0x63, 0xb7, 0x49, 0x56, 0xd1, 0x52, 0x16, 0xe4, 0x20, 0xad, 0xb0, 0x7d, 0x5c,
-	0xf3, 0x68, 0xa6, 0xc2, 0x30, 0x96, 0xc2, 0xf6, 0x1d, 0x07, 0xc3, 0xb4, 0x88, 0x52, 0xf4, 0x16,
-	0xa9, 0xa1, 0xb5, 0x2a, 0x35, 0x56, 0x67, 0xdb, 0xbf, 0xba, 0xca, 0x94, 0xdd, 0x56, 0xee, 0xa5,
-	0x12, 0x12, 0xef, 0x90, 0x15, 0xb4, 0x10, 0x09, 0x59, 0xda, 0x7e, 0xd7, 0xcf, 0x0e, 0x70, 0x51,
-	0xcd, 0xce, 0x9e, 0x7f, 0x89, 0xb1, 0xd4, 0xc6, 0xa6, 0x18, 0x9d, 0x7d, 0xcf, 0x85, 0x43, 0x00,
-	0x63, 0xf3, 0x72, 0xe0, 0x4d, 0x35, 0xcd, 0x33, 0xf9, 0xd5, 0xb8, 0x4e, 0xb6, 0xd1, 0x06, 0x95,
-	0x4a, 0xf6, 0x43, 0x15, 0x1b, 0x17, 0x82, 0xd5, 0x82, 0xb9, 0x16, 0xb5, 0xac, 0x83, 0xef, 0x96,
-	0x53, 0x95, 0x52, 0xd6, 0x10, 0xaa, 0x1e, 0x70, 0xdc, 0xf0, 0x5d, 0xab, 0xc2, 0xf9, 0x55, 0xc6,
-	0x0b, 0xc8, 0xf1, 0x3d, 0x82, 0xd0, 0x5c, 0x8b, 0xb2, 0x6e, 0x1c, 0xe1, 0xfb, 0xa5, 0x23, 0xbd,
-	0xb2, 0x3d, 0xcf, 0x94, 0x81, 0xb4, 0xa0, 0x33, 0xe8, 0x37, 0xa5, 0x23, 0x2f, 0xa7, 0xb3, 0x69,
-	0x04, 0x8e, 0x0f, 0xbd, 0xe3, 0xa6, 0x42, 0xb8, 0x30, 0xa1, 0x30, 0x06, 0x38, 0x7e, 0x90, 0x2a,
-	0xe1, 0x31, 0x2d, 0xa5, 0xba, 0x21, 0xd5, 0x5d, 0x7c, 0x44, 0x36, 0x10, 0xc9, 0x5e, 0x18, 0x00,
-	0xd5, 0xae, 0x23, 0x8c, 0x55, 0xba, 0x8f, 0x1f, 0x7a, 0x19, 0xd3, 0xb8, 0x01, 0x6b, 0x85, 0x6c,
-	0xe3, 0x47, 0xa4, 0x8e, 0x76, 0xab, 0x46, 0x50, 0xcd, 0x3a, 0xa2, 0x07, 0x2e, 0xa4, 0x6d, 0x09,
-	0x36, 0x10, 0xb2, 0x8b, 0x1f, 0xfb, 0x26, 0xa6, 0x67, 0x22, 0xad, 0x8e, 0x45, 0x00, 0x2e, 0x12,
-	0xcc, 0xc6, 0x1a, 0xf0, 0xb7, 0x7e, 0xbe, 0xd3, 0xcc, 0x6b, 0x1a, 0x04, 0x60, 0xcb, 0x51, 0xfb,
-	0x2e, 0xd5, 0x34, 0xdb, 0x28, 0xc5, 0x38, 0x15, 0x86, 0x6c, 0x7a, 0xf1, 0x34, 0x58, 0x9d, 0xcd,
-	0xd8, 0xc5, 0xe4, 0x13, 0x72, 0x88, 0x1a, 0x57, 0xda, 0xa2, 0x72, 0xed, 0xf7, 0x55, 0x07, 0x4a,
-	0x70, 0xce, 0xc8, 0xe0, 0x1f, 0x3c, 0xa5, 0xe2, 0x68, 0x71, 0x43, 0x0f, 0x74, 0xe9, 0x7e, 0xfc,
-	0xd4, 0x9b, 0xe2, 0xd2, 0xfb, 0x2e, 0x00, 0x9e, 0xf9, 0x12, 0xc5, 0x2a, 0x9a, 0x8a, 0xf8, 0xb1,
-	0xb4, 0x86, 0xd5, 0xb1, 0xb1, 0xc0, 0x5d, 0x6c, 0x40, 0xe3, 0x9f, 0xca, 0x8e, 0x8f, 0xa3, 0x4b,
-	0x7e, 0x3f, 0x97, 0x1d, 0xbf, 0xc4, 0xdc, 0x71, 0x60, 0xc2, 0xf8, 0xc2, 0xbf, 0x64, 0x3b, 0x68,
-	0x8a, 0x04, 0x01, 0xd0, 0x1e, 0xe0, 0x5f, 0x7d, 0x3e, 0x2d, 0x91, 0x3b, 0xdd, 0x6f, 0xdd, 0xb0,
-	0x32, 0xfc, 0x6f, 0x65, 0xeb, 0x0d, 0xed, 0x01, 0x2f, 0x96, 0x33, 0x7e, 0xee, 0xb7, 0x49, 0x55,
-	0x97, 0x51, 0xc9, 0x20, 0x98, 0x18, 0xbc, 0xdf, 0xbd, 0x32, 0x79, 0x6e, 0x2a, 0xef, 0x17, 0x25,
-	0x6f, 0x1a, 0x04, 0xae, 0x0b, 0x7d, 0x46, 0x35, 0x37, 0xf8, 0x8f, 0xd2, 0x0a, 0x79, 0xc8, 0xe5,
-	0xbb, 0xe4, 0xcf, 0xd6, 0xd2, 0x5f, 0x0b, 0xcd, 0x27, 0xcf, 0x8b, 0xdf, 0xcf, 0x37, 0x73, 0xe9,
-	0x7f, 0xcf, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x4d, 0xc7, 0x99, 0x73, 0xe6, 0x07, 0x00, 0x00,
+	0x14, 0x6d, 0x80, 0x26, 0xa0, 0xbc, 0x14, 0x91, 0x87, 0xf3, 0x36, 0x86, 0x86, 0x00, 0xad, 0x69,
+	0xa1, 0xed, 0xb4, 0xa5, 0xb4, 0x95, 0xa5, 0x6b, 0x5b, 0x78, 0x57, 0x5a, 0x24, 0xad, 0x19, 0xf7,
+	0x8b, 0x66, 0x29, 0x2e, 0x93, 0x19, 0x20, 0x1e, 0x62, 0x3e, 0xe4, 0x7f, 0xf5, 0x57, 0xf4, 0x57,
+	0x75, 0xb4, 0x4f, 0x27, 0x76, 0xca, 0xa7, 0x64, 0xef, 0x3d, 0xba, 0xd2, 0x39, 0xf7, 0xdc, 0x6b,
+	0xd4, 0x48, 0x46, 0xa3, 0x77, 0x27, 0x7f, 0x25, 0xe3, 0x93, 0xd3, 0x0f, 0xee, 0xfd, 0x70, 0x9c,
+	0xbc, 0x49, 0xc6, 0x89, 0x7b, 0x3f, 0x3c, 0x3b, 0x4b, 0xde, 0x0e, 0x9b, 0xa3, 0x8f, 0xa7, 0xe3,
+	0x53, 0x72, 0x33, 0xfd, 0xf3, 0xfa, 0xd3, 0xdf, 0x8d, 0x7f, 0x57, 0xd1, 0x0e, 0xad, 0x0e, 0x84,
+	0x39, 0x3e, 0xcc, 0xe0, 0x64, 0x0f, 0xdd, 0x3a, 0x3b, 0x79, 0xfb, 0x21, 0x19, 0x7f, 0xfa, 0x38,
+	0xac, 0xcd, 0xd5, 0xe7, 0x8e, 0x97, 0x74, 0x15, 0x20, 0x35, 0xb4, 0x30, 0x4a, 0xce, 0xdf, 0x9d,
+	0x26, 0x6f, 0x6a, 0xd7, 0xd2, 0x5c, 0xf1, 0x49, 0x9e, 0xa3, 0x1b, 0xe3, 0xf3, 0xd1, 0xb0, 0x76,
+	0xbd, 0x3e, 0x77, 0xbc, 0xf2, 0xe4, 0x41, 0xb3, 0xb8, 0xaf, 0x79, 0xf5, 0x5d, 0x4d, 0x7b, 0x3e,
+	0x1a, 0xea, 0xf4, 0x58, 0xe3, 0x9f, 0x15, 0x74, 0xc3, 0x7f, 0x92, 0x45, 0xb4, 0x10, 0xcb, 0x9e,
+	0x54, 0xaf, 0x24, 0xfe, 0x82, 0x60, 0xb4, 0xc4, 0xba, 0xd4, 0xba, 0x10, 0x8c, 0xa1, 0x1d, 0xc0,
+	0x73, 0x84, 0xa0, 0x15, 0xa6, 0xa4, 0xa5, 0xcc, 0xba, 0x38, 0xe2, 0xd4, 0x02, 0xbe, 0x46, 0xf6,
+	0xd1, 0x76, 0x08, 0x61, 0x0b, 0xb4, 0xe9, 0x8a, 0x28, 0x0f, 0x97, 0x47, 0xae, 0x93, 0x0d, 0xb4,
+	0x16, 0x51, 0xa1, 0x9d, 0x90, 0xc6, 0xd2, 0x20, 0xa0, 0x56, 0x28, 0x89, 0x6f, 0xf8, 0xb0, 0x19,
+	0x48, 0x76, 0x31, 0xfc, 0x25, 0xb9, 0x8b, 0x0e, 0x35, 0xbc, 0x8c, 0xc1, 0x58, 0x47, 0x39, 0xd7,
+	0x60, 0x8c, 0x6b, 0x2b, 0xed, 0xac, 0xa6, 0xd2, 0x50, 0x96, 0x82, 0xe6, 0xc9, 0x43, 0x74, 0x44,
+	0x19, 0x83, 0xc8, 0xba, 0xcf, 0x61, 0x17, 0xc8, 0x23, 0x74, 0x9f, 0x03, 0x0b, 0x84, 0x84, 0xcf,
+	0x82, 0x6f, 0x92, 0x2d, 0x74, 0xbb, 0x00, 0x4d, 0x26, 0x6e, 0x91, 0x75, 0x84, 0x0d, 0x48, 0x7e,
+	0x21, 0x8a, 0xc8, 0x21, 0xda, 0xbd, 0x5c, 0x7b, 0x12, 0xb0, 0xe8, 0xa5, 0x99, 0x22, 0xe9, 0x72,
+	0x01, 0xf1, 0xd2, 0xec, 0x34, 0x65, 0x4c, 0xc5, 0xd2, 0xe2, 0x65, 0x72, 0x07, 0xed, 0x4f, 0xa7,
+	0xa3, 0xb8, 0x15, 0x08, 0xe6, 0x7c, 0x5f, 0xf0, 0x0a, 0x39, 0x40, 0x3b, 0x45, 0x3f, 0x98, 0xe2,
+	0xe0, 0x28, 0xef, 0x83, 0xb6, 0xc2, 0x40, 0x08, 0xd2, 0xe2, 0x55, 0xd2, 0x40, 0x07, 0x51, 0x6c,
+	0xba, 0x4e, 0x2a, 0x2b, 0xda, 0x82, 0x65, 0x25, 0x34, 0x74, 0x84, 0xb1, 0x3a, 0xfd, 0xc0, 0xd8,
+	0x2b, 0xf4, 0xff, 0x18, 0xa7, 0xc1, 0x44, 0x4a, 0x1a, 0xc0, 0x6b, 0x64, 0x17, 0x6d, 0x4d, 0x83,
+	0x5f, 0xc6, 0xa0, 0x07, 0x98, 0x90, 0x7b, 0xa8, 0x7e, 0x45, 0xb2, 0x2a, 0x71, 0xdb, 0xb3, 0x9e,
+	0x75, 0x5f, 0xaa, 0x1f, 0x5e, 0xf7, 0x94, 0x66, 0xa5, 0xf3, 0xe3, 0x1b, 0xde, 0x82, 0x10, 0xaa,
+	0x17, 0xc2, 0x69, 0xc8, 0x75, 0xde, 0x24, 0xdb, 0x68, 0xa3, 0xa3, 0x55, 0x1c, 0xa5, 0xb2, 0x38,
+	0x21, 0xfb, 0xc2, 0x66, 0xec, 0xb6, 0xc8, 0x1a, 0x5a, 0xce, 0x82, 0x1c, 0xa4, 0x15, 0x76, 0x80,
+	0x6b, 0x1e, 0xcd, 0x54, 0x18, 0xc6, 0x52, 0xd8, 0x81, 0xe3, 0x60, 0x98, 0x16, 0x51, 0x8a, 0xde,
+	0x26, 0x35, 0xb4, 0x5e, 0xa5, 0x26, 0xea, 0xec, 0xf8, 0x57, 0x57, 0x99, 0xb2, 0xdb, 0xca, 0xbd,
+	0x50, 0x42, 0xe2, 0x5d, 0xb2, 0x8a, 0x16, 0x23, 0x21, 0x4b, 0xdb, 0xef, 0xf9, 0xd9, 0x01, 0x2e,
+	0xaa, 0xd9, 0xd9, 0xf7, 0x2f, 0x31, 0x96, 0xda, 0xd8, 0x14, 0xa3, 0x73, 0xe0, 0xb9, 0x70, 0x08,
+	0x60, 0x62, 0x5e, 0x0e, 0xbd, 0xa9, 0x66, 0x79, 0x26, 0xbf, 0x1a, 0xd7, 0xc9, 0x0e, 0xda, 0xa4,
+	0x52, 0xc9, 0x41, 0xa8, 0x62, 0xe3, 0x42, 0xb0, 0x5a, 0x30, 0xd7, 0xa2, 0x96, 0x75, 0xf1, 0x9d,
+	0x72, 0xaa, 0x52, 0xca, 0x1a, 0x42, 0xd5, 0x07, 0x8e, 0x1b, 0xbe, 0x6b, 0x55, 0x38, 0xbf, 0xca,
+	0x78, 0x01, 0x39, 0xbe, 0x4b, 0x10, 0x9a, 0x6f, 0x51, 0xd6, 0x8b, 0x23, 0x7c, 0xaf, 0x74, 0xa4,
+	0x57, 0xb6, 0xef, 0x99, 0x32, 0x90, 0x16, 0x74, 0x06, 0xfd, 0xaa, 0x74, 0xe4, 0xe5, 0x74, 0x36,
+	0x8d, 0xc0, 0xf1, 0x91, 0x77, 0xdc, 0x4c, 0x08, 0x17, 0x26, 0x14, 0xc6, 0x00, 0xc7, 0xf7, 0x53,
+	0x25, 0x3c, 0xa6, 0xa5, 0x54, 0x2f, 0xa4, 0xba, 0x87, 0x8f, 0xc9, 0x26, 0x22, 0xd9, 0x0b, 0x03,
+	0xa0, 0xda, 0x75, 0x85, 0xb1, 0x4a, 0x0f, 0xf0, 0x03, 0x2f, 0x63, 0x1a, 0x37, 0x60, 0xad, 0x90,
+	0x1d, 0xfc, 0x90, 0xd4, 0xd1, 0x5e, 0xd5, 0x08, 0xaa, 0x59, 0x57, 0xf4, 0xc1, 0x85, 0xb4, 0x23,
+	0xc1, 0x06, 0x42, 0xf6, 0xf0, 0x23, 0xdf, 0xc4, 0xf4, 0x4c, 0xa4, 0x55, 0x5b, 0x04, 0xe0, 0x22,
+	0xc1, 0x6c, 0xac, 0x01, 0x7f, 0xed, 0xe7, 0x3b, 0xcd, 0xbc, 0xa2, 0x41, 0x00, 0xb6, 0x1c, 0xb5,
+	0x6f, 0x52, 0x4d, 0xb3, 0x8d, 0x52, 0x8c, 0x53, 0x61, 0xc8, 0xa6, 0x17, 0x4f, 0x83, 0xd5, 0x94,
+	0x4d, 0x27, 0x1f, 0x93, 0x23, 0xd4, 0xb8, 0xd2, 0x16, 0x95, 0x6b, 0xbf, 0xad, 0x3a, 0x50, 0x82,
+	0x73, 0x46, 0x06, 0x7f, 0xe7, 0x29, 0x15, 0x47, 0x8b, 0x1b, 0xfa, 0xa0, 0x4b, 0xf7, 0xe3, 0x27,
+	0xde, 0x14, 0x97, 0xde, 0x77, 0x01, 0xf0, 0xd4, 0x97, 0x28, 0x56, 0xd1, 0x4c, 0xc4, 0xf7, 0xa5,
+	0x35, 0xac, 0x8e, 0x8d, 0x05, 0xee, 0x62, 0x03, 0x1a, 0xff, 0x50, 0x76, 0x7c, 0x12, 0x5d, 0xf2,
+	0xfb, 0xb1, 0xec, 0xf8, 0x25, 0xe6, 0x8e, 0x03, 0x13, 0xc6, 0x17, 0xfe, 0x29, 0xdb, 0x41, 0x33,
+	0x24, 0x08, 0x80, 0xf6, 0x01, 0xff, 0xec, 0xf3, 0x69, 0x89, 0xdc, 0xe9, 0x7e, 0xeb, 0x86, 0x95,
+	0xe1, 0x7f, 0x29, 0x5b, 0x6f, 0x68, 0x1f, 0x78, 0xb1, 0x9c, 0xf1, 0x33, 0xbf, 0x4d, 0xaa, 0xba,
+	0x8c, 0x4a, 0x06, 0xc1, 0xd4, 0xe0, 0xfd, 0xea, 0x95, 0xc9, 0x73, 0x33, 0x79, 0x3f, 0x2f, 0x79,
+	0xd3, 0x20, 0x70, 0x3d, 0x18, 0x30, 0xaa, 0xb9, 0xc1, 0xbf, 0x95, 0x56, 0xc8, 0x43, 0x2e, 0xdf,
+	0x25, 0xbf, 0x5f, 0xa4, 0x33, 0xb1, 0x1d, 0x1c, 0x17, 0xed, 0x36, 0xfe, 0xc3, 0x8f, 0x8b, 0x86,
+	0x48, 0x69, 0x8b, 0x69, 0x6b, 0xf9, 0xcf, 0xc5, 0xe6, 0xe3, 0x67, 0xc5, 0x6f, 0xed, 0xeb, 0xf9,
+	0xf4, 0xbf, 0xa7, 0xff, 0x0d, 0x00, 0x39, 0x2c, 0x34, 0xeb, 0x12, 0x08, 0x00, 0x00,
 }
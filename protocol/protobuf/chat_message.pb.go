@@ -67,6 +67,8 @@ const (
 	ChatMessage_CONTACT_REQUEST       ChatMessage_ContentType = 11
 	ChatMessage_DISCORD_MESSAGE       ChatMessage_ContentType = 12
 	ChatMessage_IDENTITY_VERIFICATION ChatMessage_ContentType = 13
+	// Ephemeral, not stored
+	ChatMessage_TYPING ChatMessage_ContentType = 14
 )
 
 var ChatMessage_ContentType_name = map[int32]string{
@@ -84,6 +86,7 @@ var ChatMessage_ContentType_name = map[int32]string{
 	11: "CONTACT_REQUEST",
 	12: "DISCORD_MESSAGE",
 	13: "IDENTITY_VERIFICATION",
+	14: "TYPING",
 }
 
 var ChatMessage_ContentType_value = map[string]int32{
@@ -101,6 +104,7 @@ var ChatMessage_ContentType_value = map[string]int32{
 	"CONTACT_REQUEST":                      11,
 	"DISCORD_MESSAGE":                      12,
 	"IDENTITY_VERIFICATION":                13,
+	"TYPING":                               14,
 }
 
 func (x ChatMessage_ContentType) String() string {
@@ -1083,84 +1087,84 @@ func init() {
 }
 
 var fileDescriptor_263952f55fd35689 = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcb, 0x8e, 0xdb, 0x36,
-	0x17, 0x1e, 0x7b, 0x7c, 0xd3, 0x91, 0xed, 0x11, 0x98, 0x49, 0xa2, 0x04, 0xb9, 0x38, 0x42, 0x80,
-	0xcc, 0xca, 0x3f, 0x90, 0x3f, 0x2d, 0x02, 0x74, 0x51, 0x68, 0x6c, 0x65, 0xa2, 0xa6, 0xbe, 0x94,
-	0x92, 0xd3, 0x4e, 0x37, 0x02, 0x47, 0x62, 0xc6, 0xc2, 0x58, 0x92, 0x2b, 0xd1, 0x6d, 0xdd, 0x7d,
-	0x5f, 0xa2, 0xab, 0x3e, 0x45, 0x9f, 0xa2, 0xdb, 0xbe, 0x42, 0x9f, 0xa0, 0xeb, 0xa2, 0x20, 0x75,
-	0xb1, 0xe4, 0x66, 0x26, 0x45, 0x56, 0xe2, 0x39, 0x3a, 0x87, 0xfc, 0xce, 0xc7, 0x8f, 0x87, 0x04,
-	0xe4, 0x2e, 0x09, 0x73, 0x02, 0x9a, 0x24, 0xe4, 0x92, 0x0e, 0xd7, 0x71, 0xc4, 0x22, 0xd4, 0x11,
-	0x9f, 0x8b, 0xcd, 0xbb, 0xfb, 0x32, 0x0d, 0x37, 0x41, 0x92, 0xba, 0xef, 0xf7, 0xdc, 0x28, 0x64,
-	0xc4, 0x65, 0xa9, 0xa9, 0xbd, 0x84, 0xbe, 0xc5, 0x7c, 0xf7, 0x8a, 0xc6, 0x93, 0x34, 0x1b, 0x21,
-	0x68, 0x2c, 0x49, 0xb2, 0x54, 0x6b, 0x83, 0xda, 0x89, 0x84, 0xc5, 0x98, 0xfb, 0xd6, 0xc4, 0xbd,
-	0x52, 0xeb, 0x83, 0xda, 0x49, 0x13, 0x8b, 0xb1, 0xf6, 0x4b, 0x0d, 0xba, 0x66, 0x40, 0x2e, 0x69,
-	0x9e, 0xa8, 0x42, 0x7b, 0x4d, 0xb6, 0xab, 0x88, 0x78, 0x22, 0xb7, 0x8b, 0x73, 0x13, 0x3d, 0x83,
-	0x06, 0xdb, 0xae, 0xa9, 0x48, 0xef, 0x3f, 0xbf, 0x35, 0xcc, 0x91, 0x0d, 0x45, 0xbe, 0xbd, 0x5d,
-	0x53, 0x2c, 0x02, 0xd0, 0x3d, 0xe8, 0x90, 0xd5, 0xc5, 0x26, 0x70, 0x7c, 0x4f, 0x3d, 0x14, 0xeb,
-	0xb7, 0x85, 0x6d, 0x7a, 0xe8, 0x18, 0x9a, 0x3f, 0xf8, 0x1e, 0x5b, 0xaa, 0x8d, 0x41, 0xed, 0xa4,
-	0x87, 0x53, 0x03, 0xdd, 0x81, 0xd6, 0x92, 0xfa, 0x97, 0x4b, 0xa6, 0x36, 0x85, 0x3b, 0xb3, 0xb4,
-	0xdf, 0x6a, 0xd0, 0xd5, 0x37, 0x9e, 0x1f, 0x7d, 0x18, 0xdc, 0x8b, 0x0a, 0xb8, 0xc1, 0x0e, 0x5c,
-	0x39, 0x3f, 0x35, 0x4a, 0x48, 0x1f, 0x83, 0xec, 0x6d, 0x62, 0xc2, 0xfc, 0x28, 0x74, 0x82, 0x44,
-	0x80, 0x6d, 0x60, 0xc8, 0x5d, 0x93, 0x44, 0xfb, 0x04, 0xa4, 0x22, 0x07, 0xdd, 0x01, 0xb4, 0x98,
-	0xbe, 0x99, 0xce, 0xbe, 0x9e, 0x3a, 0xfa, 0x62, 0x6c, 0xce, 0x1c, 0xfb, 0x7c, 0x6e, 0x28, 0x07,
-	0xa8, 0x0d, 0x87, 0xba, 0x3e, 0x52, 0x6a, 0x62, 0x30, 0xc1, 0x4a, 0x5d, 0xfb, 0xb9, 0x0e, 0xb2,
-	0xe1, 0xf9, 0x2c, 0xc7, 0x7d, 0x0c, 0x4d, 0x77, 0x15, 0xb9, 0x57, 0x02, 0x75, 0x03, 0xa7, 0x06,
-	0xdf, 0x0f, 0x46, 0x7f, 0x64, 0x02, 0xb3, 0x84, 0xc5, 0x18, 0xdd, 0x85, 0xb6, 0x50, 0x41, 0x41,
-	0x5d, 0x8b, 0x9b, 0xa6, 0x87, 0x1e, 0x02, 0x64, 0xca, 0xe0, 0xff, 0x1a, 0xe2, 0x9f, 0x94, 0x79,
-	0x52, 0x62, 0x2f, 0x63, 0x12, 0xa6, 0x0c, 0x76, 0x71, 0x6a, 0xa0, 0x97, 0xd0, 0xcd, 0x93, 0x04,
-	0x3b, 0x2d, 0xc1, 0xce, 0xed, 0x1d, 0x3b, 0x19, 0x40, 0x41, 0x89, 0x1c, 0xec, 0x0c, 0x34, 0x86,
-	0x2e, 0x97, 0x18, 0x0d, 0x59, 0x9a, 0xd9, 0x16, 0x99, 0x4f, 0x76, 0x99, 0xa3, 0x25, 0xc9, 0xcb,
-	0x1b, 0x8e, 0xd2, 0xc8, 0x74, 0x16, 0x77, 0x67, 0x68, 0xbf, 0xd7, 0xa0, 0x37, 0xa6, 0x2b, 0xca,
-	0xe8, 0xcd, 0x4c, 0x94, 0xaa, 0xae, 0xdf, 0x50, 0xf5, 0xe1, 0xb5, 0x55, 0x37, 0x6e, 0xaa, 0xba,
-	0xf9, 0x9f, 0xab, 0x7e, 0x08, 0xe0, 0x09, 0xb8, 0x9e, 0x73, 0xb1, 0x15, 0x6c, 0x49, 0x58, 0xca,
-	0x3c, 0xa7, 0x5b, 0xcd, 0x04, 0x94, 0x56, 0xf3, 0x2a, 0x8a, 0x27, 0x1f, 0x28, 0xa9, 0x8a, 0xbc,
-	0xbe, 0x87, 0x5c, 0xfb, 0xa3, 0x0e, 0xfd, 0xb1, 0x9f, 0xb8, 0x51, 0xec, 0xe5, 0xf3, 0xf4, 0xa1,
-	0xee, 0x7b, 0xd9, 0x81, 0xad, 0xfb, 0x9e, 0x90, 0x47, 0x2e, 0x69, 0x29, 0x13, 0xec, 0x03, 0x90,
-	0x98, 0x1f, 0xd0, 0x84, 0x91, 0x60, 0x9d, 0xd3, 0x51, 0x38, 0xd0, 0x09, 0x1c, 0x15, 0x06, 0x97,
-	0x1f, 0xcd, 0x85, 0xb2, 0xef, 0xe6, 0x07, 0x29, 0xdb, 0x27, 0xc1, 0x8e, 0x84, 0x73, 0x13, 0x7d,
-	0x0a, 0x2d, 0xb2, 0x61, 0xcb, 0x28, 0x16, 0xe5, 0xcb, 0xcf, 0x1f, 0xed, 0x68, 0xab, 0xe2, 0xd5,
-	0x45, 0x14, 0xce, 0xa2, 0xd1, 0xe7, 0x20, 0xc5, 0xf4, 0x1d, 0x8d, 0x69, 0xe8, 0xa6, 0x6a, 0x91,
-	0xcb, 0x6a, 0xa9, 0xa6, 0xe2, 0x3c, 0x10, 0xef, 0x72, 0xd0, 0x18, 0x64, 0xc2, 0x18, 0x71, 0x97,
-	0x01, 0x0d, 0x59, 0xa2, 0x76, 0x06, 0x87, 0x27, 0xf2, 0x73, 0xed, 0xda, 0xd5, 0x8b, 0x50, 0x5c,
-	0x4e, 0xd3, 0xfe, 0xac, 0xc1, 0xf1, 0xfb, 0x70, 0xbe, 0x8f, 0xdd, 0x90, 0x04, 0x05, 0xbb, 0x7c,
-	0x8c, 0x9e, 0x42, 0xcf, 0xf3, 0x13, 0x37, 0xf6, 0x03, 0x3f, 0x24, 0x2c, 0x8a, 0x33, 0x86, 0xab,
-	0x4e, 0x74, 0x1f, 0x3a, 0xa1, 0xef, 0x5e, 0x89, 0xec, 0x94, 0xde, 0xc2, 0xe6, 0xfb, 0x43, 0xbe,
-	0x27, 0x8c, 0xc4, 0x8b, 0x78, 0x95, 0x31, 0xbb, 0x73, 0xa0, 0x21, 0xa0, 0xd4, 0x10, 0x1d, 0x73,
-	0x9e, 0x75, 0xb2, 0x96, 0xd0, 0xee, 0x7b, 0xfe, 0xf0, 0x95, 0x56, 0x91, 0x4b, 0x56, 0x7c, 0xb2,
-	0x76, 0xba, 0x52, 0x6e, 0x6b, 0x11, 0xdc, 0xbd, 0x86, 0x54, 0x0e, 0xa2, 0x10, 0x5a, 0x56, 0x71,
-	0xe9, 0xcc, 0x3c, 0x00, 0xc9, 0x5d, 0x92, 0x30, 0xa4, 0x2b, 0xb3, 0xd0, 0x65, 0xe1, 0xe0, 0xc2,
-	0xb8, 0xdc, 0xf8, 0x2b, 0xcf, 0x2c, 0x5a, 0x77, 0x66, 0x6a, 0x7f, 0xd5, 0x40, 0xbd, 0x6e, 0x0f,
-	0xfe, 0xc5, 0x6e, 0x05, 0xc2, 0xbe, 0xf8, 0x91, 0x02, 0x87, 0x9b, 0x78, 0x95, 0x2d, 0xc0, 0x87,
-	0xbc, 0xd2, 0x77, 0xfe, 0x8a, 0x4e, 0x4b, 0x9c, 0xe6, 0x36, 0xdf, 0x15, 0x3e, 0xb6, 0xfc, 0x9f,
-	0xe8, 0xe9, 0x96, 0xd1, 0x44, 0xf0, 0xda, 0xc0, 0x55, 0x27, 0x1a, 0x40, 0xb9, 0xf3, 0x64, 0x67,
-	0xb7, 0xec, 0x2a, 0x5f, 0x1e, 0xed, 0xea, 0xe5, 0x51, 0xe6, 0xb9, 0xb3, 0xc7, 0xf3, 0xdf, 0x6d,
-	0x90, 0x4b, 0xbd, 0xee, 0x9a, 0xd3, 0x5e, 0x39, 0x97, 0x75, 0xf1, 0xa7, 0x74, 0x2e, 0xf3, 0x46,
-	0x7f, 0x58, 0x6a, 0xf4, 0x8f, 0x41, 0x8e, 0x69, 0xb2, 0x8e, 0xc2, 0x84, 0x3a, 0x2c, 0xca, 0x8a,
-	0x86, 0xdc, 0x65, 0x47, 0xfc, 0x16, 0xa5, 0x61, 0xe2, 0x08, 0x99, 0x65, 0x67, 0x94, 0x86, 0x89,
-	0x60, 0xa4, 0xd4, 0x2e, 0x5b, 0x95, 0x76, 0xb9, 0xdf, 0xf9, 0xda, 0x1f, 0xdd, 0xef, 0x3b, 0x1f,
-	0xd3, 0xef, 0xd1, 0x0b, 0x68, 0x27, 0xe9, 0x3b, 0x44, 0x95, 0x44, 0x0b, 0x50, 0x77, 0x13, 0x54,
-	0x1f, 0x28, 0xaf, 0x0f, 0x70, 0x1e, 0x8a, 0x86, 0xd0, 0xf4, 0xb9, 0xec, 0x55, 0x10, 0x39, 0x77,
-	0xf6, 0x5e, 0x16, 0xbb, 0x8c, 0x34, 0x8c, 0xc7, 0x13, 0x7e, 0x29, 0xab, 0xf2, 0x7e, 0x7c, 0xf9,
-	0xb2, 0xe7, 0xf1, 0x22, 0x0c, 0x3d, 0x02, 0xc9, 0x8d, 0x82, 0x60, 0x13, 0xfa, 0x6c, 0xab, 0x76,
-	0xf9, 0xd6, 0xbf, 0x3e, 0xc0, 0x3b, 0x17, 0x1a, 0xc1, 0x91, 0x97, 0x0a, 0x3b, 0x7f, 0x7c, 0xa9,
-	0xee, 0x3e, 0xfa, 0xaa, 0xf2, 0x5f, 0x1f, 0xe0, 0xbe, 0x57, 0xed, 0xde, 0xc5, 0x55, 0xd4, 0x2b,
-	0x5f, 0x45, 0x4f, 0xa0, 0xeb, 0xf9, 0xc9, 0x7a, 0x45, 0xb6, 0xe9, 0x46, 0xf6, 0x53, 0x59, 0x66,
-	0x3e, 0xb1, 0x99, 0x6b, 0x18, 0x64, 0x8f, 0x39, 0x27, 0xa6, 0xdf, 0x6d, 0x68, 0xc2, 0x9c, 0x75,
-	0x1c, 0xad, 0xc9, 0x25, 0xe1, 0xd7, 0x50, 0xc2, 0x08, 0xa3, 0xea, 0x91, 0x80, 0xf3, 0xac, 0xb4,
-	0x1b, 0x69, 0x06, 0x4e, 0x13, 0xe6, 0x45, 0xbc, 0xc5, 0xc3, 0xf1, 0x43, 0xf7, 0xa6, 0xdf, 0xda,
-	0xaf, 0x75, 0x90, 0x47, 0x95, 0x83, 0x71, 0x9c, 0xbf, 0x6b, 0x46, 0xb3, 0xa9, 0x6d, 0x4c, 0xed,
-	0xfc, 0x65, 0xd3, 0x07, 0xb0, 0x8d, 0x6f, 0x6c, 0x67, 0xfe, 0xa5, 0x6e, 0x4e, 0x95, 0x1a, 0x92,
-	0xa1, 0x6d, 0xd9, 0xe6, 0xe8, 0x8d, 0x81, 0x95, 0x3a, 0x02, 0x68, 0x59, 0xb6, 0x6e, 0x2f, 0x2c,
-	0xe5, 0x10, 0x49, 0xd0, 0x34, 0x26, 0xb3, 0x2f, 0x4c, 0xa5, 0x81, 0xee, 0xc2, 0x2d, 0x1b, 0xeb,
-	0x53, 0x4b, 0x1f, 0xd9, 0xe6, 0x8c, 0xcf, 0x38, 0x99, 0xe8, 0xd3, 0xb1, 0xd2, 0x44, 0x27, 0xf0,
-	0xd4, 0x3a, 0xb7, 0x6c, 0x63, 0xe2, 0x4c, 0x0c, 0xcb, 0xd2, 0xcf, 0x8c, 0x62, 0xb5, 0x39, 0x36,
-	0xdf, 0xea, 0xb6, 0xe1, 0x9c, 0xe1, 0xd9, 0x62, 0xae, 0xb4, 0xf8, 0x6c, 0xe6, 0x44, 0x3f, 0x33,
-	0x94, 0x36, 0x1f, 0x8a, 0xb7, 0x96, 0xd2, 0x41, 0x3d, 0x90, 0xf8, 0x64, 0x8b, 0xa9, 0x69, 0x9f,
-	0x2b, 0x12, 0x7f, 0x8d, 0xed, 0x4d, 0x77, 0xa6, 0xcf, 0x15, 0x40, 0xb7, 0xe0, 0x88, 0xcf, 0xab,
-	0x8f, 0x6c, 0x07, 0x1b, 0x5f, 0x2d, 0x0c, 0xcb, 0x56, 0x64, 0xee, 0x1c, 0x9b, 0xd6, 0x68, 0x86,
-	0xc7, 0x79, 0xb4, 0xd2, 0x45, 0xf7, 0xe0, 0xb6, 0x39, 0x36, 0xa6, 0xb6, 0x69, 0x9f, 0x3b, 0x6f,
-	0x0d, 0x6c, 0xbe, 0x32, 0x47, 0x3a, 0xc7, 0xac, 0xf4, 0x4e, 0xa5, 0xa2, 0x57, 0x9c, 0xf6, 0xbe,
-	0x95, 0x87, 0xff, 0xfb, 0x2c, 0x67, 0xfe, 0xa2, 0x25, 0x46, 0xff, 0xff, 0x27, 0x00, 0x00, 0xff,
-	0xff, 0xbb, 0x9e, 0xc6, 0x61, 0xad, 0x0b, 0x00, 0x00,
+	// 1259 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0xde, 0xfc, 0xc7, 0xc7, 0x49, 0xd6, 0x9a, 0x6e, 0x5b, 0xb7, 0xa2, 0x6d, 0x1a, 0x55, 0xea,
+	0x5e, 0x05, 0x69, 0x29, 0xa8, 0x12, 0x17, 0xc8, 0x9b, 0xb8, 0x5b, 0x53, 0xf2, 0xc3, 0xc4, 0x29,
+	0x2c, 0x37, 0xd6, 0xac, 0x3d, 0xdd, 0x58, 0x1b, 0xdb, 0xc1, 0x9e, 0x00, 0xe1, 0x9e, 0x1b, 0x1e,
+	0x81, 0x67, 0xe0, 0x9a, 0xa7, 0xe0, 0x96, 0x57, 0xe0, 0x09, 0x78, 0x00, 0x34, 0xe3, 0xff, 0xb0,
+	0xbb, 0x45, 0xbd, 0xf2, 0x9c, 0xcf, 0xe7, 0xcc, 0x7c, 0xe7, 0x9b, 0x33, 0x67, 0x06, 0x90, 0xbd,
+	0x22, 0xcc, 0xf2, 0x68, 0x14, 0x91, 0x4b, 0x3a, 0xdc, 0x84, 0x01, 0x0b, 0x50, 0x5b, 0x7c, 0x2e,
+	0xb6, 0xef, 0x1e, 0xca, 0xd4, 0xdf, 0x7a, 0x51, 0x0c, 0x3f, 0xec, 0xda, 0x81, 0xcf, 0x88, 0xcd,
+	0x62, 0x73, 0xf0, 0x12, 0x7a, 0x0b, 0xe6, 0xda, 0x57, 0x34, 0x9c, 0xc4, 0xd1, 0x08, 0x41, 0x7d,
+	0x45, 0xa2, 0x95, 0x5a, 0xe9, 0x57, 0x8e, 0x25, 0x2c, 0xc6, 0x1c, 0xdb, 0x10, 0xfb, 0x4a, 0xad,
+	0xf6, 0x2b, 0xc7, 0x0d, 0x2c, 0xc6, 0x83, 0xdf, 0x2a, 0xd0, 0x31, 0x3c, 0x72, 0x49, 0xd3, 0x40,
+	0x15, 0x5a, 0x1b, 0xb2, 0x5b, 0x07, 0xc4, 0x11, 0xb1, 0x1d, 0x9c, 0x9a, 0xe8, 0x39, 0xd4, 0xd9,
+	0x6e, 0x43, 0x45, 0x78, 0xef, 0xe4, 0xce, 0x30, 0x65, 0x36, 0x14, 0xf1, 0xe6, 0x6e, 0x43, 0xb1,
+	0x70, 0x40, 0x0f, 0xa0, 0x4d, 0xd6, 0x17, 0x5b, 0xcf, 0x72, 0x1d, 0xb5, 0x26, 0xd6, 0x6f, 0x09,
+	0xdb, 0x70, 0xd0, 0x11, 0x34, 0x7e, 0x74, 0x1d, 0xb6, 0x52, 0xeb, 0xfd, 0xca, 0x71, 0x17, 0xc7,
+	0x06, 0xba, 0x07, 0xcd, 0x15, 0x75, 0x2f, 0x57, 0x4c, 0x6d, 0x08, 0x38, 0xb1, 0x06, 0x7f, 0x54,
+	0xa0, 0xa3, 0x6d, 0x1d, 0x37, 0x78, 0x3f, 0xb9, 0x17, 0x25, 0x72, 0xfd, 0x9c, 0x5c, 0x31, 0x3e,
+	0x36, 0x0a, 0x4c, 0x9f, 0x80, 0xec, 0x6c, 0x43, 0xc2, 0xdc, 0xc0, 0xb7, 0xbc, 0x48, 0x90, 0xad,
+	0x63, 0x48, 0xa1, 0x49, 0x34, 0xf8, 0x14, 0xa4, 0x2c, 0x06, 0xdd, 0x03, 0xb4, 0x9c, 0xbe, 0x99,
+	0xce, 0xbe, 0x99, 0x5a, 0xda, 0x72, 0x6c, 0xcc, 0x2c, 0xf3, 0x7c, 0xae, 0x2b, 0x07, 0xa8, 0x05,
+	0x35, 0x4d, 0x1b, 0x29, 0x15, 0x31, 0x98, 0x60, 0xa5, 0x3a, 0xf8, 0xa5, 0x0a, 0xb2, 0xee, 0xb8,
+	0x2c, 0xe5, 0x7d, 0x04, 0x0d, 0x7b, 0x1d, 0xd8, 0x57, 0x82, 0x75, 0x1d, 0xc7, 0x06, 0xdf, 0x0f,
+	0x46, 0x7f, 0x62, 0x82, 0xb3, 0x84, 0xc5, 0x18, 0xdd, 0x87, 0x96, 0xa8, 0x82, 0x4c, 0xba, 0x26,
+	0x37, 0x0d, 0x07, 0x3d, 0x02, 0x48, 0x2a, 0x83, 0xff, 0xab, 0x8b, 0x7f, 0x52, 0x82, 0xc4, 0xc2,
+	0x5e, 0x86, 0xc4, 0x8f, 0x15, 0xec, 0xe0, 0xd8, 0x40, 0x2f, 0xa1, 0x93, 0x06, 0x09, 0x75, 0x9a,
+	0x42, 0x9d, 0xbb, 0xb9, 0x3a, 0x09, 0x41, 0x21, 0x89, 0xec, 0xe5, 0x06, 0x1a, 0x43, 0x87, 0x97,
+	0x18, 0xf5, 0x59, 0x1c, 0xd9, 0x12, 0x91, 0x4f, 0xf3, 0xc8, 0xd1, 0x8a, 0xa4, 0xe9, 0x0d, 0x47,
+	0xb1, 0x67, 0x3c, 0x8b, 0x9d, 0x1b, 0x83, 0x3f, 0x2b, 0xd0, 0x1d, 0xd3, 0x35, 0x65, 0xf4, 0x76,
+	0x25, 0x0a, 0x59, 0x57, 0x6f, 0xc9, 0xba, 0x76, 0x63, 0xd6, 0xf5, 0xdb, 0xb2, 0x6e, 0xfc, 0xef,
+	0xac, 0x1f, 0x01, 0x38, 0x82, 0xae, 0x63, 0x5d, 0xec, 0x84, 0x5a, 0x12, 0x96, 0x12, 0xe4, 0x74,
+	0x37, 0x30, 0x00, 0xc5, 0xd9, 0xbc, 0x0a, 0xc2, 0xc9, 0x7b, 0x52, 0x2a, 0x33, 0xaf, 0xee, 0x31,
+	0x1f, 0xfc, 0x55, 0x85, 0xde, 0xd8, 0x8d, 0xec, 0x20, 0x74, 0xd2, 0x79, 0x7a, 0x50, 0x75, 0x9d,
+	0xe4, 0xc0, 0x56, 0x5d, 0x47, 0x94, 0x47, 0x5a, 0xd2, 0x52, 0x52, 0xb0, 0x1f, 0x81, 0xc4, 0x5c,
+	0x8f, 0x46, 0x8c, 0x78, 0x9b, 0x54, 0x8e, 0x0c, 0x40, 0xc7, 0x70, 0x98, 0x19, 0xbc, 0xfc, 0x68,
+	0x5a, 0x28, 0xfb, 0x30, 0x3f, 0x48, 0xc9, 0x3e, 0x09, 0x75, 0x24, 0x9c, 0x9a, 0xe8, 0x33, 0x68,
+	0x92, 0x2d, 0x5b, 0x05, 0xa1, 0x48, 0x5f, 0x3e, 0x79, 0x9c, 0xcb, 0x56, 0xe6, 0xab, 0x09, 0x2f,
+	0x9c, 0x78, 0xa3, 0x2f, 0x40, 0x0a, 0xe9, 0x3b, 0x1a, 0x52, 0xdf, 0x8e, 0xab, 0x45, 0x3e, 0x79,
+	0x7a, 0x53, 0x28, 0x4e, 0x1d, 0x71, 0x1e, 0x83, 0xc6, 0x20, 0x13, 0xc6, 0x88, 0xbd, 0xf2, 0xa8,
+	0xcf, 0x22, 0xb5, 0xdd, 0xaf, 0x1d, 0xcb, 0x27, 0x83, 0x1b, 0x57, 0xcf, 0x5c, 0x71, 0x31, 0x6c,
+	0xf0, 0x77, 0x05, 0x8e, 0xae, 0xe3, 0x79, 0x9d, 0xba, 0x3e, 0xf1, 0x32, 0x75, 0xf9, 0x18, 0x3d,
+	0x83, 0xae, 0xe3, 0x46, 0x76, 0xe8, 0x7a, 0xae, 0x4f, 0x58, 0x10, 0x26, 0x0a, 0x97, 0x41, 0xf4,
+	0x10, 0xda, 0xbe, 0x6b, 0x5f, 0x89, 0xe8, 0x58, 0xde, 0xcc, 0xe6, 0xfb, 0x43, 0x7e, 0x20, 0x8c,
+	0x84, 0xcb, 0x70, 0x9d, 0x28, 0x9b, 0x03, 0x68, 0x08, 0x28, 0x36, 0x44, 0xc7, 0x9c, 0x27, 0x9d,
+	0xac, 0x29, 0x6a, 0xf7, 0x9a, 0x3f, 0x7c, 0xa5, 0x75, 0x60, 0x93, 0x35, 0x9f, 0xac, 0x15, 0xaf,
+	0x94, 0xda, 0x83, 0x00, 0xee, 0xdf, 0x20, 0x2a, 0x27, 0x91, 0x15, 0x5a, 0x92, 0x71, 0x0e, 0xf0,
+	0xbf, 0xf6, 0x8a, 0xf8, 0x3e, 0x5d, 0x1b, 0x59, 0x5d, 0x66, 0x00, 0x2f, 0x8c, 0xcb, 0xad, 0xbb,
+	0x76, 0x8c, 0xac, 0x75, 0x27, 0xe6, 0xe0, 0x9f, 0x0a, 0xa8, 0x37, 0xed, 0xc1, 0x7f, 0xd4, 0x2d,
+	0x51, 0xd8, 0x2f, 0x7e, 0xa4, 0x40, 0x6d, 0x1b, 0xae, 0x93, 0x05, 0xf8, 0x90, 0x67, 0xfa, 0xce,
+	0x5d, 0xd3, 0x69, 0x41, 0xd3, 0xd4, 0xe6, 0xbb, 0xc2, 0xc7, 0x0b, 0xf7, 0x67, 0x7a, 0xba, 0x63,
+	0x34, 0x12, 0xba, 0xd6, 0x71, 0x19, 0x44, 0x7d, 0x28, 0x76, 0x9e, 0xe4, 0xec, 0x16, 0xa1, 0xe2,
+	0xe5, 0xd1, 0x2a, 0x5f, 0x1e, 0x45, 0x9d, 0xdb, 0x7b, 0x3a, 0xff, 0xda, 0x06, 0xb9, 0xd0, 0xeb,
+	0x6e, 0x38, 0xed, 0xa5, 0x73, 0x59, 0x15, 0x7f, 0x72, 0x20, 0x6b, 0xf4, 0xb5, 0x42, 0xa3, 0x7f,
+	0x02, 0x72, 0x48, 0xa3, 0x4d, 0xe0, 0x47, 0xd4, 0x62, 0x41, 0x92, 0x34, 0xa4, 0x90, 0x19, 0xf0,
+	0x5b, 0x94, 0xfa, 0x91, 0x25, 0xca, 0x2c, 0x39, 0xa3, 0xd4, 0x8f, 0x84, 0x22, 0x85, 0x76, 0xd9,
+	0x2c, 0xb5, 0xcb, 0xfd, 0xce, 0xd7, 0xfa, 0xe0, 0x7e, 0xdf, 0xfe, 0x90, 0x7e, 0x8f, 0x5e, 0x40,
+	0x2b, 0x8a, 0xdf, 0x21, 0xaa, 0x24, 0x5a, 0x80, 0x9a, 0x4f, 0x50, 0x7e, 0xa0, 0xbc, 0x3e, 0xc0,
+	0xa9, 0x2b, 0x1a, 0x42, 0xc3, 0xe5, 0x65, 0xaf, 0x82, 0x88, 0xb9, 0xb7, 0xf7, 0xb2, 0xc8, 0x23,
+	0x62, 0x37, 0xee, 0x4f, 0xf8, 0xa5, 0xac, 0xca, 0xfb, 0xfe, 0xc5, 0xcb, 0x9e, 0xfb, 0x0b, 0x37,
+	0xf4, 0x18, 0x24, 0x3b, 0xf0, 0xbc, 0xad, 0xef, 0xb2, 0x9d, 0xda, 0xe1, 0x5b, 0xff, 0xfa, 0x00,
+	0xe7, 0x10, 0x1a, 0xc1, 0xa1, 0x13, 0x17, 0x76, 0xfa, 0xf8, 0x52, 0xed, 0x7d, 0xf6, 0xe5, 0xca,
+	0x7f, 0x7d, 0x80, 0x7b, 0x4e, 0x09, 0xc9, 0xaf, 0xa2, 0x6e, 0xf1, 0x2a, 0x7a, 0x0a, 0x1d, 0xc7,
+	0x8d, 0x36, 0x6b, 0xb2, 0x8b, 0x37, 0xb2, 0x17, 0x97, 0x65, 0x82, 0x89, 0xcd, 0xdc, 0x40, 0x3f,
+	0x79, 0xcc, 0x59, 0x21, 0xfd, 0x7e, 0x4b, 0x23, 0x66, 0x6d, 0xc2, 0x60, 0x43, 0x2e, 0x09, 0xbf,
+	0x86, 0x22, 0x46, 0x18, 0x55, 0x0f, 0x05, 0x9d, 0xe7, 0x85, 0xdd, 0x88, 0x23, 0x70, 0x1c, 0x30,
+	0xcf, 0xfc, 0x17, 0xdc, 0x1d, 0x3f, 0xb2, 0x6f, 0xfb, 0x3d, 0xf8, 0xbd, 0x0a, 0xf2, 0xa8, 0x74,
+	0x30, 0x8e, 0xd2, 0x77, 0xcd, 0x68, 0x36, 0x35, 0xf5, 0xa9, 0x99, 0xbe, 0x6c, 0x7a, 0x00, 0xa6,
+	0xfe, 0xad, 0x69, 0xcd, 0xbf, 0xd2, 0x8c, 0xa9, 0x52, 0x41, 0x32, 0xb4, 0x16, 0xa6, 0x31, 0x7a,
+	0xa3, 0x63, 0xa5, 0x8a, 0x00, 0x9a, 0x0b, 0x53, 0x33, 0x97, 0x0b, 0xa5, 0x86, 0x24, 0x68, 0xe8,
+	0x93, 0xd9, 0x97, 0x86, 0x52, 0x47, 0xf7, 0xe1, 0x8e, 0x89, 0xb5, 0xe9, 0x42, 0x1b, 0x99, 0xc6,
+	0x8c, 0xcf, 0x38, 0x99, 0x68, 0xd3, 0xb1, 0xd2, 0x40, 0xc7, 0xf0, 0x6c, 0x71, 0xbe, 0x30, 0xf5,
+	0x89, 0x35, 0xd1, 0x17, 0x0b, 0xed, 0x4c, 0xcf, 0x56, 0x9b, 0x63, 0xe3, 0xad, 0x66, 0xea, 0xd6,
+	0x19, 0x9e, 0x2d, 0xe7, 0x4a, 0x93, 0xcf, 0x66, 0x4c, 0xb4, 0x33, 0x5d, 0x69, 0xf1, 0xa1, 0x78,
+	0x6b, 0x29, 0x6d, 0xd4, 0x05, 0x89, 0x4f, 0xb6, 0x9c, 0x1a, 0xe6, 0xb9, 0x22, 0xf1, 0xd7, 0xd8,
+	0xde, 0x74, 0x67, 0xda, 0x5c, 0x01, 0x74, 0x07, 0x0e, 0xf9, 0xbc, 0xda, 0xc8, 0xb4, 0xb0, 0xfe,
+	0xf5, 0x52, 0x5f, 0x98, 0x8a, 0xcc, 0xc1, 0xb1, 0xb1, 0x18, 0xcd, 0xf0, 0x38, 0xf5, 0x56, 0x3a,
+	0xe8, 0x01, 0xdc, 0x35, 0xc6, 0xfa, 0xd4, 0x34, 0xcc, 0x73, 0xeb, 0xad, 0x8e, 0x8d, 0x57, 0xc6,
+	0x48, 0xe3, 0x9c, 0x95, 0x2e, 0xcf, 0xcd, 0x3c, 0x9f, 0x1b, 0xd3, 0x33, 0xa5, 0x77, 0x2a, 0x65,
+	0x7d, 0xe3, 0xb4, 0xfb, 0x9d, 0x3c, 0xfc, 0xf8, 0xf3, 0x74, 0x17, 0x2e, 0x9a, 0x62, 0xf4, 0xc9,
+	0xbf, 0x03, 0x00, 0x4e, 0xc4, 0xe4, 0x32, 0xb9, 0x0b, 0x00, 0x00,
 }
@@ -27,6 +27,7 @@ const (
 	CommunityMember_ROLE_ALL              CommunityMember_Roles = 1
 	CommunityMember_ROLE_MANAGE_USERS     CommunityMember_Roles = 2
 	CommunityMember_ROLE_MODERATE_CONTENT CommunityMember_Roles = 3
+	CommunityMember_ROLE_OWNER            CommunityMember_Roles = 4
 )
 
 var CommunityMember_Roles_name = map[int32]string{
@@ -34,6 +35,7 @@ var CommunityMember_Roles_name = map[int32]string{
 	1: "ROLE_ALL",
 	2: "ROLE_MANAGE_USERS",
 	3: "ROLE_MODERATE_CONTENT",
+	4: "ROLE_OWNER",
 }
 
 var CommunityMember_Roles_value = map[string]int32{
@@ -41,6 +43,7 @@ var CommunityMember_Roles_value = map[string]int32{
 	"ROLE_ALL":              1,
 	"ROLE_MANAGE_USERS":     2,
 	"ROLE_MODERATE_CONTENT": 3,
+	"ROLE_OWNER":            4,
 }
 
 func (x CommunityMember_Roles) String() string {
@@ -537,6 +540,7 @@ type CommunityDescription struct {
 	Tags                    []string                             `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
 	TokenPermissions        map[string]*CommunityTokenPermission `protobuf:"bytes,15,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	CommunityTokensMetadata []*CommunityTokenMetadata            `protobuf:"bytes,16,rep,name=community_tokens_metadata,json=communityTokensMetadata,proto3" json:"community_tokens_metadata,omitempty"`
+	EventSequence           uint64                               `protobuf:"varint,17,opt,name=event_sequence,json=eventSequence,proto3" json:"event_sequence,omitempty"`
 	XXX_NoUnkeyedLiteral    struct{}                             `json:"-"`
 	XXX_unrecognized        []byte                               `json:"-"`
 	XXX_sizecache           int32                                `json:"-"`
@@ -672,6 +676,13 @@ func (m *CommunityDescription) GetCommunityTokensMetadata() []*CommunityTokenMet
 	return nil
 }
 
+func (m *CommunityDescription) GetEventSequence() uint64 {
+	if m != nil {
+		return m.EventSequence
+	}
+	return 0
+}
+
 type CommunityAdminSettings struct {
 	PinMessageAllMembersEnabled bool     `protobuf:"varint,1,opt,name=pin_message_all_members_enabled,json=pinMessageAllMembersEnabled,proto3" json:"pin_message_all_members_enabled,omitempty"`
 	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
@@ -717,6 +728,7 @@ type CommunityChat struct {
 	Identity             *ChatIdentity               `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
 	CategoryId           string                      `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	Position             int32                       `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	MaxPinnedMessages    int32                       `protobuf:"varint,6,opt,name=max_pinned_messages,json=maxPinnedMessages,proto3" json:"max_pinned_messages,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
 	XXX_sizecache        int32                       `json:"-"`
@@ -782,6 +794,13 @@ func (m *CommunityChat) GetPosition() int32 {
 	return 0
 }
 
+func (m *CommunityChat) GetMaxPinnedMessages() int32 {
+	if m != nil {
+		return m.MaxPinnedMessages
+	}
+	return 0
+}
+
 type CommunityCategory struct {
 	CategoryId           string   `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -1538,6 +1557,153 @@ func (m *WakuMessageArchiveIndex) GetArchives() map[string]*WakuMessageArchiveIn
 	return nil
 }
 
+type CommunityDescriptionDiff struct {
+	// clock of the description after the diff is applied
+	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	// clock of the description the diff was computed against
+	BaseClock uint64 `protobuf:"varint,2,opt,name=base_clock,json=baseClock,proto3" json:"base_clock,omitempty"`
+	// names of the CommunityDescription fields changed by the diff
+	FieldMask []string `protobuf:"bytes,3,rep,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	// changed fields, fields not listed in field_mask are ignored
+	Description *CommunityDescription `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// signature of the community over the resulting description
+	Signature            []byte   `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityDescriptionDiff) Reset()         { *m = CommunityDescriptionDiff{} }
+func (m *CommunityDescriptionDiff) String() string { return proto.CompactTextString(m) }
+func (*CommunityDescriptionDiff) ProtoMessage()    {}
+func (*CommunityDescriptionDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{21}
+}
+
+func (m *CommunityDescriptionDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityDescriptionDiff.Unmarshal(m, b)
+}
+func (m *CommunityDescriptionDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityDescriptionDiff.Marshal(b, m, deterministic)
+}
+func (m *CommunityDescriptionDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityDescriptionDiff.Merge(m, src)
+}
+func (m *CommunityDescriptionDiff) XXX_Size() int {
+	return xxx_messageInfo_CommunityDescriptionDiff.Size(m)
+}
+func (m *CommunityDescriptionDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityDescriptionDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityDescriptionDiff proto.InternalMessageInfo
+
+func (m *CommunityDescriptionDiff) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityDescriptionDiff) GetBaseClock() uint64 {
+	if m != nil {
+		return m.BaseClock
+	}
+	return 0
+}
+
+func (m *CommunityDescriptionDiff) GetFieldMask() []string {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+func (m *CommunityDescriptionDiff) GetDescription() *CommunityDescription {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+func (m *CommunityDescriptionDiff) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type CommunityMessageReport struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	CommunityId          []byte   `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	ChatId               string   `protobuf:"bytes,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	MessageId            string   `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Reason               string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityMessageReport) Reset()         { *m = CommunityMessageReport{} }
+func (m *CommunityMessageReport) String() string { return proto.CompactTextString(m) }
+func (*CommunityMessageReport) ProtoMessage()    {}
+func (*CommunityMessageReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{22}
+}
+
+func (m *CommunityMessageReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityMessageReport.Unmarshal(m, b)
+}
+func (m *CommunityMessageReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityMessageReport.Marshal(b, m, deterministic)
+}
+func (m *CommunityMessageReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityMessageReport.Merge(m, src)
+}
+func (m *CommunityMessageReport) XXX_Size() int {
+	return xxx_messageInfo_CommunityMessageReport.Size(m)
+}
+func (m *CommunityMessageReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityMessageReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityMessageReport proto.InternalMessageInfo
+
+func (m *CommunityMessageReport) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityMessageReport) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityMessageReport) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *CommunityMessageReport) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *CommunityMessageReport) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("protobuf.CommunityMember_Roles", CommunityMember_Roles_name, CommunityMember_Roles_value)
 	proto.RegisterEnum("protobuf.CommunityPermissions_Access", CommunityPermissions_Access_name, CommunityPermissions_Access_value)
@@ -1571,6 +1737,8 @@ func init() {
 	proto.RegisterType((*WakuMessageArchive)(nil), "protobuf.WakuMessageArchive")
 	proto.RegisterType((*WakuMessageArchiveIndexMetadata)(nil), "protobuf.WakuMessageArchiveIndexMetadata")
 	proto.RegisterType((*WakuMessageArchiveIndex)(nil), "protobuf.WakuMessageArchiveIndex")
+	proto.RegisterType((*CommunityDescriptionDiff)(nil), "protobuf.CommunityDescriptionDiff")
+	proto.RegisterType((*CommunityMessageReport)(nil), "protobuf.CommunityMessageReport")
 	proto.RegisterMapType((map[string]*WakuMessageArchiveIndexMetadata)(nil), "protobuf.WakuMessageArchiveIndex.ArchivesEntry")
 }
 
@@ -1579,125 +1747,134 @@ func init() {
 }
 
 var fileDescriptor_f937943d74c1cd8b = []byte{
-	// 1905 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x72, 0x23, 0x49,
-	0xf1, 0x9f, 0xd6, 0x87, 0x2d, 0xa5, 0x3e, 0x46, 0xae, 0x19, 0xdb, 0x6d, 0xcf, 0xcc, 0xda, 0xd3,
-	0xff, 0x3f, 0xb1, 0xde, 0x20, 0xd0, 0xb0, 0x5a, 0x08, 0x26, 0x76, 0x61, 0x77, 0x35, 0xb6, 0x62,
-	0x10, 0x63, 0x49, 0xde, 0xb2, 0xcc, 0xb2, 0x1b, 0x40, 0x47, 0xb9, 0xbb, 0x6c, 0x57, 0xb8, 0xd5,
-	0x2d, 0xba, 0x4a, 0x06, 0x71, 0xe0, 0xc4, 0x43, 0x70, 0xe7, 0x4a, 0xf0, 0x0a, 0x1c, 0xe0, 0x48,
-	0x70, 0x24, 0x82, 0x1b, 0x27, 0x82, 0xc7, 0x20, 0xea, 0xa3, 0xa5, 0x6e, 0x59, 0xb2, 0x87, 0x58,
-	0x88, 0xe0, 0xa4, 0xce, 0xac, 0xcc, 0xac, 0xac, 0xcc, 0x5f, 0x65, 0x65, 0x0a, 0x36, 0xbc, 0x68,
-	0x34, 0x9a, 0x84, 0x4c, 0x30, 0xca, 0x9b, 0xe3, 0x38, 0x12, 0x11, 0x2a, 0xa9, 0x9f, 0xf3, 0xc9,
-	0xc5, 0xee, 0x23, 0xef, 0x8a, 0x08, 0x97, 0xf9, 0x34, 0x14, 0x4c, 0x4c, 0xf5, 0xf2, 0x6e, 0x85,
-	0x86, 0x93, 0x91, 0x91, 0x75, 0x6e, 0xa0, 0xf8, 0x3a, 0x26, 0xa1, 0x40, 0xcf, 0xa1, 0x9a, 0x58,
-	0x9a, 0xba, 0xcc, 0xb7, 0xad, 0x7d, 0xeb, 0xa0, 0x8a, 0x2b, 0x33, 0x5e, 0xd7, 0x47, 0x4f, 0xa0,
-	0x3c, 0xa2, 0xa3, 0x73, 0x1a, 0xcb, 0xf5, 0x9c, 0x5a, 0x2f, 0x69, 0x46, 0xd7, 0x47, 0xdb, 0xb0,
-	0x6e, 0x36, 0xb3, 0xf3, 0xfb, 0xd6, 0x41, 0x19, 0xaf, 0x49, 0xb2, 0xeb, 0xa3, 0xc7, 0x50, 0xf4,
-	0x82, 0xc8, 0xbb, 0xb6, 0x0b, 0xfb, 0xd6, 0x41, 0x01, 0x6b, 0xc2, 0xf9, 0xb3, 0x05, 0x0f, 0x0f,
-	0x13, 0xdb, 0x3d, 0x65, 0x04, 0x7d, 0x1b, 0x8a, 0x71, 0x14, 0x50, 0x6e, 0x5b, 0xfb, 0xf9, 0x83,
-	0x7a, 0x6b, 0xaf, 0x99, 0x9c, 0xa3, 0xb9, 0x20, 0xd9, 0xc4, 0x52, 0x0c, 0x6b, 0x69, 0xf4, 0x2e,
-	0x3c, 0xfc, 0x39, 0x09, 0x02, 0x2a, 0x5c, 0xe2, 0x79, 0xd1, 0x24, 0x14, 0xdc, 0xce, 0xed, 0xe7,
-	0x0f, 0xca, 0xb8, 0xae, 0xd9, 0x6d, 0xc3, 0x75, 0xbe, 0x80, 0xa2, 0x52, 0x44, 0x0d, 0xa8, 0x9e,
-	0xf5, 0xdf, 0xf4, 0x07, 0x9f, 0xf7, 0x5d, 0x3c, 0x38, 0xee, 0x34, 0x1e, 0xa0, 0x2a, 0x94, 0xe4,
-	0x97, 0xdb, 0x3e, 0x3e, 0x6e, 0x58, 0x68, 0x13, 0x36, 0x14, 0xd5, 0x6b, 0xf7, 0xdb, 0xaf, 0x3b,
-	0xee, 0xd9, 0x69, 0x07, 0x9f, 0x36, 0x72, 0x68, 0x07, 0x36, 0x35, 0x7b, 0x70, 0xd4, 0xc1, 0xed,
-	0x61, 0xc7, 0x3d, 0x1c, 0xf4, 0x87, 0x9d, 0xfe, 0xb0, 0x91, 0x77, 0xfe, 0x91, 0x83, 0xad, 0x99,
-	0x93, 0xc3, 0xe8, 0x9a, 0x86, 0x3d, 0x2a, 0x88, 0x4f, 0x04, 0x41, 0x17, 0x80, 0xbc, 0x28, 0x14,
-	0x31, 0xf1, 0x84, 0x4b, 0x7c, 0x3f, 0xa6, 0x9c, 0x9b, 0x23, 0x56, 0x5a, 0xdf, 0x59, 0x72, 0xc4,
-	0x8c, 0x76, 0xf3, 0xd0, 0xa8, 0xb6, 0x13, 0xcd, 0x4e, 0x28, 0xe2, 0x29, 0xde, 0xf0, 0x16, 0xf9,
-	0x68, 0x1f, 0x2a, 0x3e, 0xe5, 0x5e, 0xcc, 0xc6, 0x82, 0x45, 0xa1, 0xca, 0x4f, 0x19, 0xa7, 0x59,
-	0x32, 0x13, 0x6c, 0x44, 0x2e, 0xa9, 0x49, 0x90, 0x26, 0xd0, 0x87, 0x50, 0x16, 0x72, 0xcb, 0xe1,
-	0x74, 0x4c, 0x55, 0x8e, 0xea, 0xad, 0xa7, 0xab, 0xdc, 0x92, 0x32, 0x78, 0x2e, 0x8e, 0xb6, 0x60,
-	0x8d, 0x4f, 0x47, 0xe7, 0x51, 0x60, 0x17, 0x75, 0xce, 0x35, 0x85, 0x10, 0x14, 0x42, 0x32, 0xa2,
-	0xf6, 0x9a, 0xe2, 0xaa, 0xef, 0xdd, 0x23, 0x19, 0xa1, 0x65, 0x87, 0x41, 0x0d, 0xc8, 0x5f, 0xd3,
-	0xa9, 0x42, 0x5c, 0x01, 0xcb, 0x4f, 0xe9, 0xe9, 0x0d, 0x09, 0x26, 0xd4, 0x9c, 0x42, 0x13, 0x1f,
-	0xe6, 0x5e, 0x5a, 0xce, 0xdf, 0x2d, 0x78, 0x3c, 0xf3, 0xe9, 0x84, 0xc6, 0x23, 0xc6, 0x39, 0x8b,
-	0x42, 0x8e, 0x76, 0xa0, 0x44, 0x43, 0xee, 0x46, 0x61, 0xa0, 0x2d, 0x95, 0xf0, 0x3a, 0x0d, 0xf9,
-	0x20, 0x0c, 0xa6, 0xc8, 0x86, 0xf5, 0x71, 0xcc, 0x6e, 0x88, 0xd0, 0xf6, 0x4a, 0x38, 0x21, 0xd1,
-	0xf7, 0x60, 0x8d, 0x78, 0x1e, 0xe5, 0x5c, 0x85, 0xa4, 0xde, 0xfa, 0xda, 0x92, 0x83, 0xa7, 0x36,
-	0x69, 0xb6, 0x95, 0x30, 0x36, 0x4a, 0xce, 0x10, 0xd6, 0x34, 0x07, 0x21, 0xa8, 0x27, 0x88, 0x6a,
-	0x1f, 0x1e, 0x76, 0x4e, 0x4f, 0x1b, 0x0f, 0xd0, 0x06, 0xd4, 0xfa, 0x03, 0xb7, 0xd7, 0xe9, 0xbd,
-	0xea, 0xe0, 0xd3, 0xef, 0x77, 0x4f, 0x1a, 0x16, 0x7a, 0x04, 0x0f, 0xbb, 0xfd, 0x1f, 0x76, 0x87,
-	0xed, 0x61, 0x77, 0xd0, 0x77, 0x07, 0xfd, 0xe3, 0x2f, 0x1a, 0x39, 0x54, 0x07, 0x18, 0xf4, 0x5d,
-	0xdc, 0xf9, 0xec, 0xac, 0x73, 0x2a, 0xb1, 0xf4, 0xeb, 0x3c, 0xd4, 0x54, 0xb4, 0x0f, 0x63, 0x26,
-	0x68, 0xcc, 0x08, 0xfa, 0xc9, 0x1d, 0x10, 0x6a, 0xce, 0x5d, 0xce, 0x28, 0xfd, 0x1b, 0xc8, 0xf9,
-	0x26, 0x14, 0x84, 0x4c, 0x7e, 0xee, 0x2d, 0x92, 0xaf, 0x24, 0x53, 0x79, 0xcf, 0x2f, 0xcd, 0x7b,
-	0x61, 0x9e, 0x77, 0x29, 0x4b, 0x46, 0xf2, 0x02, 0x26, 0x18, 0xd1, 0x94, 0xac, 0x26, 0x0a, 0x48,
-	0x2e, 0xf3, 0xb9, 0xbd, 0xb6, 0x9f, 0x3f, 0x28, 0xe0, 0x92, 0x62, 0x74, 0x7d, 0x8e, 0xf6, 0xa0,
-	0x22, 0xb3, 0x39, 0x26, 0x42, 0xd0, 0x38, 0xb4, 0xd7, 0x95, 0x26, 0xd0, 0x90, 0x9f, 0x68, 0x0e,
-	0xda, 0x85, 0x92, 0x4f, 0x3d, 0x36, 0x22, 0x01, 0xb7, 0x4b, 0x0a, 0x38, 0x33, 0xfa, 0x3f, 0x84,
-	0xb4, 0xdf, 0xe5, 0xc0, 0xce, 0x06, 0x60, 0x8e, 0x04, 0x54, 0x87, 0x9c, 0xa9, 0x91, 0x65, 0x9c,
-	0x63, 0x3e, 0xfa, 0x28, 0x13, 0xc2, 0x77, 0x57, 0x85, 0x70, 0x6e, 0xa1, 0x99, 0x8a, 0xe6, 0xc7,
-	0x50, 0xd7, 0x91, 0xf0, 0x4c, 0xee, 0xec, 0xbc, 0x4a, 0xed, 0xf6, 0x8a, 0xd4, 0xe2, 0x9a, 0xc8,
-	0xc0, 0x63, 0x07, 0x4a, 0xa6, 0xf4, 0x72, 0xbb, 0xa0, 0x2a, 0xdf, 0xba, 0xae, 0xbd, 0x1c, 0x3d,
-	0x03, 0x60, 0xdc, 0x4d, 0xd0, 0x5f, 0x54, 0xe8, 0x2f, 0x33, 0x7e, 0xa2, 0x19, 0x4e, 0x17, 0x0a,
-	0xea, 0x1e, 0x3f, 0x05, 0x3b, 0x81, 0xef, 0x70, 0xf0, 0xa6, 0xd3, 0x77, 0x4f, 0x3a, 0xb8, 0xd7,
-	0x3d, 0x3d, 0xed, 0x0e, 0xfa, 0x8d, 0x07, 0xb2, 0x5c, 0xbe, 0xea, 0x1c, 0x0e, 0x7a, 0x1d, 0xb7,
-	0x7d, 0xd4, 0xeb, 0xf6, 0x1b, 0x96, 0x84, 0xb6, 0xe1, 0x68, 0x78, 0x37, 0x72, 0xce, 0x9f, 0xca,
-	0xa9, 0x8b, 0x79, 0x94, 0xad, 0x3a, 0xba, 0xfe, 0x5b, 0xa9, 0xfa, 0x8f, 0x3a, 0xb0, 0xae, 0x9f,
-	0x0e, 0x5d, 0xac, 0x2b, 0xad, 0xaf, 0x2f, 0x89, 0x59, 0xca, 0x4c, 0x53, 0x57, 0x7e, 0x03, 0xe2,
-	0x44, 0x17, 0x7d, 0x0a, 0x95, 0xf1, 0xfc, 0x7e, 0x2a, 0x34, 0x56, 0x5a, 0xef, 0xdc, 0x7d, 0x8b,
-	0x71, 0x5a, 0x05, 0xb5, 0xa0, 0x94, 0xbc, 0x8f, 0x2a, 0x3e, 0x95, 0xd6, 0x56, 0x4a, 0x5d, 0x85,
-	0x51, 0xaf, 0xe2, 0x99, 0x1c, 0xfa, 0x04, 0x8a, 0x32, 0xc0, 0x1a, 0xb6, 0x95, 0xd6, 0x7b, 0xf7,
-	0xb8, 0x2e, 0xad, 0x18, 0xc7, 0xb5, 0x9e, 0xcc, 0xd8, 0x39, 0x09, 0xdd, 0x80, 0x71, 0x61, 0xaf,
-	0xeb, 0x8c, 0x9d, 0x93, 0xf0, 0x98, 0x71, 0x81, 0xfa, 0x00, 0x1e, 0x11, 0xf4, 0x32, 0x8a, 0x19,
-	0x95, 0xd0, 0x5e, 0xb8, 0xe3, 0xcb, 0x37, 0x98, 0x29, 0xe8, 0x5d, 0x52, 0x16, 0xd0, 0x4b, 0xb0,
-	0x49, 0xec, 0x5d, 0xb1, 0x1b, 0xea, 0x8e, 0xc8, 0x65, 0x48, 0x45, 0xc0, 0xc2, 0x6b, 0x57, 0x67,
-	0xa4, 0xac, 0x32, 0xb2, 0x65, 0xd6, 0x7b, 0xb3, 0xe5, 0x43, 0x95, 0xa2, 0xd7, 0x50, 0x27, 0xfe,
-	0x88, 0x85, 0x2e, 0xa7, 0x42, 0xb0, 0xf0, 0x92, 0xdb, 0xa0, 0xe2, 0xb3, 0xbf, 0xc4, 0x9b, 0xb6,
-	0x14, 0x3c, 0x35, 0x72, 0xb8, 0x46, 0xd2, 0x24, 0xfa, 0x3f, 0xa8, 0xb1, 0x50, 0xc4, 0x91, 0x3b,
-	0xa2, 0x9c, 0xcb, 0xf7, 0xa7, 0xa2, 0xee, 0x4d, 0x55, 0x31, 0x7b, 0x9a, 0x27, 0x85, 0xa2, 0x49,
-	0x5a, 0xa8, 0xaa, 0x85, 0x14, 0x33, 0x11, 0x7a, 0x0a, 0x65, 0x1a, 0x7a, 0xf1, 0x74, 0x2c, 0xa8,
-	0x6f, 0xd7, 0x34, 0x9a, 0x67, 0x0c, 0x59, 0x7d, 0x04, 0xb9, 0xe4, 0x76, 0x5d, 0x45, 0x54, 0x7d,
-	0x23, 0x02, 0x1b, 0xfa, 0x6e, 0xa5, 0x61, 0xf2, 0x50, 0x45, 0xf5, 0x5b, 0xf7, 0x44, 0x75, 0xe1,
-	0xc6, 0x9a, 0xd8, 0x36, 0xc4, 0x02, 0x1b, 0xfd, 0x18, 0x76, 0xe6, 0x9d, 0x93, 0x5a, 0xe5, 0xee,
-	0xc8, 0xbc, 0xdf, 0x76, 0x43, 0x6d, 0xb5, 0x7f, 0xdf, 0x3b, 0x8f, 0xb7, 0xbd, 0x0c, 0x9f, 0x27,
-	0x0b, 0xbb, 0x67, 0x50, 0x4d, 0x43, 0x3f, 0x5d, 0xc2, 0xca, 0xba, 0x84, 0xbd, 0x48, 0x97, 0xb0,
-	0x4a, 0x6b, 0x67, 0x65, 0xdb, 0x94, 0xaa, 0x6e, 0xbb, 0x9f, 0x01, 0xcc, 0x61, 0xb9, 0xc4, 0xe8,
-	0x37, 0xb2, 0x46, 0xb7, 0x97, 0x18, 0x95, 0xfa, 0x69, 0x93, 0x5f, 0xc2, 0xc3, 0x05, 0x20, 0x2e,
-	0xb1, 0xfb, 0x7e, 0xd6, 0xee, 0x93, 0x65, 0x76, 0xb5, 0x91, 0x69, 0xda, 0xf6, 0x25, 0x6c, 0x2e,
-	0x4d, 0xc7, 0x92, 0x1d, 0x5e, 0x66, 0x77, 0x70, 0xee, 0xaf, 0xc5, 0xe9, 0xaa, 0xff, 0xd3, 0x54,
-	0x1f, 0x97, 0x01, 0x35, 0x3a, 0x82, 0xbd, 0x31, 0x0b, 0x13, 0x78, 0xba, 0x24, 0x08, 0x5c, 0x53,
-	0x85, 0x5c, 0x1a, 0x92, 0xf3, 0x80, 0xfa, 0xa6, 0xef, 0x78, 0x32, 0x66, 0xa1, 0x01, 0x6c, 0x3b,
-	0x08, 0x66, 0xc9, 0x53, 0x22, 0xce, 0xdf, 0x72, 0x50, 0xcb, 0x44, 0x10, 0x7d, 0x3c, 0xaf, 0x84,
-	0xfa, 0x45, 0xff, 0xff, 0x15, 0xb1, 0x7e, 0xbb, 0x12, 0x98, 0xfb, 0x6a, 0x25, 0x30, 0xff, 0x96,
-	0x25, 0x70, 0x0f, 0x2a, 0xa6, 0xc8, 0xa8, 0x69, 0x41, 0x3f, 0xf8, 0x49, 0xdd, 0x91, 0xc3, 0xc2,
-	0x2e, 0x94, 0xc6, 0x11, 0x67, 0xaa, 0x17, 0x95, 0x75, 0xb5, 0x88, 0x67, 0xf4, 0x7f, 0x09, 0xd3,
-	0x8e, 0x0f, 0x1b, 0xb7, 0x40, 0xb4, 0xe8, 0xa8, 0x75, 0xcb, 0xd1, 0xa4, 0x67, 0xc9, 0xa5, 0x7a,
-	0x96, 0xb4, 0xf3, 0xf9, 0xac, 0xf3, 0xce, 0x6f, 0x2c, 0x78, 0x34, 0xdb, 0xa6, 0x1b, 0xde, 0x30,
-	0x41, 0xd4, 0x3b, 0xf7, 0x01, 0x6c, 0xce, 0xcb, 0x40, 0xba, 0x13, 0xd7, 0x93, 0xd4, 0x63, 0x6f,
-	0xc5, 0xe3, 0x78, 0x29, 0xc7, 0x2f, 0x33, 0x4e, 0x69, 0x62, 0xf5, 0x2c, 0xf5, 0x0c, 0x60, 0x3c,
-	0x39, 0x0f, 0x98, 0xe7, 0xca, 0x78, 0x15, 0x94, 0x4e, 0x59, 0x73, 0xde, 0xd0, 0xa9, 0xf3, 0xd7,
-	0xf4, 0x14, 0x82, 0xe9, 0xcf, 0x26, 0x94, 0x8b, 0x61, 0xf4, 0x83, 0x88, 0xad, 0x7a, 0x85, 0x4d,
-	0xd3, 0x9c, 0x3a, 0xbf, 0x6c, 0x9a, 0xfb, 0x32, 0x04, 0x2b, 0x7d, 0x58, 0x1c, 0x14, 0x0b, 0xb7,
-	0x07, 0xc5, 0xe7, 0x50, 0xf5, 0x19, 0x1f, 0x07, 0x64, 0xaa, 0x4d, 0x17, 0xcd, 0x2c, 0xa2, 0x79,
-	0xca, 0xfc, 0x05, 0xa0, 0x98, 0xde, 0x50, 0x12, 0x50, 0x3f, 0xd5, 0xd2, 0xae, 0xad, 0x9c, 0x8a,
-	0x32, 0xa7, 0x69, 0x62, 0xa3, 0xba, 0xd8, 0xdb, 0xc6, 0x8b, 0x7c, 0xd9, 0x0b, 0x2e, 0x17, 0x5e,
-	0x02, 0xba, 0x4c, 0x2f, 0x58, 0x4d, 0x23, 0xeb, 0xf7, 0x16, 0x3c, 0x4d, 0x41, 0x2b, 0xf4, 0x68,
-	0xf0, 0x3f, 0x1d, 0x5e, 0xe7, 0x9f, 0x16, 0xbc, 0xb3, 0x3c, 0x76, 0x98, 0xf2, 0x71, 0x14, 0x72,
-	0xba, 0xc2, 0xe5, 0xef, 0x42, 0x79, 0xb6, 0xd5, 0x1d, 0xb5, 0x24, 0x85, 0x61, 0x3c, 0x57, 0x90,
-	0xf7, 0x46, 0x8e, 0x46, 0xea, 0x79, 0xce, 0xab, 0x62, 0x38, 0xa3, 0xe7, 0x50, 0x2f, 0xa4, 0xa1,
-	0xbe, 0x78, 0xdc, 0xe2, 0xed, 0xe3, 0x3e, 0x03, 0xd0, 0x9d, 0x8b, 0x3b, 0x89, 0x99, 0x19, 0x29,
-	0xcb, 0x9a, 0x73, 0x16, 0x33, 0x07, 0xc3, 0xf6, 0xed, 0x93, 0x1e, 0x53, 0x72, 0xb3, 0xea, 0x88,
-	0x8b, 0x5b, 0xe6, 0x6e, 0x6d, 0xe9, 0xfc, 0x08, 0x9e, 0xa7, 0xea, 0x8c, 0x2e, 0xe5, 0x8b, 0x4d,
-	0xd2, 0x0a, 0xeb, 0x59, 0x6f, 0x73, 0x8b, 0xde, 0xfe, 0xc1, 0x82, 0xca, 0xe7, 0xe4, 0x7a, 0x92,
-	0x74, 0x34, 0x0d, 0xc8, 0x73, 0x76, 0x69, 0x6a, 0x84, 0xfc, 0x94, 0x3d, 0x8e, 0x60, 0x23, 0xca,
-	0x05, 0x19, 0x8d, 0x95, 0x7e, 0x01, 0xcf, 0x19, 0x72, 0x53, 0x11, 0x8d, 0x99, 0xa7, 0xc2, 0x5b,
-	0xc5, 0x9a, 0x50, 0x13, 0x2e, 0x99, 0x06, 0x11, 0x49, 0xf0, 0x92, 0x90, 0x7a, 0xc5, 0xf7, 0x59,
-	0x78, 0x69, 0x42, 0x9b, 0x90, 0xb2, 0xee, 0x5d, 0x11, 0x7e, 0xa5, 0x02, 0x5a, 0xc5, 0xea, 0x1b,
-	0x39, 0x50, 0x15, 0x57, 0x2c, 0xf6, 0x4f, 0x48, 0x2c, 0xe3, 0x60, 0xe6, 0xae, 0x0c, 0xcf, 0xf9,
-	0x15, 0xec, 0xa6, 0x0e, 0x90, 0x84, 0x25, 0xf9, 0xb7, 0xc3, 0x86, 0xf5, 0x1b, 0x1a, 0xf3, 0xa4,
-	0xee, 0xd5, 0x70, 0x42, 0xca, 0xfd, 0x2e, 0xe2, 0x68, 0x64, 0x8e, 0xa4, 0xbe, 0xe5, 0x18, 0x25,
-	0x22, 0x75, 0x94, 0x02, 0xce, 0x89, 0x48, 0xee, 0x2f, 0xc7, 0x53, 0x1a, 0x8a, 0xa1, 0x3a, 0xa4,
-	0x9c, 0x66, 0xaa, 0x38, 0xc3, 0x73, 0x7e, 0x6b, 0x01, 0xba, 0xed, 0xc0, 0x1d, 0x1b, 0x7f, 0x0a,
-	0xa5, 0x59, 0x3b, 0xa6, 0x11, 0x9d, 0x7a, 0x61, 0x57, 0x1f, 0x05, 0xcf, 0xb4, 0xd0, 0xfb, 0xd2,
-	0x82, 0x92, 0xe1, 0x66, 0x34, 0xdb, 0x5c, 0x6a, 0x01, 0xcf, 0xc4, 0x9c, 0x3f, 0x5a, 0xb0, 0x77,
-	0xdb, 0x76, 0x37, 0xf4, 0xe9, 0x2f, 0xde, 0x22, 0x56, 0x5f, 0xdd, 0xe5, 0x2d, 0x58, 0x8b, 0x2e,
-	0x2e, 0x38, 0x15, 0x26, 0xba, 0x86, 0x92, 0x59, 0xe0, 0xec, 0x97, 0xd4, 0xfc, 0x19, 0xa7, 0xbe,
-	0x17, 0x31, 0x52, 0x98, 0x61, 0xc4, 0xf9, 0x8b, 0x05, 0xdb, 0x2b, 0x4e, 0x81, 0xde, 0x40, 0xc9,
-	0x0c, 0x0e, 0x49, 0xe3, 0xf2, 0xe2, 0x2e, 0x1f, 0x95, 0x52, 0xd3, 0x10, 0xa6, 0x5e, 0xcf, 0x0c,
-	0xec, 0x5e, 0x40, 0x2d, 0xb3, 0xb4, 0xa4, 0x3a, 0x7f, 0x92, 0x6d, 0x09, 0xde, 0xbb, 0x77, 0xb3,
-	0x59, 0x54, 0xe6, 0x85, 0xfc, 0x55, 0xed, 0xcb, 0x4a, 0xf3, 0xc5, 0x47, 0x89, 0xe6, 0xf9, 0x9a,
-	0xfa, 0xfa, 0xe0, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x53, 0xf5, 0x9f, 0xe9, 0x45, 0x15, 0x00,
-	0x00,
+	// 2063 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x73, 0x1b, 0x49,
+	0xf5, 0xcf, 0xe8, 0x87, 0x2d, 0x3d, 0xfd, 0x88, 0xdc, 0x49, 0xec, 0x89, 0x93, 0x6c, 0x9c, 0xf9,
+	0x7e, 0xb7, 0xd6, 0x5b, 0x14, 0x0a, 0xeb, 0x85, 0x22, 0xb5, 0x0b, 0xbb, 0xab, 0xd8, 0xaa, 0x20,
+	0x62, 0x49, 0xde, 0xb6, 0x42, 0x60, 0x0b, 0x98, 0x6a, 0xcf, 0xb4, 0x95, 0x2e, 0xcf, 0x0f, 0x31,
+	0xdd, 0x32, 0x11, 0x07, 0x4e, 0x5c, 0xf8, 0x0f, 0xb8, 0x52, 0x5c, 0x29, 0xfe, 0x05, 0x0e, 0xdc,
+	0xf7, 0xc8, 0x89, 0x03, 0x27, 0x8a, 0x2a, 0xfe, 0x09, 0xaa, 0x7f, 0x8c, 0x34, 0x92, 0x47, 0x4e,
+	0x60, 0xa1, 0x8a, 0x93, 0xe6, 0xbd, 0xee, 0x7e, 0xdd, 0xef, 0xbd, 0x4f, 0x7f, 0xfa, 0x3d, 0xc1,
+	0x96, 0x17, 0x87, 0xe1, 0x34, 0x62, 0x82, 0x51, 0xde, 0x9e, 0x24, 0xb1, 0x88, 0x51, 0x45, 0xfd,
+	0x9c, 0x4d, 0xcf, 0x77, 0x6f, 0x79, 0xaf, 0x88, 0x70, 0x99, 0x4f, 0x23, 0xc1, 0xc4, 0x4c, 0x0f,
+	0xef, 0xd6, 0x68, 0x34, 0x0d, 0xcd, 0x5c, 0xe7, 0x12, 0xca, 0xcf, 0x12, 0x12, 0x09, 0xf4, 0x08,
+	0xea, 0xa9, 0xa5, 0x99, 0xcb, 0x7c, 0xdb, 0xda, 0xb3, 0xf6, 0xeb, 0xb8, 0x36, 0xd7, 0xf5, 0x7c,
+	0x74, 0x0f, 0xaa, 0x21, 0x0d, 0xcf, 0x68, 0x22, 0xc7, 0x0b, 0x6a, 0xbc, 0xa2, 0x15, 0x3d, 0x1f,
+	0xed, 0xc0, 0xa6, 0xd9, 0xcc, 0x2e, 0xee, 0x59, 0xfb, 0x55, 0xbc, 0x21, 0xc5, 0x9e, 0x8f, 0x6e,
+	0x43, 0xd9, 0x0b, 0x62, 0xef, 0xc2, 0x2e, 0xed, 0x59, 0xfb, 0x25, 0xac, 0x05, 0xe7, 0x2f, 0x16,
+	0xdc, 0x3c, 0x4c, 0x6d, 0xf7, 0x95, 0x11, 0xf4, 0x2d, 0x28, 0x27, 0x71, 0x40, 0xb9, 0x6d, 0xed,
+	0x15, 0xf7, 0x9b, 0x07, 0x0f, 0xdb, 0xa9, 0x1f, 0xed, 0x95, 0x99, 0x6d, 0x2c, 0xa7, 0x61, 0x3d,
+	0x1b, 0xbd, 0x07, 0x37, 0x7f, 0x4e, 0x82, 0x80, 0x0a, 0x97, 0x78, 0x5e, 0x3c, 0x8d, 0x04, 0xb7,
+	0x0b, 0x7b, 0xc5, 0xfd, 0x2a, 0x6e, 0x6a, 0x75, 0xc7, 0x68, 0x1d, 0x06, 0x65, 0xb5, 0x10, 0xb5,
+	0xa0, 0xfe, 0x62, 0xf0, 0x7c, 0x30, 0x7c, 0x39, 0x70, 0xf1, 0xf0, 0xb8, 0xdb, 0xba, 0x81, 0xea,
+	0x50, 0x91, 0x5f, 0x6e, 0xe7, 0xf8, 0xb8, 0x65, 0xa1, 0x3b, 0xb0, 0xa5, 0xa4, 0x7e, 0x67, 0xd0,
+	0x79, 0xd6, 0x75, 0x5f, 0x9c, 0x76, 0xf1, 0x69, 0xab, 0x80, 0xee, 0xc2, 0x1d, 0xad, 0x1e, 0x1e,
+	0x75, 0x71, 0x67, 0xd4, 0x75, 0x0f, 0x87, 0x83, 0x51, 0x77, 0x30, 0x6a, 0x15, 0x51, 0x13, 0x40,
+	0x0d, 0x0d, 0x5f, 0x0e, 0xba, 0xb8, 0x55, 0x72, 0xfe, 0x56, 0x80, 0xed, 0xf9, 0xa1, 0x47, 0xf1,
+	0x05, 0x8d, 0xfa, 0x54, 0x10, 0x9f, 0x08, 0x82, 0xce, 0x01, 0x79, 0x71, 0x24, 0x12, 0xe2, 0x09,
+	0x97, 0xf8, 0x7e, 0x42, 0x39, 0x37, 0x2e, 0xd7, 0x0e, 0xbe, 0x9d, 0xe3, 0xf2, 0xd2, 0xea, 0xf6,
+	0xa1, 0x59, 0xda, 0x49, 0x57, 0x76, 0x23, 0x91, 0xcc, 0xf0, 0x96, 0xb7, 0xaa, 0x47, 0x7b, 0x50,
+	0xf3, 0x29, 0xf7, 0x12, 0x36, 0x11, 0x2c, 0x8e, 0x54, 0xbe, 0xaa, 0x38, 0xab, 0x92, 0x99, 0x61,
+	0x21, 0x19, 0x53, 0x93, 0x30, 0x2d, 0xa0, 0x8f, 0xa0, 0x2a, 0xe4, 0x96, 0xa3, 0xd9, 0x84, 0xaa,
+	0x9c, 0x35, 0x0f, 0xee, 0xaf, 0x3b, 0x96, 0x9c, 0x83, 0x17, 0xd3, 0xd1, 0x36, 0x6c, 0xf0, 0x59,
+	0x78, 0x16, 0x07, 0x76, 0x59, 0x63, 0x40, 0x4b, 0x08, 0x41, 0x29, 0x22, 0x21, 0xb5, 0x37, 0x94,
+	0x56, 0x7d, 0xef, 0x1e, 0xc9, 0x08, 0xe5, 0x39, 0x83, 0x5a, 0x50, 0xbc, 0xa0, 0x33, 0x85, 0xc0,
+	0x12, 0x96, 0x9f, 0xf2, 0xa4, 0x97, 0x24, 0x98, 0x52, 0xe3, 0x85, 0x16, 0x3e, 0x2a, 0x3c, 0xb1,
+	0x9c, 0xbf, 0x5a, 0x70, 0x7b, 0x7e, 0xa6, 0x13, 0x9a, 0x84, 0x8c, 0x73, 0x16, 0x47, 0x1c, 0xdd,
+	0x85, 0x0a, 0x8d, 0xb8, 0x1b, 0x47, 0x81, 0xb6, 0x54, 0xc1, 0x9b, 0x34, 0xe2, 0xc3, 0x28, 0x98,
+	0x21, 0x1b, 0x36, 0x27, 0x09, 0xbb, 0x24, 0x42, 0xdb, 0xab, 0xe0, 0x54, 0x44, 0xdf, 0x85, 0x0d,
+	0xe2, 0x79, 0x94, 0x73, 0x15, 0x92, 0xe6, 0xc1, 0xbb, 0x39, 0x8e, 0x67, 0x36, 0x69, 0x77, 0xd4,
+	0x64, 0x6c, 0x16, 0x39, 0x23, 0xd8, 0xd0, 0x1a, 0x84, 0xa0, 0x99, 0x22, 0xac, 0x73, 0x78, 0xd8,
+	0x3d, 0x3d, 0x6d, 0xdd, 0x40, 0x5b, 0xd0, 0x18, 0x0c, 0xdd, 0x7e, 0xb7, 0xff, 0xb4, 0x8b, 0x4f,
+	0xbf, 0xd7, 0x3b, 0x69, 0x59, 0xe8, 0x16, 0xdc, 0xec, 0x0d, 0x7e, 0xd0, 0x1b, 0x75, 0x46, 0xbd,
+	0xe1, 0xc0, 0x1d, 0x0e, 0x8e, 0x7f, 0xd4, 0x2a, 0x48, 0x2c, 0x0d, 0x07, 0x2e, 0xee, 0x7e, 0xfe,
+	0xa2, 0x7b, 0x3a, 0x6a, 0x15, 0x9d, 0x5f, 0x15, 0xa1, 0xa1, 0xa2, 0x7d, 0x98, 0x30, 0x41, 0x13,
+	0x46, 0xd0, 0x4f, 0xae, 0x81, 0x50, 0x7b, 0x71, 0xe4, 0xa5, 0x45, 0xff, 0x02, 0x72, 0xbe, 0x01,
+	0x25, 0x31, 0x9b, 0xe8, 0xe0, 0xbc, 0x29, 0xf9, 0x25, 0xb1, 0x9c, 0xf7, 0x62, 0x6e, 0xde, 0x4b,
+	0x8b, 0xbc, 0xcb, 0xb9, 0x24, 0x94, 0x17, 0x32, 0xc5, 0x88, 0x96, 0x24, 0xbb, 0x28, 0x20, 0xb9,
+	0xcc, 0xe7, 0xf6, 0xc6, 0x5e, 0x71, 0xbf, 0x84, 0x2b, 0x4a, 0xd1, 0xf3, 0x39, 0x7a, 0x08, 0x35,
+	0x99, 0xcd, 0x09, 0x11, 0x82, 0x26, 0x91, 0xbd, 0xa9, 0x56, 0x02, 0x8d, 0xf8, 0x89, 0xd6, 0xa0,
+	0x5d, 0xa8, 0xf8, 0xd4, 0x63, 0x21, 0x09, 0xb8, 0x5d, 0x51, 0xc0, 0x99, 0xcb, 0xff, 0x21, 0xa4,
+	0xfd, 0xbe, 0x00, 0xf6, 0x72, 0x00, 0x16, 0x48, 0x40, 0x4d, 0x28, 0x18, 0xce, 0xac, 0xe2, 0x02,
+	0xf3, 0xd1, 0xc7, 0x4b, 0x21, 0x7c, 0x6f, 0x5d, 0x08, 0x17, 0x16, 0xda, 0x99, 0x68, 0x7e, 0x02,
+	0x4d, 0x1d, 0x09, 0xcf, 0xe4, 0xce, 0x2e, 0xaa, 0xd4, 0xee, 0xac, 0x49, 0x2d, 0x6e, 0x88, 0xac,
+	0x28, 0xa1, 0x6f, 0xa8, 0x98, 0xdb, 0x25, 0xc5, 0x84, 0x9b, 0x9a, 0x8b, 0x39, 0x7a, 0x00, 0xc0,
+	0xb8, 0x9b, 0xa2, 0xbf, 0xac, 0xd0, 0x5f, 0x65, 0xfc, 0x44, 0x2b, 0x9c, 0x1e, 0x94, 0xd4, 0x3d,
+	0xbe, 0x0f, 0x76, 0x0a, 0xdf, 0xd1, 0xf0, 0x79, 0x77, 0xe0, 0x9e, 0x74, 0x71, 0xbf, 0x77, 0x7a,
+	0xda, 0x1b, 0x0e, 0x5a, 0x37, 0x24, 0x7d, 0x3e, 0xed, 0x1e, 0x0e, 0xfb, 0x5d, 0xb7, 0x73, 0xd4,
+	0xef, 0x0d, 0x5a, 0x96, 0x84, 0xb6, 0xd1, 0x68, 0x78, 0xb7, 0x0a, 0xce, 0x3f, 0xaa, 0x99, 0x8b,
+	0x79, 0xb4, 0xcc, 0x3a, 0xfa, 0x3d, 0xb0, 0x32, 0xef, 0x01, 0xea, 0xc2, 0xa6, 0x7e, 0x4a, 0x34,
+	0x79, 0xd7, 0x0e, 0xbe, 0x96, 0x13, 0xb3, 0x8c, 0x99, 0xb6, 0x7e, 0x09, 0x0c, 0x88, 0xd3, 0xb5,
+	0xe8, 0x33, 0xa8, 0x4d, 0x16, 0xf7, 0x53, 0xa1, 0xb1, 0x76, 0xf0, 0xce, 0xf5, 0xb7, 0x18, 0x67,
+	0x97, 0xa0, 0x03, 0xa8, 0xa4, 0xef, 0xa5, 0x8a, 0x4f, 0xed, 0x60, 0x3b, 0xb3, 0x5c, 0x85, 0x51,
+	0x8f, 0xe2, 0xf9, 0x3c, 0xf4, 0x29, 0x94, 0x65, 0x80, 0x35, 0x6c, 0x6b, 0x07, 0xef, 0xbf, 0xe1,
+	0xe8, 0xd2, 0x8a, 0x39, 0xb8, 0x5e, 0x27, 0x33, 0x76, 0x46, 0x22, 0x37, 0x60, 0x5c, 0xd8, 0x9b,
+	0x3a, 0x63, 0x67, 0x24, 0x3a, 0x66, 0x5c, 0xa0, 0x01, 0x80, 0x47, 0x04, 0x1d, 0xc7, 0x09, 0xa3,
+	0x12, 0xda, 0x2b, 0x77, 0x3c, 0x7f, 0x83, 0xf9, 0x02, 0xbd, 0x4b, 0xc6, 0x02, 0x7a, 0x02, 0x36,
+	0x49, 0xbc, 0x57, 0xec, 0x92, 0xba, 0x21, 0x19, 0x47, 0x54, 0x04, 0x2c, 0xba, 0x70, 0x75, 0x46,
+	0xaa, 0x2a, 0x23, 0xdb, 0x66, 0xbc, 0x3f, 0x1f, 0x3e, 0x54, 0x29, 0x7a, 0x06, 0x4d, 0xe2, 0x87,
+	0x2c, 0x72, 0x39, 0x15, 0x82, 0x45, 0x63, 0x6e, 0x83, 0x8a, 0xcf, 0x5e, 0xce, 0x69, 0x3a, 0x72,
+	0xe2, 0xa9, 0x99, 0x87, 0x1b, 0x24, 0x2b, 0xa2, 0xff, 0x83, 0x06, 0x8b, 0x44, 0x12, 0xbb, 0x21,
+	0xe5, 0x5c, 0xbe, 0x3f, 0x35, 0x75, 0x6f, 0xea, 0x4a, 0xd9, 0xd7, 0x3a, 0x39, 0x29, 0x9e, 0x66,
+	0x27, 0xd5, 0xf5, 0xa4, 0x78, 0x9a, 0x99, 0x74, 0x1f, 0xaa, 0x34, 0xf2, 0x92, 0xd9, 0x44, 0x50,
+	0xdf, 0x6e, 0x68, 0x34, 0xcf, 0x15, 0x92, 0x7d, 0x04, 0x19, 0x73, 0xbb, 0xa9, 0x22, 0xaa, 0xbe,
+	0x11, 0x81, 0x2d, 0x7d, 0xb7, 0xb2, 0x30, 0xb9, 0xa9, 0xa2, 0xfa, 0xcd, 0x37, 0x44, 0x75, 0xe5,
+	0xc6, 0x9a, 0xd8, 0xb6, 0xc4, 0x8a, 0x1a, 0xfd, 0x18, 0xee, 0x2e, 0x2a, 0x29, 0x35, 0xca, 0xdd,
+	0xd0, 0xbc, 0xdf, 0x76, 0x6b, 0xaf, 0xb8, 0x26, 0x64, 0x4b, 0xef, 0x3c, 0xde, 0xf1, 0x96, 0xf4,
+	0x3c, 0x1d, 0x40, 0xef, 0x42, 0x93, 0x5e, 0xd2, 0x48, 0xb8, 0x9c, 0xfe, 0x6c, 0x4a, 0x23, 0x8f,
+	0xda, 0x5b, 0x2a, 0x6b, 0x0d, 0xa5, 0x3d, 0x35, 0xca, 0xdd, 0x17, 0x50, 0xcf, 0xde, 0x90, 0x2c,
+	0xd3, 0x55, 0x35, 0xd3, 0x3d, 0xce, 0x32, 0x5d, 0xed, 0xe0, 0xee, 0xda, 0x6a, 0x2b, 0x43, 0x82,
+	0xbb, 0x9f, 0x03, 0x2c, 0xd0, 0x9b, 0x63, 0xf4, 0xeb, 0xcb, 0x46, 0x77, 0x72, 0x8c, 0xca, 0xf5,
+	0x59, 0x93, 0x5f, 0xc0, 0xcd, 0x15, 0xbc, 0xe6, 0xd8, 0xfd, 0x60, 0xd9, 0xee, 0xbd, 0x3c, 0xbb,
+	0xda, 0xc8, 0x2c, 0x6b, 0x7b, 0x0c, 0x77, 0x72, 0xb3, 0x96, 0xb3, 0xc3, 0x93, 0xe5, 0x1d, 0x9c,
+	0x37, 0x53, 0x76, 0xf6, 0x71, 0xf8, 0x29, 0x6c, 0xe7, 0x63, 0x1f, 0x1d, 0xc1, 0xc3, 0x09, 0x8b,
+	0x52, 0x14, 0xbb, 0x24, 0x08, 0x5c, 0x43, 0x56, 0x2e, 0x8d, 0xc8, 0x59, 0x40, 0x7d, 0x53, 0x9e,
+	0xdc, 0x9b, 0xb0, 0xc8, 0xe0, 0xba, 0x13, 0x04, 0xf3, 0xe4, 0xa9, 0x29, 0xce, 0xaf, 0x8b, 0xd0,
+	0x58, 0x8a, 0x20, 0xfa, 0x64, 0x41, 0x98, 0xfa, 0xe1, 0xff, 0xff, 0x35, 0xb1, 0x7e, 0x3b, 0xa6,
+	0x2c, 0x7c, 0x35, 0xa6, 0x2c, 0xbe, 0x25, 0x53, 0x3e, 0x84, 0x9a, 0xe1, 0x22, 0xd5, 0x64, 0xe8,
+	0xba, 0x20, 0xa5, 0x27, 0xd9, 0x63, 0xec, 0x42, 0x65, 0x12, 0x73, 0xa6, 0x4a, 0x56, 0x49, 0xbf,
+	0x65, 0x3c, 0x97, 0x51, 0x1b, 0x6e, 0x85, 0xe4, 0xb5, 0x3b, 0x61, 0x51, 0x44, 0xfd, 0x34, 0xa2,
+	0x5c, 0x15, 0x95, 0x65, 0xbc, 0x15, 0x92, 0xd7, 0x27, 0x6a, 0xc4, 0x04, 0x91, 0xff, 0x97, 0xee,
+	0x80, 0xe3, 0xc3, 0xd6, 0x15, 0xd0, 0xad, 0x3a, 0x66, 0x5d, 0x71, 0x2c, 0x2d, 0x85, 0x0a, 0x99,
+	0x52, 0x28, 0xeb, 0x6c, 0x71, 0xd9, 0x59, 0xe7, 0x37, 0x16, 0xdc, 0x9a, 0x6f, 0xd3, 0x8b, 0x2e,
+	0x99, 0x20, 0x2a, 0x08, 0x1f, 0xc2, 0x9d, 0x05, 0xbb, 0x64, 0x0b, 0x7c, 0xdd, 0xb0, 0xdd, 0xf6,
+	0xd6, 0xbc, 0xb9, 0x63, 0xd9, 0xe5, 0x99, 0xae, 0x4d, 0x0b, 0xeb, 0x5b, 0xb6, 0x07, 0x00, 0x93,
+	0xe9, 0x59, 0xc0, 0x3c, 0x57, 0xc6, 0xab, 0xa4, 0xd6, 0x54, 0xb5, 0xe6, 0x39, 0x9d, 0x39, 0x7f,
+	0xce, 0x36, 0x37, 0x58, 0x32, 0x0e, 0x17, 0xa3, 0xf8, 0xfb, 0x31, 0x5b, 0xf7, 0xb8, 0x9b, 0x5a,
+	0x3c, 0xe3, 0xbf, 0xac, 0xc5, 0x07, 0x32, 0x04, 0x6b, 0xcf, 0xb0, 0xda, 0x8f, 0x96, 0xae, 0xf6,
+	0xa3, 0x8f, 0xa0, 0xee, 0x33, 0x3e, 0x09, 0xc8, 0x4c, 0x9b, 0x2e, 0x9b, 0x16, 0x47, 0xeb, 0x94,
+	0xf9, 0x73, 0x40, 0x09, 0xbd, 0xa4, 0x24, 0xa0, 0x7e, 0xa6, 0x52, 0xde, 0x58, 0xdb, 0x6c, 0x2d,
+	0x79, 0xd3, 0xc6, 0x66, 0xe9, 0x6a, 0xc9, 0x9c, 0xac, 0xea, 0x65, 0x89, 0x99, 0x3f, 0x39, 0x07,
+	0x74, 0x4b, 0x25, 0x66, 0x3d, 0x8b, 0xac, 0x3f, 0x58, 0x70, 0x3f, 0x03, 0xad, 0xc8, 0xa3, 0xc1,
+	0xff, 0x74, 0x78, 0x9d, 0xbf, 0x5b, 0xf0, 0x4e, 0x7e, 0xec, 0x30, 0xe5, 0x93, 0x38, 0xe2, 0x74,
+	0xcd, 0x91, 0xbf, 0x03, 0xd5, 0xf9, 0x56, 0xd7, 0x70, 0x4f, 0x06, 0xc3, 0x78, 0xb1, 0x40, 0xde,
+	0x1b, 0xd9, 0x71, 0xa9, 0x57, 0xbf, 0xa8, 0xc8, 0x73, 0x2e, 0x2f, 0xa0, 0x5e, 0xca, 0x42, 0x7d,
+	0xd5, 0xdd, 0xf2, 0x55, 0x77, 0x1f, 0x00, 0xe8, 0x82, 0xc8, 0x9d, 0x26, 0xcc, 0x74, 0xaa, 0x55,
+	0xad, 0x79, 0x91, 0x30, 0x07, 0xc3, 0xce, 0x55, 0x4f, 0x8f, 0x29, 0xb9, 0x5c, 0xe7, 0xe2, 0xea,
+	0x96, 0x85, 0x2b, 0x5b, 0x3a, 0x3f, 0x84, 0x47, 0x19, 0x9e, 0xd1, 0xd4, 0xbf, 0x5a, 0x7b, 0xad,
+	0xb1, 0xbe, 0x7c, 0xda, 0xc2, 0xea, 0x69, 0xff, 0x68, 0x41, 0xed, 0x25, 0xb9, 0x98, 0x1a, 0xab,
+	0x12, 0x85, 0x9c, 0x8d, 0x0d, 0x47, 0xc8, 0x4f, 0x59, 0x3a, 0x09, 0x16, 0x52, 0x2e, 0x48, 0x38,
+	0x51, 0xeb, 0x4b, 0x78, 0xa1, 0x90, 0x9b, 0x8a, 0x78, 0xc2, 0x3c, 0x15, 0xde, 0x3a, 0xd6, 0x82,
+	0x6a, 0x9c, 0xc9, 0x2c, 0x88, 0x49, 0x8a, 0x97, 0x54, 0xd4, 0x23, 0xbe, 0xcf, 0xa2, 0xb1, 0x09,
+	0x6d, 0x2a, 0x4a, 0xde, 0x7b, 0x45, 0xf8, 0x2b, 0x15, 0xd0, 0x3a, 0x56, 0xdf, 0xc8, 0x81, 0xba,
+	0x78, 0xc5, 0x12, 0xff, 0x84, 0x24, 0x32, 0x0e, 0xa6, 0x9d, 0x5b, 0xd2, 0x39, 0xbf, 0x84, 0xdd,
+	0x8c, 0x03, 0x69, 0x58, 0xd2, 0x2a, 0xc8, 0x86, 0xcd, 0x4b, 0x9a, 0xf0, 0x94, 0xf7, 0x1a, 0x38,
+	0x15, 0xe5, 0x7e, 0xe7, 0x49, 0x1c, 0x1a, 0x97, 0xd4, 0xb7, 0xec, 0xce, 0x44, 0xac, 0x5c, 0x29,
+	0xe1, 0x82, 0x88, 0xe5, 0xfe, 0xb2, 0xeb, 0xa5, 0x91, 0x18, 0x29, 0x27, 0x65, 0x93, 0x54, 0xc7,
+	0x4b, 0x3a, 0xe7, 0x77, 0x16, 0xa0, 0xab, 0x07, 0xb8, 0x66, 0xe3, 0xcf, 0xa0, 0x32, 0xaf, 0xf2,
+	0x34, 0xa2, 0x33, 0x2f, 0xf2, 0x7a, 0x57, 0xf0, 0x7c, 0x15, 0xfa, 0x40, 0x5a, 0x30, 0x8f, 0x9a,
+	0xee, 0xf8, 0xee, 0xe4, 0x5a, 0xc0, 0xf3, 0x69, 0xce, 0x9f, 0x2c, 0x78, 0x78, 0xd5, 0x76, 0x2f,
+	0xf2, 0xe9, 0xeb, 0xb7, 0x88, 0xd5, 0x57, 0x3f, 0xf2, 0x36, 0x6c, 0xc4, 0xe7, 0xe7, 0x9c, 0x0a,
+	0x13, 0x5d, 0x23, 0xc9, 0x2c, 0x70, 0xf6, 0x0b, 0x6a, 0xfe, 0xf3, 0x53, 0xdf, 0xab, 0x18, 0x29,
+	0xcd, 0x31, 0xe2, 0x7c, 0x69, 0xc1, 0xce, 0x1a, 0x2f, 0xd0, 0x73, 0xa8, 0x98, 0x7e, 0x24, 0x2d,
+	0x74, 0x1e, 0x5f, 0x77, 0x46, 0xb5, 0xa8, 0x6d, 0x04, 0xc3, 0xd7, 0x73, 0x03, 0xbb, 0xe7, 0xd0,
+	0x58, 0x1a, 0xca, 0x61, 0xe7, 0x4f, 0x97, 0x4b, 0x82, 0xf7, 0xdf, 0xb8, 0xd9, 0x3c, 0x2a, 0x19,
+	0x22, 0xff, 0xd2, 0x02, 0x3b, 0x8f, 0xc4, 0x8e, 0xd8, 0xf9, 0xf9, 0xfa, 0x0b, 0x7d, 0x46, 0x38,
+	0x35, 0x9d, 0x98, 0xb9, 0x90, 0x52, 0x73, 0x98, 0x0e, 0x9f, 0x33, 0x1a, 0xf8, 0x6e, 0x48, 0xf8,
+	0x85, 0x42, 0x47, 0x15, 0x57, 0x95, 0xa6, 0x4f, 0xf8, 0x85, 0xac, 0xe6, 0xb2, 0xb5, 0x40, 0xe9,
+	0xad, 0x18, 0x35, 0xbb, 0x44, 0xf2, 0x01, 0x67, 0xe3, 0x88, 0x88, 0x69, 0x42, 0xcd, 0x1d, 0x5e,
+	0x28, 0x9c, 0xdf, 0x5a, 0x99, 0x27, 0x3f, 0x85, 0x21, 0x9d, 0xc4, 0x89, 0xf8, 0xb7, 0xd9, 0xef,
+	0xda, 0xf2, 0x23, 0x2d, 0x97, 0xe7, 0x35, 0x62, 0xd5, 0x68, 0x7a, 0xbe, 0xc4, 0x5c, 0x42, 0x09,
+	0x37, 0x05, 0x62, 0x15, 0x1b, 0xe9, 0x69, 0xe3, 0x8b, 0x5a, 0xfb, 0xf1, 0xc7, 0xa9, 0xcb, 0x67,
+	0x1b, 0xea, 0xeb, 0xc3, 0x7f, 0x0e, 0x00, 0xad, 0x80, 0xa4, 0xc8, 0x21, 0x17, 0x00, 0x00,
 }
@@ -2155,6 +2155,9 @@ type SyncCommunitySettings struct {
 	Clock                        uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	CommunityId                  string   `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
 	HistoryArchiveSupportEnabled bool     `protobuf:"varint,3,opt,name=history_archive_support_enabled,json=historyArchiveSupportEnabled,proto3" json:"history_archive_support_enabled,omitempty"`
+	DndStartHour                 int32    `protobuf:"varint,4,opt,name=dnd_start_hour,json=dndStartHour,proto3" json:"dnd_start_hour,omitempty"`
+	DndEndHour                   int32    `protobuf:"varint,5,opt,name=dnd_end_hour,json=dndEndHour,proto3" json:"dnd_end_hour,omitempty"`
+	DndTimezone                  string   `protobuf:"bytes,6,opt,name=dnd_timezone,json=dndTimezone,proto3" json:"dnd_timezone,omitempty"`
 	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
 	XXX_unrecognized             []byte   `json:"-"`
 	XXX_sizecache                int32    `json:"-"`
@@ -2206,6 +2209,27 @@ func (m *SyncCommunitySettings) GetHistoryArchiveSupportEnabled() bool {
 	return false
 }
 
+func (m *SyncCommunitySettings) GetDndStartHour() int32 {
+	if m != nil {
+		return m.DndStartHour
+	}
+	return 0
+}
+
+func (m *SyncCommunitySettings) GetDndEndHour() int32 {
+	if m != nil {
+		return m.DndEndHour
+	}
+	return 0
+}
+
+func (m *SyncCommunitySettings) GetDndTimezone() string {
+	if m != nil {
+		return m.DndTimezone
+	}
+	return ""
+}
+
 type SyncTrustedUser struct {
 	Clock                uint64                      `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	Id                   string                      `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -2817,177 +2841,181 @@ func init() {
 }
 
 var fileDescriptor_d61ab7221f0b5518 = []byte{
-	// 2751 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x39, 0x4d, 0x73, 0x1b, 0xc7,
-	0xb1, 0x5e, 0x00, 0xc2, 0x47, 0x03, 0x04, 0xa1, 0x11, 0x2d, 0x41, 0x14, 0x5d, 0xa2, 0xd6, 0x76,
-	0x59, 0xef, 0x95, 0x1f, 0xf5, 0x42, 0xc7, 0xb1, 0x23, 0xdb, 0xe5, 0x40, 0x00, 0x62, 0x51, 0x14,
-	0x41, 0xd6, 0x90, 0x90, 0x63, 0x57, 0xaa, 0xb6, 0x86, 0xbb, 0x23, 0x62, 0xc3, 0xc5, 0x2e, 0xb2,
-	0x33, 0xa0, 0x02, 0xdf, 0x92, 0x9f, 0x90, 0x4b, 0x72, 0xf4, 0x39, 0xb9, 0xa5, 0xca, 0xf7, 0x1c,
-	0x73, 0xcf, 0x31, 0x39, 0xe4, 0x9c, 0xca, 0x0f, 0xc8, 0x31, 0x35, 0x3d, 0xb3, 0x8b, 0x5d, 0x10,
-	0x60, 0xa8, 0xca, 0x29, 0x27, 0x4c, 0xf7, 0x76, 0xf7, 0xf6, 0xf4, 0x77, 0x2f, 0x60, 0x6d, 0xc2,
-	0xfc, 0xd8, 0x0f, 0xcf, 0x76, 0x26, 0x71, 0x24, 0x23, 0x52, 0xc5, 0x9f, 0xd3, 0xe9, 0xcb, 0xcd,
-	0x5b, 0x62, 0x16, 0xba, 0x8e, 0xe0, 0x52, 0xfa, 0xe1, 0x99, 0xd0, 0x8f, 0x37, 0x6d, 0x36, 0x99,
-	0x04, 0xbe, 0xcb, 0xa4, 0x1f, 0x85, 0xce, 0x98, 0x4b, 0xe6, 0x31, 0xc9, 0x9c, 0x31, 0x17, 0x82,
-	0x9d, 0x71, 0x4d, 0x63, 0x33, 0xb8, 0xf7, 0x63, 0x2e, 0xdd, 0x91, 0x1f, 0x9e, 0x3d, 0x61, 0xee,
-	0x39, 0xf7, 0x86, 0x93, 0x1e, 0x93, 0xac, 0xc7, 0x25, 0xf3, 0x03, 0x41, 0xee, 0x43, 0x1d, 0x99,
-	0xc2, 0xe9, 0xf8, 0x94, 0xc7, 0x6d, 0x6b, 0xdb, 0x7a, 0xb8, 0x46, 0x41, 0xa1, 0x06, 0x88, 0x21,
-	0x0f, 0xa0, 0x21, 0x23, 0xc9, 0x82, 0x84, 0xa2, 0x80, 0x14, 0x75, 0xc4, 0x69, 0x12, 0xfb, 0x6f,
-	0x37, 0xa0, 0xac, 0x64, 0x4f, 0x27, 0x64, 0x03, 0x6e, 0xb8, 0x41, 0xe4, 0x9e, 0xa3, 0xa0, 0x12,
-	0xd5, 0x00, 0x69, 0x42, 0xc1, 0xf7, 0x90, 0xb3, 0x46, 0x0b, 0xbe, 0x47, 0x3e, 0x87, 0xaa, 0x1b,
-	0x85, 0x92, 0xb9, 0x52, 0xb4, 0x8b, 0xdb, 0xc5, 0x87, 0xf5, 0xdd, 0xb7, 0x77, 0x92, 0x9b, 0xee,
-	0x1c, 0xcf, 0x42, 0x77, 0x2f, 0x14, 0x92, 0x05, 0x01, 0x5e, 0xac, 0xab, 0x29, 0x5f, 0xec, 0xd2,
-	0x94, 0x89, 0xfc, 0x10, 0xea, 0x6e, 0x34, 0x1e, 0x4f, 0x43, 0x5f, 0xfa, 0x5c, 0xb4, 0x4b, 0x28,
-	0xe3, 0x4e, 0x5e, 0x46, 0xd7, 0x10, 0xcc, 0x68, 0x96, 0x96, 0x1c, 0xc2, 0x7a, 0x22, 0xc6, 0xd8,
-	0xa0, 0x7d, 0x63, 0xdb, 0x7a, 0x58, 0xdf, 0x7d, 0x77, 0xce, 0x7e, 0x85, 0xc1, 0xe8, 0x22, 0x37,
-	0x19, 0x02, 0xc9, 0xc8, 0x4f, 0x64, 0x96, 0x5f, 0x47, 0xe6, 0x12, 0x01, 0xe4, 0x03, 0xa8, 0x4c,
-	0xe2, 0xe8, 0xa5, 0x1f, 0xf0, 0x76, 0x05, 0x65, 0xdd, 0x9d, 0xcb, 0x4a, 0x64, 0x1c, 0x69, 0x02,
-	0x9a, 0x50, 0x92, 0x03, 0x68, 0x9a, 0x63, 0xa2, 0x47, 0xf5, 0x75, 0xf4, 0x58, 0x60, 0x26, 0x8f,
-	0xa0, 0x62, 0x22, 0xae, 0x5d, 0x43, 0x39, 0x6f, 0xe6, 0x4d, 0x7c, 0xac, 0x1f, 0xd2, 0x84, 0x4a,
-	0x19, 0x37, 0x09, 0xd1, 0x44, 0x01, 0x78, 0x2d, 0xe3, 0x2e, 0x70, 0x93, 0x0f, 0xa1, 0x7a, 0xce,
-	0x67, 0x2e, 0x8b, 0x3d, 0xd1, 0xae, 0x2f, 0x9a, 0x41, 0xa9, 0xd0, 0x09, 0x82, 0x7d, 0x43, 0x40,
-	0x53, 0x52, 0xa5, 0x47, 0x72, 0x4e, 0xf4, 0x68, 0xbc, 0x96, 0x1e, 0x0b, 0xdc, 0xf6, 0x3f, 0x4a,
-	0xd0, 0x38, 0x98, 0x06, 0xd2, 0xef, 0xb8, 0x6e, 0x34, 0x0d, 0x25, 0x21, 0x50, 0x0a, 0xd9, 0x98,
-	0x63, 0x9c, 0xd7, 0x28, 0x9e, 0xc9, 0x16, 0xd4, 0xa4, 0x3f, 0xe6, 0x42, 0xb2, 0xf1, 0x04, 0xa3,
-	0xbd, 0x48, 0xe7, 0x08, 0xf5, 0xd4, 0xf7, 0x78, 0x28, 0x7d, 0x37, 0x0a, 0xdb, 0x45, 0x64, 0x9b,
-	0x23, 0xc8, 0xe7, 0x00, 0x6e, 0x14, 0x44, 0xb1, 0x33, 0x62, 0x62, 0x64, 0x02, 0x7a, 0x7b, 0xae,
-	0x6c, 0xf6, 0xdd, 0x3b, 0x5d, 0x45, 0xf8, 0x94, 0x89, 0x11, 0xad, 0xb9, 0xc9, 0x91, 0xdc, 0x55,
-	0x39, 0xa5, 0x04, 0xf8, 0x1e, 0x06, 0x74, 0x91, 0x56, 0x10, 0xde, 0xf3, 0xc8, 0x7b, 0xa9, 0x35,
-	0x1c, 0x53, 0x5e, 0x30, 0x3c, 0x6b, 0xb4, 0x69, 0xd0, 0x47, 0x1a, 0x4b, 0xee, 0x40, 0xe5, 0x9c,
-	0xcf, 0x9c, 0xa9, 0xef, 0x61, 0xcc, 0xd5, 0x68, 0xf9, 0x9c, 0xcf, 0x86, 0xbe, 0x47, 0x3e, 0x85,
-	0xb2, 0x3f, 0x66, 0x67, 0x5c, 0xc5, 0x93, 0xd2, 0xec, 0x9d, 0x15, 0x9a, 0xed, 0xe1, 0x7d, 0xe4,
-	0x6c, 0x4f, 0x11, 0x53, 0xc3, 0x43, 0x1e, 0xc1, 0x2d, 0x77, 0x2a, 0x64, 0x34, 0xf6, 0xbf, 0xd1,
-	0xa5, 0x0a, 0x15, 0xc3, 0x90, 0xaa, 0x51, 0x92, 0x7b, 0x84, 0x57, 0xdb, 0x7c, 0x00, 0xb5, 0xf4,
-	0x8e, 0xaa, 0xa4, 0xf8, 0xa1, 0xc7, 0x7f, 0xd1, 0xb6, 0xb6, 0x8b, 0x0f, 0x8b, 0x54, 0x03, 0x9b,
-	0x7f, 0xb1, 0x60, 0x2d, 0xf7, 0xb6, 0xac, 0xf2, 0x56, 0x4e, 0xf9, 0xc4, 0x55, 0x85, 0x8c, 0xab,
-	0xda, 0x50, 0x99, 0xb0, 0x59, 0x10, 0x31, 0x0f, 0x5d, 0xd1, 0xa0, 0x09, 0xa8, 0x5e, 0xf7, 0xca,
-	0xf7, 0xa4, 0xf2, 0x81, 0x32, 0xa2, 0x06, 0xc8, 0x6d, 0x28, 0x8f, 0xb8, 0x7f, 0x36, 0x92, 0xc6,
-	0xb6, 0x06, 0x22, 0x9b, 0x50, 0x55, 0x09, 0x23, 0xfc, 0x6f, 0x38, 0xda, 0xb4, 0x48, 0x53, 0x98,
-	0xbc, 0x0d, 0x6b, 0x31, 0x9e, 0x1c, 0xc9, 0xe2, 0x33, 0x2e, 0xd1, 0xa6, 0x45, 0xda, 0xd0, 0xc8,
-	0x13, 0xc4, 0xcd, 0x0b, 0x66, 0x35, 0x53, 0x30, 0xed, 0x3f, 0x5b, 0x70, 0xeb, 0x79, 0xe4, 0xb2,
-	0xc0, 0x78, 0xe6, 0xc8, 0x28, 0xf7, 0x21, 0x94, 0xce, 0xf9, 0x4c, 0xa0, 0x29, 0xea, 0xbb, 0x0f,
-	0xe6, 0x5e, 0x58, 0x42, 0xbc, 0xb3, 0xcf, 0x67, 0x14, 0xc9, 0xc9, 0x63, 0x68, 0x8c, 0x95, 0x9b,
-	0x98, 0x76, 0x13, 0x5a, 0xa2, 0xbe, 0x7b, 0x7b, 0xb9, 0x13, 0x69, 0x8e, 0x56, 0xdd, 0x70, 0xc2,
-	0x84, 0x78, 0x15, 0xc5, 0x9e, 0x89, 0xda, 0x14, 0xde, 0xfc, 0x3f, 0x28, 0xee, 0xf3, 0xd9, 0xd2,
-	0x5c, 0x20, 0x50, 0x52, 0x4d, 0x04, 0x5f, 0xd5, 0xa0, 0x78, 0xb6, 0xbf, 0xb5, 0xa0, 0xa5, 0x74,
-	0xcc, 0x56, 0xf7, 0x15, 0x1d, 0xe3, 0x3d, 0x58, 0xf7, 0x33, 0x54, 0x4e, 0xda, 0x3e, 0x9a, 0x59,
-	0xf4, 0x9e, 0x87, 0xfd, 0x8b, 0x5f, 0xf8, 0x2e, 0x77, 0xe4, 0x6c, 0xc2, 0x8d, 0x86, 0xa0, 0x51,
-	0x27, 0xb3, 0x09, 0x4f, 0x95, 0x2b, 0xe5, 0xbd, 0x7f, 0xc1, 0x63, 0xe1, 0x47, 0x21, 0xba, 0x73,
-	0x8d, 0x26, 0xa0, 0xfd, 0x77, 0x0b, 0xee, 0xac, 0x68, 0x40, 0xd7, 0xec, 0x6d, 0x6f, 0xc3, 0x9a,
-	0xa9, 0xa2, 0x0e, 0x86, 0xbf, 0x51, 0xa9, 0x61, 0x90, 0x3a, 0x56, 0xef, 0x42, 0x95, 0x87, 0xc2,
-	0xc9, 0x28, 0x56, 0xe1, 0xa1, 0x18, 0x28, 0xdd, 0x1e, 0x40, 0x23, 0x60, 0x42, 0x3a, 0xd3, 0x89,
-	0xc7, 0x24, 0xd7, 0xb9, 0x5c, 0xa2, 0x75, 0x85, 0x1b, 0x6a, 0x94, 0xba, 0xb3, 0x98, 0x09, 0xc9,
-	0xc7, 0x8e, 0x64, 0x67, 0xaa, 0xd5, 0x14, 0xd5, 0x9d, 0x35, 0xea, 0x84, 0x9d, 0x09, 0xf2, 0x2e,
-	0x34, 0x03, 0x15, 0x10, 0x4e, 0xe8, 0xbb, 0xe7, 0xf8, 0x12, 0x9d, 0xce, 0x6b, 0x88, 0x1d, 0x18,
-	0xa4, 0xfd, 0xcb, 0x32, 0xdc, 0x5d, 0xd9, 0x6d, 0xc9, 0xff, 0xc3, 0x46, 0x56, 0x11, 0x07, 0x79,
-	0x83, 0x99, 0xb9, 0x3d, 0xc9, 0x28, 0xf4, 0x5c, 0x3f, 0xf9, 0x2f, 0x36, 0x85, 0xf2, 0x2d, 0xf3,
-	0x3c, 0xee, 0x61, 0x51, 0xaa, 0x52, 0x0d, 0xa8, 0x38, 0x39, 0x55, 0x4e, 0xe6, 0x1e, 0xb6, 0xb1,
-	0x2a, 0x4d, 0x40, 0x45, 0x3f, 0x9e, 0x2a, 0x9d, 0xea, 0x9a, 0x1e, 0x01, 0x45, 0x1f, 0xf3, 0x71,
-	0x74, 0xc1, 0x3d, 0x6c, 0x37, 0x55, 0x9a, 0x80, 0x64, 0x1b, 0x1a, 0x23, 0x26, 0x1c, 0x14, 0xeb,
-	0x4c, 0x45, 0x7b, 0x0d, 0x1f, 0xc3, 0x88, 0x89, 0x8e, 0x42, 0x0d, 0xb1, 0x48, 0x5e, 0xf0, 0xd8,
-	0x7f, 0x99, 0x8c, 0x73, 0x42, 0x32, 0x39, 0x15, 0xed, 0x26, 0xd6, 0x0c, 0x92, 0x7d, 0x74, 0x8c,
-	0x4f, 0x70, 0x30, 0x8b, 0xa7, 0x42, 0x26, 0x94, 0xeb, 0x48, 0x59, 0x47, 0x9c, 0x21, 0xf9, 0x0c,
-	0xee, 0x99, 0x69, 0xc5, 0x89, 0xf9, 0xcf, 0xa7, 0x5c, 0x48, 0xed, 0x45, 0x64, 0xe1, 0xed, 0x16,
-	0x72, 0xb4, 0x0d, 0x09, 0xd5, 0x14, 0xe8, 0x4c, 0xc5, 0xcf, 0x57, 0xb3, 0xeb, 0x34, 0xb8, 0xb9,
-	0x92, 0xbd, 0x8b, 0x99, 0xf1, 0x39, 0x6c, 0x2d, 0xb2, 0x2b, 0x73, 0x48, 0x6e, 0x5e, 0x4f, 0x90,
-	0xff, 0x6e, 0x9e, 0x9f, 0x22, 0x85, 0x7e, 0xff, 0x6a, 0x01, 0x5a, 0x81, 0x5b, 0xab, 0x05, 0x68,
-	0x0d, 0x1e, 0x40, 0xc3, 0xf3, 0xc5, 0x24, 0x60, 0x33, 0x1d, 0x5f, 0x1b, 0xe8, 0xfa, 0xba, 0xc1,
-	0xa9, 0x18, 0xb3, 0x5f, 0x5d, 0xce, 0xf7, 0xa4, 0xc5, 0x2f, 0xcf, 0xf7, 0x4b, 0x41, 0x5d, 0x58,
-	0x12, 0xd4, 0x8b, 0x91, 0x5b, 0xbc, 0x14, 0xb9, 0xf6, 0x13, 0xd8, 0x5c, 0x7c, 0xf1, 0xd1, 0xf4,
-	0x34, 0xf0, 0xdd, 0xee, 0x88, 0x5d, 0xb3, 0xd6, 0xd8, 0xdf, 0x15, 0x61, 0x2d, 0x37, 0xea, 0xfe,
-	0x5b, 0xbe, 0x06, 0x26, 0xe6, 0x7d, 0xa8, 0x4f, 0x62, 0xff, 0x82, 0x49, 0xee, 0x9c, 0xf3, 0x99,
-	0xe9, 0x80, 0x60, 0x50, 0xaa, 0xa2, 0x6f, 0xab, 0xaa, 0x2a, 0xdc, 0xd8, 0x9f, 0x28, 0xbd, 0x30,
-	0x2f, 0x1b, 0x34, 0x8b, 0x52, 0x0d, 0xf1, 0x67, 0x91, 0x1f, 0x9a, 0xac, 0xac, 0x52, 0x03, 0xa9,
-	0x76, 0xa1, 0x63, 0x95, 0x7b, 0xd8, 0x10, 0xab, 0x34, 0x85, 0xe7, 0x49, 0x53, 0xc9, 0x26, 0xcd,
-	0x21, 0xb4, 0x8c, 0x77, 0x85, 0x23, 0x23, 0x47, 0xc9, 0x31, 0x53, 0xc6, 0xbb, 0xab, 0x06, 0x7a,
-	0x43, 0x7e, 0x12, 0x3d, 0x8b, 0xfc, 0x90, 0x36, 0xe3, 0x1c, 0x4c, 0x3e, 0x81, 0x6a, 0x32, 0x46,
-	0x9a, 0xb1, 0xf5, 0xfe, 0x0a, 0x41, 0x66, 0x7e, 0x15, 0x34, 0x65, 0x50, 0x53, 0x1a, 0x0f, 0xdd,
-	0x78, 0x36, 0x91, 0x69, 0xd2, 0xcf, 0x11, 0xea, 0xa9, 0x98, 0x70, 0x57, 0xb2, 0x79, 0xea, 0xcf,
-	0x11, 0xaa, 0x69, 0x19, 0x52, 0x95, 0xc0, 0xd8, 0xa8, 0x1b, 0x68, 0xb9, 0xe6, 0x1c, 0xbd, 0xcf,
-	0x67, 0xc2, 0xfe, 0x55, 0x11, 0xee, 0x5d, 0x71, 0x23, 0xe3, 0x2f, 0x2b, 0xf5, 0xd7, 0x5b, 0x00,
-	0x13, 0x8c, 0x0d, 0x74, 0x97, 0xf6, 0x7f, 0x4d, 0x63, 0x94, 0xb7, 0x52, 0xa7, 0x17, 0xb3, 0x4e,
-	0xbf, 0xa2, 0xb0, 0xde, 0x81, 0x8a, 0x3b, 0x62, 0x32, 0x19, 0x15, 0x6b, 0xb4, 0xac, 0xc0, 0x3d,
-	0x4f, 0xc5, 0x6d, 0xb2, 0x8a, 0xcc, 0xd4, 0xd3, 0xb2, 0x76, 0x7c, 0x8a, 0xdb, 0x43, 0x27, 0xea,
-	0xf4, 0xad, 0xe8, 0x97, 0x21, 0x40, 0xce, 0x81, 0xc4, 0xfc, 0x82, 0xb3, 0x80, 0x7b, 0xaa, 0xc8,
-	0xc5, 0x5c, 0x88, 0x74, 0x58, 0xfc, 0xf4, 0x5a, 0x6e, 0xdc, 0xa1, 0x86, 0xbf, 0x93, 0xb0, 0xf7,
-	0x43, 0x19, 0xcf, 0xe8, 0xcd, 0x78, 0x11, 0xbf, 0xd9, 0x83, 0xdb, 0xcb, 0x89, 0x49, 0x0b, 0x8a,
-	0xca, 0x42, 0x7a, 0x10, 0x51, 0x47, 0xa5, 0xee, 0x05, 0x0b, 0xa6, 0xdc, 0x44, 0xbf, 0x06, 0x1e,
-	0x17, 0x3e, 0xb6, 0xec, 0x5f, 0x17, 0xa0, 0xb5, 0x98, 0x81, 0xe4, 0xb3, 0xcc, 0x66, 0x7a, 0x69,
-	0xc8, 0x5a, 0xd1, 0x2b, 0x33, 0x7b, 0xe9, 0x17, 0xd0, 0x30, 0x8e, 0x52, 0x06, 0x15, 0xed, 0xc2,
-	0xe2, 0xb4, 0xbc, 0x3a, 0xe5, 0x69, 0x7d, 0x92, 0x9e, 0x05, 0xf9, 0x04, 0x2a, 0xc9, 0xb0, 0x56,
-	0xc4, 0x10, 0xbe, 0x42, 0x8d, 0x64, 0x6e, 0x4b, 0x38, 0xfe, 0x83, 0xed, 0xd8, 0xfe, 0x08, 0xd6,
-	0xf1, 0xa9, 0x52, 0xc8, 0xb4, 0xae, 0xeb, 0x95, 0xa2, 0x4f, 0x61, 0x23, 0x61, 0x3c, 0xd0, 0xdf,
-	0x1f, 0x04, 0xe5, 0xec, 0xba, 0xdc, 0x3f, 0x82, 0xdb, 0xb8, 0xcc, 0xb9, 0xd2, 0xbf, 0xf0, 0xe5,
-	0xac, 0xcb, 0x43, 0xc9, 0xe3, 0x2b, 0xf8, 0x5b, 0x50, 0xf4, 0x3d, 0x6d, 0xde, 0x06, 0x55, 0x47,
-	0xbb, 0xa7, 0xcb, 0x69, 0x5e, 0x42, 0xc7, 0x75, 0x39, 0xe6, 0xed, 0x75, 0xa5, 0xf4, 0x75, 0x5e,
-	0xe6, 0xa5, 0xf4, 0x7c, 0x31, 0xf6, 0x85, 0x78, 0x0d, 0x31, 0xdf, 0x5a, 0xd0, 0x50, 0x72, 0x9e,
-	0x44, 0xd1, 0xf9, 0x98, 0xc5, 0xe7, 0xab, 0x19, 0xa7, 0x71, 0x60, 0xcc, 0xa0, 0x8e, 0xe9, 0xb0,
-	0x5a, 0xcc, 0x0c, 0xab, 0xf7, 0xa0, 0x86, 0x8d, 0xc6, 0x51, 0xb4, 0x3a, 0x91, 0xab, 0x88, 0x18,
-	0xc6, 0x41, 0x76, 0xe2, 0xb8, 0x91, 0x9f, 0x38, 0xde, 0x02, 0xf0, 0x78, 0xc0, 0xd5, 0xe4, 0xc6,
-	0x24, 0x26, 0x72, 0x89, 0xd6, 0x0c, 0xa6, 0x23, 0xed, 0x67, 0x3a, 0xf8, 0xbb, 0x01, 0x67, 0xf1,
-	0x53, 0x5f, 0xc8, 0x28, 0x9e, 0x65, 0xcb, 0x82, 0x95, 0x2b, 0x0b, 0x6f, 0x01, 0xb8, 0x8a, 0x50,
-	0xcb, 0x2a, 0x68, 0x59, 0x06, 0xd3, 0x91, 0xf6, 0x9f, 0x2c, 0x20, 0x4a, 0x98, 0xf9, 0x1c, 0x71,
-	0xe4, 0xbb, 0x72, 0x1a, 0xf3, 0xa5, 0x6b, 0x41, 0x66, 0xef, 0x2a, 0xac, 0xd8, 0xbb, 0x8a, 0x38,
-	0x91, 0x5f, 0xda, 0xbb, 0x4a, 0x88, 0x4e, 0xf6, 0xae, 0x7b, 0x50, 0xc3, 0x16, 0x8c, 0x8b, 0x97,
-	0x9e, 0xe1, 0x71, 0xf1, 0x3a, 0x5e, 0xba, 0x78, 0x95, 0x91, 0x60, 0xc5, 0xe2, 0x55, 0xc9, 0x2e,
-	0x5e, 0x23, 0xb8, 0x75, 0xf9, 0x26, 0x62, 0xf5, 0x6e, 0xf9, 0x31, 0x54, 0x27, 0x86, 0xc8, 0x24,
-	0xfb, 0x56, 0x3e, 0xcf, 0xf2, 0x92, 0x68, 0x4a, 0x6d, 0xff, 0xbe, 0x00, 0x37, 0x15, 0xc1, 0x97,
-	0x2c, 0x08, 0xb8, 0xbc, 0x7a, 0xe6, 0x68, 0x43, 0xc5, 0x14, 0xd5, 0xc4, 0x6a, 0x06, 0x54, 0xf6,
-	0x79, 0x85, 0x02, 0xd0, 0x6c, 0x55, 0x6a, 0x20, 0x65, 0x7b, 0xe5, 0x3b, 0xb4, 0x5a, 0x95, 0xe2,
-	0x59, 0xe1, 0x70, 0x47, 0xd2, 0x25, 0x1f, 0xcf, 0x4a, 0xb2, 0xf2, 0xbd, 0x9a, 0x63, 0xf4, 0x27,
-	0x81, 0x04, 0x54, 0xd4, 0x13, 0x26, 0x47, 0x66, 0x5c, 0xc6, 0xb3, 0x6a, 0x7f, 0x69, 0xd7, 0xc1,
-	0x85, 0xb5, 0x91, 0x6d, 0x43, 0x89, 0xbf, 0x6b, 0x19, 0x7f, 0xab, 0xfb, 0xe0, 0xb2, 0x0f, 0x88,
-	0xd4, 0x00, 0x7a, 0xd5, 0xf7, 0x3c, 0x1e, 0x9a, 0x1e, 0x6a, 0xa0, 0xd5, 0xf3, 0xb3, 0x7d, 0xa0,
-	0x23, 0x2c, 0x67, 0x2c, 0x41, 0x3e, 0x82, 0xaa, 0xa9, 0x79, 0x49, 0xb5, 0xbe, 0x97, 0xb7, 0x7e,
-	0x8e, 0x9e, 0xa6, 0xc4, 0xf6, 0x3f, 0x2d, 0x1d, 0xfe, 0xc7, 0xec, 0x22, 0xed, 0x21, 0x59, 0x2b,
-	0x5b, 0x79, 0x2b, 0x2f, 0xfb, 0x82, 0xb0, 0x05, 0xb5, 0x97, 0xec, 0x22, 0x9a, 0xc6, 0xbe, 0xe4,
-	0xc6, 0xf8, 0x73, 0xc4, 0x15, 0x79, 0xf9, 0x00, 0x1a, 0x7a, 0x2a, 0x74, 0xb2, 0xe1, 0x57, 0xd7,
-	0x38, 0x3d, 0xb6, 0xfe, 0x2f, 0xdc, 0x74, 0x47, 0xcc, 0x0f, 0x1d, 0x31, 0x8a, 0x62, 0x89, 0x1d,
-	0x5c, 0x7f, 0xc8, 0xab, 0xd1, 0x75, 0x7c, 0x70, 0xac, 0xf0, 0xaa, 0x93, 0x0b, 0x55, 0x43, 0x78,
-	0x28, 0x8c, 0xcd, 0xd5, 0x51, 0xc5, 0xaa, 0x2f, 0x1c, 0xc9, 0x85, 0x34, 0xf3, 0x4b, 0xd9, 0x17,
-	0x27, 0x5c, 0xc8, 0x67, 0xa5, 0x6a, 0xa9, 0x75, 0xc3, 0xfe, 0x8d, 0x05, 0x6f, 0x2e, 0x1d, 0x82,
-	0x56, 0xc4, 0xde, 0xe2, 0x48, 0xa0, 0x6d, 0x90, 0x1b, 0x09, 0xfa, 0x70, 0x7f, 0xa4, 0x4b, 0x88,
-	0xc3, 0x62, 0x77, 0xe4, 0x5f, 0x70, 0x47, 0x4c, 0x27, 0x13, 0xa5, 0x3b, 0x0f, 0xd9, 0x69, 0x60,
-	0x06, 0xe0, 0x2a, 0xdd, 0x32, 0x64, 0x1d, 0x4d, 0x75, 0xac, 0x89, 0xfa, 0x9a, 0xc6, 0xfe, 0x83,
-	0xa5, 0x9b, 0xcf, 0x89, 0xda, 0x60, 0xd4, 0x4e, 0xc4, 0xe3, 0x6b, 0xee, 0xdc, 0x9f, 0x41, 0xd9,
-	0x2c, 0x41, 0xea, 0x3d, 0xcd, 0xc5, 0xc1, 0x31, 0x23, 0x70, 0xe7, 0x64, 0xbe, 0x1e, 0x51, 0xc3,
-	0x64, 0x3f, 0x86, 0x7a, 0x06, 0x4d, 0xea, 0x50, 0x19, 0x0e, 0xf6, 0x07, 0x87, 0x5f, 0x0e, 0x5a,
-	0x6f, 0x28, 0xe0, 0x84, 0x0e, 0x8f, 0x4f, 0xfa, 0xbd, 0x96, 0x45, 0x6e, 0xc2, 0xda, 0x70, 0x80,
-	0xe0, 0x97, 0x87, 0xf4, 0xe4, 0xe9, 0x57, 0xad, 0x82, 0xfd, 0x6d, 0x51, 0x2f, 0x10, 0x2f, 0x32,
-	0x0b, 0x9a, 0x19, 0x6c, 0x56, 0x28, 0x4f, 0xa0, 0xf4, 0x32, 0x8e, 0xc6, 0x49, 0x30, 0xa9, 0xb3,
-	0xba, 0x90, 0x8c, 0x4c, 0xd5, 0x2f, 0xc8, 0x48, 0x05, 0x97, 0x3b, 0x52, 0xb1, 0x1b, 0x9e, 0x25,
-	0xc3, 0xdb, 0x1c, 0xa1, 0x5c, 0x62, 0x46, 0x5e, 0x5d, 0x90, 0xcd, 0x5e, 0x9c, 0xe2, 0x3a, 0xf8,
-	0xd5, 0x26, 0xe6, 0x62, 0x12, 0x85, 0x22, 0x49, 0xec, 0x14, 0x56, 0xd5, 0x3c, 0xe6, 0x93, 0xc0,
-	0xd7, 0xcc, 0x3a, 0xfe, 0x6a, 0x06, 0xd3, 0x91, 0x84, 0x2f, 0x5f, 0x44, 0xab, 0x68, 0xd9, 0xef,
-	0xe7, 0x2d, 0xbb, 0xe4, 0xd6, 0x3b, 0x2f, 0x2e, 0xad, 0xaa, 0x4b, 0xd7, 0x57, 0xed, 0xc3, 0x5a,
-	0x3a, 0x02, 0xfc, 0x04, 0xc8, 0x65, 0xce, 0x4b, 0xbe, 0x38, 0xea, 0x0f, 0x7a, 0x7b, 0x83, 0x2f,
-	0x5a, 0x16, 0x69, 0x40, 0xb5, 0xd3, 0xed, 0xf6, 0x8f, 0x94, 0x67, 0x0a, 0x0a, 0xea, 0xf5, 0xbb,
-	0xcf, 0xf7, 0x06, 0xfd, 0x5e, 0xab, 0xa8, 0xa0, 0x6e, 0x67, 0xd0, 0xed, 0x3f, 0xef, 0xf7, 0x5a,
-	0x25, 0xfb, 0xaf, 0x96, 0x9e, 0x0d, 0xba, 0xb9, 0x3d, 0xb1, 0xc7, 0x5d, 0x5f, 0xac, 0xfe, 0x00,
-	0xb5, 0x05, 0x35, 0x63, 0xcf, 0xbd, 0x24, 0xd2, 0xe6, 0x08, 0xf2, 0x53, 0x58, 0xf7, 0x0c, 0xbf,
-	0x93, 0x8b, 0xbc, 0x0f, 0x16, 0xa7, 0xac, 0x65, 0xaf, 0xdc, 0x49, 0x0e, 0xc6, 0x3c, 0x4d, 0x2f,
-	0x07, 0xdb, 0xef, 0x43, 0x33, 0x4f, 0x91, 0xbb, 0xec, 0x1b, 0xb9, 0xcb, 0x5a, 0xf6, 0x77, 0x16,
-	0xac, 0x2f, 0xfc, 0x21, 0xb0, 0xba, 0x5f, 0x2d, 0x6e, 0xc4, 0x85, 0x4b, 0x1b, 0x31, 0x79, 0x1f,
-	0x48, 0x96, 0xc4, 0xc9, 0xae, 0x16, 0xad, 0x0c, 0xa1, 0xae, 0x55, 0xd9, 0x06, 0x58, 0x7a, 0xad,
-	0x06, 0x28, 0x00, 0x28, 0x7b, 0x65, 0x86, 0xc5, 0xec, 0x60, 0x60, 0xe5, 0x07, 0x83, 0x7d, 0xa8,
-	0x9b, 0x7f, 0xb4, 0x4e, 0x54, 0xf7, 0x2a, 0xa0, 0x9d, 0xff, 0x67, 0xfe, 0x92, 0xce, 0xfc, 0x3f,
-	0xb0, 0x03, 0xf3, 0x17, 0x98, 0x11, 0xba, 0xa3, 0x18, 0x68, 0x96, 0xdb, 0xfe, 0x9d, 0x05, 0x4d,
-	0xa5, 0x55, 0xe6, 0xcd, 0x3f, 0x80, 0x7a, 0x9c, 0x42, 0x49, 0x1f, 0xd9, 0x98, 0xcb, 0x9f, 0x93,
-	0xd2, 0x2c, 0x21, 0xd9, 0x85, 0x0d, 0x31, 0x3d, 0x4d, 0x7a, 0xd1, 0x33, 0x11, 0x85, 0x4f, 0x66,
-	0x92, 0x27, 0x1d, 0x7a, 0xe9, 0x33, 0xf2, 0x3e, 0xdc, 0x4c, 0x36, 0xcd, 0x39, 0x83, 0x5e, 0xbf,
-	0x2f, 0x3f, 0xb0, 0x7f, 0x6b, 0x41, 0x5d, 0x29, 0x6b, 0xfe, 0xe0, 0xc0, 0x79, 0x31, 0xf5, 0xa8,
-	0x3a, 0x2e, 0x6d, 0x4c, 0xb7, 0xa1, 0x6c, 0xbe, 0x59, 0x99, 0x91, 0xc0, 0x7c, 0xb2, 0xca, 0xc4,
-	0x44, 0x29, 0x17, 0x13, 0x5b, 0x50, 0x9b, 0xaf, 0x6c, 0x37, 0x70, 0x8a, 0x9d, 0x23, 0xe6, 0xe9,
-	0x51, 0xce, 0xce, 0x49, 0x7f, 0x34, 0xd3, 0x8b, 0x51, 0x4d, 0x0d, 0xcc, 0x51, 0x48, 0x1e, 0x43,
-	0x99, 0xe1, 0x09, 0x75, 0x6c, 0xee, 0xda, 0xf9, 0x50, 0xc8, 0x11, 0xef, 0xe8, 0x1f, 0x6a, 0x38,
-	0xc8, 0x3b, 0xb0, 0x16, 0x05, 0x9e, 0x21, 0x19, 0xa6, 0xe5, 0x3d, 0x8f, 0x24, 0x8f, 0xf0, 0x12,
-	0x0a, 0x32, 0x7b, 0xd1, 0x9b, 0x4b, 0x5f, 0x41, 0x13, 0x2a, 0xd5, 0xee, 0xca, 0x46, 0xbb, 0x9b,
-	0xb0, 0xb6, 0xdf, 0xff, 0xaa, 0xdb, 0xa1, 0x3d, 0xa7, 0xd3, 0xeb, 0x61, 0x26, 0x11, 0x68, 0x76,
-	0xba, 0xdd, 0xc3, 0xe1, 0xe0, 0xe4, 0xd8, 0xe0, 0x2c, 0x72, 0x0b, 0xd6, 0x13, 0xb2, 0x5e, 0xff,
-	0x79, 0x5f, 0xd7, 0x97, 0x0d, 0x68, 0xa5, 0x84, 0xb4, 0x7f, 0x70, 0xf8, 0x02, 0xeb, 0x0c, 0x40,
-	0xf9, 0xf9, 0x61, 0x77, 0x5f, 0x55, 0x19, 0x95, 0x94, 0xc3, 0x81, 0x81, 0x6e, 0x90, 0x75, 0xa8,
-	0x0f, 0xf7, 0x7a, 0xce, 0xf0, 0xa8, 0xd7, 0x51, 0x02, 0xca, 0xa4, 0x05, 0x8d, 0x41, 0xe7, 0xa0,
-	0xef, 0x74, 0x9f, 0x76, 0x06, 0x5f, 0xf4, 0x7b, 0xad, 0x8a, 0xfd, 0xb5, 0xee, 0x76, 0x99, 0x3f,
-	0xb0, 0xc8, 0xf7, 0x32, 0xff, 0x76, 0xe9, 0x38, 0x5c, 0x71, 0xbd, 0xf9, 0x3f, 0x5d, 0xa9, 0x7b,
-	0x0a, 0x19, 0xf7, 0x3c, 0x59, 0xfb, 0xba, 0xbe, 0xf3, 0xe8, 0x93, 0x84, 0xf5, 0xb4, 0x8c, 0xa7,
-	0x0f, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0xf0, 0xeb, 0x53, 0xb8, 0x5e, 0x1e, 0x00, 0x00,
+	// 2806 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4d, 0x73, 0x1b, 0xc7,
+	0xd1, 0xf6, 0x02, 0x20, 0x3e, 0x1a, 0x20, 0x08, 0x8d, 0x64, 0x09, 0xa2, 0xe4, 0x12, 0xb5, 0xb6,
+	0xcb, 0x7a, 0xdf, 0x72, 0xe8, 0x84, 0x8e, 0x63, 0x47, 0xb6, 0xcb, 0x81, 0x00, 0xc4, 0xa2, 0x28,
+	0x81, 0xac, 0x21, 0x21, 0xc7, 0xae, 0x54, 0x6d, 0x0d, 0x77, 0x47, 0xc4, 0x86, 0x8b, 0x5d, 0x64,
+	0x67, 0x40, 0x05, 0xbe, 0xc5, 0x3f, 0x21, 0x97, 0xe4, 0xe8, 0x73, 0x72, 0x4b, 0x95, 0xef, 0x39,
+	0xe6, 0x9e, 0x63, 0x72, 0xc8, 0x39, 0x95, 0x1f, 0x90, 0x63, 0x6a, 0x7a, 0x66, 0x17, 0xbb, 0xf8,
+	0x60, 0xa8, 0xca, 0x29, 0x27, 0x4c, 0xf7, 0x3e, 0xdd, 0xdb, 0xd3, 0xdd, 0x33, 0xdd, 0x8d, 0x85,
+	0xcd, 0x09, 0xf3, 0x63, 0x3f, 0x3c, 0xdb, 0x9d, 0xc4, 0x91, 0x8c, 0x48, 0x15, 0x7f, 0x4e, 0xa7,
+	0x2f, 0xb6, 0xaf, 0x8b, 0x59, 0xe8, 0x3a, 0x82, 0x4b, 0xe9, 0x87, 0x67, 0x42, 0x3f, 0xde, 0xb6,
+	0xd9, 0x64, 0x12, 0xf8, 0x2e, 0x93, 0x7e, 0x14, 0x3a, 0x63, 0x2e, 0x99, 0xc7, 0x24, 0x73, 0xc6,
+	0x5c, 0x08, 0x76, 0xc6, 0x35, 0xc6, 0x66, 0x70, 0xe7, 0xa7, 0x5c, 0xba, 0x23, 0x3f, 0x3c, 0x7b,
+	0xc4, 0xdc, 0x73, 0xee, 0x0d, 0x27, 0x3d, 0x26, 0x59, 0x8f, 0x4b, 0xe6, 0x07, 0x82, 0xdc, 0x83,
+	0x3a, 0x0a, 0x85, 0xd3, 0xf1, 0x29, 0x8f, 0xdb, 0xd6, 0x8e, 0xf5, 0x60, 0x93, 0x82, 0x62, 0x0d,
+	0x90, 0x43, 0xee, 0x43, 0x43, 0x46, 0x92, 0x05, 0x09, 0xa2, 0x80, 0x88, 0x3a, 0xf2, 0x34, 0xc4,
+	0xfe, 0xfb, 0x06, 0x94, 0x95, 0xee, 0xe9, 0x84, 0xdc, 0x80, 0x0d, 0x37, 0x88, 0xdc, 0x73, 0x54,
+	0x54, 0xa2, 0x9a, 0x20, 0x4d, 0x28, 0xf8, 0x1e, 0x4a, 0xd6, 0x68, 0xc1, 0xf7, 0xc8, 0x67, 0x50,
+	0x75, 0xa3, 0x50, 0x32, 0x57, 0x8a, 0x76, 0x71, 0xa7, 0xf8, 0xa0, 0xbe, 0xf7, 0xe6, 0x6e, 0xb2,
+	0xd3, 0xdd, 0xe3, 0x59, 0xe8, 0xee, 0x87, 0x42, 0xb2, 0x20, 0xc0, 0x8d, 0x75, 0x35, 0xf2, 0xf9,
+	0x1e, 0x4d, 0x85, 0xc8, 0x8f, 0xa1, 0xee, 0x46, 0xe3, 0xf1, 0x34, 0xf4, 0xa5, 0xcf, 0x45, 0xbb,
+	0x84, 0x3a, 0x6e, 0xe5, 0x75, 0x74, 0x0d, 0x60, 0x46, 0xb3, 0x58, 0x72, 0x08, 0x5b, 0x89, 0x1a,
+	0xe3, 0x83, 0xf6, 0xc6, 0x8e, 0xf5, 0xa0, 0xbe, 0xf7, 0xf6, 0x5c, 0xfc, 0x12, 0x87, 0xd1, 0x45,
+	0x69, 0x32, 0x04, 0x92, 0xd1, 0x9f, 0xe8, 0x2c, 0xbf, 0x8a, 0xce, 0x15, 0x0a, 0xc8, 0xfb, 0x50,
+	0x99, 0xc4, 0xd1, 0x0b, 0x3f, 0xe0, 0xed, 0x0a, 0xea, 0xba, 0x3d, 0xd7, 0x95, 0xe8, 0x38, 0xd2,
+	0x00, 0x9a, 0x20, 0xc9, 0x33, 0x68, 0x9a, 0x65, 0x62, 0x47, 0xf5, 0x55, 0xec, 0x58, 0x10, 0x26,
+	0xef, 0x41, 0xc5, 0x64, 0x5c, 0xbb, 0x86, 0x7a, 0x5e, 0xcf, 0xbb, 0xf8, 0x58, 0x3f, 0xa4, 0x09,
+	0x4a, 0x39, 0xd7, 0x2c, 0x53, 0x47, 0xc0, 0x2b, 0x39, 0x77, 0x41, 0x9a, 0x7c, 0x00, 0xd5, 0x73,
+	0x3e, 0x73, 0x59, 0xec, 0x89, 0x76, 0x7d, 0xd1, 0x0d, 0xca, 0x84, 0x4e, 0x10, 0x1c, 0x18, 0x00,
+	0x4d, 0xa1, 0xca, 0x8e, 0x64, 0x9d, 0xd8, 0xd1, 0x78, 0x25, 0x3b, 0x16, 0xa4, 0xed, 0x7f, 0x96,
+	0xa0, 0xf1, 0x6c, 0x1a, 0x48, 0xbf, 0xe3, 0xba, 0xd1, 0x34, 0x94, 0x84, 0x40, 0x29, 0x64, 0x63,
+	0x8e, 0x79, 0x5e, 0xa3, 0xb8, 0x26, 0x77, 0xa1, 0x26, 0xfd, 0x31, 0x17, 0x92, 0x8d, 0x27, 0x98,
+	0xed, 0x45, 0x3a, 0x67, 0xa8, 0xa7, 0xbe, 0xc7, 0x43, 0xe9, 0xbb, 0x51, 0xd8, 0x2e, 0xa2, 0xd8,
+	0x9c, 0x41, 0x3e, 0x03, 0x70, 0xa3, 0x20, 0x8a, 0x9d, 0x11, 0x13, 0x23, 0x93, 0xd0, 0x3b, 0x73,
+	0x63, 0xb3, 0xef, 0xde, 0xed, 0x2a, 0xe0, 0x63, 0x26, 0x46, 0xb4, 0xe6, 0x26, 0x4b, 0x72, 0x1b,
+	0xaa, 0x5a, 0x81, 0xef, 0x61, 0x42, 0x17, 0x69, 0x05, 0xe9, 0x7d, 0x8f, 0xbc, 0x93, 0x7a, 0xc3,
+	0x31, 0xd7, 0x0b, 0xa6, 0x67, 0x8d, 0x36, 0x0d, 0xfb, 0x48, 0x73, 0xc9, 0x2d, 0xa8, 0x9c, 0xf3,
+	0x99, 0x33, 0xf5, 0x3d, 0xcc, 0xb9, 0x1a, 0x2d, 0x9f, 0xf3, 0xd9, 0xd0, 0xf7, 0xc8, 0x27, 0x50,
+	0xf6, 0xc7, 0xec, 0x8c, 0xab, 0x7c, 0x52, 0x96, 0xbd, 0xb5, 0xc6, 0xb2, 0x7d, 0xdc, 0x8f, 0x9c,
+	0xed, 0x2b, 0x30, 0x35, 0x32, 0xe4, 0x3d, 0xb8, 0xee, 0x4e, 0x85, 0x8c, 0xc6, 0xfe, 0xd7, 0xfa,
+	0xaa, 0x42, 0xc3, 0x30, 0xa5, 0x6a, 0x94, 0xe4, 0x1e, 0xe1, 0xd6, 0xb6, 0xef, 0x43, 0x2d, 0xdd,
+	0xa3, 0xba, 0x52, 0xfc, 0xd0, 0xe3, 0xbf, 0x6a, 0x5b, 0x3b, 0xc5, 0x07, 0x45, 0xaa, 0x89, 0xed,
+	0xbf, 0x5a, 0xb0, 0x99, 0x7b, 0x5b, 0xd6, 0x78, 0x2b, 0x67, 0x7c, 0x12, 0xaa, 0x42, 0x26, 0x54,
+	0x6d, 0xa8, 0x4c, 0xd8, 0x2c, 0x88, 0x98, 0x87, 0xa1, 0x68, 0xd0, 0x84, 0x54, 0xaf, 0x7b, 0xe9,
+	0x7b, 0x52, 0xc5, 0x40, 0x39, 0x51, 0x13, 0xe4, 0x26, 0x94, 0x47, 0xdc, 0x3f, 0x1b, 0x49, 0xe3,
+	0x5b, 0x43, 0x91, 0x6d, 0xa8, 0xaa, 0x03, 0x23, 0xfc, 0xaf, 0x39, 0xfa, 0xb4, 0x48, 0x53, 0x9a,
+	0xbc, 0x09, 0x9b, 0x31, 0xae, 0x1c, 0xc9, 0xe2, 0x33, 0x2e, 0xd1, 0xa7, 0x45, 0xda, 0xd0, 0xcc,
+	0x13, 0xe4, 0xcd, 0x2f, 0xcc, 0x6a, 0xe6, 0xc2, 0xb4, 0xff, 0x62, 0xc1, 0xf5, 0xa7, 0x91, 0xcb,
+	0x02, 0x13, 0x99, 0x23, 0x63, 0xdc, 0x07, 0x50, 0x3a, 0xe7, 0x33, 0x81, 0xae, 0xa8, 0xef, 0xdd,
+	0x9f, 0x47, 0x61, 0x05, 0x78, 0xf7, 0x80, 0xcf, 0x28, 0xc2, 0xc9, 0x43, 0x68, 0x8c, 0x55, 0x98,
+	0x98, 0x0e, 0x13, 0x7a, 0xa2, 0xbe, 0x77, 0x73, 0x75, 0x10, 0x69, 0x0e, 0xab, 0x76, 0x38, 0x61,
+	0x42, 0xbc, 0x8c, 0x62, 0xcf, 0x64, 0x6d, 0x4a, 0x6f, 0x7f, 0x0f, 0x8a, 0x07, 0x7c, 0xb6, 0xf2,
+	0x2c, 0x10, 0x28, 0xa9, 0x22, 0x82, 0xaf, 0x6a, 0x50, 0x5c, 0xdb, 0xdf, 0x5a, 0xd0, 0x52, 0x36,
+	0x66, 0x6f, 0xf7, 0x35, 0x15, 0xe3, 0x1d, 0xd8, 0xf2, 0x33, 0x28, 0x27, 0x2d, 0x1f, 0xcd, 0x2c,
+	0x7b, 0xdf, 0xc3, 0xfa, 0xc5, 0x2f, 0x7c, 0x97, 0x3b, 0x72, 0x36, 0xe1, 0xc6, 0x42, 0xd0, 0xac,
+	0x93, 0xd9, 0x84, 0xa7, 0xc6, 0x95, 0xf2, 0xd1, 0xbf, 0xe0, 0xb1, 0xf0, 0xa3, 0x10, 0xc3, 0xb9,
+	0x49, 0x13, 0xd2, 0xfe, 0x87, 0x05, 0xb7, 0xd6, 0x14, 0xa0, 0x2b, 0xd6, 0xb6, 0x37, 0x61, 0xd3,
+	0xdc, 0xa2, 0x0e, 0xa6, 0xbf, 0x31, 0xa9, 0x61, 0x98, 0x3a, 0x57, 0x6f, 0x43, 0x95, 0x87, 0xc2,
+	0xc9, 0x18, 0x56, 0xe1, 0xa1, 0x18, 0x28, 0xdb, 0xee, 0x43, 0x23, 0x60, 0x42, 0x3a, 0xd3, 0x89,
+	0xc7, 0x24, 0xd7, 0x67, 0xb9, 0x44, 0xeb, 0x8a, 0x37, 0xd4, 0x2c, 0xb5, 0x67, 0x31, 0x13, 0x92,
+	0x8f, 0x1d, 0xc9, 0xce, 0x54, 0xa9, 0x29, 0xaa, 0x3d, 0x6b, 0xd6, 0x09, 0x3b, 0x13, 0xe4, 0x6d,
+	0x68, 0x06, 0x2a, 0x21, 0x9c, 0xd0, 0x77, 0xcf, 0xf1, 0x25, 0xfa, 0x38, 0x6f, 0x22, 0x77, 0x60,
+	0x98, 0xf6, 0xaf, 0xcb, 0x70, 0x7b, 0x6d, 0xb5, 0x25, 0xdf, 0x87, 0x1b, 0x59, 0x43, 0x1c, 0x94,
+	0x0d, 0x66, 0x66, 0xf7, 0x24, 0x63, 0xd0, 0x53, 0xfd, 0xe4, 0x7f, 0xd8, 0x15, 0x2a, 0xb6, 0xcc,
+	0xf3, 0xb8, 0x87, 0x97, 0x52, 0x95, 0x6a, 0x42, 0xe5, 0xc9, 0xa9, 0x0a, 0x32, 0xf7, 0xb0, 0x8c,
+	0x55, 0x69, 0x42, 0x2a, 0xfc, 0x78, 0xaa, 0x6c, 0xaa, 0x6b, 0x3c, 0x12, 0x0a, 0x1f, 0xf3, 0x71,
+	0x74, 0xc1, 0x3d, 0x2c, 0x37, 0x55, 0x9a, 0x90, 0x64, 0x07, 0x1a, 0x23, 0x26, 0x1c, 0x54, 0xeb,
+	0x4c, 0x45, 0x7b, 0x13, 0x1f, 0xc3, 0x88, 0x89, 0x8e, 0x62, 0x0d, 0xf1, 0x92, 0xbc, 0xe0, 0xb1,
+	0xff, 0x22, 0x69, 0xe7, 0x84, 0x64, 0x72, 0x2a, 0xda, 0x4d, 0xbc, 0x33, 0x48, 0xf6, 0xd1, 0x31,
+	0x3e, 0xc1, 0xc6, 0x2c, 0x9e, 0x0a, 0x99, 0x20, 0xb7, 0x10, 0x59, 0x47, 0x9e, 0x81, 0x7c, 0x0a,
+	0x77, 0x4c, 0xb7, 0xe2, 0xc4, 0xfc, 0x97, 0x53, 0x2e, 0xa4, 0x8e, 0x22, 0x8a, 0xf0, 0x76, 0x0b,
+	0x25, 0xda, 0x06, 0x42, 0x35, 0x02, 0x83, 0xa9, 0xe4, 0xf9, 0x7a, 0x71, 0x7d, 0x0c, 0xae, 0xad,
+	0x15, 0xef, 0xe2, 0xc9, 0xf8, 0x0c, 0xee, 0x2e, 0x8a, 0x2b, 0x77, 0x48, 0x6e, 0x5e, 0x4f, 0x50,
+	0xfe, 0x76, 0x5e, 0x9e, 0x22, 0x42, 0xbf, 0x7f, 0xbd, 0x02, 0x6d, 0xc0, 0xf5, 0xf5, 0x0a, 0xb4,
+	0x05, 0xf7, 0xa1, 0xe1, 0xf9, 0x62, 0x12, 0xb0, 0x99, 0xce, 0xaf, 0x1b, 0x18, 0xfa, 0xba, 0xe1,
+	0xa9, 0x1c, 0xb3, 0x5f, 0x2e, 0x9f, 0xf7, 0xa4, 0xc4, 0xaf, 0x3e, 0xef, 0x4b, 0x49, 0x5d, 0x58,
+	0x91, 0xd4, 0x8b, 0x99, 0x5b, 0x5c, 0xca, 0x5c, 0xfb, 0x11, 0x6c, 0x2f, 0xbe, 0xf8, 0x68, 0x7a,
+	0x1a, 0xf8, 0x6e, 0x77, 0xc4, 0xae, 0x78, 0xd7, 0xd8, 0xdf, 0x15, 0x61, 0x33, 0xd7, 0xea, 0xfe,
+	0x47, 0xb9, 0x06, 0x1e, 0xcc, 0x7b, 0x50, 0x9f, 0xc4, 0xfe, 0x05, 0x93, 0xdc, 0x39, 0xe7, 0x33,
+	0x53, 0x01, 0xc1, 0xb0, 0xd4, 0x8d, 0xbe, 0xa3, 0x6e, 0x55, 0xe1, 0xc6, 0xfe, 0x44, 0xd9, 0x85,
+	0xe7, 0xb2, 0x41, 0xb3, 0x2c, 0x55, 0x10, 0x7f, 0x11, 0xf9, 0xa1, 0x39, 0x95, 0x55, 0x6a, 0x28,
+	0x55, 0x2e, 0x74, 0xae, 0x72, 0x0f, 0x0b, 0x62, 0x95, 0xa6, 0xf4, 0xfc, 0xd0, 0x54, 0xb2, 0x87,
+	0xe6, 0x10, 0x5a, 0x26, 0xba, 0xc2, 0x91, 0x91, 0xa3, 0xf4, 0x98, 0x2e, 0xe3, 0xed, 0x75, 0x0d,
+	0xbd, 0x81, 0x9f, 0x44, 0x4f, 0x22, 0x3f, 0xa4, 0xcd, 0x38, 0x47, 0x93, 0x8f, 0xa1, 0x9a, 0xb4,
+	0x91, 0xa6, 0x6d, 0xbd, 0xb7, 0x46, 0x91, 0xe9, 0x5f, 0x05, 0x4d, 0x05, 0x54, 0x97, 0xc6, 0x43,
+	0x37, 0x9e, 0x4d, 0x64, 0x7a, 0xe8, 0xe7, 0x0c, 0xf5, 0x54, 0x4c, 0xb8, 0x2b, 0xd9, 0xfc, 0xe8,
+	0xcf, 0x19, 0xaa, 0x68, 0x19, 0xa8, 0x3a, 0xc0, 0x58, 0xa8, 0x1b, 0xe8, 0xb9, 0xe6, 0x9c, 0x7d,
+	0xc0, 0x67, 0xc2, 0xfe, 0xa6, 0x08, 0x77, 0x2e, 0xd9, 0x91, 0x89, 0x97, 0x95, 0xc6, 0xeb, 0x0d,
+	0x80, 0x09, 0xe6, 0x06, 0x86, 0x4b, 0xc7, 0xbf, 0xa6, 0x39, 0x07, 0x3c, 0x13, 0xf4, 0x62, 0x36,
+	0xe8, 0x97, 0x5c, 0xac, 0xb7, 0xa0, 0xe2, 0x8e, 0x98, 0x4c, 0x5a, 0xc5, 0x1a, 0x2d, 0x2b, 0x72,
+	0xdf, 0x53, 0x79, 0x9b, 0x8c, 0x22, 0x33, 0xc7, 0xd7, 0x11, 0x6c, 0xcc, 0xe7, 0xa7, 0xd9, 0x3e,
+	0x06, 0x51, 0x1f, 0xdf, 0x8a, 0x7e, 0x19, 0x12, 0xe4, 0x1c, 0x48, 0xcc, 0x2f, 0x38, 0x0b, 0xb8,
+	0xa7, 0x2e, 0xb9, 0x98, 0x0b, 0x91, 0x36, 0x8b, 0x9f, 0x5c, 0x29, 0x8c, 0xbb, 0xd4, 0xc8, 0x77,
+	0x12, 0xf1, 0x7e, 0x28, 0xe3, 0x19, 0xbd, 0x16, 0x2f, 0xf2, 0xb7, 0x7b, 0x70, 0x73, 0x35, 0x98,
+	0xb4, 0xa0, 0xa8, 0x3c, 0xa4, 0x1b, 0x11, 0xb5, 0x54, 0xe6, 0x5e, 0xb0, 0x60, 0xca, 0x4d, 0xf6,
+	0x6b, 0xe2, 0x61, 0xe1, 0x23, 0xcb, 0xfe, 0x4d, 0x01, 0x5a, 0x8b, 0x27, 0x90, 0x7c, 0x9a, 0x99,
+	0x4c, 0x97, 0x9a, 0xac, 0x35, 0xb5, 0x32, 0x33, 0x97, 0x7e, 0x0e, 0x0d, 0x13, 0x28, 0xe5, 0x50,
+	0xd1, 0x2e, 0x2c, 0x76, 0xcb, 0xeb, 0x8f, 0x3c, 0xad, 0x4f, 0xd2, 0xb5, 0x20, 0x1f, 0x43, 0x25,
+	0x69, 0xd6, 0x8a, 0x3b, 0xd6, 0xe5, 0x66, 0x24, 0x7d, 0x5b, 0x22, 0xf1, 0x5f, 0x4c, 0xc7, 0xf6,
+	0x87, 0xb0, 0x85, 0x4f, 0x95, 0x41, 0xa6, 0x74, 0x5d, 0xed, 0x2a, 0xfa, 0x04, 0x6e, 0x24, 0x82,
+	0xcf, 0xf4, 0xff, 0x0f, 0x82, 0x72, 0x76, 0x55, 0xe9, 0x9f, 0xc0, 0x4d, 0x1c, 0xe6, 0x5c, 0xe9,
+	0x5f, 0xf8, 0x72, 0xd6, 0xe5, 0xa1, 0xe4, 0xf1, 0x25, 0xf2, 0x2d, 0x28, 0xfa, 0x9e, 0x76, 0x6f,
+	0x83, 0xaa, 0xa5, 0xdd, 0x83, 0xed, 0x65, 0x0d, 0x1d, 0xd7, 0xe5, 0x78, 0x6e, 0xaf, 0xaa, 0xa5,
+	0x0f, 0x77, 0x96, 0xb5, 0xf4, 0x7c, 0x31, 0xf6, 0x85, 0x78, 0x05, 0x35, 0xdf, 0x5a, 0xd0, 0x50,
+	0x7a, 0x1e, 0x45, 0xd1, 0xf9, 0x98, 0xc5, 0xe7, 0xeb, 0x05, 0xa7, 0x71, 0x60, 0xdc, 0xa0, 0x96,
+	0x69, 0xb3, 0x5a, 0xcc, 0x34, 0xab, 0x77, 0xa0, 0x86, 0x85, 0xc6, 0x51, 0x58, 0x7d, 0x90, 0xab,
+	0xc8, 0x18, 0xc6, 0x41, 0xb6, 0xe3, 0xd8, 0xc8, 0x77, 0x1c, 0x6f, 0x00, 0x78, 0x3c, 0xe0, 0xaa,
+	0x73, 0x63, 0x12, 0x0f, 0x72, 0x89, 0xd6, 0x0c, 0xa7, 0x23, 0xed, 0x27, 0x3a, 0xf9, 0xbb, 0x01,
+	0x67, 0xf1, 0x63, 0x5f, 0xc8, 0x28, 0x9e, 0x65, 0xaf, 0x05, 0x2b, 0x77, 0x2d, 0xbc, 0x01, 0xe0,
+	0x2a, 0xa0, 0xd6, 0x55, 0xd0, 0xba, 0x0c, 0xa7, 0x23, 0xed, 0x3f, 0x5b, 0x40, 0x94, 0x32, 0xf3,
+	0x77, 0xc4, 0x91, 0xef, 0xca, 0x69, 0xcc, 0x57, 0x8e, 0x05, 0x99, 0xb9, 0xab, 0xb0, 0x66, 0xee,
+	0x2a, 0x62, 0x47, 0xbe, 0x34, 0x77, 0x95, 0x90, 0x6d, 0x28, 0xe5, 0x14, 0x2c, 0xc1, 0x38, 0x78,
+	0xe9, 0x1e, 0x1e, 0x07, 0xaf, 0xe3, 0x95, 0x83, 0x57, 0x19, 0x01, 0x6b, 0x06, 0xaf, 0x4a, 0x76,
+	0xf0, 0x1a, 0xc1, 0xf5, 0xe5, 0x9d, 0x88, 0xf5, 0xb3, 0xe5, 0x47, 0x50, 0x9d, 0x18, 0x90, 0x39,
+	0xec, 0x77, 0xf3, 0xe7, 0x2c, 0xaf, 0x89, 0xa6, 0x68, 0xfb, 0x0f, 0x05, 0xb8, 0xa6, 0x00, 0x5f,
+	0xb0, 0x20, 0xe0, 0xf2, 0xf2, 0x9e, 0xa3, 0x0d, 0x15, 0x73, 0xa9, 0x26, 0x5e, 0x33, 0xa4, 0xf2,
+	0xcf, 0x4b, 0x54, 0x80, 0x6e, 0xab, 0x52, 0x43, 0x29, 0xdf, 0xab, 0xd8, 0xa1, 0xd7, 0xaa, 0x14,
+	0xd7, 0x8a, 0x87, 0x33, 0x92, 0xbe, 0xf2, 0x71, 0xad, 0x34, 0xab, 0xd8, 0xab, 0x3e, 0x46, 0xff,
+	0x25, 0x90, 0x90, 0x0a, 0x3d, 0x61, 0x72, 0x64, 0xda, 0x65, 0x5c, 0xab, 0xf2, 0x97, 0x56, 0x1d,
+	0x1c, 0x58, 0x1b, 0xd9, 0x32, 0x94, 0xc4, 0xbb, 0x96, 0x89, 0xb7, 0xda, 0x0f, 0x0e, 0xfb, 0x80,
+	0x4c, 0x4d, 0x60, 0x54, 0x7d, 0xcf, 0xe3, 0xa1, 0xa9, 0xa1, 0x86, 0x5a, 0xdf, 0x3f, 0xdb, 0xcf,
+	0x80, 0x2c, 0x39, 0x4b, 0x90, 0x0f, 0xa1, 0x6a, 0xee, 0xbc, 0xe4, 0xb6, 0xbe, 0x93, 0xf7, 0x7e,
+	0x0e, 0x4f, 0x53, 0xb0, 0xfd, 0x2f, 0x4b, 0xa7, 0xff, 0x31, 0xbb, 0x48, 0x6b, 0x48, 0xd6, 0xcb,
+	0x56, 0xde, 0xcb, 0xab, 0xfe, 0x41, 0xb8, 0x0b, 0xb5, 0x17, 0xec, 0x22, 0x9a, 0xc6, 0xbe, 0xe4,
+	0xc6, 0xf9, 0x73, 0xc6, 0x25, 0xe7, 0xf2, 0x3e, 0x34, 0x74, 0x57, 0xe8, 0x64, 0xd3, 0xaf, 0xae,
+	0x79, 0xba, 0x6d, 0xfd, 0x7f, 0xb8, 0xe6, 0x8e, 0x98, 0x1f, 0x3a, 0x62, 0x14, 0xc5, 0x12, 0x2b,
+	0xb8, 0xfe, 0x23, 0xaf, 0x46, 0xb7, 0xf0, 0xc1, 0xb1, 0xe2, 0xab, 0x4a, 0x2e, 0xd4, 0x1d, 0xc2,
+	0x43, 0x61, 0x7c, 0xae, 0x96, 0x2a, 0x57, 0x7d, 0xe1, 0x48, 0x2e, 0xa4, 0xe9, 0x5f, 0xca, 0xbe,
+	0x38, 0xe1, 0x42, 0x3e, 0x29, 0x55, 0x4b, 0xad, 0x0d, 0xfb, 0x9b, 0x02, 0xbc, 0xbe, 0xb2, 0x09,
+	0x5a, 0x93, 0x7b, 0x8b, 0x2d, 0x81, 0xf6, 0x41, 0xae, 0x25, 0xe8, 0xc3, 0xbd, 0x91, 0xbe, 0x42,
+	0x1c, 0x16, 0xbb, 0x23, 0xff, 0x82, 0x3b, 0x62, 0x3a, 0x99, 0x28, 0xdb, 0x79, 0xc8, 0x4e, 0x03,
+	0xd3, 0x00, 0x57, 0xe9, 0x5d, 0x03, 0xeb, 0x68, 0xd4, 0xb1, 0x06, 0xf5, 0x35, 0x86, 0xbc, 0x05,
+	0x4d, 0x2f, 0xf4, 0xd4, 0x70, 0x10, 0x4b, 0x67, 0x14, 0x4d, 0x63, 0xcc, 0xde, 0x0d, 0xda, 0xf0,
+	0x42, 0xef, 0x58, 0x31, 0x1f, 0x47, 0xd3, 0x58, 0x4d, 0x52, 0x0a, 0xc5, 0x43, 0x4f, 0x63, 0x36,
+	0x10, 0x03, 0x5e, 0xe8, 0xf5, 0x43, 0x0f, 0x11, 0xf7, 0x35, 0x42, 0xfd, 0xf3, 0xf6, 0x75, 0x14,
+	0x26, 0x89, 0x5d, 0xf7, 0x42, 0xef, 0xc4, 0xb0, 0xec, 0x3f, 0x5a, 0xba, 0xce, 0x9d, 0xa8, 0x61,
+	0x49, 0x8d, 0x5f, 0x3c, 0xbe, 0xe2, 0x78, 0xff, 0x29, 0x94, 0xcd, 0xbc, 0xa5, 0xb6, 0xd4, 0x5c,
+	0xec, 0x51, 0x33, 0x0a, 0x77, 0x4f, 0xe6, 0x93, 0x18, 0x35, 0x42, 0xf6, 0x43, 0xa8, 0x67, 0xd8,
+	0xa4, 0x0e, 0x95, 0xe1, 0xe0, 0x60, 0x70, 0xf8, 0xc5, 0xa0, 0xf5, 0x9a, 0x22, 0x4e, 0xe8, 0xf0,
+	0xf8, 0xa4, 0xdf, 0x6b, 0x59, 0xe4, 0x1a, 0x6c, 0x0e, 0x07, 0x48, 0x7e, 0x71, 0x48, 0x4f, 0x1e,
+	0x7f, 0xd9, 0x2a, 0xd8, 0xdf, 0x16, 0xf5, 0xac, 0xf2, 0x3c, 0x33, 0x0b, 0x9a, 0x1e, 0x6a, 0x8d,
+	0xf1, 0x04, 0x4a, 0x2f, 0xe2, 0x68, 0x9c, 0xe4, 0xad, 0x5a, 0xab, 0x0d, 0xc9, 0xc8, 0x14, 0x98,
+	0x82, 0x8c, 0x54, 0x1e, 0xbb, 0x23, 0x75, 0x4c, 0xc2, 0xb3, 0xa4, 0x4f, 0x9c, 0x33, 0x94, 0x2f,
+	0x4d, 0x77, 0xad, 0xef, 0x7e, 0x33, 0x82, 0xa7, 0xbc, 0x0e, 0xfe, 0x41, 0x14, 0x73, 0x31, 0x89,
+	0x42, 0x91, 0xb8, 0x3a, 0xa5, 0x55, 0xe1, 0x88, 0xf9, 0x24, 0xf0, 0xb5, 0xb0, 0x4e, 0xf5, 0x9a,
+	0xe1, 0x74, 0x24, 0xe1, 0xab, 0x67, 0xde, 0x2a, 0x7a, 0xf6, 0x87, 0x79, 0xcf, 0xae, 0xd8, 0xf5,
+	0xee, 0xf3, 0xa5, 0xa9, 0x78, 0xe5, 0xa4, 0xac, 0x63, 0x58, 0x4b, 0xbb, 0x8d, 0x9f, 0x01, 0x59,
+	0x96, 0x5c, 0x8a, 0xc5, 0x51, 0x7f, 0xd0, 0xdb, 0x1f, 0x7c, 0xde, 0xb2, 0x48, 0x03, 0xaa, 0x9d,
+	0x6e, 0xb7, 0x7f, 0xa4, 0x22, 0x53, 0x50, 0x54, 0xaf, 0xdf, 0x7d, 0xba, 0x3f, 0xe8, 0xf7, 0x5a,
+	0x45, 0x45, 0x75, 0x3b, 0x83, 0x6e, 0xff, 0x69, 0xbf, 0xd7, 0x2a, 0xd9, 0x7f, 0xb3, 0x74, 0x1b,
+	0xd2, 0xcd, 0x8d, 0xa4, 0x3d, 0xee, 0xfa, 0x62, 0xfd, 0x7f, 0x5d, 0x77, 0xa1, 0x66, 0xfc, 0xb9,
+	0x9f, 0x64, 0xda, 0x9c, 0x41, 0x7e, 0x0e, 0x5b, 0x9e, 0x91, 0x77, 0x72, 0x99, 0xf7, 0xfe, 0x62,
+	0x43, 0xb7, 0xea, 0x95, 0xbb, 0xc9, 0xc2, 0xb8, 0xa7, 0xe9, 0xe5, 0x68, 0xfb, 0x5d, 0x68, 0xe6,
+	0x11, 0xb9, 0xcd, 0xbe, 0x96, 0xdb, 0xac, 0x65, 0x7f, 0x67, 0xc1, 0xd6, 0xc2, 0xb7, 0x87, 0xf5,
+	0xa5, 0x71, 0x71, 0xf8, 0x2e, 0x2c, 0x0d, 0xdf, 0xe4, 0x5d, 0x20, 0x59, 0x88, 0x93, 0x9d, 0x62,
+	0x5a, 0x19, 0xa0, 0xbe, 0x16, 0xb3, 0xb5, 0xb6, 0xf4, 0x4a, 0xb5, 0x56, 0x00, 0x50, 0xf6, 0xd2,
+	0xf4, 0xa5, 0xd9, 0x1e, 0xc4, 0xca, 0xf7, 0x20, 0x07, 0x50, 0x37, 0x1f, 0xcf, 0xd4, 0x5f, 0x87,
+	0x68, 0x71, 0x73, 0xef, 0xff, 0xe6, 0x2f, 0xe9, 0xcc, 0x3f, 0xb7, 0x3d, 0x33, 0x5f, 0xdb, 0x8c,
+	0xd2, 0x5d, 0x25, 0x40, 0xb3, 0xd2, 0xf6, 0xef, 0x2d, 0x68, 0x2a, 0xab, 0x32, 0x6f, 0xfe, 0x11,
+	0xd4, 0xe3, 0x94, 0x4a, 0x4a, 0xd6, 0x8d, 0xb9, 0xfe, 0x39, 0x94, 0x66, 0x81, 0x64, 0x0f, 0x6e,
+	0x88, 0xe9, 0x69, 0x52, 0xf6, 0x9e, 0x88, 0x28, 0x7c, 0x34, 0x93, 0x3c, 0x69, 0x06, 0x56, 0x3e,
+	0x23, 0xef, 0xc2, 0xb5, 0x64, 0xa8, 0x9d, 0x0b, 0xe8, 0x49, 0x7f, 0xf9, 0x81, 0xfd, 0x3b, 0x0b,
+	0xea, 0xca, 0x58, 0xf3, 0x2d, 0x05, 0x5b, 0xd3, 0x34, 0xa2, 0x6a, 0xb9, 0xb2, 0x06, 0xde, 0x84,
+	0xb2, 0xf9, 0x7b, 0xcc, 0x74, 0x1f, 0x9a, 0xca, 0xe6, 0x44, 0x29, 0x97, 0x13, 0x77, 0xa1, 0x36,
+	0x9f, 0x0e, 0x37, 0xb0, 0x61, 0x9e, 0x33, 0xe6, 0xc7, 0xa3, 0x9c, 0x6d, 0xc9, 0xfe, 0x64, 0x1a,
+	0x25, 0x63, 0x9a, 0xea, 0xcd, 0xa3, 0x90, 0x3c, 0x84, 0x32, 0xc3, 0x15, 0xda, 0xd8, 0xdc, 0xb3,
+	0xf3, 0xa9, 0x90, 0x03, 0xef, 0xea, 0x1f, 0x6a, 0x24, 0xc8, 0x5b, 0xb0, 0x19, 0x05, 0x9e, 0x81,
+	0x0c, 0xd3, 0xeb, 0x3d, 0xcf, 0x54, 0x1f, 0xbf, 0xcc, 0xe7, 0x91, 0x76, 0x71, 0xd5, 0xc7, 0x2f,
+	0x03, 0xa5, 0x09, 0xca, 0xfe, 0xad, 0x05, 0x65, 0x63, 0xdd, 0x35, 0xd8, 0x3c, 0xe8, 0x7f, 0xd9,
+	0xed, 0xd0, 0x9e, 0xd3, 0xe9, 0xf5, 0xf0, 0x24, 0x11, 0x68, 0x76, 0xba, 0xdd, 0xc3, 0xe1, 0xe0,
+	0xe4, 0xd8, 0xf0, 0x2c, 0x72, 0x1d, 0xb6, 0x12, 0x58, 0xaf, 0xff, 0xb4, 0xaf, 0xef, 0x97, 0x1b,
+	0xd0, 0x4a, 0x81, 0xb4, 0xff, 0xec, 0xf0, 0x39, 0xde, 0x33, 0x00, 0xe5, 0xa7, 0x87, 0xdd, 0x03,
+	0x75, 0xcb, 0xa8, 0x43, 0x39, 0x1c, 0x18, 0x6a, 0x83, 0x6c, 0x41, 0x7d, 0xb8, 0xdf, 0x73, 0x86,
+	0x47, 0xbd, 0x8e, 0x52, 0x50, 0x26, 0x2d, 0x68, 0x0c, 0x3a, 0xcf, 0xfa, 0x4e, 0xf7, 0x71, 0x67,
+	0xf0, 0x79, 0xbf, 0xd7, 0xaa, 0xd8, 0x5f, 0xc1, 0xd6, 0xc2, 0xb7, 0x32, 0xf2, 0x83, 0xcc, 0x87,
+	0x35, 0x9d, 0x87, 0x6b, 0xb6, 0x97, 0xc2, 0xe6, 0xe1, 0x29, 0x64, 0xc2, 0xf3, 0x68, 0xf3, 0xab,
+	0xfa, 0xee, 0x7b, 0x1f, 0x27, 0xa2, 0xa7, 0x65, 0x5c, 0xbd, 0xff, 0xef, 0x01, 0x00, 0x4f, 0x04,
+	0x2a, 0xb4, 0xc9, 0x1e, 0x00, 0x00,
 }
@@ -37,6 +37,7 @@ const (
 	SyncSetting_STICKERS_PACKS_PENDING      SyncSetting_Type = 11
 	SyncSetting_STICKERS_RECENT_STICKERS    SyncSetting_Type = 12
 	SyncSetting_DISPLAY_NAME                SyncSetting_Type = 13
+	SyncSetting_NOTIFICATION_LEVEL          SyncSetting_Type = 14
 )
 
 var SyncSetting_Type_name = map[int32]string{
@@ -54,6 +55,7 @@ var SyncSetting_Type_name = map[int32]string{
 	11: "STICKERS_PACKS_PENDING",
 	12: "STICKERS_RECENT_STICKERS",
 	13: "DISPLAY_NAME",
+	14: "NOTIFICATION_LEVEL",
 }
 
 var SyncSetting_Type_value = map[string]int32{
@@ -71,6 +73,7 @@ var SyncSetting_Type_value = map[string]int32{
 	"STICKERS_PACKS_PENDING":      11,
 	"STICKERS_RECENT_STICKERS":    12,
 	"DISPLAY_NAME":                13,
+	"NOTIFICATION_LEVEL":          14,
 }
 
 func (x SyncSetting_Type) String() string {
@@ -217,34 +220,35 @@ func init() {
 }
 
 var fileDescriptor_e2f7a0bce2873c78 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcd, 0x8e, 0xda, 0x30,
-	0x10, 0x80, 0xc9, 0x12, 0xfe, 0x26, 0xec, 0xae, 0x65, 0xaa, 0x36, 0xda, 0x56, 0xda, 0x74, 0x7b,
-	0xc9, 0x29, 0x95, 0xda, 0xaa, 0x97, 0x9e, 0x4c, 0x62, 0xc0, 0x22, 0x38, 0x91, 0xc7, 0x01, 0xd1,
-	0x8b, 0x55, 0x10, 0x5d, 0xa1, 0x22, 0x82, 0x96, 0x6c, 0x25, 0x1e, 0xae, 0x2f, 0xd1, 0x27, 0xaa,
-	0x92, 0x94, 0xfe, 0x9e, 0x92, 0xf9, 0xe6, 0x9b, 0xf1, 0xf8, 0x07, 0x06, 0xc7, 0xd3, 0x7e, 0x6d,
-	0x8e, 0x9b, 0xa2, 0xd8, 0xee, 0xef, 0x8f, 0xc1, 0xe1, 0x21, 0x2f, 0x72, 0xda, 0xad, 0x3e, 0xab,
-	0xc7, 0xcf, 0x77, 0xdf, 0x6c, 0x70, 0xf0, 0xb4, 0x5f, 0x63, 0x2d, 0xd0, 0x00, 0xec, 0xe2, 0x74,
-	0xd8, 0xb8, 0x96, 0x67, 0xf9, 0x57, 0x6f, 0x6e, 0x82, 0xb3, 0x18, 0xfc, 0x21, 0x05, 0xfa, 0x74,
-	0xd8, 0xa8, 0xca, 0xa3, 0x4f, 0xa0, 0xb5, 0xde, 0xe5, 0xeb, 0x2f, 0xee, 0x85, 0x67, 0xf9, 0xb6,
-	0xaa, 0x03, 0xfa, 0x0a, 0xfa, 0x5f, 0x3f, 0xed, 0x1e, 0x37, 0xe6, 0x58, 0x3c, 0x6c, 0xf7, 0xf7,
-	0x6e, 0xd3, 0xb3, 0xfc, 0xde, 0xa4, 0xa1, 0x9c, 0x8a, 0x62, 0x05, 0xe9, 0x4b, 0xa8, 0x43, 0xb3,
-	0x3a, 0x15, 0x9b, 0xa3, 0x6b, 0x7b, 0x96, 0xdf, 0x9f, 0x34, 0x14, 0x54, 0x70, 0x58, 0x32, 0x7a,
-	0x0b, 0xf0, 0x53, 0xc9, 0xf3, 0x9d, 0xdb, 0xf2, 0x2c, 0xbf, 0x3b, 0x69, 0xa8, 0x5e, 0x6d, 0xe4,
-	0xf9, 0xee, 0x77, 0x8f, 0xed, 0xbe, 0x78, 0xff, 0xce, 0x6d, 0x7b, 0x96, 0xdf, 0xfc, 0xd5, 0x43,
-	0x94, 0xec, 0xee, 0xfb, 0x05, 0xd8, 0xe5, 0xc0, 0xd4, 0x81, 0x4e, 0x26, 0xa7, 0x32, 0x59, 0x48,
-	0xd2, 0xa0, 0x7d, 0xe8, 0x86, 0x99, 0x52, 0x5c, 0x86, 0x4b, 0x62, 0xd1, 0x6b, 0x70, 0xc6, 0x62,
-	0x64, 0x14, 0x0f, 0xb9, 0xd4, 0x48, 0x2e, 0x28, 0x85, 0xab, 0x12, 0x8c, 0xd8, 0x3c, 0xc9, 0x94,
-	0xd0, 0x1c, 0x49, 0x93, 0xde, 0xc2, 0xf3, 0x19, 0x47, 0x64, 0x63, 0x8e, 0x66, 0xa4, 0x92, 0x99,
-	0x09, 0x13, 0xa9, 0x59, 0xa8, 0xd1, 0x24, 0x32, 0x5e, 0x12, 0xbb, 0x2c, 0x4a, 0x15, 0x1f, 0x71,
-	0xa5, 0x78, 0x64, 0x24, 0x9b, 0x71, 0xd2, 0xa2, 0x03, 0xb8, 0x4e, 0x15, 0x9f, 0x0b, 0xbe, 0x30,
-	0xa9, 0x12, 0x73, 0x16, 0x2e, 0x49, 0x9b, 0xbe, 0x00, 0x37, 0x55, 0xc9, 0x48, 0xc4, 0xdc, 0xa4,
-	0x22, 0xd4, 0x99, 0xe2, 0x68, 0x70, 0x92, 0x2c, 0x8c, 0x4e, 0x48, 0xa7, 0x5c, 0xe7, 0xbf, 0xec,
-	0x5c, 0xa0, 0x18, 0x8a, 0x58, 0xe8, 0x25, 0xe9, 0xd2, 0x67, 0x30, 0x40, 0x2e, 0x23, 0x83, 0x9a,
-	0xe9, 0x0c, 0x4d, 0x96, 0x46, 0xac, 0x9c, 0xb0, 0x57, 0xf6, 0x45, 0x2d, 0xc2, 0x29, 0x57, 0x68,
-	0x52, 0x16, 0x4e, 0xd1, 0x08, 0x89, 0x9a, 0xc5, 0x31, 0x8f, 0x08, 0xd0, 0x1b, 0x78, 0xfa, 0x4f,
-	0x36, 0xe5, 0x32, 0x12, 0x72, 0x4c, 0x9c, 0xbf, 0x2a, 0xeb, 0x53, 0x30, 0xe7, 0x98, 0xf4, 0x29,
-	0x81, 0x7e, 0x24, 0x30, 0x8d, 0xd9, 0xb2, 0xde, 0xd6, 0xe5, 0xb0, 0x03, 0xad, 0xfa, 0x12, 0x2e,
-	0x3f, 0x3a, 0xc1, 0xeb, 0x0f, 0xe7, 0x57, 0xb2, 0x6a, 0x57, 0x7f, 0x6f, 0x7f, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x8b, 0x2e, 0x81, 0x96, 0x76, 0x02, 0x00, 0x00,
+	// 472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4d, 0x8f, 0xda, 0x3c,
+	0x10, 0x80, 0x09, 0x84, 0xaf, 0x09, 0xcb, 0x5a, 0xe6, 0xd5, 0xbe, 0xd1, 0xb6, 0xd2, 0xa6, 0xdb,
+	0x4b, 0x4e, 0xa9, 0xd4, 0x56, 0xbd, 0xf4, 0x64, 0x12, 0x07, 0x2c, 0x82, 0x1d, 0xd9, 0x0e, 0x88,
+	0x5e, 0xac, 0x82, 0xe8, 0x0a, 0x15, 0x11, 0xb4, 0x64, 0x2b, 0xf1, 0x43, 0xfb, 0x27, 0xfa, 0x2b,
+	0xaa, 0x24, 0xa5, 0x9f, 0xa7, 0x64, 0x9e, 0x79, 0x66, 0x3c, 0xfe, 0x80, 0xd1, 0xe9, 0x7c, 0xd8,
+	0x98, 0xd3, 0xb6, 0x28, 0x76, 0x87, 0x87, 0x53, 0x70, 0x7c, 0xcc, 0x8b, 0x1c, 0xf7, 0xaa, 0xcf,
+	0xfa, 0xe9, 0xd3, 0xfd, 0x57, 0x1b, 0x1c, 0x75, 0x3e, 0x6c, 0x54, 0x2d, 0xe0, 0x00, 0xec, 0xe2,
+	0x7c, 0xdc, 0xba, 0x96, 0x67, 0xf9, 0xc3, 0xd7, 0xb7, 0xc1, 0x45, 0x0c, 0x7e, 0x93, 0x02, 0x7d,
+	0x3e, 0x6e, 0x65, 0xe5, 0xe1, 0xff, 0xa0, 0xbd, 0xd9, 0xe7, 0x9b, 0xcf, 0x6e, 0xd3, 0xb3, 0x7c,
+	0x5b, 0xd6, 0x01, 0x7e, 0x09, 0x83, 0x2f, 0x1f, 0xf7, 0x4f, 0x5b, 0x73, 0x2a, 0x1e, 0x77, 0x87,
+	0x07, 0xb7, 0xe5, 0x59, 0x7e, 0x7f, 0xda, 0x90, 0x4e, 0x45, 0x55, 0x05, 0xf1, 0x0b, 0xa8, 0x43,
+	0xb3, 0x3e, 0x17, 0xdb, 0x93, 0x6b, 0x7b, 0x96, 0x3f, 0x98, 0x36, 0x24, 0x54, 0x70, 0x5c, 0x32,
+	0x7c, 0x07, 0xf0, 0x43, 0xc9, 0xf3, 0xbd, 0xdb, 0xf6, 0x2c, 0xbf, 0x37, 0x6d, 0xc8, 0x7e, 0x6d,
+	0xe4, 0xf9, 0xfe, 0x57, 0x8f, 0xdd, 0xa1, 0x78, 0xf7, 0xd6, 0xed, 0x78, 0x96, 0xdf, 0xfa, 0xd9,
+	0x83, 0x95, 0xec, 0xfe, 0x5b, 0x13, 0xec, 0x72, 0x60, 0xec, 0x40, 0x37, 0xe3, 0x33, 0x2e, 0x96,
+	0x1c, 0x35, 0xf0, 0x00, 0x7a, 0x61, 0x26, 0x25, 0xe5, 0xe1, 0x0a, 0x59, 0xf8, 0x1a, 0x9c, 0x09,
+	0x8b, 0x8d, 0xa4, 0x21, 0xe5, 0x5a, 0xa1, 0x26, 0xc6, 0x30, 0x2c, 0x41, 0x4c, 0x16, 0x22, 0x93,
+	0x4c, 0x53, 0x85, 0x5a, 0xf8, 0x0e, 0x9e, 0xcd, 0xa9, 0x52, 0x64, 0x42, 0x95, 0x89, 0xa5, 0x98,
+	0x9b, 0x50, 0x70, 0x4d, 0x42, 0xad, 0x8c, 0xe0, 0xc9, 0x0a, 0xd9, 0x65, 0x51, 0x2a, 0x69, 0x4c,
+	0xa5, 0xa4, 0x91, 0xe1, 0x64, 0x4e, 0x51, 0x1b, 0x8f, 0xe0, 0x3a, 0x95, 0x74, 0xc1, 0xe8, 0xd2,
+	0xa4, 0x92, 0x2d, 0x48, 0xb8, 0x42, 0x1d, 0xfc, 0x1c, 0xdc, 0x54, 0x8a, 0x98, 0x25, 0xd4, 0xa4,
+	0x2c, 0xd4, 0x99, 0xa4, 0xca, 0xa8, 0xa9, 0x58, 0x1a, 0x2d, 0x50, 0xb7, 0x5c, 0xe7, 0x9f, 0xec,
+	0x82, 0x29, 0x36, 0x66, 0x09, 0xd3, 0x2b, 0xd4, 0xc3, 0xff, 0xc3, 0x48, 0x51, 0x1e, 0x19, 0xa5,
+	0x89, 0xce, 0x94, 0xc9, 0xd2, 0x88, 0x94, 0x13, 0xf6, 0xcb, 0xbe, 0x4a, 0xb3, 0x70, 0x46, 0xa5,
+	0x32, 0x29, 0x09, 0x67, 0xca, 0x30, 0xae, 0x34, 0x49, 0x12, 0x1a, 0x21, 0xc0, 0xb7, 0x70, 0xf3,
+	0x57, 0x36, 0xa5, 0x3c, 0x62, 0x7c, 0x82, 0x9c, 0x3f, 0x2a, 0xeb, 0x53, 0x30, 0x97, 0x18, 0x0d,
+	0x30, 0x82, 0x41, 0xc4, 0x54, 0x9a, 0x90, 0x55, 0xbd, 0xad, 0x2b, 0x7c, 0x03, 0x98, 0x0b, 0xcd,
+	0x62, 0x16, 0x12, 0xcd, 0x04, 0x37, 0x09, 0x5d, 0xd0, 0x04, 0x0d, 0xc7, 0x5d, 0x68, 0xd7, 0x97,
+	0x73, 0xf5, 0xc1, 0x09, 0x5e, 0xbd, 0xbf, 0xbc, 0x9e, 0x75, 0xa7, 0xfa, 0x7b, 0xf3, 0x7d, 0x00,
+	0x13, 0xa1, 0x73, 0xc8, 0x8e, 0x02, 0x00, 0x00,
 }
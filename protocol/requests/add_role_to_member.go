@@ -26,7 +26,8 @@ func (a *AddRoleToMember) Validate() error {
 		return ErrAddRoleToMemberInvalidUser
 	}
 
-	if a.Role == protobuf.CommunityMember_UNKNOWN_ROLE {
+	// The owner role comes with the community and can't be granted or revoked
+	if a.Role == protobuf.CommunityMember_UNKNOWN_ROLE || a.Role == protobuf.CommunityMember_ROLE_OWNER {
 		return ErrAddRoleToMemberInvalidRole
 	}
 
@@ -30,6 +30,9 @@ type CreateAccount struct {
 	LogFilePath              string  `json:"logFilePath"`
 	LogEnabled               bool    `json:"logEnabled"`
 	PreviewPrivacy           bool    `json:"previewPrivacy"`
+	// ClusterConfigFile is the path of a node config JSON file the cluster
+	// config is loaded from, instead of the status.prod fleet
+	ClusterConfigFile *string `json:"clusterConfigFile"`
 }
 
 func (c *CreateAccount) Validate() error {
@@ -26,7 +26,8 @@ func (r *RemoveRoleFromMember) Validate() error {
 		return ErrRemoveRoleFromMemberInvalidUser
 	}
 
-	if r.Role == protobuf.CommunityMember_UNKNOWN_ROLE {
+	// The owner role comes with the community and can't be granted or revoked
+	if r.Role == protobuf.CommunityMember_UNKNOWN_ROLE || r.Role == protobuf.CommunityMember_ROLE_OWNER {
 		return ErrRemoveRoleFromMemberInvalidRole
 	}
 
@@ -0,0 +1,39 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrReorderCommunityChannelsInvalidCommunityID = errors.New("reorder-community-channels: invalid community id")
+var ErrReorderCommunityChannelsNoChannels = errors.New("reorder-community-channels: no channels")
+var ErrReorderCommunityChannelsInvalidChatID = errors.New("reorder-community-channels: invalid chat id")
+var ErrReorderCommunityChannelsInvalidPosition = errors.New("reorder-community-channels: invalid position")
+
+type ReorderCommunityChannels struct {
+	CommunityID      types.HexBytes   `json:"communityId"`
+	ChannelPositions map[string]int32 `json:"channelPositions"`
+}
+
+func (j *ReorderCommunityChannels) Validate() error {
+	if len(j.CommunityID) == 0 {
+		return ErrReorderCommunityChannelsInvalidCommunityID
+	}
+
+	if len(j.ChannelPositions) == 0 {
+		return ErrReorderCommunityChannelsNoChannels
+	}
+
+	for chatID, position := range j.ChannelPositions {
+		if len(chatID) == 0 {
+			return ErrReorderCommunityChannelsInvalidChatID
+		}
+
+		if position < 0 {
+			return ErrReorderCommunityChannelsInvalidPosition
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,491 @@
+package mockwaku
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/connection"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+const maxMessageSize = 1024 * 1024
+
+var (
+	ErrNotAvailable   = errors.New("not available in MockWaku")
+	ErrKeyNotFound    = errors.New("key not found")
+	ErrFilterNotFound = errors.New("filter not found")
+)
+
+// network delivers the messages posted by any of its nodes to all of them
+type network struct {
+	mu    sync.RWMutex
+	nodes []*MockWaku
+}
+
+func (n *network) join(w *MockWaku) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.nodes = append(n.nodes, w)
+	w.network = n
+}
+
+func (n *network) broadcast(msg *postedMessage) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	for _, node := range n.nodes {
+		node.deliver(msg)
+	}
+}
+
+// postedMessage is a message on the mock network, with the key it is
+// encrypted with
+type postedMessage struct {
+	message *types.Message
+	symKey  []byte
+	dst     []byte
+}
+
+type filter struct {
+	id       string
+	keyAsym  *ecdsa.PrivateKey
+	keySym   []byte
+	topics   map[types.TopicType]bool
+	messages []*types.Message
+}
+
+func (f *filter) matches(msg *postedMessage) bool {
+	if !f.topics[msg.message.Topic] {
+		return false
+	}
+
+	if msg.symKey != nil {
+		return f.keySym != nil && bytes.Equal(f.keySym, msg.symKey)
+	}
+
+	return f.keyAsym != nil && bytes.Equal(crypto.FromECDSAPub(&f.keyAsym.PublicKey), msg.dst)
+}
+
+// MockWaku is an in-memory types.Waku. Messages are not encrypted and are
+// delivered synchronously to the filters of all the nodes of the network
+// the MockWaku belongs to.
+type MockWaku struct {
+	network *network
+
+	mu          sync.RWMutex
+	privateKeys map[string]*ecdsa.PrivateKey
+	symKeys     map[string][]byte
+	filters     map[string]*filter
+	timeSource  func() time.Time
+}
+
+// NewMockWaku returns a MockWaku which is the only node of its network.
+func NewMockWaku() *MockWaku {
+	w := &MockWaku{
+		privateKeys: make(map[string]*ecdsa.PrivateKey),
+		symKeys:     make(map[string][]byte),
+		filters:     make(map[string]*filter),
+		timeSource:  time.Now,
+	}
+	(&network{}).join(w)
+	return w
+}
+
+// NewMockWakuPair returns two connected MockWaku, messages sent by either of
+// them are received by both.
+func NewMockWakuPair() (*MockWaku, *MockWaku) {
+	a := NewMockWaku()
+	b := NewMockWaku()
+	a.network.join(b)
+	return a, b
+}
+
+func (w *MockWaku) deliver(msg *postedMessage) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, f := range w.filters {
+		if f.matches(msg) {
+			f.messages = append(f.messages, msg.message)
+		}
+	}
+}
+
+// NewFilter installs a filter for the messages matching the given options
+// and returns its ID.
+func (w *MockWaku) NewFilter(opts *types.SubscriptionOptions) (string, error) {
+	f := &filter{
+		id:     uuid.New().String(),
+		topics: make(map[types.TopicType]bool),
+	}
+
+	for _, topic := range opts.Topics {
+		f.topics[types.BytesToTopic(topic)] = true
+	}
+
+	var err error
+	if opts.SymKeyID != "" {
+		if f.keySym, err = w.GetSymKey(opts.SymKeyID); err != nil {
+			return "", err
+		}
+	}
+	if opts.PrivateKeyID != "" {
+		if f.keyAsym, err = w.GetPrivateKey(opts.PrivateKeyID); err != nil {
+			return "", err
+		}
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.filters[f.id] = f
+	return f.id, nil
+}
+
+// Send posts a message to all the nodes of the network and returns its hash.
+func (w *MockWaku) Send(req types.NewMessage) ([]byte, error) {
+	msg := &postedMessage{
+		message: &types.Message{
+			TTL:       req.TTL,
+			Timestamp: uint32(w.GetCurrentTime().Unix()),
+			Topic:     req.Topic,
+			Payload:   req.Payload,
+			Padding:   req.Padding,
+		},
+	}
+
+	if req.SigID != "" {
+		key, err := w.GetPrivateKey(req.SigID)
+		if err != nil {
+			return nil, err
+		}
+		msg.message.Sig = crypto.FromECDSAPub(&key.PublicKey)
+	}
+
+	if req.SymKeyID != "" {
+		key, err := w.GetSymKey(req.SymKeyID)
+		if err != nil {
+			return nil, err
+		}
+		msg.symKey = key
+	} else if req.PublicKey != nil {
+		msg.dst = req.PublicKey
+		msg.message.Dst = req.PublicKey
+	} else {
+		return nil, errors.New("message has no recipient")
+	}
+
+	// Each message gets a unique hash, as for envelopes with a random nonce
+	msg.message.Hash = crypto.Keccak256(msg.message.Sig, msg.message.Topic[:], msg.message.Payload, []byte(uuid.New().String()))
+
+	w.network.broadcast(msg)
+
+	return msg.message.Hash, nil
+}
+
+// retrieve returns and removes the messages received by a filter
+func (w *MockWaku) retrieve(id string) ([]*types.Message, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	f, ok := w.filters[id]
+	if !ok {
+		return nil, ErrFilterNotFound
+	}
+
+	messages := f.messages
+	f.messages = nil
+	return messages, nil
+}
+
+func (w *MockWaku) PublicWakuAPI() types.PublicWakuAPI {
+	return &publicAPI{waku: w}
+}
+
+func (w *MockWaku) Version() uint {
+	return 1
+}
+
+func (w *MockWaku) PeerCount() int {
+	w.network.mu.RLock()
+	defer w.network.mu.RUnlock()
+
+	return len(w.network.nodes) - 1
+}
+
+func (w *MockWaku) StartDiscV5() error {
+	return ErrNotAvailable
+}
+
+func (w *MockWaku) StopDiscV5() error {
+	return ErrNotAvailable
+}
+
+func (w *MockWaku) ListenAddresses() ([]string, error) {
+	return nil, ErrNotAvailable
+}
+
+func (w *MockWaku) Peers() map[string]types.WakuV2Peer {
+	return make(map[string]types.WakuV2Peer)
+}
+
+func (w *MockWaku) AddStorePeer(address string) (peer.ID, error) {
+	return "", ErrNotAvailable
+}
+
+func (w *MockWaku) AddRelayPeer(address string) (peer.ID, error) {
+	return "", ErrNotAvailable
+}
+
+func (w *MockWaku) DialPeer(address string) error {
+	return ErrNotAvailable
+}
+
+func (w *MockWaku) DialPeerByID(peerID string) error {
+	return ErrNotAvailable
+}
+
+func (w *MockWaku) DropPeer(peerID string) error {
+	return ErrNotAvailable
+}
+
+func (w *MockWaku) SubscribeToConnStatusChanges() (*types.ConnStatusSubscription, error) {
+	return nil, ErrNotAvailable
+}
+
+func (w *MockWaku) SubscribeToCommunityShard(communityID string) error {
+	return nil
+}
+
+func (w *MockWaku) UnsubscribeFromCommunityShard(communityID string) error {
+	return nil
+}
+
+func (w *MockWaku) MinPow() float64 {
+	return 0
+}
+
+func (w *MockWaku) BloomFilter() []byte {
+	return nil
+}
+
+func (w *MockWaku) SetTimeSource(timesource func() time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.timeSource = timesource
+}
+
+func (w *MockWaku) GetCurrentTime() time.Time {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return w.timeSource()
+}
+
+func (w *MockWaku) GetPrivateKey(id string) (*ecdsa.PrivateKey, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	key, ok := w.privateKeys[id]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return key, nil
+}
+
+func (w *MockWaku) SubscribeEnvelopeEvents(events chan<- types.EnvelopeEvent) types.Subscription {
+	return &subscription{err: make(chan error)}
+}
+
+func (w *MockWaku) AddKeyPair(key *ecdsa.PrivateKey) (string, error) {
+	id := types.EncodeHex(crypto.FromECDSAPub(&key.PublicKey))
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.privateKeys[id] = key
+	return id, nil
+}
+
+func (w *MockWaku) DeleteKeyPair(keyID string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, ok := w.privateKeys[keyID]
+	delete(w.privateKeys, keyID)
+	return ok
+}
+
+func (w *MockWaku) AddSymKeyDirect(key []byte) (string, error) {
+	id := types.EncodeHex(crypto.Keccak256(key))
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.symKeys[id] = key
+	return id, nil
+}
+
+// AddSymKeyFromPassword derives the key from the password with a single
+// hash, which is enough to have the same key on all the nodes.
+func (w *MockWaku) AddSymKeyFromPassword(password string) (string, error) {
+	key := sha256.Sum256([]byte(password))
+	return w.AddSymKeyDirect(key[:])
+}
+
+func (w *MockWaku) DeleteSymKey(id string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, ok := w.symKeys[id]
+	delete(w.symKeys, id)
+	return ok
+}
+
+func (w *MockWaku) GetSymKey(id string) ([]byte, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	key, ok := w.symKeys[id]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return key, nil
+}
+
+func (w *MockWaku) MaxMessageSize() uint32 {
+	return maxMessageSize
+}
+
+func (w *MockWaku) GetStats() types.StatsSummary {
+	return types.StatsSummary{}
+}
+
+func (w *MockWaku) Subscribe(opts *types.SubscriptionOptions) (string, error) {
+	return w.NewFilter(opts)
+}
+
+func (w *MockWaku) GetFilter(id string) types.Filter {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	f, ok := w.filters[id]
+	if !ok {
+		return nil
+	}
+	return f
+}
+
+func (w *MockWaku) Unsubscribe(id string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, ok := w.filters[id]; !ok {
+		return ErrFilterNotFound
+	}
+	delete(w.filters, id)
+	return nil
+}
+
+func (w *MockWaku) UnsubscribeMany(ids []string) error {
+	for _, id := range ids {
+		if err := w.Unsubscribe(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *MockWaku) RequestHistoricMessagesWithTimeout(peerID []byte, envelope types.Envelope, timeout time.Duration) error {
+	return ErrNotAvailable
+}
+
+func (w *MockWaku) SendMessagesRequest(peerID []byte, request types.MessagesRequest) error {
+	return ErrNotAvailable
+}
+
+func (w *MockWaku) RequestStoreMessages(ctx context.Context, peerID []byte, request types.MessagesRequest) (*types.StoreRequestCursor, error) {
+	return nil, ErrNotAvailable
+}
+
+func (w *MockWaku) ProcessingP2PMessages() bool {
+	return false
+}
+
+func (w *MockWaku) MarkP2PMessageAsProcessed(common.Hash) {}
+
+func (w *MockWaku) ConnectionChanged(connection.State) {}
+
+func (f *filter) ID() string {
+	return f.id
+}
+
+type subscription struct {
+	err  chan error
+	once sync.Once
+}
+
+func (s *subscription) Err() <-chan error {
+	return s.err
+}
+
+func (s *subscription) Unsubscribe() {
+	s.once.Do(func() { close(s.err) })
+}
+
+type publicAPI struct {
+	waku *MockWaku
+}
+
+func (api *publicAPI) AddPrivateKey(ctx context.Context, privateKey types.HexBytes) (string, error) {
+	key, err := crypto.ToECDSA(privateKey)
+	if err != nil {
+		return "", err
+	}
+	return api.waku.AddKeyPair(key)
+}
+
+func (api *publicAPI) GenerateSymKeyFromPassword(ctx context.Context, passwd string) (string, error) {
+	return api.waku.AddSymKeyFromPassword(passwd)
+}
+
+func (api *publicAPI) DeleteKeyPair(ctx context.Context, key string) (bool, error) {
+	return api.waku.DeleteKeyPair(key), nil
+}
+
+func (api *publicAPI) Post(ctx context.Context, req types.NewMessage) ([]byte, error) {
+	return api.waku.Send(req)
+}
+
+func (api *publicAPI) NewMessageFilter(req types.Criteria) (string, error) {
+	opts := &types.SubscriptionOptions{
+		SymKeyID:     req.SymKeyID,
+		PrivateKeyID: req.PrivateKeyID,
+		PoW:          req.MinPow,
+	}
+	for _, topic := range req.Topics {
+		opts.Topics = append(opts.Topics, topic[:])
+	}
+	return api.waku.NewFilter(opts)
+}
+
+func (api *publicAPI) GetFilterMessages(id string) ([]*types.Message, error) {
+	return api.waku.retrieve(id)
+}
+
+func (api *publicAPI) BloomFilter() []byte {
+	return api.waku.BloomFilter()
+}
@@ -1,6 +1,10 @@
 package transport
 
-import "github.com/status-im/status-go/eth-node/types"
+import (
+	"fmt"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
 
 // TODO: revise fields encoding/decoding. Some are encoded using hexutil and some using encoding/hex.
 type Filter struct {
@@ -32,3 +36,8 @@ type Filter struct {
 func (c *Filter) IsPublic() bool {
 	return !c.OneToOne
 }
+
+// String returns a compact representation of the filter, meant for logging
+func (c *Filter) String() string {
+	return fmt.Sprintf("topic=%s chatID=%s negotiated=%t discovery=%t", c.Topic.String(), c.ChatID, c.Negotiated, c.Discovery)
+}
@@ -0,0 +1,27 @@
+package transport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+func TestFilterString(t *testing.T) {
+	topic := types.BytesToTopic([]byte{0x01, 0x02, 0x03, 0x04})
+
+	discovery := &Filter{
+		ChatID:    "contact-discovery",
+		Topic:     topic,
+		Discovery: true,
+	}
+	require.Equal(t, "topic=0x01020304 chatID=contact-discovery negotiated=false discovery=true", discovery.String())
+
+	negotiated := &Filter{
+		ChatID:     "0x04abcd",
+		Topic:      topic,
+		Negotiated: true,
+	}
+	require.Equal(t, "topic=0x01020304 chatID=0x04abcd negotiated=true discovery=false", negotiated.String())
+}
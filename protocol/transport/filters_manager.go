@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/ecdsa"
 	"encoding/hex"
+	"sort"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -49,6 +50,8 @@ type FiltersManager struct {
 	logger      *zap.Logger
 	mutex       sync.Mutex
 	filters     map[string]*Filter
+	// filtersByTopic indexes filters by their topic and chat ID
+	filtersByTopic map[types.TopicType]map[string]*Filter
 }
 
 // NewFiltersManager returns a new filtersManager.
@@ -63,12 +66,13 @@ func NewFiltersManager(persistence KeysPersistence, service FiltersService, priv
 	}
 
 	return &FiltersManager{
-		privateKey:  privateKey,
-		service:     service,
-		persistence: persistence,
-		keys:        keys,
-		filters:     make(map[string]*Filter),
-		logger:      logger.With(zap.Namespace("filtersManager")),
+		privateKey:     privateKey,
+		service:        service,
+		persistence:    persistence,
+		keys:           keys,
+		filters:        make(map[string]*Filter),
+		filtersByTopic: make(map[types.TopicType]map[string]*Filter),
+		logger:         logger.With(zap.Namespace("filtersManager")),
 	}, nil
 }
 
@@ -157,9 +161,9 @@ func (f *FiltersManager) InitCommunityFilters(pks []*ecdsa.PrivateKey) ([]*Filte
 			OneToOne: true,
 		}
 
-		f.filters[filterID] = filter
+		f.addFilter(filter)
 
-		f.logger.Debug("registering filter for", zap.String("chatID", filterID), zap.String("type", "community"), zap.String("topic", rawFilter.Topic.String()))
+		f.logger.Debug("registering filter for", zap.String("type", "community"), zap.Stringer("filter", filter))
 
 		filters = append(filters, filter)
 	}
@@ -240,6 +244,22 @@ func (f *FiltersManager) FilterByTopic(topic []byte) *Filter {
 	return nil
 }
 
+// GetFiltersByTopic returns all the filters listening on the given topic, sorted by chat ID
+func (f *FiltersManager) GetFiltersByTopic(topic types.TopicType) ([]*Filter, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	var filters []*Filter
+	for _, filter := range f.filtersByTopic[topic] {
+		filters = append(filters, filter)
+	}
+	sort.Slice(filters, func(i, j int) bool {
+		return filters[i].ChatID < filters[j].ChatID
+	})
+
+	return filters, nil
+}
+
 // FiltersByIdentities returns an array of filters for given list of public keys
 func (f *FiltersManager) FiltersByIdentities(identities []string) []*Filter {
 	f.mutex.Lock()
@@ -282,7 +302,7 @@ func (f *FiltersManager) Remove(filters ...*Filter) error {
 		if filter.SymKeyID != "" {
 			f.service.DeleteSymKey(filter.SymKeyID)
 		}
-		delete(f.filters, filter.ChatID)
+		f.removeFilter(filter.ChatID)
 	}
 
 	return nil
@@ -309,7 +329,7 @@ func (f *FiltersManager) RemoveNoListenFilters() error {
 		if filter.SymKeyID != "" {
 			f.service.DeleteSymKey(filter.SymKeyID)
 		}
-		delete(f.filters, filter.ChatID)
+		f.removeFilter(filter.ChatID)
 	}
 
 	return nil
@@ -370,9 +390,9 @@ func (f *FiltersManager) LoadPersonal(publicKey *ecdsa.PublicKey, identity *ecds
 		OneToOne: true,
 	}
 
-	f.filters[chatID] = chat
+	f.addFilter(chat)
 
-	f.logger.Debug("registering filter for", zap.String("chatID", chatID), zap.String("type", "personal"), zap.String("topic", filter.Topic.String()))
+	f.logger.Debug("registering filter for", zap.String("type", "personal"), zap.Stringer("filter", chat))
 
 	return chat, nil
 
@@ -409,9 +429,9 @@ func (f *FiltersManager) loadPartitioned(publicKey *ecdsa.PublicKey, identity *e
 		OneToOne:  true,
 	}
 
-	f.filters[chatID] = chat
+	f.addFilter(chat)
 
-	f.logger.Debug("registering filter for", zap.String("chatID", chatID), zap.String("type", "partitioned"), zap.String("topic", filter.Topic.String()))
+	f.logger.Debug("registering filter for", zap.String("type", "partitioned"), zap.Stringer("filter", chat))
 
 	return chat, nil
 }
@@ -445,9 +465,9 @@ func (f *FiltersManager) LoadNegotiated(secret types.NegotiatedSecret) (*Filter,
 		OneToOne:   true,
 	}
 
-	f.filters[chat.ChatID] = chat
+	f.addFilter(chat)
 
-	f.logger.Debug("registering filter for", zap.String("chatID", chatID), zap.String("type", "negotiated"), zap.String("topic", filter.Topic.String()))
+	f.logger.Debug("registering filter for", zap.String("type", "negotiated"), zap.Stringer("filter", chat))
 
 	return chat, nil
 }
@@ -493,9 +513,9 @@ func (f *FiltersManager) LoadDiscovery() ([]*Filter, error) {
 	personalDiscoveryChat.Topic = discoveryResponse.Topic
 	personalDiscoveryChat.FilterID = discoveryResponse.FilterID
 
-	f.filters[personalDiscoveryChat.ChatID] = personalDiscoveryChat
+	f.addFilter(personalDiscoveryChat)
 
-	f.logger.Debug("registering filter for", zap.String("chatID", personalDiscoveryChat.ChatID), zap.String("type", "discovery"), zap.String("topic", personalDiscoveryChat.Topic.String()))
+	f.logger.Debug("registering filter for", zap.String("type", "discovery"), zap.Stringer("filter", personalDiscoveryChat))
 
 	return []*Filter{personalDiscoveryChat}, nil
 }
@@ -530,9 +550,9 @@ func (f *FiltersManager) LoadPublic(chatID string) (*Filter, error) {
 		OneToOne: false,
 	}
 
-	f.filters[chatID] = chat
+	f.addFilter(chat)
 
-	f.logger.Debug("registering filter for", zap.String("chatID", chatID), zap.String("type", "public"), zap.String("topic", filterAndTopic.Topic.String()))
+	f.logger.Debug("registering filter for", zap.String("type", "public"), zap.Stringer("filter", chat))
 
 	return chat, nil
 }
@@ -563,9 +583,9 @@ func (f *FiltersManager) LoadContactCode(pubKey *ecdsa.PublicKey) (*Filter, erro
 		Listen:   true,
 	}
 
-	f.filters[chatID] = chat
+	f.addFilter(chat)
 
-	f.logger.Debug("registering filter for", zap.String("chatID", chatID), zap.String("type", "contact-code"), zap.String("topic", contactCodeFilter.Topic.String()))
+	f.logger.Debug("registering filter for", zap.String("type", "contact-code"), zap.Stringer("filter", chat))
 
 	return chat, nil
 }
@@ -654,3 +674,28 @@ func (f *FiltersManager) GetNegotiated(identity *ecdsa.PublicKey) *Filter {
 
 	return f.filters[NegotiatedTopic(identity)]
 }
+
+// addFilter stores the filter and indexes it by topic, the caller needs to hold the lock
+func (f *FiltersManager) addFilter(filter *Filter) {
+	f.removeFilter(filter.ChatID)
+
+	f.filters[filter.ChatID] = filter
+	if f.filtersByTopic[filter.Topic] == nil {
+		f.filtersByTopic[filter.Topic] = make(map[string]*Filter)
+	}
+	f.filtersByTopic[filter.Topic][filter.ChatID] = filter
+}
+
+// removeFilter removes the filter and its topic index entry, the caller needs to hold the lock
+func (f *FiltersManager) removeFilter(chatID string) {
+	filter, ok := f.filters[chatID]
+	if !ok {
+		return
+	}
+
+	delete(f.filters, chatID)
+	delete(f.filtersByTopic[filter.Topic], chatID)
+	if len(f.filtersByTopic[filter.Topic]) == 0 {
+		delete(f.filtersByTopic, filter.Topic)
+	}
+}
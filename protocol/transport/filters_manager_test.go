@@ -8,7 +8,7 @@ import (
 	"os"
 	"testing"
 
-	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/protocol/testutils/mockwaku"
 	"github.com/status-im/status-go/protocol/tt"
 
 	_ "github.com/mutecomm/go-sqlcipher"
@@ -16,7 +16,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/status-im/status-go/eth-node/crypto"
-	"github.com/status-im/status-go/waku"
+	"github.com/status-im/status-go/eth-node/types"
 )
 
 type testKeysPersistence struct {
@@ -90,9 +90,7 @@ func (s *FiltersManagerSuite) SetupTest() {
 
 	keysPersistence := newTestKeysPersistence()
 
-	waku := gethbridge.NewGethWakuWrapper(waku.New(&waku.DefaultConfig, nil))
-
-	s.chats, err = NewFiltersManager(keysPersistence, waku, s.manager[0].privateKey, s.logger)
+	s.chats, err = NewFiltersManager(keysPersistence, mockwaku.NewMockWaku(), s.manager[0].privateKey, s.logger)
 	s.Require().NoError(err)
 }
 
@@ -130,3 +128,49 @@ func (s *FiltersManagerSuite) assertRequiredFilters() {
 	s.Require().NotNil(partitionedFilter, "It adds the partitioned filter")
 	s.Require().True(partitionedFilter.Listen)
 }
+
+func (s *FiltersManagerSuite) TestGetFiltersByTopicSingleFilter() {
+	filter, err := s.chats.LoadPublic("status")
+	s.Require().NoError(err)
+
+	filters, err := s.chats.GetFiltersByTopic(filter.Topic)
+	s.Require().NoError(err)
+	s.Require().Equal([]*Filter{filter}, filters)
+
+	s.Require().NoError(s.chats.Remove(filter))
+
+	filters, err = s.chats.GetFiltersByTopic(filter.Topic)
+	s.Require().NoError(err)
+	s.Require().Empty(filters)
+}
+
+func (s *FiltersManagerSuite) TestGetFiltersByTopicMultipleFilters() {
+	topic := types.BytesToTopic([]byte("shared"))
+	first := &Filter{ChatID: "first", Topic: topic}
+	second := &Filter{ChatID: "second", Topic: topic}
+	other := &Filter{ChatID: "other", Topic: types.BytesToTopic([]byte("other"))}
+
+	s.chats.mutex.Lock()
+	s.chats.addFilter(second)
+	s.chats.addFilter(first)
+	s.chats.addFilter(other)
+	s.chats.mutex.Unlock()
+
+	filters, err := s.chats.GetFiltersByTopic(topic)
+	s.Require().NoError(err)
+	s.Require().Equal([]*Filter{first, second}, filters)
+
+	// Re-adding a filter with a new topic moves it in the index
+	moved := &Filter{ChatID: "second", Topic: other.Topic}
+	s.chats.mutex.Lock()
+	s.chats.addFilter(moved)
+	s.chats.mutex.Unlock()
+
+	filters, err = s.chats.GetFiltersByTopic(topic)
+	s.Require().NoError(err)
+	s.Require().Equal([]*Filter{first}, filters)
+
+	filters, err = s.chats.GetFiltersByTopic(other.Topic)
+	s.Require().NoError(err)
+	s.Require().Equal([]*Filter{other, moved}, filters)
+}
@@ -188,6 +188,18 @@ func (t *Transport) ProcessNegotiatedSecret(secret types.NegotiatedSecret) (*Fil
 	return filter, nil
 }
 
+// SubscribeToCommunityShard subscribes to the relay topic the community
+// messages are sharded to
+func (t *Transport) SubscribeToCommunityShard(communityID string) error {
+	return t.waku.SubscribeToCommunityShard(communityID)
+}
+
+// UnsubscribeFromCommunityShard unsubscribes from the relay topic the
+// community messages are sharded to
+func (t *Transport) UnsubscribeFromCommunityShard(communityID string) error {
+	return t.waku.UnsubscribeFromCommunityShard(communityID)
+}
+
 func (t *Transport) JoinPublic(chatID string) (*Filter, error) {
 	return t.filters.LoadPublic(chatID)
 }
@@ -364,7 +376,7 @@ func (t *Transport) SendCommunityMessage(ctx context.Context, newMessage *types.
 	newMessage.Topic = filter.Topic
 	newMessage.PublicKey = crypto.FromECDSAPub(publicKey)
 
-	t.logger.Debug("SENDING message", zap.Binary("topic", filter.Topic[:]))
+	t.logger.Debug("SENDING message", zap.Stringer("filter", filter))
 
 	return t.api.Post(ctx, *newMessage)
 }
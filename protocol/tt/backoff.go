@@ -8,6 +8,31 @@ import (
 
 type BackOffOption func(*backoff.ExponentialBackOff)
 
+// RetryOption configures the backoff used by RetryWithBackOff.
+// It is an alias so that plain BackOffOption lambdas can still be passed.
+type RetryOption = BackOffOption
+
+// WithMaxElapsedTime sets the time after which RetryWithBackOff gives up.
+func WithMaxElapsedTime(d time.Duration) RetryOption {
+	return func(b *backoff.ExponentialBackOff) {
+		b.MaxElapsedTime = d
+	}
+}
+
+// WithInitialInterval sets the interval before the first retry.
+func WithInitialInterval(d time.Duration) RetryOption {
+	return func(b *backoff.ExponentialBackOff) {
+		b.InitialInterval = d
+	}
+}
+
+// WithMultiplier sets the factor the interval grows by after each retry.
+func WithMultiplier(f float64) RetryOption {
+	return func(b *backoff.ExponentialBackOff) {
+		b.Multiplier = f
+	}
+}
+
 func RetryWithBackOff(o func() error, options ...BackOffOption) error {
 	b := backoff.ExponentialBackOff{
 		InitialInterval:     time.Millisecond * 100,
@@ -0,0 +1,22 @@
+package tt
+
+import (
+	"testing"
+	"time"
+)
+
+const waitForConditionInterval = 50 * time.Millisecond
+
+// WaitForCondition polls condition every 50ms until it returns true.
+// The test fails with the given message if it still doesn't after timeout.
+func WaitForCondition(t *testing.T, timeout time.Duration, condition func() bool, msgFmt string, args ...interface{}) {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf(msgFmt, args...)
+		}
+		time.Sleep(waitForConditionInterval)
+	}
+}
@@ -50,6 +50,8 @@ type StatusMessage struct {
 	// ApplicationMetadataLayerPubKey contains the public key provided by the application metadata layer
 	ApplicationMetadataLayerSigPubKey *ecdsa.PublicKey `json:"-"`
 
+	// InstallationID is the installation the message was sent from, if known
+	InstallationID string `json:"-"`
 	// Installations is the new installations returned by the encryption layer
 	Installations []*multidevice.Installation
 	// SharedSecret is the shared secret returned by the encryption layer
@@ -149,6 +151,7 @@ func (m *StatusMessage) HandleEncryption(myKey *ecdsa.PrivateKey, senderKey *ecd
 	}
 
 	m.DecryptedPayload = response.DecryptedMessage
+	m.InstallationID = protocolMessage.GetInstallationId()
 	m.Installations = response.Installations
 	m.SharedSecrets = response.SharedSecrets
 	m.HashRatchetInfo = response.HashRatchetInfo
@@ -247,6 +250,8 @@ func (m *StatusMessage) HandleApplication() error {
 		return m.unmarshalProtobufData(new(protobuf.GroupChatInvitation))
 	case protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION:
 		return m.unmarshalProtobufData(new(protobuf.CommunityDescription))
+	case protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION_DIFF:
+		return m.unmarshalProtobufData(new(protobuf.CommunityDescriptionDiff))
 	case protobuf.ApplicationMetadataMessage_COMMUNITY_INVITATION:
 		return m.unmarshalProtobufData(new(protobuf.CommunityInvitation))
 	case protobuf.ApplicationMetadataMessage_COMMUNITY_REQUEST_TO_JOIN:
@@ -257,6 +262,8 @@ func (m *StatusMessage) HandleApplication() error {
 		return m.unmarshalProtobufData(new(protobuf.CommunityCancelRequestToJoin))
 	case protobuf.ApplicationMetadataMessage_COMMUNITY_REQUEST_TO_LEAVE:
 		return m.unmarshalProtobufData(new(protobuf.CommunityRequestToLeave))
+	case protobuf.ApplicationMetadataMessage_REPORT:
+		return m.unmarshalProtobufData(new(protobuf.CommunityMessageReport))
 	case protobuf.ApplicationMetadataMessage_EDIT_MESSAGE:
 		return m.unmarshalProtobufData(new(protobuf.EditMessage))
 	case protobuf.ApplicationMetadataMessage_DELETE_MESSAGE:
@@ -34,3 +34,14 @@ func (sfwr *WakuBackedUpDataResponse) FetchingBackedUpDataDetails() map[string]F
 	}
 	return result
 }
+
+func (sfwr *WakuBackedUpDataResponse) AddRestorationProgress(section string, applied int) {
+	if applied == 0 {
+		return
+	}
+	if sfwr.RestorationProgress == nil {
+		sfwr.RestorationProgress = make(map[string]int)
+	}
+
+	sfwr.RestorationProgress[section] += applied
+}
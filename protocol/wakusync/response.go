@@ -10,6 +10,7 @@ import (
 
 type WakuBackedUpDataResponse struct {
 	FetchingDataProgress map[string]protobuf.FetchingBackedUpDataDetails // key represents the data/section backup details refer to
+	RestorationProgress  map[string]int                                  // number of items of each section applied from the backup
 	Profile              *BackedUpProfile
 	Setting              *settings.SyncSettingField
 	Keycards             []*keypairs.KeyPair
@@ -18,13 +19,15 @@ type WakuBackedUpDataResponse struct {
 func (sfwr *WakuBackedUpDataResponse) MarshalJSON() ([]byte, error) {
 	responseItem := struct {
 		FetchingDataProgress map[string]FetchingBackupedDataDetails `json:"fetchingBackedUpDataProgress,omitempty"`
+		RestorationProgress  map[string]int                         `json:"restorationProgress,omitempty"`
 		Profile              *BackedUpProfile                       `json:"backedUpProfile,omitempty"`
 		Setting              *settings.SyncSettingField             `json:"backedUpSettings,omitempty"`
 		Keycards             []*keypairs.KeyPair                    `json:"backedUpKeycards,omitempty"`
 	}{
-		Profile:  sfwr.Profile,
-		Setting:  sfwr.Setting,
-		Keycards: sfwr.Keycards,
+		RestorationProgress: sfwr.RestorationProgress,
+		Profile:             sfwr.Profile,
+		Setting:             sfwr.Setting,
+		Keycards:            sfwr.Keycards,
 	}
 
 	responseItem.FetchingDataProgress = sfwr.FetchingBackedUpDataDetails()
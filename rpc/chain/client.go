@@ -675,6 +675,15 @@ func (c *ClientWithFallback) CallContext(ctx context.Context, result interface{}
 	)
 }
 
+func (c *ClientWithFallback) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	rpcstats.CountCall("eth_BatchCallContext")
+
+	return c.makeCallNoReturn(
+		func() error { return c.mainRPC.BatchCallContext(ctx, b) },
+		func() error { return c.fallbackRPC.BatchCallContext(ctx, b) },
+	)
+}
+
 func (c *ClientWithFallback) ToBigInt() *big.Int {
 	return big.NewInt(int64(c.ChainID))
 }
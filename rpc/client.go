@@ -10,6 +10,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/ethereum/go-ethereum/log"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
@@ -32,6 +34,35 @@ var (
 // Handler defines handler for RPC methods.
 type Handler func(context.Context, uint64, ...interface{}) (interface{}, error)
 
+// ClientOption configures a Client.
+type ClientOption func(*Client)
+
+// WithLogger sets the logger used by the Client.
+func WithLogger(l log.Logger) ClientOption {
+	return func(c *Client) {
+		c.log = l
+	}
+}
+
+type traceIDKey struct{}
+
+// TraceIDFromContext returns the trace ID of the RPC call the context
+// belongs to, or an empty string if there is none.
+func TraceIDFromContext(ctx context.Context) string {
+	traceID, _ := ctx.Value(traceIDKey{}).(string)
+	return traceID
+}
+
+// withTraceID returns a context carrying a trace ID, reusing the one
+// already set on ctx if any.
+func withTraceID(ctx context.Context) (context.Context, string) {
+	if traceID := TraceIDFromContext(ctx); traceID != "" {
+		return ctx, traceID
+	}
+	traceID := uuid.New().String()
+	return context.WithValue(ctx, traceIDKey{}, traceID), traceID
+}
+
 // Client represents RPC client with custom routing
 // scheme. It automatically decides where RPC call
 // goes - Upstream or Local node.
@@ -45,10 +76,17 @@ type Client struct {
 	local      *gethrpc.Client
 	upstream   *chain.ClientWithFallback
 	rpcClients map[uint64]*chain.ClientWithFallback
+	endpoints  map[uint64][]*chain.ClientWithFallback // additional endpoints, selected with routePolicy
+
+	routePolicy RoutePolicy
 
 	router         *router
 	NetworkManager *network.Manager
 
+	// PollInterval is the interval at which SubscribeNewHeads polls new heads
+	// when the upstream doesn't support subscriptions
+	PollInterval time.Duration
+
 	handlersMx sync.RWMutex       // mx guards handlers
 	handlers   map[string]Handler // locally registered handlers
 	log        log.Logger
@@ -59,22 +97,26 @@ type Client struct {
 //
 // Client is safe for concurrent use and will automatically
 // reconnect to the server if connection is lost.
-func NewClient(client *gethrpc.Client, upstreamChainID uint64, upstream params.UpstreamRPCConfig, networks []params.Network, db *sql.DB) (*Client, error) {
+func NewClient(client *gethrpc.Client, upstreamChainID uint64, upstream params.UpstreamRPCConfig, networks []params.Network, db *sql.DB, opts ...ClientOption) (*Client, error) {
 	var err error
 
-	log := log.New("package", "status-go/rpc.Client")
-	networkManager := network.NewManager(db)
-	err = networkManager.Init(networks)
-	if err != nil {
-		log.Error("Network manager failed to initialize", "error", err)
-	}
-
 	c := Client{
 		local:          client,
-		NetworkManager: networkManager,
+		NetworkManager: network.NewManager(db),
 		handlers:       make(map[string]Handler),
 		rpcClients:     make(map[uint64]*chain.ClientWithFallback),
-		log:            log,
+		endpoints:      make(map[uint64][]*chain.ClientWithFallback),
+		PollInterval:   DefaultPollInterval,
+		log:            log.New("package", "status-go/rpc.Client"),
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	err = c.NetworkManager.Init(networks)
+	if err != nil {
+		c.log.Error("Network manager failed to initialize", "error", err)
 	}
 
 	if upstream.Enabled {
@@ -185,14 +227,21 @@ func (c *Client) Call(result interface{}, chainID uint64, method string, args ..
 //
 // It uses custom routing scheme for calls.
 // If there are any local handlers registered for this call, they will handle it.
+// Each call is given a trace ID, available with TraceIDFromContext, which is
+// attached to all the log lines of the call.
 func (c *Client) CallContext(ctx context.Context, result interface{}, chainID uint64, method string, args ...interface{}) error {
+	ctx, traceID := withTraceID(ctx)
+	c.log.Debug("RPC call", "traceID", traceID, "method", method, "chainID", chainID)
+
 	rpcstats.CountCall(method)
 	if c.router.routeBlocked(method) {
+		c.log.Debug("RPC method blocked", "traceID", traceID, "method", method)
 		return ErrMethodNotFound
 	}
 
 	// check locally registered handlers first
 	if handler, ok := c.handler(method); ok {
+		c.log.Debug("RPC call routed to local handler", "traceID", traceID, "method", method)
 		return c.callMethod(ctx, result, chainID, handler, args...)
 	}
 
@@ -207,23 +256,37 @@ func (c *Client) CallContext(ctx context.Context, result interface{}, chainID ui
 // handler itself.
 // Upstream calls routing will be used anyway.
 func (c *Client) CallContextIgnoringLocalHandlers(ctx context.Context, result interface{}, chainID uint64, method string, args ...interface{}) error {
+	ctx, traceID := withTraceID(ctx)
+
 	if c.router.routeBlocked(method) {
+		c.log.Debug("RPC method blocked", "traceID", traceID, "method", method)
 		return ErrMethodNotFound
 	}
 
 	if c.router.routeRemote(method) {
-		client, err := c.getClientUsingCache(chainID)
+		c.log.Debug("RPC call routed to upstream", "traceID", traceID, "method", method, "chainID", chainID)
+		client, err := c.selectClient(chainID, method)
 		if err != nil {
+			c.log.Debug("RPC upstream client unavailable", "traceID", traceID, "chainID", chainID, "error", err)
 			return err
 		}
-		return client.CallContext(ctx, result, method, args...)
+		start := time.Now()
+		err = client.CallContext(ctx, result, method, args...)
+		if recorder, ok := c.routePolicy.(CallRecorder); ok {
+			recorder.RecordCall(client, method, time.Since(start), err)
+		}
+		c.log.Debug("RPC upstream call done", "traceID", traceID, "method", method, "error", err)
+		return err
 	}
 
 	if c.local == nil {
-		c.log.Warn("Local JSON-RPC endpoint missing", "method", method)
+		c.log.Warn("Local JSON-RPC endpoint missing", "traceID", traceID, "method", method)
 		return errors.New("missing local JSON-RPC endpoint")
 	}
-	return c.local.CallContext(ctx, result, method, args...)
+	c.log.Debug("RPC call routed to local node", "traceID", traceID, "method", method)
+	err := c.local.CallContext(ctx, result, method, args...)
+	c.log.Debug("RPC local node call done", "traceID", traceID, "method", method, "error", err)
+	return err
 }
 
 // RegisterHandler registers local handler for specific RPC method.
@@ -243,6 +306,7 @@ func (c *Client) RegisterHandler(method string, handler Handler) {
 // TODO(divan): use cancellation via context here?
 func (c *Client) callMethod(ctx context.Context, result interface{}, chainID uint64, handler Handler, args ...interface{}) error {
 	response, err := handler(ctx, chainID, args...)
+	c.log.Debug("RPC local handler done", "traceID", TraceIDFromContext(ctx), "error", err)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/status-im/status-go/params"
 	"github.com/status-im/status-go/sqlite"
 
+	"github.com/ethereum/go-ethereum/log"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -126,6 +127,41 @@ func TestUpdateUpstreamURL(t *testing.T) {
 	require.Equal(t, updatedUpstreamTs.URL, c.upstreamURL)
 }
 
+func TestCallContextTraceID(t *testing.T) {
+	db, close := setupTestNetworkDB(t)
+	defer close()
+
+	var traceIDs []string
+	logger := log.New()
+	logger.SetHandler(log.FuncHandler(func(r *log.Record) error {
+		for i := 0; i+1 < len(r.Ctx); i += 2 {
+			if r.Ctx[i] == "traceID" {
+				traceIDs = append(traceIDs, r.Ctx[i+1].(string))
+			}
+		}
+		return nil
+	}))
+
+	c, err := NewClient(nil, 1, params.UpstreamRPCConfig{}, []params.Network{}, db, WithLogger(logger))
+	require.NoError(t, err)
+
+	var handlerTraceID string
+	c.RegisterHandler("test_method", func(ctx context.Context, chainID uint64, args ...interface{}) (interface{}, error) {
+		handlerTraceID = TraceIDFromContext(ctx)
+		return "ok", nil
+	})
+
+	var result string
+	require.NoError(t, c.CallContext(context.Background(), &result, 1, "test_method"))
+	require.Equal(t, "ok", result)
+
+	require.NotEmpty(t, handlerTraceID)
+	require.GreaterOrEqual(t, len(traceIDs), 3)
+	for _, traceID := range traceIDs {
+		require.Equal(t, handlerTraceID, traceID)
+	}
+}
+
 func createTestServer(resp string) *httptest.Server {
 	if resp == "" {
 		resp = `{
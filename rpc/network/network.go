@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"database/sql"
 	"fmt"
+	"sync"
 
 	"github.com/status-im/status-go/params"
 )
@@ -70,8 +71,9 @@ func (nq *networksQuery) exec(db *sql.DB) ([]*params.Network, error) {
 }
 
 type Manager struct {
-	db       *sql.DB
-	networks []params.Network
+	db         *sql.DB
+	networks   []params.Network
+	networksMu sync.RWMutex // guards networks
 }
 
 func NewManager(db *sql.DB) *Manager {
@@ -93,7 +95,9 @@ func (nm *Manager) Init(networks []params.Network) error {
 	if networks == nil {
 		return nil
 	}
+	nm.networksMu.Lock()
 	nm.networks = networks
+	nm.networksMu.Unlock()
 
 	var errors string
 	currentNetworks, _ := nm.Get(false)
@@ -149,6 +153,48 @@ func (nm *Manager) Init(networks []params.Network) error {
 	return nil
 }
 
+// RefreshNetworks reloads the networks from the database into the
+// configured networks, adding the new ones, updating the existing ones and
+// disabling the ones that were removed from the database.
+func (nm *Manager) RefreshNetworks() error {
+	dbNetworks, err := nm.Get(false)
+	if err != nil {
+		return err
+	}
+
+	nm.networksMu.Lock()
+	defer nm.networksMu.Unlock()
+
+	// Work on a copy, the configured networks may be shared with the node config
+	networks := make([]params.Network, len(nm.networks))
+	copy(networks, nm.networks)
+
+	inDB := make(map[uint64]bool, len(dbNetworks))
+	for _, network := range dbNetworks {
+		inDB[network.ChainID] = true
+
+		i := find(network.ChainID, networks)
+		if i == -1 {
+			networks = append(networks, *network)
+			continue
+		}
+
+		// Token overrides are not stored in the database
+		tokenOverrides := networks[i].TokenOverrides
+		networks[i] = *network
+		networks[i].TokenOverrides = tokenOverrides
+	}
+
+	for i := range networks {
+		if !inDB[networks[i].ChainID] {
+			networks[i].Enabled = false
+		}
+	}
+
+	nm.networks = networks
+	return nil
+}
+
 func (nm *Manager) Upsert(network *params.Network) error {
 	_, err := nm.db.Exec(
 		"INSERT OR REPLACE INTO networks (chain_id, chain_name, rpc_url, fallback_url, block_explorer_url, icon_url, native_currency_name, native_currency_symbol, native_currency_decimals, is_test, layer, enabled, chain_color, short_name) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
@@ -192,5 +238,8 @@ func (nm *Manager) Get(onlyEnabled bool) ([]*params.Network, error) {
 }
 
 func (nm *Manager) GetConfiguredNetworks() []params.Network {
+	nm.networksMu.RLock()
+	defer nm.networksMu.RUnlock()
+
 	return nm.networks
 }
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"io/ioutil"
 	"os"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -127,3 +128,45 @@ func TestUpsert(t *testing.T) {
 	require.NotNil(t, network)
 	require.Equal(t, newName, network.ChainName)
 }
+
+func TestRefreshNetworks(t *testing.T) {
+	db, stop := setupTestNetworkDB(t)
+	defer stop()
+
+	nm := &Manager{db: db}
+	err := nm.Init(initNetworks)
+	require.NoError(t, err)
+
+	customNetwork := params.Network{
+		ChainID:   1337,
+		ChainName: "Custom Network",
+		RPCURL:    "http://localhost:8545",
+		Enabled:   true,
+	}
+	require.NoError(t, nm.Upsert(&customNetwork))
+	require.NoError(t, nm.Delete(10))
+	require.NoError(t, nm.UpdateRPCURL(1, "https://mainnet.example.com"))
+
+	require.Len(t, nm.GetConfiguredNetworks(), 3)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, nm.RefreshNetworks())
+		}()
+	}
+	wg.Wait()
+
+	networks := nm.GetConfiguredNetworks()
+	require.Len(t, networks, 4)
+
+	require.Equal(t, "https://mainnet.example.com", networks[find(1, networks)].RPCURL)
+	require.False(t, networks[find(10, networks)].Enabled)
+	require.Equal(t, customNetwork, networks[find(1337, networks)])
+
+	// The initial configuration is left untouched
+	require.Equal(t, "https://mainnet.infura.io/nKmXgiFgc2KqtoQ8BCGJ", initNetworks[0].RPCURL)
+	require.True(t, initNetworks[2].Enabled)
+}
@@ -0,0 +1,144 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/rpc/chain"
+)
+
+const (
+	// DefaultPollInterval is the default interval at which new heads are
+	// polled when the upstream doesn't support subscriptions
+	DefaultPollInterval = 10 * time.Second
+
+	// newHeadsReconnectDelay is the delay before resubscribing after
+	// a new heads subscription dropped
+	newHeadsReconnectDelay = time.Second
+)
+
+// SubscribeNewHeads subscribes to the new block headers of the given chain.
+// It uses eth_subscribe when the upstream supports it, and falls back to
+// polling every PollInterval otherwise. Dropped subscriptions are
+// automatically renewed.
+//
+// The returned channel is closed once the returned function is called or
+// ctx is done.
+func (c *Client) SubscribeNewHeads(ctx context.Context, chainID uint64) (<-chan *gethtypes.Header, func(), error) {
+	client, err := c.getClientUsingCache(chainID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	out := make(chan *gethtypes.Header)
+
+	headers := make(chan *gethtypes.Header)
+	sub, err := client.SubscribeNewHead(ctx, headers)
+	if isNotificationsUnsupported(err) {
+		c.log.Debug("new heads subscription unsupported, polling", "chainID", chainID)
+		go c.pollNewHeads(ctx, client, out)
+		return out, cancel, nil
+	} else if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	go c.forwardNewHeads(ctx, client, sub, headers, out)
+	return out, cancel, nil
+}
+
+// isNotificationsUnsupported checks whether err comes from an upstream not
+// supporting subscriptions. The error is matched on its message as hystrix
+// doesn't wrap the errors of failed fallbacks.
+func isNotificationsUnsupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Is(err, gethrpc.ErrNotificationsUnsupported) ||
+		strings.Contains(err.Error(), gethrpc.ErrNotificationsUnsupported.Error())
+}
+
+// forwardNewHeads forwards the headers received by the subscription to out,
+// resubscribing whenever the subscription drops
+func (c *Client) forwardNewHeads(ctx context.Context, client *chain.ClientWithFallback, sub ethereum.Subscription, headers chan *gethtypes.Header, out chan<- *gethtypes.Header) {
+	defer close(out)
+
+	for {
+		select {
+		case header := <-headers:
+			select {
+			case out <- header:
+			case <-ctx.Done():
+				sub.Unsubscribe()
+				return
+			}
+
+		case err := <-sub.Err():
+			c.log.Warn("new heads subscription dropped", "chainID", client.ChainID, "error", err)
+			sub.Unsubscribe()
+
+			sub = c.resubscribeNewHeads(ctx, client, headers)
+			if sub == nil {
+				return
+			}
+
+		case <-ctx.Done():
+			sub.Unsubscribe()
+			return
+		}
+	}
+}
+
+// resubscribeNewHeads retries subscribing to new heads until it succeeds
+// or ctx is done, in which case it returns nil
+func (c *Client) resubscribeNewHeads(ctx context.Context, client *chain.ClientWithFallback, headers chan *gethtypes.Header) ethereum.Subscription {
+	for {
+		select {
+		case <-time.After(newHeadsReconnectDelay):
+		case <-ctx.Done():
+			return nil
+		}
+
+		sub, err := client.SubscribeNewHead(ctx, headers)
+		if err == nil {
+			return sub
+		}
+		c.log.Warn("failed to resubscribe to new heads", "chainID", client.ChainID, "error", err)
+	}
+}
+
+// pollNewHeads sends the latest header to out each time it changes
+func (c *Client) pollNewHeads(ctx context.Context, client *chain.ClientWithFallback, out chan<- *gethtypes.Header) {
+	defer close(out)
+
+	ticker := time.NewTicker(c.PollInterval)
+	defer ticker.Stop()
+
+	var last *gethtypes.Header
+	for {
+		header, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			c.log.Warn("failed to poll new heads", "chainID", client.ChainID, "error", err)
+		} else if last == nil || header.Number.Cmp(last.Number) > 0 {
+			select {
+			case out <- header:
+			case <-ctx.Done():
+				return
+			}
+			last = header
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/rpc/chain"
+)
+
+type newHeadsService struct {
+	mu      sync.Mutex
+	headers []*gethtypes.Header
+	polled  int
+}
+
+func (s *newHeadsService) NewHeads(ctx context.Context) (*gethrpc.Subscription, error) {
+	notifier, supported := gethrpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, gethrpc.ErrNotificationsUnsupported
+	}
+
+	sub := notifier.CreateSubscription()
+	go func() {
+		for _, header := range s.headers {
+			_ = notifier.Notify(sub.ID, header)
+		}
+	}()
+	return sub, nil
+}
+
+func (s *newHeadsService) GetBlockByNumber(ctx context.Context, number gethrpc.BlockNumber, fullTx bool) (*gethtypes.Header, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	header := s.headers[s.polled]
+	if s.polled < len(s.headers)-1 {
+		s.polled++
+	}
+	return header, nil
+}
+
+func newTestHeaders() []*gethtypes.Header {
+	return []*gethtypes.Header{
+		{Number: big.NewInt(1), Difficulty: big.NewInt(1)},
+		{Number: big.NewInt(2), Difficulty: big.NewInt(1)},
+	}
+}
+
+func newTestClientWithUpstream(t *testing.T, upstream *gethrpc.Client) *Client {
+	db, close := setupTestNetworkDB(t)
+	t.Cleanup(close)
+
+	c, err := NewClient(nil, 1, params.UpstreamRPCConfig{}, []params.Network{}, db)
+	require.NoError(t, err)
+	c.rpcClients[1] = chain.NewSimpleClient(upstream, 1)
+	c.PollInterval = 10 * time.Millisecond
+	return c
+}
+
+func receiveHeaders(t *testing.T, headers <-chan *gethtypes.Header, count int) []*gethtypes.Header {
+	var received []*gethtypes.Header
+	for len(received) < count {
+		select {
+		case header := <-headers:
+			received = append(received, header)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for headers")
+		}
+	}
+	return received
+}
+
+func TestSubscribeNewHeads(t *testing.T) {
+	server := gethrpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &newHeadsService{headers: newTestHeaders()}))
+	defer server.Stop()
+
+	ts := httptest.NewServer(server.WebsocketHandler([]string{"*"}))
+	defer ts.Close()
+
+	upstream, err := gethrpc.Dial("ws" + strings.TrimPrefix(ts.URL, "http"))
+	require.NoError(t, err)
+
+	c := newTestClientWithUpstream(t, upstream)
+
+	headers, unsubscribe, err := c.SubscribeNewHeads(context.Background(), 1)
+	require.NoError(t, err)
+
+	received := receiveHeaders(t, headers, 2)
+	require.Equal(t, int64(1), received[0].Number.Int64())
+	require.Equal(t, int64(2), received[1].Number.Int64())
+
+	unsubscribe()
+	_, ok := <-headers
+	require.False(t, ok)
+}
+
+func TestSubscribeNewHeadsPolling(t *testing.T) {
+	server := gethrpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &newHeadsService{headers: newTestHeaders()}))
+	defer server.Stop()
+
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	upstream, err := gethrpc.Dial(ts.URL)
+	require.NoError(t, err)
+
+	c := newTestClientWithUpstream(t, upstream)
+
+	headers, unsubscribe, err := c.SubscribeNewHeads(context.Background(), 1)
+	require.NoError(t, err)
+
+	received := receiveHeaders(t, headers, 2)
+	require.Equal(t, int64(1), received[0].Number.Int64())
+	require.Equal(t, int64(2), received[1].Number.Int64())
+
+	unsubscribe()
+	// The channel is closed once polling stopped
+	for range headers {
+	}
+}
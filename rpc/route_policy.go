@@ -0,0 +1,158 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/rpc/chain"
+)
+
+const (
+	// latencyWindowSize is the number of calls the rolling average latency of
+	// an endpoint is computed on
+	latencyWindowSize = 10
+
+	// failedCallLatency is the latency recorded for a failed call, so that
+	// failing endpoints are avoided
+	failedCallLatency = 30 * time.Second
+)
+
+var ErrNoRouteCandidates = errors.New("no endpoint to route the call to")
+
+// RoutePolicy selects the endpoint a call to a chain is routed to when
+// several endpoints are available for it.
+type RoutePolicy interface {
+	Select(chainID uint64, method string, candidates []*chain.ClientWithFallback) (*chain.ClientWithFallback, error)
+}
+
+// CallRecorder is implemented by route policies which need the outcome of
+// the calls they routed.
+type CallRecorder interface {
+	RecordCall(client *chain.ClientWithFallback, method string, latency time.Duration, err error)
+}
+
+// WithRoutePolicy sets the policy used to select the endpoint of a chain
+// among the ones added with AddEndpoint.
+func WithRoutePolicy(p RoutePolicy) ClientOption {
+	return func(c *Client) {
+		c.routePolicy = p
+	}
+}
+
+// AddEndpoint adds an endpoint calls to chainID can be routed to, in
+// addition to the one of the chain network.
+func (c *Client) AddEndpoint(chainID uint64, client *chain.ClientWithFallback) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.endpoints[chainID] = append(c.endpoints[chainID], client)
+}
+
+// selectClient returns the client a call to chainID is routed to
+func (c *Client) selectClient(chainID uint64, method string) (*chain.ClientWithFallback, error) {
+	client, err := c.getClientUsingCache(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.RLock()
+	endpoints := c.endpoints[chainID]
+	c.RUnlock()
+
+	if c.routePolicy == nil || len(endpoints) == 0 {
+		return client, nil
+	}
+
+	candidates := append([]*chain.ClientWithFallback{client}, endpoints...)
+	return c.routePolicy.Select(chainID, method, candidates)
+}
+
+type latencyWindow struct {
+	samples []time.Duration
+	next    int
+}
+
+func (w *latencyWindow) add(latency time.Duration) {
+	if len(w.samples) < latencyWindowSize {
+		w.samples = append(w.samples, latency)
+		return
+	}
+	w.samples[w.next] = latency
+	w.next = (w.next + 1) % latencyWindowSize
+}
+
+func (w *latencyWindow) average() time.Duration {
+	var total time.Duration
+	for _, sample := range w.samples {
+		total += sample
+	}
+	return total / time.Duration(len(w.samples))
+}
+
+// LatencyBasedPolicy routes calls to the endpoint with the lowest rolling
+// average latency. Endpoints which were never called are tried first.
+type LatencyBasedPolicy struct {
+	mu        sync.Mutex
+	latencies map[*chain.ClientWithFallback]*latencyWindow
+	log       log.Logger
+}
+
+func NewLatencyBasedPolicy() *LatencyBasedPolicy {
+	return &LatencyBasedPolicy{
+		latencies: make(map[*chain.ClientWithFallback]*latencyWindow),
+		log:       log.New("package", "status-go/rpc.LatencyBasedPolicy"),
+	}
+}
+
+func (p *LatencyBasedPolicy) Select(chainID uint64, method string, candidates []*chain.ClientWithFallback) (*chain.ClientWithFallback, error) {
+	if len(candidates) == 0 {
+		return nil, ErrNoRouteCandidates
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var selected *chain.ClientWithFallback
+	var lowest time.Duration
+	for _, candidate := range candidates {
+		window, ok := p.latencies[candidate]
+		if !ok {
+			return candidate, nil
+		}
+
+		if average := window.average(); selected == nil || average < lowest {
+			selected = candidate
+			lowest = average
+		}
+	}
+
+	return selected, nil
+}
+
+// RecordCall records the latency of a call. Failed calls are recorded with
+// failedCallLatency, calls canceled by the caller are not recorded.
+func (p *LatencyBasedPolicy) RecordCall(client *chain.ClientWithFallback, method string, latency time.Duration, err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	if err != nil {
+		p.log.Warn("RPC call failed", "chainID", client.ChainID, "method", method, "error", err)
+		if latency < failedCallLatency {
+			latency = failedCallLatency
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	window, ok := p.latencies[client]
+	if !ok {
+		window = &latencyWindow{}
+		p.latencies[client] = window
+	}
+	window.add(latency)
+}
@@ -0,0 +1,80 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/rpc/chain"
+)
+
+func newCountingServer(delay time.Duration, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		time.Sleep(delay)
+		fmt.Fprintln(w, `{"id": 1, "jsonrpc": "2.0", "result": "0x1"}`)
+	}))
+}
+
+func TestLatencyBasedPolicy(t *testing.T) {
+	db, close := setupTestNetworkDB(t)
+	defer close()
+
+	var slowCalls, fastCalls int32
+	slow := newCountingServer(100*time.Millisecond, &slowCalls)
+	defer slow.Close()
+	fast := newCountingServer(0, &fastCalls)
+	defer fast.Close()
+
+	c, err := NewClient(nil, 1, params.UpstreamRPCConfig{Enabled: true, URL: slow.URL}, []params.Network{}, db, WithRoutePolicy(NewLatencyBasedPolicy()))
+	require.NoError(t, err)
+
+	fastClient, err := gethrpc.Dial(fast.URL)
+	require.NoError(t, err)
+	c.AddEndpoint(1, chain.NewSimpleClient(fastClient, 1))
+
+	var result string
+	// Endpoints are tried once before their latency is known
+	require.NoError(t, c.Call(&result, 1, "eth_blockNumber"))
+	require.Equal(t, int32(1), atomic.LoadInt32(&slowCalls))
+	require.NoError(t, c.Call(&result, 1, "eth_blockNumber"))
+	require.Equal(t, int32(1), atomic.LoadInt32(&fastCalls))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, c.Call(&result, 1, "eth_blockNumber"))
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&slowCalls))
+	require.Equal(t, int32(4), atomic.LoadInt32(&fastCalls))
+}
+
+func TestLatencyBasedPolicyRecordsFailedCalls(t *testing.T) {
+	p := NewLatencyBasedPolicy()
+	failing := &chain.ClientWithFallback{ChainID: 1}
+	working := &chain.ClientWithFallback{ChainID: 1}
+	candidates := []*chain.ClientWithFallback{failing, working}
+
+	p.RecordCall(failing, "eth_blockNumber", time.Millisecond, errors.New("connection refused"))
+	p.RecordCall(working, "eth_blockNumber", time.Second, nil)
+
+	selected, err := p.Select(1, "eth_blockNumber", candidates)
+	require.NoError(t, err)
+	require.Equal(t, working, selected)
+
+	// Calls canceled by the caller say nothing about the endpoint
+	canceled := &chain.ClientWithFallback{ChainID: 1}
+	p.RecordCall(canceled, "eth_blockNumber", time.Millisecond, context.Canceled)
+
+	selected, err = p.Select(1, "eth_blockNumber", append(candidates, canceled))
+	require.NoError(t, err)
+	require.Equal(t, canceled, selected)
+}
@@ -0,0 +1,73 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/contracts/ierc20"
+)
+
+// GetTokenBalances returns the balances of owner for the given ERC-20 tokens,
+// fetching all of them in a single batch request.
+// Tokens whose balance could not be fetched are missing from the result.
+func (c *Client) GetTokenBalances(ctx context.Context, chainID uint64, owner common.Address, tokens []common.Address) (map[common.Address]*big.Int, error) {
+	client, err := c.getClientUsingCache(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(ierc20.IERC20ABI))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := parsed.Pack("balanceOf", owner)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]hexutil.Bytes, len(tokens))
+	batch := make([]gethrpc.BatchElem, len(tokens))
+	for i, token := range tokens {
+		batch[i] = gethrpc.BatchElem{
+			Method: "eth_call",
+			Args: []interface{}{
+				map[string]interface{}{
+					"to":   token,
+					"data": hexutil.Bytes(data),
+				},
+				"latest",
+			},
+			Result: &results[i],
+		}
+	}
+
+	err = client.BatchCallContext(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[common.Address]*big.Int, len(tokens))
+	for i, token := range tokens {
+		if batch[i].Error != nil {
+			c.log.Warn("can't fetch erc20 token balance", "token", token, "error", batch[i].Error)
+			continue
+		}
+
+		balance, err := parsed.Unpack("balanceOf", results[i])
+		if err != nil {
+			c.log.Warn("can't decode erc20 token balance", "token", token, "error", err)
+			continue
+		}
+
+		balances[token] = balance[0].(*big.Int)
+	}
+
+	return balances, nil
+}
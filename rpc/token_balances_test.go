@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+func TestGetTokenBalances(t *testing.T) {
+	owner := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	tokenA := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	tokenB := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	failing := common.HexToAddress("0x00000000000000000000000000000000000000ff")
+
+	var batchSize int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requests []struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []json.RawMessage
+		}
+		if err := json.NewDecoder(r.Body).Decode(&requests); err != nil {
+			http.Error(w, "expected a batch request", http.StatusBadRequest)
+			return
+		}
+		batchSize = len(requests)
+
+		var responses []string
+		for _, req := range requests {
+			var call struct {
+				To   common.Address `json:"to"`
+				Data hexutil.Bytes  `json:"data"`
+			}
+			require.Equal(t, "eth_call", req.Method)
+			require.NoError(t, json.Unmarshal(req.Params[0], &call))
+			// balanceOf(owner)
+			require.Equal(t, "70a08231", common.Bytes2Hex(call.Data[:4]))
+			require.Equal(t, owner, common.BytesToAddress(call.Data[4:]))
+
+			if call.To == failing {
+				responses = append(responses, fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"error":{"code":-32000,"message":"execution reverted"}}`, req.ID))
+				continue
+			}
+
+			// the balance of a token is its last address byte
+			balance := common.LeftPadBytes([]byte{call.To[19]}, 32)
+			responses = append(responses, fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":"%s"}`, req.ID, hexutil.Encode(balance)))
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(responses, ","))
+	}))
+	defer ts.Close()
+
+	upstream, err := gethrpc.Dial(ts.URL)
+	require.NoError(t, err)
+
+	c := newTestClientWithUpstream(t, upstream)
+
+	balances, err := c.GetTokenBalances(context.Background(), 1, owner, []common.Address{tokenA, tokenB, failing})
+	require.NoError(t, err)
+	require.Equal(t, 3, batchSize)
+	require.Len(t, balances, 2)
+	require.Equal(t, big.NewInt(0xaa), balances[tokenA])
+	require.Equal(t, big.NewInt(0xbb), balances[tokenB])
+}
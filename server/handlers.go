@@ -2,13 +2,17 @@ package server
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"image"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
+	lru "github.com/hashicorp/golang-lru/v2"
 	"go.uber.org/zap"
 
 	"github.com/status-im/status-go/images"
@@ -29,9 +33,10 @@ const (
 	discordAttachmentsPath = basePath + "/discord/attachments"
 
 	// Handler routes for pairing
-	accountImagesPath = "/accountImages"
-	contactImagesPath = "/contactImages"
-	generateQRCode    = "/GenerateQRCode"
+	accountImagesPath         = "/accountImages"
+	animatedAccountImagesPath = "/accountAnimatedImages"
+	contactImagesPath         = "/contactImages"
+	generateQRCode            = "/GenerateQRCode"
 )
 
 type HandlerPatternMap map[string]http.HandlerFunc
@@ -102,6 +107,39 @@ func handleAccountImages(multiaccountsDB *multiaccounts.Database, logger *zap.Lo
 	}
 }
 
+func handleAnimatedAccountImage(multiaccountsDB *multiaccounts.Database, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		keyUids, ok := r.URL.Query()["keyUid"]
+		if !ok || len(keyUids) == 0 {
+			logger.Error("no keyUid")
+			return
+		}
+
+		identityImage, err := multiaccountsDB.GetIdentityImage(keyUids[0], images.AnimatedIdentityName)
+		if err != nil {
+			logger.Error("handleAnimatedAccountImage: failed to load image.", zap.String("keyUid", keyUids[0]), zap.Error(err))
+			return
+		}
+		if identityImage == nil || len(identityImage.Payload) == 0 {
+			http.NotFound(w, r)
+			return
+		}
+
+		mime, err := images.GetProtobufImageMime(identityImage.Payload)
+		if err != nil {
+			logger.Error("failed to get mime", zap.Error(err))
+		}
+
+		w.Header().Set("Content-Type", mime)
+		w.Header().Set("Cache-Control", "no-store")
+
+		_, err = w.Write(identityImage.Payload)
+		if err != nil {
+			logger.Error("failed to write image", zap.Error(err))
+		}
+	}
+}
+
 func handleContactImages(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		params := r.URL.Query()
@@ -128,6 +166,14 @@ func handleContactImages(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
 			return
 		}
 
+		etag := contactImageETag(payload, params)
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", "max-age=3600")
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
 		if ringEnabled(params) {
 			var theme = getTheme(params, logger)
 			config, _, err := image.DecodeConfig(bytes.NewReader(payload))
@@ -156,7 +202,6 @@ func handleContactImages(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
 		}
 
 		w.Header().Set("Content-Type", mime)
-		w.Header().Set("Cache-Control", "no-store")
 
 		_, err = w.Write(payload)
 		if err != nil {
@@ -165,6 +210,25 @@ func handleContactImages(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
 	}
 }
 
+// contactImageETag identifies a contact image by the hash of its payload and of the params changing how it is drawn
+func contactImageETag(payload []byte, params url.Values) string {
+	hash := sha256.New()
+	hash.Write(payload)
+	hash.Write([]byte(params.Get("addRing") + "/" + params.Get("theme")))
+	return `"` + hex.EncodeToString(hash.Sum(nil)) + `"`
+}
+
+// etagMatches tells if the If-None-Match header of a request matches etag
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
+
 func ringEnabled(params url.Values) bool {
 	addRings, ok := params["addRing"]
 	return ok && len(addRings) == 1 && addRings[0] == "1"
@@ -298,24 +362,64 @@ func handleDiscordAttachment(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
 	}
 }
 
-func handleImage(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+func handleImage(db *sql.DB, resizedImages *lru.Cache[resizedImageKey, []byte], logger *zap.Logger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		messageIDs, ok := r.URL.Query()["messageId"]
+		params := r.URL.Query()
+		messageIDs, ok := params["messageId"]
 		if !ok || len(messageIDs) == 0 {
 			logger.Error("no messageID")
 			return
 		}
 		messageID := messageIDs[0]
-		var image []byte
-		err := db.QueryRow(`SELECT image_payload FROM user_messages WHERE id = ?`, messageID).Scan(&image)
+
+		width, height, err := parseResizeDimensions(params)
 		if err != nil {
-			logger.Error("failed to find image", zap.Error(err))
+			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
+
+		var image []byte
+		var cached bool
+		key := resizedImageKey{messageID: messageID, width: width, height: height}
+		if width > 0 {
+			image, cached = resizedImages.Get(key)
+		}
+		if cached {
+			// Resized images of deleted messages must not be served
+			var exists bool
+			err = db.QueryRow(`SELECT EXISTS(SELECT 1 FROM user_messages WHERE id = ?)`, messageID).Scan(&exists)
+			if err != nil {
+				logger.Error("failed to find image", zap.Error(err))
+				return
+			}
+			if !exists {
+				evictResizedImages(resizedImages, messageID)
+				logger.Error("failed to find image", zap.String("messageID", messageID))
+				return
+			}
+		}
+		if !cached {
+			err = db.QueryRow(`SELECT image_payload FROM user_messages WHERE id = ?`, messageID).Scan(&image)
+			if err != nil {
+				logger.Error("failed to find image", zap.Error(err))
+				return
+			}
+		}
 		if len(image) == 0 {
 			logger.Error("empty image")
 			return
 		}
+
+		if !cached && width > 0 {
+			image, err = resizeImagePayload(image, width, height)
+			if err != nil {
+				logger.Error("failed to resize image", zap.Error(err))
+				http.Error(w, "error", http.StatusInternalServerError)
+				return
+			}
+			resizedImages.Add(key, image)
+		}
+
 		mime, err := images.GetProtobufImageMime(image)
 		if err != nil {
 			logger.Error("failed to get mime", zap.Error(err))
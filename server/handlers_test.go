@@ -0,0 +1,71 @@
+package server
+
+import (
+	"bytes"
+	"database/sql"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/tt"
+)
+
+func TestHandleContactImagesETag(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE chat_identity_contacts (contact_id VARCHAR, image_type VARCHAR, payload BLOB)`)
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	publicKey := types.EncodeHex(crypto.FromECDSAPub(&key.PublicKey))
+
+	bb := bytes.NewBuffer([]byte{})
+	require.NoError(t, png.Encode(bb, image.NewRGBA(image.Rect(0, 0, 10, 10))))
+	_, err = db.Exec(`INSERT INTO chat_identity_contacts (contact_id, image_type, payload) VALUES (?, ?, ?)`, publicKey, "thumbnail", bb.Bytes())
+	require.NoError(t, err)
+
+	handler := handleContactImages(db, tt.MustCreateTestLogger())
+	get := func(etag string) *httptest.ResponseRecorder {
+		query := url.Values{"publicKey": {publicKey}, "imageName": {"thumbnail"}}
+		r := httptest.NewRequest(http.MethodGet, contactImagesPath+"?"+query.Encode(), nil)
+		if etag != "" {
+			r.Header.Set("If-None-Match", etag)
+		}
+		w := httptest.NewRecorder()
+		handler(w, r)
+		return w
+	}
+
+	w := get("")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "max-age=3600", w.Header().Get("Cache-Control"))
+	require.Equal(t, bb.Bytes(), w.Body.Bytes())
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	// Same image, the cached one can be used
+	w = get(etag)
+	require.Equal(t, http.StatusNotModified, w.Code)
+	require.Empty(t, w.Body.Bytes())
+
+	// The image is updated, the ETag changes
+	bb.Reset()
+	require.NoError(t, png.Encode(bb, image.NewRGBA(image.Rect(0, 0, 20, 20))))
+	_, err = db.Exec(`UPDATE chat_identity_contacts SET payload = ?`, bb.Bytes())
+	require.NoError(t, err)
+
+	w = get(etag)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotEqual(t, etag, w.Header().Get("ETag"))
+	require.Equal(t, bb.Bytes(), w.Body.Bytes())
+}
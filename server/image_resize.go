@@ -0,0 +1,82 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"net/url"
+	"strconv"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/status-im/status-go/images"
+)
+
+const (
+	// maxResizeDimension is the largest width or height images can be resized to
+	maxResizeDimension = 2048
+
+	// resizedImagesCacheSize is the number of resized images kept in memory
+	resizedImagesCacheSize = 200
+)
+
+var ErrInvalidResizeDimensions = errors.New("invalid resize dimensions")
+
+type resizedImageKey struct {
+	messageID string
+	width     int
+	height    int
+}
+
+// parseResizeDimensions returns the width and height images are requested to be resized to, both are 0 if
+// no resizing is requested
+func parseResizeDimensions(params url.Values) (int, int, error) {
+	if params.Get("width") == "" && params.Get("height") == "" {
+		return 0, 0, nil
+	}
+
+	width, err := strconv.Atoi(params.Get("width"))
+	if err != nil {
+		return 0, 0, ErrInvalidResizeDimensions
+	}
+	height, err := strconv.Atoi(params.Get("height"))
+	if err != nil {
+		return 0, 0, ErrInvalidResizeDimensions
+	}
+
+	if width < 1 || height < 1 || width > maxResizeDimension || height > maxResizeDimension {
+		return 0, 0, ErrInvalidResizeDimensions
+	}
+	return width, height, nil
+}
+
+// evictResizedImages removes all the resized images of a message from the cache
+func evictResizedImages(resizedImages *lru.Cache[resizedImageKey, []byte], messageID string) {
+	for _, key := range resizedImages.Keys() {
+		if key.messageID == messageID {
+			resizedImages.Remove(key)
+		}
+	}
+}
+
+// resizeImagePayload resizes an image, JPEG images stay JPEG while other formats are encoded as PNG
+func resizeImagePayload(payload []byte, width, height int) ([]byte, error) {
+	img, _, err := image.Decode(bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	resized := images.ResizeExact(img, width, height)
+
+	bb := bytes.NewBuffer([]byte{})
+	if images.GetType(payload) == images.JPEG {
+		err = images.Encode(bb, resized, images.EncodeConfig{Quality: images.MaxJpegQuality})
+	} else {
+		err = png.Encode(bb, resized)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return bb.Bytes(), nil
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"bytes"
+	"database/sql"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	_ "github.com/mutecomm/go-sqlcipher" // require go-sqlcipher that overrides default implementation
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/tt"
+)
+
+func TestHandleImageResize(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE user_messages (id VARCHAR PRIMARY KEY, image_payload BLOB)`)
+	require.NoError(t, err)
+
+	img := image.NewRGBA(image.Rect(0, 0, 1200, 1000))
+	for x := 0; x < 1200; x++ {
+		for y := 0; y < 1000; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	bb := bytes.NewBuffer([]byte{})
+	require.NoError(t, png.Encode(bb, img))
+
+	_, err = db.Exec(`INSERT INTO user_messages (id, image_payload) VALUES (?, ?)`, "0x1", bb.Bytes())
+	require.NoError(t, err)
+
+	resizedImages, err := lru.New[resizedImageKey, []byte](resizedImagesCacheSize)
+	require.NoError(t, err)
+	handler := handleImage(db, resizedImages, tt.MustCreateTestLogger())
+
+	get := func(query string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodGet, imagesPath+"?"+query, nil))
+		return w
+	}
+
+	w := get("messageId=0x1&width=300&height=250")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "image/png", w.Header().Get("Content-Type"))
+
+	resized, _, err := image.Decode(bytes.NewReader(w.Body.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, 300, resized.Bounds().Dx())
+	require.Equal(t, 250, resized.Bounds().Dy())
+
+	// The resized image is served from the cache
+	require.True(t, resizedImages.Contains(resizedImageKey{messageID: "0x1", width: 300, height: 250}))
+	cached := get("messageId=0x1&width=300&height=250")
+	require.Equal(t, http.StatusOK, cached.Code)
+	require.Equal(t, w.Body.Bytes(), cached.Body.Bytes())
+
+	// Resized images of deleted messages are evicted instead of being served
+	_, err = db.Exec(`DELETE FROM user_messages`)
+	require.NoError(t, err)
+	deleted := get("messageId=0x1&width=300&height=250")
+	require.Empty(t, deleted.Body.Bytes())
+	require.Equal(t, 0, resizedImages.Len())
+
+	require.Equal(t, http.StatusBadRequest, get("messageId=0x1&width=2049&height=250").Code)
+	require.Equal(t, http.StatusBadRequest, get("messageId=0x1&width=300&height=4096").Code)
+	require.Equal(t, http.StatusBadRequest, get("messageId=0x1&width=300").Code)
+}
+
+func TestHandleImageResizeWithToken(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE user_messages (id VARCHAR PRIMARY KEY, image_payload BLOB)`)
+	require.NoError(t, err)
+
+	bb := bytes.NewBuffer([]byte{})
+	require.NoError(t, png.Encode(bb, image.NewRGBA(image.Rect(0, 0, 1200, 1000))))
+	_, err = db.Exec(`INSERT INTO user_messages (id, image_payload) VALUES (?, ?)`, "0x1", bb.Bytes())
+	require.NoError(t, err)
+
+	resizedImages, err := lru.New[resizedImageKey, []byte](resizedImagesCacheSize)
+	require.NoError(t, err)
+
+	s := &MediaServer{db: db, resizedImages: resizedImages}
+	require.NoError(t, s.rotateTokenSecret())
+	handler := s.requireToken(handleImage(db, resizedImages, tt.MustCreateTestLogger()))
+
+	u, err := url.Parse(s.withToken(&url.URL{Path: imagesPath, RawQuery: url.Values{"messageId": {"0x1"}}.Encode()}))
+	require.NoError(t, err)
+
+	get := func(params url.Values) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodGet, u.Path+"?"+params.Encode(), nil))
+		return w
+	}
+
+	// Clients add the resize params to the URL made by the server
+	params := u.Query()
+	params.Set("width", "300")
+	params.Set("height", "250")
+	w := get(params)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	resized, _, err := image.Decode(bytes.NewReader(w.Body.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, 300, resized.Bounds().Dx())
+	require.Equal(t, 250, resized.Bounds().Dy())
+
+	// The other params are still covered by the token
+	params.Set("messageId", "0x2")
+	require.Equal(t, http.StatusForbidden, get(params).Code)
+}
+
+func TestEvictResizedImages(t *testing.T) {
+	resizedImages, err := lru.New[resizedImageKey, []byte](resizedImagesCacheSize)
+	require.NoError(t, err)
+
+	resizedImages.Add(resizedImageKey{messageID: "0x1", width: 100, height: 100}, []byte{1})
+	resizedImages.Add(resizedImageKey{messageID: "0x1", width: 300, height: 250}, []byte{2})
+	resizedImages.Add(resizedImageKey{messageID: "0x2", width: 100, height: 100}, []byte{3})
+
+	evictResizedImages(resizedImages, "0x1")
+	require.Equal(t, []resizedImageKey{{messageID: "0x2", width: 100, height: 100}}, resizedImages.Keys())
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+)
+
+const tokenParam = "token"
+
+// unsignedParams are added by the clients to the URLs made by the server, so they aren't covered by the token
+var unsignedParams = []string{"width", "height"}
+
+// rotateTokenSecret generates the secret of a new session, invalidating the URLs made during the previous one
+func (s *MediaServer) rotateTokenSecret() error {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return err
+	}
+
+	s.tokenSecretLock.Lock()
+	defer s.tokenSecretLock.Unlock()
+
+	s.tokenSecret = secret
+	return nil
+}
+
+// makeToken returns the HMAC of the path and signed query params of a URL with the secret of the session, or an
+// empty string if the server doesn't authenticate requests
+func (s *MediaServer) makeToken(path string, params url.Values) string {
+	s.tokenSecretLock.RLock()
+	defer s.tokenSecretLock.RUnlock()
+
+	if s.tokenSecret == nil {
+		return ""
+	}
+
+	signed := url.Values{}
+	for key, values := range params {
+		signed[key] = values
+	}
+	for _, key := range unsignedParams {
+		signed.Del(key)
+	}
+
+	mac := hmac.New(sha256.New, s.tokenSecret)
+	mac.Write([]byte(path + "?" + signed.Encode()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// withToken adds the token of the session to u
+func (s *MediaServer) withToken(u *url.URL) string {
+	params := u.Query()
+	if token := s.makeToken(u.Path, params); token != "" {
+		params.Set(tokenParam, token)
+		u.RawQuery = params.Encode()
+	}
+	return u.String()
+}
+
+// requireToken only lets through requests carrying a valid token of the current session
+func (s *MediaServer) requireToken(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+		token, err := hex.DecodeString(params.Get(tokenParam))
+		params.Del(tokenParam)
+
+		expected, _ := hex.DecodeString(s.makeToken(r.URL.Path, params))
+		if err != nil || !hmac.Equal(token, expected) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		r.URL.RawQuery = params.Encode()
+		next(w, r)
+	}
+}
+
+// Start starts the server with a new session, URLs made before are not accepted anymore
+func (s *MediaServer) Start() error {
+	err := s.rotateTokenSecret()
+	if err != nil {
+		return err
+	}
+	return s.Server.Start()
+}
+
+// Stop stops the server and ends its session, URLs made before are not accepted anymore
+func (s *MediaServer) Stop() error {
+	err := s.rotateTokenSecret()
+	if err != nil {
+		return err
+	}
+	return s.Server.Stop()
+}
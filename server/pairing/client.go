@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
+	"strconv"
 
 	"github.com/status-im/status-go/api"
 	"github.com/status-im/status-go/logutils"
@@ -32,6 +33,15 @@ type BaseClient struct {
 	serverCert     *x509.Certificate
 	baseAddress    *url.URL
 	challengeTaker *ChallengeTaker
+
+	// transfers holds the unfinished chunked transfers, by path, to resume them on the next attempt
+	transfers map[string]*chunkedTransfer
+}
+
+// chunkedTransfer is the state of a payload being received in chunks
+type chunkedTransfer struct {
+	sessionID string
+	chunks    [][]byte
 }
 
 // NewBaseClient returns a fully qualified BaseClient from the given ConnectionParams
@@ -79,6 +89,7 @@ func NewBaseClient(c *ConnectionParams) (*BaseClient, error) {
 		serverCert:     serverCert,
 		challengeTaker: NewChallengeTaker(NewPayloadEncryptor(c.aesKey)),
 		baseAddress:    u,
+		transfers:      make(map[string]*chunkedTransfer),
 	}, nil
 }
 
@@ -97,6 +108,77 @@ func (c *BaseClient) getChallenge() error {
 	return c.challengeTaker.SetChallenge(resp)
 }
 
+// receivePayloadChunks receives a payload, described by name, sent in chunks from path, resuming the
+// previous transfer from the same path if it was interrupted
+func (c *BaseClient) receivePayloadChunks(path, name string) ([]byte, error) {
+	t, ok := c.transfers[path]
+	if !ok {
+		t = new(chunkedTransfer)
+		c.transfers[path] = t
+	}
+
+	for {
+		if len(t.chunks) > 0 {
+			err := c.getChallenge()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		c.baseAddress.Path = path
+		c.baseAddress.RawQuery = url.Values{
+			paramSessionID:  {t.sessionID},
+			paramChunkIndex: {strconv.Itoa(len(t.chunks))},
+		}.Encode()
+		req, err := http.NewRequest(http.MethodGet, c.baseAddress.String(), nil)
+		c.baseAddress.RawQuery = ""
+		if err != nil {
+			return nil, err
+		}
+
+		err = c.challengeTaker.DoChallenge(req)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		chunk, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("[client] status not ok when receiving %s, received '%s'", name, resp.Status)
+		}
+
+		// Servers that don't split payloads into chunks don't set the header
+		chunkCount := 1
+		if header := resp.Header.Get(headerChunkCount); header != "" {
+			chunkCount, err = strconv.Atoi(header)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// The server started a new session, the previous one expired
+		if sessionID := resp.Header.Get(headerSessionID); sessionID != t.sessionID {
+			t.sessionID = sessionID
+			t.chunks = nil
+		}
+		t.chunks = append(t.chunks, chunk)
+
+		if len(t.chunks) >= chunkCount {
+			delete(c.transfers, path)
+			return bytes.Join(t.chunks, nil), nil
+		}
+	}
+}
+
 /*
 |--------------------------------------------------------------------------
 | SenderClient
@@ -137,12 +219,12 @@ func NewSenderClient(backend *api.GethStatusBackend, c *ConnectionParams, config
 	}, nil
 }
 
-func (c *SenderClient) sendAccountData() error {
-	err := c.accountMounter.Mount()
-	if err != nil {
-		return err
-	}
+// mountPayloads mounts the account and sync device payloads concurrently, before sending them
+func (c *SenderClient) mountPayloads() error {
+	return MountPayloads(c.accountMounter, c.rawMessageMounter)
+}
 
+func (c *SenderClient) sendAccountData() error {
 	c.baseAddress.Path = pairingReceiveAccount
 	resp, err := c.Post(c.baseAddress.String(), "application/octet-stream", bytes.NewBuffer(c.accountMounter.ToSend()))
 	if err != nil {
@@ -163,11 +245,6 @@ func (c *SenderClient) sendAccountData() error {
 }
 
 func (c *SenderClient) sendSyncDeviceData() error {
-	err := c.rawMessageMounter.Mount()
-	if err != nil {
-		return err
-	}
-
 	c.baseAddress.Path = pairingReceiveSyncDevice
 	resp, err := c.Post(c.baseAddress.String(), "application/octet-stream", bytes.NewBuffer(c.rawMessageMounter.ToSend()))
 	if err != nil {
@@ -186,31 +263,7 @@ func (c *SenderClient) sendSyncDeviceData() error {
 }
 
 func (c *SenderClient) receiveInstallationData() error {
-	c.baseAddress.Path = pairingSendInstallation
-	req, err := http.NewRequest(http.MethodGet, c.baseAddress.String(), nil)
-	if err != nil {
-		return err
-	}
-
-	err = c.challengeTaker.DoChallenge(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
-		return err
-	}
-
-	resp, err := c.Do(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not ok when receiving installation data, received '%s'", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
-		return err
-	}
-
-	payload, err := io.ReadAll(resp.Body)
+	payload, err := c.receivePayloadChunks(pairingSendInstallation, "installation data")
 	if err != nil {
 		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
 		return err
@@ -234,6 +287,12 @@ func setupSendingClient(backend *api.GethStatusBackend, cs, configJSON string) (
 		return nil, err
 	}
 
+	// The receiving device may not be logged in, in which case it can't sign the connection string
+	err = ccp.verifyIdentity(loggedInIdentityPublicKey(backend), false)
+	if err != nil {
+		return nil, err
+	}
+
 	conf := NewSenderClientConfig()
 	err = json.Unmarshal([]byte(configJSON), conf)
 	if err != nil {
@@ -251,6 +310,10 @@ func StartUpSendingClient(backend *api.GethStatusBackend, cs, configJSON string)
 	if err != nil {
 		return err
 	}
+	err = c.mountPayloads()
+	if err != nil {
+		return err
+	}
 	err = c.sendAccountData()
 	if err != nil {
 		return err
@@ -308,31 +371,7 @@ func NewReceiverClient(backend *api.GethStatusBackend, c *ConnectionParams, conf
 }
 
 func (c *ReceiverClient) receiveAccountData() error {
-	c.baseAddress.Path = pairingSendAccount
-	req, err := http.NewRequest(http.MethodGet, c.baseAddress.String(), nil)
-	if err != nil {
-		return err
-	}
-
-	err = c.challengeTaker.DoChallenge(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-		return err
-	}
-
-	resp, err := c.Do(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not ok when receiving account data, received '%s'", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-		return err
-	}
-
-	payload, err := io.ReadAll(resp.Body)
+	payload, err := c.receivePayloadChunks(pairingSendAccount, "account data")
 	if err != nil {
 		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
 		return err
@@ -349,31 +388,7 @@ func (c *ReceiverClient) receiveAccountData() error {
 }
 
 func (c *ReceiverClient) receiveSyncDeviceData() error {
-	c.baseAddress.Path = pairingSendSyncDevice
-	req, err := http.NewRequest(http.MethodGet, c.baseAddress.String(), nil)
-	if err != nil {
-		return err
-	}
-
-	err = c.challengeTaker.DoChallenge(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-		return err
-	}
-
-	resp, err := c.Do(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not ok when receiving sync device data, received '%s'", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-		return err
-	}
-
-	payload, err := io.ReadAll(resp.Body)
+	payload, err := c.receivePayloadChunks(pairingSendSyncDevice, "sync device data")
 	if err != nil {
 		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
 		return err
@@ -432,6 +447,14 @@ func setupReceivingClient(backend *api.GethStatusBackend, cs, configJSON string)
 		return nil, err
 	}
 
+	// The sending device is always logged in, a logged in receiving device only accepts connection strings
+	// signed with the key of its account
+	trustedKey := loggedInIdentityPublicKey(backend)
+	err = ccp.verifyIdentity(trustedKey, trustedKey != nil)
+	if err != nil {
+		return nil, err
+	}
+
 	conf := NewReceiverClientConfig()
 	err = json.Unmarshal([]byte(configJSON), conf)
 	if err != nil {
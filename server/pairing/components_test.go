@@ -2,7 +2,6 @@ package pairing
 
 import (
 	"crypto/ecdsa"
-	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/tls"
 	"net"
@@ -13,9 +12,12 @@ import (
 
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/server"
+	"github.com/status-im/status-go/server/servertest"
 )
 
 type TestPairingServerComponents struct {
+	servertest.TestKeyComponents
+
 	EphemeralPK  *ecdsa.PrivateKey
 	EphemeralAES []byte
 	OutboundIP   net.IP
@@ -29,8 +31,7 @@ func (tpsc *TestPairingServerComponents) SetupPairingServerComponents(t *testing
 
 	// Get 4 key components for tls.cert generation
 	// 1) Ephemeral private key
-	tpsc.EphemeralPK, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
-	require.NoError(t, err)
+	tpsc.EphemeralPK, _ = tpsc.GeneratePairingKey(t)
 
 	// 2) AES encryption key
 	tpsc.EphemeralAES, err = common.MakeECDHSharedKey(tpsc.EphemeralPK, &tpsc.EphemeralPK.PublicKey)
@@ -3,20 +3,56 @@ package pairing
 import (
 	"crypto/ecdsa"
 	"crypto/tls"
+	"errors"
 
 	"github.com/status-im/status-go/multiaccounts"
 	"github.com/status-im/status-go/params"
 )
 
+// DeviceType is the type of device an installation is paired from
+type DeviceType string
+
+const (
+	DeviceTypeDesktop DeviceType = "desktop"
+	DeviceTypeMobile  DeviceType = "mobile"
+	DeviceTypeTablet  DeviceType = "tablet"
+	DeviceTypeUnknown DeviceType = "unknown"
+
+	// Installations default their device type to runtime.GOOS
+	DeviceTypeAndroid DeviceType = "android"
+	DeviceTypeIOS     DeviceType = "ios"
+	DeviceTypeDarwin  DeviceType = "darwin"
+	DeviceTypeLinux   DeviceType = "linux"
+	DeviceTypeWindows DeviceType = "windows"
+)
+
+var ErrInvalidDeviceType = errors.New("invalid device type")
+
+// ValidateDeviceType parses s as a DeviceType, an empty s is an unknown device type
+func ValidateDeviceType(s string) (DeviceType, error) {
+	switch dt := DeviceType(s); dt {
+	case DeviceTypeDesktop, DeviceTypeMobile, DeviceTypeTablet, DeviceTypeUnknown,
+		DeviceTypeAndroid, DeviceTypeIOS, DeviceTypeDarwin, DeviceTypeLinux, DeviceTypeWindows:
+		return dt, nil
+	case "":
+		return DeviceTypeUnknown, nil
+	default:
+		return "", ErrInvalidDeviceType
+	}
+}
+
 type SenderConfig struct {
 	// SenderConfig.KeystorePath must end with keyUID
 	KeystorePath string `json:"keystorePath"`
 	// DeviceType SendPairInstallation need this information
-	DeviceType string `json:"deviceType"`
+	DeviceType DeviceType `json:"deviceType"`
 
 	KeyUID   string `json:"keyUID"`
 	Password string `json:"password"`
 
+	// CompressionLevel is the zstd level the payloads are compressed with, from 1 to 22. 0 disables compression
+	CompressionLevel int `json:"compressionLevel"`
+
 	DB *multiaccounts.Database `json:"-"`
 }
 
@@ -46,6 +82,10 @@ type ServerConfig struct {
 	EK       []byte           `json:"-"`
 	Cert     *tls.Certificate `json:"-"`
 	Hostname string           `json:"-"`
+
+	// IdentityKey is the chat key of the logged in account the connection string is signed with,
+	// nil if no account is logged in
+	IdentityKey *ecdsa.PrivateKey `json:"-"`
 }
 
 type ClientConfig struct{}
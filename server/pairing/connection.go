@@ -3,6 +3,7 @@ package pairing
 import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
+	"errors"
 	"fmt"
 	"math/big"
 	"net"
@@ -10,24 +11,38 @@ import (
 	"strings"
 
 	"github.com/btcsuite/btcutil/base58"
+
+	"github.com/status-im/status-go/eth-node/crypto"
 )
 
 type ConnectionParamVersion int
 
 const (
 	Version1 ConnectionParamVersion = iota + 1
+	// Version2 connection strings are signed with the identity key of the device generating them
+	Version2
 )
 
 const (
 	connectionStringID = "cs"
 )
 
+var (
+	ErrInvalidSignature          = errors.New("connection string signature is invalid")
+	ErrConnectionStringNotSigned = errors.New("connection string is not signed, the device generating it must be updated")
+	ErrUntrustedIdentity         = errors.New("connection string is signed by another account")
+)
+
 type ConnectionParams struct {
 	version   ConnectionParamVersion
 	netIP     net.IP
 	port      int
 	publicKey *ecdsa.PublicKey
 	aesKey    []byte
+
+	// identityKey and signature are only set on Version2 connection params
+	identityKey *ecdsa.PublicKey
+	signature   []byte
 }
 
 func NewConnectionParams(netIP net.IP, port int, publicKey *ecdsa.PublicKey, aesKey []byte) *ConnectionParams {
@@ -43,7 +58,7 @@ func NewConnectionParams(netIP net.IP, port int, publicKey *ecdsa.PublicKey, aes
 // ToString generates a string required for generating a secure connection to another Status device.
 //
 // The returned string will look like below:
-//   - "cs2:4FHRnp:H6G:uqnnMwVUfJc2Fkcaojet8F1ufKC3hZdGEt47joyBx9yd:BbnZ7Gc66t54a9kEFCf7FW8SGQuYypwHVeNkRYeNoqV6"
+//   - "cs2:4FHRnp:H6G:uqnnMwVUfJc2Fkcaojet8F1ufKC3hZdGEt47joyBx9yd:BbnZ7Gc66t54a9kEFCf7FW8SGQuYypwHVeNkRYeNoqV6:AN1rKvt..."
 //
 // Format bytes encoded into a base58 string, delimited by ":"
 //   - string type identifier
@@ -52,14 +67,44 @@ func NewConnectionParams(netIP net.IP, port int, publicKey *ecdsa.PublicKey, aes
 //   - port
 //   - ecdsa CompressedPublicKey
 //   - AES encryption key
+//
+// Version2 connection strings are followed by
+//   - compressed public identity key of the device generating the connection string
+//   - signature of all the above made with the identity key
 func (cp *ConnectionParams) ToString() string {
+	if cp.version == Version1 {
+		return cp.unsignedString()
+	}
+	return fmt.Sprintf("%s:%s", cp.unsignedString(), base58.Encode(cp.signature))
+}
+
+// unsignedString returns the part of the connection string covered by its signature
+func (cp *ConnectionParams) unsignedString() string {
 	v := base58.Encode(new(big.Int).SetInt64(int64(cp.version)).Bytes())
 	ip := base58.Encode(cp.netIP)
 	p := base58.Encode(new(big.Int).SetInt64(int64(cp.port)).Bytes())
 	k := base58.Encode(elliptic.MarshalCompressed(cp.publicKey.Curve, cp.publicKey.X, cp.publicKey.Y))
 	ek := base58.Encode(cp.aesKey)
 
-	return fmt.Sprintf("%s%s:%s:%s:%s:%s", connectionStringID, v, ip, p, k, ek)
+	s := fmt.Sprintf("%s%s:%s:%s:%s:%s", connectionStringID, v, ip, p, k, ek)
+	if cp.version == Version1 {
+		return s
+	}
+	return fmt.Sprintf("%s:%s", s, base58.Encode(crypto.CompressPubkey(cp.identityKey)))
+}
+
+// sign makes Version2 connection params signed with the identity key of the device generating them
+func (cp *ConnectionParams) sign(identityKey *ecdsa.PrivateKey) error {
+	cp.version = Version2
+	cp.identityKey = &identityKey.PublicKey
+
+	signature, err := crypto.Sign(crypto.Keccak256([]byte(cp.unsignedString())), identityKey)
+	if err != nil {
+		return err
+	}
+
+	cp.signature = signature
+	return nil
 }
 
 // FromString parses a connection params string required for to securely connect to another Status device.
@@ -74,14 +119,21 @@ func (cp *ConnectionParams) FromString(s string) error {
 		return fmt.Errorf("connection string doesn't begin with identifier '%s'", connectionStringID)
 	}
 
-	requiredParams := 5
-
 	sData := strings.Split(s[2:], ":")
+	cp.version = ConnectionParamVersion(new(big.Int).SetBytes(base58.Decode(sData[0])).Int64())
+	err := cp.validateVersion()
+	if err != nil {
+		return err
+	}
+
+	requiredParams := 5
+	if cp.version == Version2 {
+		requiredParams = 7
+	}
 	if len(sData) != requiredParams {
 		return fmt.Errorf("expected data '%s' to have length of '%d', received '%d'", s, requiredParams, len(sData))
 	}
 
-	cp.version = ConnectionParamVersion(new(big.Int).SetBytes(base58.Decode(sData[0])).Int64())
 	cp.netIP = base58.Decode(sData[1])
 	cp.port = int(new(big.Int).SetBytes(base58.Decode(sData[2])).Int64())
 	cp.publicKey = new(ecdsa.PublicKey)
@@ -89,7 +141,21 @@ func (cp *ConnectionParams) FromString(s string) error {
 	cp.publicKey.Curve = elliptic.P256()
 	cp.aesKey = base58.Decode(sData[4])
 
-	return cp.validate()
+	err = cp.validate()
+	if err != nil {
+		return err
+	}
+
+	if cp.version == Version1 {
+		return nil
+	}
+
+	cp.identityKey, err = crypto.DecompressPubkey(base58.Decode(sData[5]))
+	if err != nil {
+		return fmt.Errorf("invalid identity key: %w", err)
+	}
+	cp.signature = base58.Decode(sData[6])
+	return cp.validateSignature()
 }
 
 func (cp *ConnectionParams) validate() error {
@@ -118,7 +184,7 @@ func (cp *ConnectionParams) validate() error {
 
 func (cp *ConnectionParams) validateVersion() error {
 	switch cp.version {
-	case Version1:
+	case Version1, Version2:
 		return nil
 	default:
 		return fmt.Errorf("unsupported version '%d'", cp.version)
@@ -160,6 +226,31 @@ func (cp *ConnectionParams) validateAESKey() error {
 	return nil
 }
 
+func (cp *ConnectionParams) validateSignature() error {
+	signer, err := crypto.SigToPub(crypto.Keccak256([]byte(cp.unsignedString())), cp.signature)
+	if err != nil || !signer.Equal(cp.identityKey) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// verifyIdentity checks that the connection params were signed with trustedKey, the identity key of the account
+// logged in on this device, if any. Unsigned connection params are rejected if requireSigned is set.
+// As the devices being paired share the same account, a logged in device only pairs with devices of its account.
+func (cp *ConnectionParams) verifyIdentity(trustedKey *ecdsa.PublicKey, requireSigned bool) error {
+	if cp.version == Version1 {
+		if requireSigned {
+			return ErrConnectionStringNotSigned
+		}
+		return nil
+	}
+
+	if trustedKey != nil && !trustedKey.Equal(cp.identityKey) {
+		return ErrUntrustedIdentity
+	}
+	return nil
+}
+
 func (cp *ConnectionParams) URL() (*url.URL, error) {
 	err := cp.validate()
 	if err != nil {
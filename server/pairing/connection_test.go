@@ -1,10 +1,16 @@
 package pairing
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/server"
 	"github.com/status-im/status-go/server/servertest"
 )
@@ -68,3 +74,73 @@ func (s *ConnectionParamsSuite) TestConnectionParams_Generate() {
 	s.Require().True(cp.publicKey.Equal(&s.PK.PublicKey))
 	s.Require().Equal(s.AES, cp.aesKey)
 }
+
+func (s *ConnectionParamsSuite) TestGeneratePairingKey() {
+	pk, pubBytes := s.GeneratePairingKey(s.T())
+
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), pubBytes)
+	s.Require().NotNil(x)
+	s.Require().Equal(pk.X, x)
+	s.Require().Equal(pk.Y, y)
+
+	// The key pair can be used to derive an encryption key as pairing does
+	otherPK, _ := s.GeneratePairingKey(s.T())
+	sharedKey, err := common.MakeECDHSharedKey(pk, &otherPK.PublicKey)
+	s.Require().NoError(err)
+	otherSharedKey, err := common.MakeECDHSharedKey(otherPK, &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y})
+	s.Require().NoError(err)
+	s.Require().Equal(sharedKey, otherSharedKey)
+
+	encrypted, err := common.Encrypt([]byte("payload"), sharedKey, rand.Reader)
+	s.Require().NoError(err)
+	decrypted, err := common.Decrypt(encrypted, otherSharedKey)
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("payload"), decrypted)
+}
+
+func (s *ConnectionParamsSuite) TestConnectionParams_Signature() {
+	identityKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	signingServer := *s.server
+	signingServer.identityKey = identityKey
+	cp, err := signingServer.MakeConnectionParams()
+	s.Require().NoError(err)
+	cs := cp.ToString()
+	s.Require().True(strings.HasPrefix(cs, "cs3:"))
+
+	// Valid signature
+	signed := new(ConnectionParams)
+	s.Require().NoError(signed.FromString(cs))
+	s.Require().Equal(Version2, signed.version)
+	s.Require().True(signed.identityKey.Equal(&identityKey.PublicKey))
+	s.Require().NoError(signed.verifyIdentity(&identityKey.PublicKey, true))
+
+	// Tampered connection string, the port is changed to 1338
+	tampered := strings.Replace(cs, ":Q4:", ":Q5:", 1)
+	s.Require().ErrorIs(ValidateConnectionString(tampered), ErrInvalidSignature)
+
+	// Signature made with another key than the identity key of the connection string
+	otherKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	otherCP := NewConnectionParams(cp.netIP, cp.port, cp.publicKey, cp.aesKey)
+	s.Require().NoError(otherCP.sign(otherKey))
+	otherFields := strings.Split(otherCP.ToString(), ":")
+	fields := strings.Split(cs, ":")
+	fields[6] = otherFields[6]
+	s.Require().ErrorIs(ValidateConnectionString(strings.Join(fields, ":")), ErrInvalidSignature)
+
+	// Connection string re-signed by a man-in-the-middle with their own key
+	resigned := new(ConnectionParams)
+	s.Require().NoError(resigned.FromString(otherCP.ToString()))
+	s.Require().ErrorIs(resigned.verifyIdentity(&identityKey.PublicKey, true), ErrUntrustedIdentity)
+
+	// Missing signature
+	s.Require().Error(ValidateConnectionString(strings.Join(fields[:6], ":")))
+
+	// Unsigned Version1 connection strings are only accepted when no signature is required
+	unsigned := new(ConnectionParams)
+	s.Require().NoError(unsigned.FromString(connectionString))
+	s.Require().NoError(unsigned.verifyIdentity(&identityKey.PublicKey, false))
+	s.Require().ErrorIs(unsigned.verifyIdentity(&identityKey.PublicKey, true), ErrConnectionStringNotSigned)
+}
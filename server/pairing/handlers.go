@@ -49,28 +49,7 @@ func handleReceiveAccount(hs HandlerServer, pr PayloadReceiver) http.HandlerFunc
 
 func handleSendAccount(hs HandlerServer, pm PayloadMounter) http.HandlerFunc {
 	signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionPairingAccount})
-	logger := hs.GetLogger()
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/octet-stream")
-		err := pm.Mount()
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-			logger.Error("handleSendAccount pm.Mount()", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-
-		_, err = w.Write(pm.ToSend())
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-			logger.Error("handleSendAccount w.Write(pm.ToSend())", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingAccount})
-
-		pm.LockPayload()
-	}
+	return handleSendPayloadChunks(hs, pm, NewPairingSessions(), ActionPairingAccount, "handleSendAccount")
 }
 
 // Device sync handling
@@ -101,30 +80,7 @@ func handleParingSyncDeviceReceive(hs HandlerServer, pr PayloadReceiver) http.Ha
 
 func handlePairingSyncDeviceSend(hs HandlerServer, pm PayloadMounter) http.HandlerFunc {
 	signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionSyncDevice})
-	logger := hs.GetLogger()
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/octet-stream")
-
-		err := pm.Mount()
-		if err != nil {
-			// maybe better to use a new event type here instead of EventTransferError?
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-			logger.Error("handlePairingSyncDeviceSend pm.Mount()", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-
-		_, err = w.Write(pm.ToSend())
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-			logger.Error("handlePairingSyncDeviceSend w.Write(pm.ToSend())", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionSyncDevice})
-
-		pm.LockPayload()
-	}
+	return handleSendPayloadChunks(hs, pm, NewPairingSessions(), ActionSyncDevice, "handlePairingSyncDeviceSend")
 }
 
 // Installation data handling
@@ -155,27 +111,33 @@ func handleReceiveInstallation(hs HandlerServer, pmr PayloadMounterReceiver) htt
 
 func handleSendInstallation(hs HandlerServer, pmr PayloadMounterReceiver) http.HandlerFunc {
 	signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionPairingInstallation})
+	return handleSendPayloadChunks(hs, pmr, NewPairingSessions(), ActionPairingInstallation, "handleSendInstallation")
+}
+
+// Chunked payload sending
+
+// handleSendPayloadChunks sends the payload of pm in chunks, one per request, keeping the transfer
+// sessions so that an interrupted transfer can be resumed
+func handleSendPayloadChunks(hs HandlerServer, pm PayloadMounter, sessions *PairingSessions, action Action, name string) http.HandlerFunc {
 	logger := hs.GetLogger()
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/octet-stream")
-		err := pmr.Mount()
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
-			logger.Error("handleSendInstallation pmr.Mount()", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
 
-		_, err = w.Write(pmr.ToSend())
+		done, err := sessions.serveChunk(w, r, pm)
 		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
-			logger.Error("handleSendInstallation w.Write(pmr.ToSend())", zap.Error(err))
+			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+			logger.Error(name+" sessions.serveChunk()", zap.Error(err))
+			if err == ErrInvalidChunkIndex {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
 			http.Error(w, "error", http.StatusInternalServerError)
 			return
 		}
-		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingInstallation})
 
-		pmr.LockPayload()
+		if done {
+			signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: action})
+		}
 	}
 }
 
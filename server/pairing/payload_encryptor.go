@@ -2,10 +2,55 @@ package pairing
 
 import (
 	"crypto/rand"
+	"errors"
+
+	"github.com/klauspost/compress/zstd"
 
 	"github.com/status-im/status-go/protocol/common"
 )
 
+const (
+	// MaxCompressionLevel is the highest zstd compression level
+	MaxCompressionLevel = 22
+
+	// maxDecompressedPayloadSize limits the size of a decompressed payload
+	maxDecompressedPayloadSize = 64 << 20
+)
+
+// zstdCompressedFlag starts the compressed payloads. Uncompressed payloads are protobuf messages sent as is, so
+// devices without compression can still read them, and none of them starts with a 0 byte as field 0 is reserved
+const zstdCompressedFlag byte = 0
+
+var ErrInvalidCompressionLevel = errors.New("invalid compression level")
+
+// ValidateCompressionLevel checks that level is a zstd compression level, or 0 to disable compression
+func ValidateCompressionLevel(level int) error {
+	if level < 0 || level > MaxCompressionLevel {
+		return ErrInvalidCompressionLevel
+	}
+	return nil
+}
+
+func compress(data []byte, level int) ([]byte, error) {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	if err != nil {
+		return nil, err
+	}
+	defer encoder.Close()
+
+	return encoder.EncodeAll(data, nil), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxDecompressedPayloadSize))
+	if err != nil {
+		return nil, err
+	}
+	defer decoder.Close()
+
+	return decoder.DecodeAll(data, nil)
+}
+
 // EncryptionPayload represents the plain text and encrypted text of payload data
 type EncryptionPayload struct {
 	plain     []byte
@@ -24,23 +69,35 @@ func (ep *EncryptionPayload) lock() {
 type PayloadEncryptor struct {
 	aesKey  []byte
 	payload *EncryptionPayload
+
+	// compressionLevel is the zstd level payloads are compressed with before encryption, 0 disables compression
+	compressionLevel int
 }
 
 func NewPayloadEncryptor(aesKey []byte) *PayloadEncryptor {
 	return &PayloadEncryptor{
-		aesKey,
-		new(EncryptionPayload),
+		aesKey:  aesKey,
+		payload: new(EncryptionPayload),
 	}
 }
 
-// Renew regenerates the whole PayloadEncryptor and returns the new instance, only the aesKey is preserved
+// Renew regenerates the whole PayloadEncryptor and returns the new instance, only the aesKey and the
+// compression level are preserved
 func (pem *PayloadEncryptor) Renew() *PayloadEncryptor {
 	return &PayloadEncryptor{
-		aesKey:  pem.aesKey,
-		payload: new(EncryptionPayload),
+		aesKey:           pem.aesKey,
+		payload:          new(EncryptionPayload),
+		compressionLevel: pem.compressionLevel,
 	}
 }
 
+// WithCompression returns a renewed PayloadEncryptor compressing payloads with the given zstd level
+func (pem *PayloadEncryptor) WithCompression(level int) *PayloadEncryptor {
+	npem := pem.Renew()
+	npem.compressionLevel = level
+	return npem
+}
+
 // encryptPlain encrypts any given plain text using the internal AES key and returns the encrypted value
 // This function is different to Encrypt as the internal EncryptionPayload.encrypted value is not set
 func (pem *PayloadEncryptor) encryptPlain(plaintext []byte) ([]byte, error) {
@@ -53,8 +110,34 @@ func (pem *PayloadEncryptor) decryptPlain(plaintext []byte) ([]byte, error) {
 	return common.Decrypt(plaintext, pem.aesKey)
 }
 
+// flagPayload compresses data if a compression level is set, prefixing it with zstdCompressedFlag
+func (pem *PayloadEncryptor) flagPayload(data []byte) ([]byte, error) {
+	if pem.compressionLevel == 0 {
+		return data, nil
+	}
+
+	compressed, err := compress(data, pem.compressionLevel)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{zstdCompressedFlag}, compressed...), nil
+}
+
+// unflagPayload returns the payload carried by data, decompressing it if it's flagged as compressed
+func unflagPayload(data []byte) ([]byte, error) {
+	if len(data) == 0 || data[0] != zstdCompressedFlag {
+		return data, nil
+	}
+	return decompress(data[1:])
+}
+
 func (pem *PayloadEncryptor) encrypt(data []byte) error {
-	ep, err := common.Encrypt(data, pem.aesKey, rand.Reader)
+	toEncrypt, err := pem.flagPayload(data)
+	if err != nil {
+		return err
+	}
+
+	ep, err := common.Encrypt(toEncrypt, pem.aesKey, rand.Reader)
 	if err != nil {
 		return err
 	}
@@ -70,6 +153,11 @@ func (pem *PayloadEncryptor) decrypt(data []byte) error {
 		return err
 	}
 
+	pd, err = unflagPayload(pd)
+	if err != nil {
+		return err
+	}
+
 	pem.payload.encrypted = data
 	pem.payload.plain = pd
 	return nil
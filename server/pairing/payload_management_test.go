@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -351,3 +352,124 @@ func (pms *PayloadMarshallerSuite) TestPayloadMarshaller_LockPayload() {
 	toSend3 := pm.ToSend()
 	pms.Nil(toSend3)
 }
+
+func TestValidateDeviceType(t *testing.T) {
+	for _, dt := range []DeviceType{DeviceTypeDesktop, DeviceTypeMobile, DeviceTypeTablet, DeviceTypeUnknown,
+		DeviceTypeAndroid, DeviceTypeIOS, DeviceTypeDarwin, DeviceTypeLinux, DeviceTypeWindows} {
+		validated, err := ValidateDeviceType(string(dt))
+		require.NoError(t, err)
+		require.Equal(t, dt, validated)
+	}
+
+	validated, err := ValidateDeviceType("")
+	require.NoError(t, err)
+	require.Equal(t, DeviceTypeUnknown, validated)
+
+	for _, s := range []string{"Android", "Desktop", " mobile", "tv"} {
+		_, err := ValidateDeviceType(s)
+		require.ErrorIs(t, err, ErrInvalidDeviceType, s)
+	}
+}
+
+func (pms *PayloadMarshallerSuite) TestNewAccountPayloadMounter_InvalidDeviceType() {
+	pms.config1.DeviceType = "smartwatch"
+	_, err := NewAccountPayloadMounter(NewPayloadEncryptor(make([]byte, 32)), pms.config1, pms.Logger)
+	pms.Require().ErrorIs(err, ErrInvalidDeviceType)
+
+	pms.config1.DeviceType = DeviceTypeTablet
+	_, err = NewAccountPayloadMounter(NewPayloadEncryptor(make([]byte, 32)), pms.config1, pms.Logger)
+	pms.Require().NoError(err)
+}
+
+func TestPayloadEncryptor_Compression(t *testing.T) {
+	aesKey := make([]byte, 32)
+	_, err := rand.Read(aesKey)
+	require.NoError(t, err)
+
+	payload := bytes.Repeat([]byte("raw message payload "), 10000)
+
+	pe := NewPayloadEncryptor(aesKey).WithCompression(3)
+	err = pe.encrypt(payload)
+	require.NoError(t, err)
+	require.Less(t, len(pe.getEncrypted()), len(payload))
+
+	// The receiver decompresses regardless of its own compression level
+	receiver := NewPayloadEncryptor(aesKey)
+	err = receiver.decrypt(pe.getEncrypted())
+	require.NoError(t, err)
+	require.Equal(t, payload, receiver.getDecrypted())
+
+	// Uncompressed payloads are sent as is, as by devices without compression
+	err = receiver.encrypt(payload)
+	require.NoError(t, err)
+	plain, err := receiver.decryptPlain(receiver.getEncrypted())
+	require.NoError(t, err)
+	require.Equal(t, payload, plain)
+	err = pe.decrypt(receiver.getEncrypted())
+	require.NoError(t, err)
+	require.Equal(t, payload, pe.getDecrypted())
+
+	// Uncompressed payloads looking like zstd frames are not decompressed
+	zstdLike := append([]byte{0x28, 0xb5, 0x2f, 0xfd}, payload...)
+	err = receiver.encrypt(zstdLike)
+	require.NoError(t, err)
+	err = pe.decrypt(receiver.getEncrypted())
+	require.NoError(t, err)
+	require.Equal(t, zstdLike, pe.getDecrypted())
+
+	empty, err := pe.encryptPlain(nil)
+	require.NoError(t, err)
+	require.NoError(t, pe.decrypt(empty))
+	require.Empty(t, pe.getDecrypted())
+
+	// Payloads decompressing above the size limit are rejected
+	err = pe.encrypt(make([]byte, maxDecompressedPayloadSize+1))
+	require.NoError(t, err)
+	require.Error(t, receiver.decrypt(pe.getEncrypted()))
+
+	require.NoError(t, ValidateCompressionLevel(0))
+	require.NoError(t, ValidateCompressionLevel(MaxCompressionLevel))
+	require.ErrorIs(t, ValidateCompressionLevel(-1), ErrInvalidCompressionLevel)
+	require.ErrorIs(t, ValidateCompressionLevel(MaxCompressionLevel+1), ErrInvalidCompressionLevel)
+}
+
+type delayedPayloadLoader struct {
+	delay time.Duration
+}
+
+func (d *delayedPayloadLoader) Load() error {
+	time.Sleep(d.delay)
+	return nil
+}
+
+type delayedPayloadMounter struct {
+	*MockPayloadMounter
+	loader PayloadLoader
+}
+
+func (d *delayedPayloadMounter) Mount() error {
+	err := d.loader.Load()
+	if err != nil {
+		return err
+	}
+	return d.MockPayloadMounter.Mount()
+}
+
+func TestMountPayloads(t *testing.T) {
+	const delay = 200 * time.Millisecond
+	aesKey := make([]byte, 32)
+
+	mounters := make([]PayloadMounter, 3)
+	for i := range mounters {
+		mounters[i] = &delayedPayloadMounter{NewMockPayloadMounter(aesKey), &delayedPayloadLoader{delay}}
+	}
+
+	start := time.Now()
+	err := MountPayloads(mounters...)
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), time.Duration(len(mounters))*delay)
+
+	for _, m := range mounters {
+		require.NotEmpty(t, m.ToSend())
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/status-im/status-go/api"
 	"github.com/status-im/status-go/multiaccounts"
@@ -50,6 +51,13 @@ func NewAccountPayloadMounter(pe *PayloadEncryptor, config *SenderConfig, logger
 	l := logger.Named("AccountPayloadLoader")
 	l.Debug("fired", zap.Any("config", config))
 
+	if config != nil {
+		_, err := ValidateDeviceType(string(config.DeviceType))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// A new SHARED AccountPayload
 	p := new(AccountPayload)
 	apl, err := NewAccountPayloadLoader(p, config)
@@ -227,7 +235,7 @@ func NewRawMessageLoader(backend *api.GethStatusBackend, config *SenderConfig) *
 		syncRawMessageHandler: NewSyncRawMessageHandler(backend),
 		payload:               make([]byte, 0),
 		keyUID:                config.KeyUID,
-		deviceType:            config.DeviceType,
+		deviceType:            string(config.DeviceType),
 	}
 }
 
@@ -307,12 +315,28 @@ func (r *InstallationPayloadLoader) Load() error {
 |
 */
 
+// MountPayloads mounts the payloads of all the given PayloadMounters concurrently, each PayloadMounter has its
+// own PayloadEncryptor so that they don't share any state
+func MountPayloads(mounters ...PayloadMounter) error {
+	var g errgroup.Group
+	for _, m := range mounters {
+		g.Go(m.Mount)
+	}
+	return g.Wait()
+}
+
 func NewPayloadMounters(logger *zap.Logger, pe *PayloadEncryptor, backend *api.GethStatusBackend, config *SenderConfig) (*AccountPayloadMounter, *RawMessagePayloadMounter, *InstallationPayloadMounterReceiver, error) {
+	err := ValidateCompressionLevel(config.CompressionLevel)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	pe = pe.WithCompression(config.CompressionLevel)
+
 	am, err := NewAccountPayloadMounter(pe, config, logger)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 	rmm := NewRawMessagePayloadMounter(logger, pe, backend, config)
-	imr := NewInstallationPayloadMounterReceiver(logger, pe, backend, config.DeviceType)
+	imr := NewInstallationPayloadMounterReceiver(logger, pe, backend, string(config.DeviceType))
 	return am, rmm, imr, nil
 }
@@ -0,0 +1,147 @@
+package pairing
+
+import (
+	"crypto/rand"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+)
+
+const (
+	// Query parameters and headers of chunked payload transfers
+	paramSessionID   = "sessionId"
+	paramChunkIndex  = "chunk"
+	headerSessionID  = "Pairing-Session-Id"
+	headerChunkCount = "Pairing-Chunk-Count"
+
+	// sessionTimeout is the duration after which an unfinished transfer can't be resumed anymore
+	sessionTimeout = 10 * time.Minute
+)
+
+// payloadChunkSize is the maximum size of a chunk of a payload sent in a single response
+var payloadChunkSize = 256 * 1024
+
+var ErrInvalidChunkIndex = errors.New("invalid chunk index")
+
+// PairingSession holds a mounted payload being sent in chunks, so that a transfer interrupted by a
+// connection drop can be resumed without mounting the payload again
+type PairingSession struct {
+	SessionID string
+
+	payload    []byte
+	chunkCount int
+	// sentChunks is the number of chunks successfully sent, counted from the first one
+	sentChunks int
+	expiresAt  time.Time
+}
+
+func newPairingSession(payload []byte) (*PairingSession, error) {
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return nil, err
+	}
+
+	chunkCount := (len(payload) + payloadChunkSize - 1) / payloadChunkSize
+	if chunkCount == 0 {
+		chunkCount = 1
+	}
+
+	return &PairingSession{
+		SessionID:  base58.Encode(id),
+		payload:    payload,
+		chunkCount: chunkCount,
+		expiresAt:  time.Now().Add(sessionTimeout),
+	}, nil
+}
+
+// chunk returns the chunk at the given index, only chunks up to the one following the last
+// successfully sent chunk can be requested
+func (ps *PairingSession) chunk(index int) ([]byte, error) {
+	if index < 0 || index >= ps.chunkCount || index > ps.sentChunks {
+		return nil, ErrInvalidChunkIndex
+	}
+
+	start := index * payloadChunkSize
+	end := start + payloadChunkSize
+	if end > len(ps.payload) {
+		end = len(ps.payload)
+	}
+	return ps.payload[start:end], nil
+}
+
+// PairingSessions keeps the sessions of the transfers of a payload
+type PairingSessions struct {
+	sessions map[string]*PairingSession
+	lock     sync.Mutex
+}
+
+func NewPairingSessions() *PairingSessions {
+	return &PairingSessions{sessions: make(map[string]*PairingSession)}
+}
+
+// serveChunk writes to w the chunk of the payload requested by r. A new session is started, and
+// the payload mounted, unless r carries the ID of an unexpired session.
+func (pss *PairingSessions) serveChunk(w http.ResponseWriter, r *http.Request, pm PayloadMounter) (done bool, err error) {
+	pss.lock.Lock()
+	defer pss.lock.Unlock()
+
+	now := time.Now()
+	for id, session := range pss.sessions {
+		if now.After(session.expiresAt) {
+			delete(pss.sessions, id)
+		}
+	}
+
+	params := r.URL.Query()
+	session, ok := pss.sessions[params.Get(paramSessionID)]
+	if !ok {
+		err = pm.Mount()
+		if err != nil {
+			return false, err
+		}
+
+		session, err = newPairingSession(pm.ToSend())
+		if err != nil {
+			return false, err
+		}
+		pm.LockPayload()
+
+		pss.sessions[session.SessionID] = session
+	}
+
+	index := 0
+	if ok && params.Get(paramChunkIndex) != "" {
+		index, err = strconv.Atoi(params.Get(paramChunkIndex))
+		if err != nil {
+			return false, ErrInvalidChunkIndex
+		}
+	}
+
+	chunk, err := session.chunk(index)
+	if err != nil {
+		return false, err
+	}
+
+	w.Header().Set(headerSessionID, session.SessionID)
+	w.Header().Set(headerChunkCount, strconv.Itoa(session.chunkCount))
+	_, err = w.Write(chunk)
+	if err != nil {
+		return false, err
+	}
+
+	if index == session.sentChunks {
+		session.sentChunks++
+	}
+
+	done = session.sentChunks == session.chunkCount
+	if done {
+		// The payload is fully sent, it must not be served again
+		delete(pss.sessions, session.SessionID)
+	}
+	return done, nil
+}
@@ -29,8 +29,9 @@ type BaseServer struct {
 	server.Server
 	challengeGiver *ChallengeGiver
 
-	pk *ecdsa.PublicKey
-	ek []byte
+	pk          *ecdsa.PublicKey
+	ek          []byte
+	identityKey *ecdsa.PrivateKey
 }
 
 // NewBaseServer returns a *BaseServer init from the given *SenderServerConfig
@@ -50,6 +51,7 @@ func NewBaseServer(logger *zap.Logger, e *PayloadEncryptor, config *ServerConfig
 		challengeGiver: cg,
 		pk:             config.PK,
 		ek:             config.EK,
+		identityKey:    config.IdentityKey,
 	}
 	bs.SetTimeout(config.Timeout)
 	return bs, nil
@@ -68,7 +70,35 @@ func (s *BaseServer) MakeConnectionParams() (*ConnectionParams, error) {
 		netIP = netIP4
 	}
 
-	return NewConnectionParams(netIP, s.MustGetPort(), s.pk, s.ek), nil
+	cp := NewConnectionParams(netIP, s.MustGetPort(), s.pk, s.ek)
+	if s.identityKey == nil {
+		return cp, nil
+	}
+
+	err := cp.sign(s.identityKey)
+	if err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+// loggedInIdentityKey returns the chat key of the account logged in on backend, nil if no account is logged in
+func loggedInIdentityKey(backend *api.GethStatusBackend) *ecdsa.PrivateKey {
+	chatAccount, err := backend.AccountManager().SelectedChatAccount()
+	if err != nil || chatAccount.AccountKey == nil {
+		return nil
+	}
+	return chatAccount.AccountKey.PrivateKey
+}
+
+// loggedInIdentityPublicKey returns the public chat key of the account logged in on backend, nil if no account
+// is logged in
+func loggedInIdentityPublicKey(backend *api.GethStatusBackend) *ecdsa.PublicKey {
+	identityKey := loggedInIdentityKey(backend)
+	if identityKey == nil {
+		return nil
+	}
+	return &identityKey.PublicKey
 }
 
 func MakeServerConfig(config *ServerConfig) error {
@@ -160,6 +190,7 @@ func MakeFullSenderServer(backend *api.GethStatusBackend, config *SenderServerCo
 		return nil, err
 	}
 
+	config.ServerConfig.IdentityKey = loggedInIdentityKey(backend)
 	config.SenderConfig.DB = backend.GetMultiaccountDB()
 	return NewSenderServer(backend, config)
 }
@@ -255,6 +286,7 @@ func MakeFullReceiverServer(backend *api.GethStatusBackend, config *ReceiverServ
 	if activeAccount != nil {
 		config.ReceiverConfig.LoggedInKeyUID = activeAccount.KeyUID
 	}
+	config.ServerConfig.IdentityKey = loggedInIdentityKey(backend)
 	config.ReceiverConfig.DB = backend.GetMultiaccountDB()
 
 	return NewReceiverServer(backend, config)
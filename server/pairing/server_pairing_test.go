@@ -4,9 +4,14 @@ import (
 	"crypto/ecdsa"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"regexp"
+	"strconv"
 	"testing"
 	"time"
 
@@ -158,6 +163,8 @@ func (s *PairingServerSuite) TestPairingServer_StartPairingReceive() {
 	c.accountMounter = NewMockPayloadMounter(s.EphemeralAES)
 	s.Require().NoError(err)
 
+	err = c.accountMounter.Mount()
+	s.Require().NoError(err)
 	err = c.sendAccountData()
 	s.Require().NoError(err)
 
@@ -320,3 +327,126 @@ func (s *PairingServerSuite) TestGetOutboundIPWithFullServerE2e() {
 	s.Require().NoError(err)
 	s.Require().Equal("Hello I like to be a tls server. You said: `"+thing+"`", string(content[:109]))
 }
+
+type mountCountingPayloadMounter struct {
+	*MockPayloadMounter
+	mounts int
+}
+
+func (m *mountCountingPayloadMounter) Mount() error {
+	m.mounts++
+	return m.MockPayloadMounter.Mount()
+}
+
+// droppingRoundTripper drops the response of the first request for the given chunk index
+type droppingRoundTripper struct {
+	http.RoundTripper
+	dropChunk string
+	dropped   bool
+}
+
+func (d *droppingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := d.RoundTripper.RoundTrip(req)
+	if err == nil && !d.dropped && req.URL.Query().Get(paramChunkIndex) == d.dropChunk {
+		d.dropped = true
+		_ = resp.Body.Close()
+		return nil, errors.New("connection dropped")
+	}
+	return resp, err
+}
+
+func (s *PairingServerSuite) TestPairingServer_ResumeSession() {
+	defaultChunkSize := payloadChunkSize
+	payloadChunkSize = 16
+	defer func() { payloadChunkSize = defaultChunkSize }()
+
+	pm := &mountCountingPayloadMounter{MockPayloadMounter: NewMockPayloadMounter(s.EphemeralAES)}
+	s.SS.accountMounter = pm
+
+	err := s.SS.startSendingData()
+	s.Require().NoError(err)
+
+	cp, err := s.SS.MakeConnectionParams()
+	s.Require().NoError(err)
+
+	c, err := NewReceiverClient(nil, cp, NewReceiverClientConfig())
+	s.Require().NoError(err)
+	c.accountReceiver = NewMockPayloadReceiver(s.EphemeralAES)
+
+	// Drop the connection in the middle of the transfer
+	c.Transport = &droppingRoundTripper{RoundTripper: c.Transport, dropChunk: "2"}
+
+	err = c.getChallenge()
+	s.Require().NoError(err)
+	err = c.receiveAccountData()
+	s.Require().Error(err)
+
+	// The transfer is resumed from the chunk which was lost
+	transfer := c.transfers[pairingSendAccount]
+	s.Require().NotNil(transfer)
+	s.Require().NotEmpty(transfer.sessionID)
+	s.Require().Len(transfer.chunks, 2)
+
+	err = c.getChallenge()
+	s.Require().NoError(err)
+	err = c.receiveAccountData()
+	s.Require().NoError(err)
+
+	s.Require().Equal(1, pm.mounts)
+	s.Require().Equal(pm.encryptor.payload.plain, c.accountReceiver.Received())
+}
+
+func (s *PairingServerSuite) TestReceivePayloadChunks_WithoutChunkCount() {
+	payload := []byte("payload sent by a server that doesn't split it in chunks")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write(payload)
+		s.Require().NoError(err)
+	}))
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	s.Require().NoError(err)
+
+	c := &BaseClient{
+		Client:         ts.Client(),
+		baseAddress:    u,
+		challengeTaker: NewChallengeTaker(NewPayloadEncryptor(s.EphemeralAES)),
+		transfers:      make(map[string]*chunkedTransfer),
+	}
+
+	received, err := c.receivePayloadChunks(pairingSendAccount, "account data")
+	s.Require().NoError(err)
+	s.Require().Equal(payload, received)
+	s.Require().Empty(c.transfers)
+}
+
+func (s *PairingServerSuite) TestPairingSessions_DeletedWhenDone() {
+	defaultChunkSize := payloadChunkSize
+	payloadChunkSize = 16
+	defer func() { payloadChunkSize = defaultChunkSize }()
+
+	pm := NewMockPayloadMounter(s.EphemeralAES)
+	pss := NewPairingSessions()
+
+	w := httptest.NewRecorder()
+	done, err := pss.serveChunk(w, httptest.NewRequest(http.MethodGet, "/", nil), pm)
+	s.Require().NoError(err)
+	s.Require().False(done)
+
+	sessionID := w.Header().Get(headerSessionID)
+	chunkCount, err := strconv.Atoi(w.Header().Get(headerChunkCount))
+	s.Require().NoError(err)
+	s.Require().Greater(chunkCount, 1)
+
+	for i := 1; i < chunkCount; i++ {
+		s.Require().Len(pss.sessions, 1)
+
+		target := fmt.Sprintf("/?%s=%s&%s=%d", paramSessionID, sessionID, paramChunkIndex, i)
+		done, err = pss.serveChunk(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, target, nil), pm)
+		s.Require().NoError(err)
+	}
+
+	s.Require().True(done)
+	s.Require().Empty(pss.sessions)
+}
@@ -3,6 +3,9 @@ package server
 import (
 	"database/sql"
 	"net/url"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru/v2"
 
 	"github.com/status-im/status-go/ipfs"
 	"github.com/status-im/status-go/logutils"
@@ -16,6 +19,11 @@ type MediaServer struct {
 	db              *sql.DB
 	downloader      *ipfs.Downloader
 	multiaccountsDB *multiaccounts.Database
+	resizedImages   *lru.Cache[resizedImageKey, []byte]
+
+	// tokenSecret authenticates the URLs made during the current session
+	tokenSecret     []byte
+	tokenSecretLock sync.RWMutex
 }
 
 // NewMediaServer returns a *MediaServer
@@ -25,6 +33,11 @@ func NewMediaServer(db *sql.DB, downloader *ipfs.Downloader, multiaccountsDB *mu
 		return nil, err
 	}
 
+	resizedImages, err := lru.New[resizedImageKey, []byte](resizedImagesCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &MediaServer{
 		Server: NewServer(
 			globalCertificate,
@@ -35,22 +48,38 @@ func NewMediaServer(db *sql.DB, downloader *ipfs.Downloader, multiaccountsDB *mu
 		db:              db,
 		downloader:      downloader,
 		multiaccountsDB: multiaccountsDB,
+		resizedImages:   resizedImages,
 	}
+
+	err = s.rotateTokenSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	// Private assets are only served with the token of the session. Identicons,
+	// QR codes, account and contact images have URLs built by the clients
+	// from MakeImageServerURL, so they are served without one
 	s.SetHandlers(HandlerPatternMap{
-		imagesPath:             handleImage(s.db, s.logger),
-		audioPath:              handleAudio(s.db, s.logger),
-		identiconsPath:         handleIdenticon(s.logger),
-		ipfsPath:               handleIPFS(s.downloader, s.logger),
-		accountImagesPath:      handleAccountImages(s.multiaccountsDB, s.logger),
-		contactImagesPath:      handleContactImages(s.db, s.logger),
-		discordAuthorsPath:     handleDiscordAuthorAvatar(s.db, s.logger),
-		discordAttachmentsPath: handleDiscordAttachment(s.db, s.logger),
-		generateQRCode:         handleQRCodeGeneration(s.multiaccountsDB, s.logger),
+		imagesPath:                s.requireToken(handleImage(s.db, s.resizedImages, s.logger)),
+		audioPath:                 s.requireToken(handleAudio(s.db, s.logger)),
+		identiconsPath:            handleIdenticon(s.logger),
+		ipfsPath:                  s.requireToken(handleIPFS(s.downloader, s.logger)),
+		accountImagesPath:         handleAccountImages(s.multiaccountsDB, s.logger),
+		animatedAccountImagesPath: s.requireToken(handleAnimatedAccountImage(s.multiaccountsDB, s.logger)),
+		contactImagesPath:         handleContactImages(s.db, s.logger),
+		discordAuthorsPath:        s.requireToken(handleDiscordAuthorAvatar(s.db, s.logger)),
+		discordAttachmentsPath:    s.requireToken(handleDiscordAttachment(s.db, s.logger)),
+		generateQRCode:            handleQRCodeGeneration(s.multiaccountsDB, s.logger),
 	})
 
 	return s, nil
 }
 
+// EvictResizedImages removes the resized images of a deleted message from the cache
+func (s *MediaServer) EvictResizedImages(messageID string) {
+	evictResizedImages(s.resizedImages, messageID)
+}
+
 func (s *MediaServer) MakeImageServerURL() string {
 	u := s.MakeBaseURL()
 	u.Path = basePath + "/"
@@ -70,7 +99,15 @@ func (s *MediaServer) MakeImageURL(id string) string {
 	u.Path = imagesPath
 	u.RawQuery = url.Values{"messageId": {id}}.Encode()
 
-	return u.String()
+	return s.withToken(u)
+}
+
+func (s *MediaServer) MakeAnimatedAvatarURL(keyUID string) string {
+	u := s.MakeBaseURL()
+	u.Path = animatedAccountImagesPath
+	u.RawQuery = url.Values{"keyUid": {keyUID}}.Encode()
+
+	return s.withToken(u)
 }
 
 func (s *MediaServer) MakeDiscordAuthorAvatarURL(authorID string) string {
@@ -78,7 +115,7 @@ func (s *MediaServer) MakeDiscordAuthorAvatarURL(authorID string) string {
 	u.Path = discordAuthorsPath
 	u.RawQuery = url.Values{"authorId": {authorID}}.Encode()
 
-	return u.String()
+	return s.withToken(u)
 }
 
 func (s *MediaServer) MakeDiscordAttachmentURL(messageID string, id string) string {
@@ -86,7 +123,7 @@ func (s *MediaServer) MakeDiscordAttachmentURL(messageID string, id string) stri
 	u.Path = discordAttachmentsPath
 	u.RawQuery = url.Values{"messageId": {messageID}, "attachmentId": {id}}.Encode()
 
-	return u.String()
+	return s.withToken(u)
 }
 
 func (s *MediaServer) MakeAudioURL(id string) string {
@@ -94,7 +131,7 @@ func (s *MediaServer) MakeAudioURL(id string) string {
 	u.Path = audioPath
 	u.RawQuery = url.Values{"messageId": {id}}.Encode()
 
-	return u.String()
+	return s.withToken(u)
 }
 
 func (s *MediaServer) MakeStickerURL(stickerHash string) string {
@@ -102,7 +139,7 @@ func (s *MediaServer) MakeStickerURL(stickerHash string) string {
 	u.Path = ipfsPath
 	u.RawQuery = url.Values{"hash": {stickerHash}}.Encode()
 
-	return u.String()
+	return s.withToken(u)
 }
 
 func (s *MediaServer) MakeQRURL(qurul string,
@@ -4,11 +4,14 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
 	"testing"
 	"time"
 
@@ -16,6 +19,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/multiaccounts"
 	"github.com/status-im/status-go/server/servertest"
 )
 
@@ -133,6 +137,46 @@ func (s *ServerURLSuite) TestServer_MakeStickerURL() {
 		s.serverNoPort.MakeStickerURL("0xdeadbeef4ac0"))
 }
 
+func (s *ServerURLSuite) TestServer_MakeAnimatedAvatarURL() {
+	s.Require().Equal(
+		baseURLWithCustomPort+"/accountAnimatedImages?keyUid=0xdeadbeef",
+		s.server.MakeAnimatedAvatarURL("0xdeadbeef"))
+	s.testNoPort(
+		baseURLWithDefaultPort+"/accountAnimatedImages?keyUid=0xdeadbeef",
+		s.serverNoPort.MakeAnimatedAvatarURL("0xdeadbeef"))
+}
+
+func (s *ServerURLSuite) TestHandleAnimatedAccountImage() {
+	tmpfile, err := ioutil.TempFile("", "accounts-tests-")
+	s.Require().NoError(err)
+	defer os.Remove(tmpfile.Name())
+
+	db, err := multiaccounts.InitializeDB(tmpfile.Name())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	webp, err := ioutil.ReadFile("../_assets/tests/1.webp")
+	s.Require().NoError(err)
+
+	s.Require().ErrorIs(db.StoreAnimatedIdentityImage("0xdeadbeef", []byte("not an image"), 1), multiaccounts.ErrNotAnimatedImage)
+	tooLarge := append(append([]byte{}, webp...), make([]byte, images.MaxAnimatedIdentitySize)...)
+	s.Require().ErrorIs(db.StoreAnimatedIdentityImage("0xdeadbeef", tooLarge, 1), multiaccounts.ErrAnimatedImageTooLarge)
+	s.Require().NoError(db.StoreAnimatedIdentityImage("0xdeadbeef", webp, 1))
+
+	handler := handleAnimatedAccountImage(db, s.Logger)
+
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodGet, animatedAccountImagesPath+"?keyUid=0xdeadbeef", nil))
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("image/webp", w.Header().Get("Content-Type"))
+	s.Require().Equal("no-store", w.Header().Get("Cache-Control"))
+	s.Require().Equal(webp, w.Body.Bytes())
+
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodGet, animatedAccountImagesPath+"?keyUid=0xbadc0de", nil))
+	s.Require().Equal(http.StatusNotFound, w.Code)
+}
+
 // TestQRCodeGeneration tests if we provide all the correct parameters to the media server
 // do we get a valid QR code or not as part of the response payload.
 // we have stored a generated QR code in tests folder, and we compare their bytes.
@@ -213,3 +257,69 @@ func (s *ServerURLSuite) TestQRCodeGeneration() {
 	//	s.Require().NoError(err)
 	//}
 }
+
+func (s *ServerURLSuite) responseStatus(rawURL string) int {
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.serverForQR.cert.Certificate[0]})
+
+	rootCAs := x509.NewCertPool()
+	s.Require().True(rootCAs.AppendCertsFromPEM(certPem))
+
+	client := &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    rootCAs,
+		},
+	}}
+
+	resp, err := client.Get(rawURL)
+	s.Require().NoError(err)
+	s.Require().NoError(resp.Body.Close())
+
+	return resp.StatusCode
+}
+
+func (s *ServerURLSuite) TestMediaServerTokens() {
+	imagesURL := s.serverForQR.MakeBaseURL()
+	imagesURL.Path = imagesPath
+	tokenURL := s.serverForQR.withToken(imagesURL)
+	s.Require().Equal(http.StatusOK, s.responseStatus(tokenURL))
+
+	u, err := url.Parse(tokenURL)
+	s.Require().NoError(err)
+	params := u.Query()
+	s.Require().NotEmpty(params.Get(tokenParam))
+
+	// Missing token
+	params.Del(tokenParam)
+	u.RawQuery = params.Encode()
+	s.Require().Equal(http.StatusForbidden, s.responseStatus(u.String()))
+
+	// Wrong token
+	params.Set(tokenParam, hex.EncodeToString(make([]byte, 32)))
+	u.RawQuery = params.Encode()
+	s.Require().Equal(http.StatusForbidden, s.responseStatus(u.String()))
+
+	// Token of another URL
+	otherURL, err := url.Parse(s.serverForQR.MakeImageURL("0x10aded70ffee"))
+	s.Require().NoError(err)
+	params.Set(tokenParam, otherURL.Query().Get(tokenParam))
+	u.RawQuery = params.Encode()
+	s.Require().Equal(http.StatusForbidden, s.responseStatus(u.String()))
+
+	// Token of the previous session
+	s.Require().NoError(s.serverForQR.Stop())
+	s.Require().NoError(s.serverForQR.Start())
+	s.Require().Eventually(s.serverForQR.IsRunning, time.Second, 10*time.Millisecond)
+
+	previousSessionURL, err := url.Parse(tokenURL)
+	s.Require().NoError(err)
+	previousSessionURL.Host = s.serverForQR.MakeBaseURL().Host
+	s.Require().Equal(http.StatusForbidden, s.responseStatus(previousSessionURL.String()))
+
+	imagesURL = s.serverForQR.MakeBaseURL()
+	imagesURL.Path = imagesPath
+	s.Require().Equal(http.StatusOK, s.responseStatus(s.serverForQR.withToken(imagesURL)))
+
+	// Identicons are built by the clients and served without a token
+	s.Require().Equal(http.StatusOK, s.responseStatus(s.serverForQR.MakeIdenticonURL("0xdaff0d11decade")))
+}
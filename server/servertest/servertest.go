@@ -4,6 +4,7 @@ package servertest
 import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
+	"crypto/rand"
 	"encoding/asn1"
 	"math/big"
 	"testing"
@@ -63,6 +64,15 @@ func (tk *TestKeyComponents) SetupKeyComponents(t *testing.T) {
 	}
 }
 
+// GeneratePairingKey generates a fresh ECDH key pair, returning the private key
+// and its public key encoded the way pairing connection strings carry it.
+func (tk *TestKeyComponents) GeneratePairingKey(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return pk, elliptic.MarshalCompressed(pk.Curve, pk.X, pk.Y)
+}
+
 type TestCertComponents struct {
 	NotBefore, NotAfter time.Time
 	SN                  *big.Int
@@ -3,6 +3,7 @@ package accounts
 import (
 	"context"
 
+	"github.com/status-im/status-go/logutils"
 	"github.com/status-im/status-go/multiaccounts/accounts"
 	"github.com/status-im/status-go/multiaccounts/settings"
 	"github.com/status-im/status-go/nodecfg"
@@ -31,6 +32,17 @@ func (api *SettingsAPI) SaveSetting(ctx context.Context, typ string, val interfa
 	return api.db.SaveSetting(typ, val)
 }
 
+// SetLogLevel saves the log level setting and applies it right away
+func (api *SettingsAPI) SetLogLevel(ctx context.Context, level string) error {
+	// applying the level first validates it before it's saved
+	err := logutils.OverrideRootLogLevel(level)
+	if err != nil {
+		return err
+	}
+
+	return api.db.SaveSettingField(settings.LogLevel, level)
+}
+
 func (api *SettingsAPI) GetSettings(ctx context.Context) (settings.Settings, error) {
 	return api.db.GetSettings()
 }
@@ -3,6 +3,7 @@ package chat
 import (
 	"context"
 	"errors"
+	"sort"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -22,6 +23,7 @@ var (
 	ErrCommunityNotFound       = errors.New("can't find community")
 	ErrCommunitiesNotSupported = errors.New("communities are not supported")
 	ErrChatTypeNotSupported    = errors.New("chat type not supported")
+	ErrInvalidPublicKey        = errors.New("invalid public key")
 )
 
 type ChannelGroupType string
@@ -74,6 +76,7 @@ type Chat struct {
 	SyncedTo                 uint32                             `json:"syncedTo,omitempty"`
 	SyncedFrom               uint32                             `json:"syncedFrom,omitempty"`
 	FirstMessageTimestamp    uint32                             `json:"firstMessageTimestamp,omitempty"`
+	SyncCoverage             float64                            `json:"syncCoverage"`
 	Highlight                bool                               `json:"highlight,omitempty"`
 	PinnedMessages           *PinnedMessages                    `json:"pinnedMessages,omitempty"`
 	CanPost                  bool                               `json:"canPost"`
@@ -81,6 +84,7 @@ type Chat struct {
 }
 
 type ChannelGroup struct {
+	ID                      string                                   `json:"id"`
 	Type                    ChannelGroupType                         `json:"channelGroupType"`
 	Name                    string                                   `json:"name"`
 	Images                  map[string]images.IdentityImage          `json:"images"`
@@ -89,6 +93,7 @@ type ChannelGroup struct {
 	Categories              map[string]communities.CommunityCategory `json:"categories"`
 	EnsName                 string                                   `json:"ensName"`
 	Admin                   bool                                     `json:"admin"`
+	IsOwner                 bool                                     `json:"isOwner"`
 	Verified                bool                                     `json:"verified"`
 	Description             string                                   `json:"description"`
 	IntroMessage            string                                   `json:"introMessage"`
@@ -103,6 +108,10 @@ type ChannelGroup struct {
 	CommunityTokensMetadata []*protobuf.CommunityTokenMetadata       `json:"communityTokensMetadata"`
 	UnviewedMessagesCount   int                                      `json:"unviewedMessagesCount"`
 	UnviewedMentionsCount   int                                      `json:"unviewedMentionsCount"`
+	DNDSchedule             *communities.DNDSchedule                 `json:"dndSchedule,omitempty"`
+	// LastActivity is the timestamp in ms of the latest message in the
+	// group's chats, 0 if there are none
+	LastActivity int64 `json:"lastActivity"`
 }
 
 func NewAPI(service *Service) *API {
@@ -155,6 +164,7 @@ func (api *API) GetChannelGroups(ctx context.Context) (map[string]ChannelGroup,
 	}
 
 	result[pubKey] = ChannelGroup{
+		ID:                      pubKey,
 		Type:                    Personal,
 		Name:                    "",
 		Images:                  make(map[string]images.IdentityImage),
@@ -173,22 +183,19 @@ func (api *API) GetChannelGroups(ctx context.Context) (map[string]ChannelGroup,
 		CommunityTokensMetadata: []*protobuf.CommunityTokenMetadata{},
 		UnviewedMessagesCount:   totalUnviewedMessageCount,
 		UnviewedMentionsCount:   totalUnviewedMentionsCount,
+		LastActivity:            lastMessageTimestamp(channels, isPersonalChat),
 	}
 
-	for _, community := range unique(append(joinedCommunities, spectatedCommunities...)) {
-		totalUnviewedMessageCount = 0
-		totalUnviewedMentionsCount = 0
-
-		for _, chat := range channels {
-			if chat.CommunityID != community.IDString() || !chat.Active {
-				continue
-			}
+	unreadCounters := make([]communities.ChatUnreadCounter, 0, len(channels))
+	for _, chat := range channels {
+		unreadCounters = append(unreadCounters, chat)
+	}
 
-			totalUnviewedMessageCount += int(chat.UnviewedMessagesCount)
-			totalUnviewedMentionsCount += int(chat.UnviewedMentionsCount)
-		}
+	for _, community := range unique(append(joinedCommunities, spectatedCommunities...)) {
+		unviewedMessagesCount, unviewedMentionsCount := community.TotalUnreadCount(unreadCounters)
 
 		chGrp := ChannelGroup{
+			ID:                      community.IDString(),
 			Type:                    Community,
 			Name:                    community.Name(),
 			Color:                   community.Color(),
@@ -196,6 +203,7 @@ func (api *API) GetChannelGroups(ctx context.Context) (map[string]ChannelGroup,
 			Chats:                   make(map[string]*Chat),
 			Categories:              make(map[string]communities.CommunityCategory),
 			Admin:                   community.IsAdmin(),
+			IsOwner:                 community.IsOwner(),
 			Verified:                community.Verified(),
 			Description:             community.DescriptionText(),
 			IntroMessage:            community.IntroMessage(),
@@ -208,25 +216,85 @@ func (api *API) GetChannelGroups(ctx context.Context) (map[string]ChannelGroup,
 			BanList:                 community.Description().BanList,
 			Encrypted:               community.Encrypted(),
 			CommunityTokensMetadata: community.Description().CommunityTokensMetadata,
-			UnviewedMessagesCount:   totalUnviewedMessageCount,
-			UnviewedMentionsCount:   totalUnviewedMentionsCount,
+			UnviewedMessagesCount:   int(unviewedMessagesCount),
+			UnviewedMentionsCount:   int(unviewedMentionsCount),
+			LastActivity:            lastMessageTimestamp(channels, isCommunityChat(community.IDString())),
 		}
 
 		for t, i := range community.Images() {
 			chGrp.Images[t] = images.IdentityImage{Name: t, Payload: i.Payload}
 		}
 
+		chGrp.DNDSchedule, err = api.s.messenger.GetCommunityDNDSchedule(community.ID())
+		if err != nil {
+			return nil, err
+		}
+
 		result[community.IDString()] = chGrp
 	}
 
 	return result, nil
 }
 
+// GetChannelGroupsSortedByActivity returns the channel groups, the ones with
+// the most recent messages first
+func (api *API) GetChannelGroupsSortedByActivity(ctx context.Context) ([]ChannelGroup, error) {
+	channelGroups, err := api.GetChannelGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sortChannelGroupsByActivity(channelGroups), nil
+}
+
+func sortChannelGroupsByActivity(channelGroups map[string]ChannelGroup) []ChannelGroup {
+	result := make([]ChannelGroup, 0, len(channelGroups))
+	for _, channelGroup := range channelGroups {
+		result = append(result, channelGroup)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].LastActivity != result[j].LastActivity {
+			return result[i].LastActivity > result[j].LastActivity
+		}
+		// Keep the order stable for groups without activity
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+func isPersonalChat(chat *protocol.Chat) bool {
+	return chat.IsActivePersonalChat()
+}
+
+func isCommunityChat(communityID string) func(chat *protocol.Chat) bool {
+	return func(chat *protocol.Chat) bool {
+		return chat.CommunityID == communityID
+	}
+}
+
+// lastMessageTimestamp returns the timestamp of the latest message among the
+// chats matching `inGroup`
+func lastMessageTimestamp(chats []*protocol.Chat, inGroup func(chat *protocol.Chat) bool) int64 {
+	var last int64
+	for _, chat := range chats {
+		if !inGroup(chat) || chat.LastMessage == nil {
+			continue
+		}
+		if timestamp := int64(chat.LastMessage.Timestamp); timestamp > last {
+			last = timestamp
+		}
+	}
+	return last
+}
+
 func (api *API) GetChatsByChannelGroupID(ctx context.Context, channelGroupID string) (*ChannelGroup, error) {
 	pubKey := types.EncodeHex(crypto.FromECDSAPub(api.s.messenger.IdentityPublicKey()))
 
 	if pubKey == channelGroupID {
 		result := &ChannelGroup{
+			ID:                      pubKey,
 			Type:                    Personal,
 			Name:                    "",
 			Images:                  make(map[string]images.IdentityImage),
@@ -246,6 +314,7 @@ func (api *API) GetChatsByChannelGroupID(ctx context.Context, channelGroupID str
 		}
 
 		channels := api.s.messenger.Chats()
+		result.LastActivity = lastMessageTimestamp(channels, isPersonalChat)
 
 		for _, chat := range channels {
 			if !chat.IsActivePersonalChat() {
@@ -297,6 +366,7 @@ func (api *API) GetChatsByChannelGroupID(ctx context.Context, channelGroupID str
 	}
 
 	result := &ChannelGroup{
+		ID:                      community.IDString(),
 		Type:                    Community,
 		Name:                    community.Name(),
 		Color:                   community.Color(),
@@ -304,6 +374,7 @@ func (api *API) GetChatsByChannelGroupID(ctx context.Context, channelGroupID str
 		Chats:                   make(map[string]*Chat),
 		Categories:              make(map[string]communities.CommunityCategory),
 		Admin:                   community.IsAdmin(),
+		IsOwner:                 community.IsOwner(),
 		Verified:                community.Verified(),
 		Description:             community.DescriptionText(),
 		IntroMessage:            community.IntroMessage(),
@@ -322,6 +393,11 @@ func (api *API) GetChatsByChannelGroupID(ctx context.Context, channelGroupID str
 		result.Images[t] = images.IdentityImage{Name: t, Payload: i.Payload}
 	}
 
+	result.DNDSchedule, err = api.s.messenger.GetCommunityDNDSchedule(community.ID())
+	if err != nil {
+		return nil, err
+	}
+
 	for _, cat := range community.Categories() {
 		result.Categories[cat.CategoryId] = communities.CommunityCategory{
 			ID:       cat.CategoryId,
@@ -331,6 +407,7 @@ func (api *API) GetChatsByChannelGroupID(ctx context.Context, channelGroupID str
 	}
 
 	channels := api.s.messenger.Chats()
+	result.LastActivity = lastMessageTimestamp(channels, isCommunityChat(community.IDString()))
 	for _, chat := range channels {
 		if chat.CommunityID == community.IDString() && chat.Active {
 			c, err := api.toAPIChat(chat, community, pubKey, true)
@@ -364,6 +441,140 @@ func (api *API) GetChat(ctx context.Context, communityID types.HexBytes, chatID
 	return result, nil
 }
 
+// UpdateChatHighlight sets whether the chat should be highlighted, and returns the updated chat
+func (api *API) UpdateChatHighlight(ctx context.Context, chatID string, highlight bool) (*Chat, error) {
+	messengerChat, err := api.s.messenger.SetChatHighlight(chatID, highlight)
+	if err != nil {
+		return nil, err
+	}
+
+	chats, err := api.toAPIChats([]*protocol.Chat{messengerChat})
+	if err != nil {
+		return nil, err
+	}
+
+	return chats[0], nil
+}
+
+// GetChatByMemberPublicKey returns the one-to-one chat with the given public key
+func (api *API) GetChatByMemberPublicKey(ctx context.Context, pubKey string) (*Chat, error) {
+	messengerChat, err := findOneToOneChat(pubKey, api.s.messenger.Chat)
+	if err != nil {
+		return nil, err
+	}
+
+	ourPubKey := types.EncodeHex(crypto.FromECDSAPub(api.s.messenger.IdentityPublicKey()))
+	return api.toAPIChat(messengerChat, nil, ourPubKey, false)
+}
+
+// findOneToOneChat looks up the one-to-one chat with `pubKey`, whose ID is
+// derived from the public key of the other member
+func findOneToOneChat(pubKey string, getChat func(chatID string) *protocol.Chat) (*protocol.Chat, error) {
+	publicKey, err := common.HexToPubkey(pubKey)
+	if err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+
+	chat := getChat(types.EncodeHex(crypto.FromECDSAPub(publicKey)))
+	if chat == nil || !chat.OneToOne() {
+		return nil, ErrChatNotFound
+	}
+
+	return chat, nil
+}
+
+// GetChatsWithPendingMessages returns the chats having messages that failed to be sent
+func (api *API) GetChatsWithPendingMessages(ctx context.Context) ([]*Chat, error) {
+	messengerChats, err := api.s.messenger.ChatsWithPendingMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	return api.toAPIChats(messengerChats)
+}
+
+// GetChatsWithUnreadMentions returns the chats having unread mentions, the ones
+// with the most mentions first
+func (api *API) GetChatsWithUnreadMentions(ctx context.Context) ([]*Chat, error) {
+	return api.toAPIChats(api.s.messenger.GetChatsWithUnreadMentions())
+}
+
+// MarkChannelGroupAsRead marks all the messages of the active chats in a
+// channel group as read
+func (api *API) MarkChannelGroupAsRead(ctx context.Context, channelGroupID string) error {
+	pubKey := types.EncodeHex(crypto.FromECDSAPub(api.s.messenger.IdentityPublicKey()))
+
+	inGroup := isPersonalChat
+	if channelGroupID != pubKey {
+		if _, err := api.getCommunityByID(channelGroupID); err != nil {
+			return err
+		}
+		inGroup = isCommunityChat(channelGroupID)
+	}
+
+	_, err := api.s.messenger.MarkChatsAsRead(ctx, activeChatIDs(api.s.messenger.Chats(), inGroup))
+	return err
+}
+
+// activeChatIDs returns the IDs of the active chats matching `inGroup`
+func activeChatIDs(chats []*protocol.Chat, inGroup func(chat *protocol.Chat) bool) []string {
+	var chatIDs []string
+	for _, chat := range chats {
+		if chat.Active && inGroup(chat) {
+			chatIDs = append(chatIDs, chat.ID)
+		}
+	}
+
+	return chatIDs
+}
+
+type PersonalGroupStats struct {
+	TotalChats             int   `json:"totalChats"`
+	TotalContacts          int   `json:"totalContacts"`
+	TotalMessages          int   `json:"totalMessages"`
+	OldestMessageTimestamp int64 `json:"oldestMessageTimestamp"`
+}
+
+// GetPersonalChannelGroupStats returns aggregate metrics about the personal
+// channel group, used to estimate the size of a backup
+func (api *API) GetPersonalChannelGroupStats(ctx context.Context) (*PersonalGroupStats, error) {
+	stats, err := api.s.messenger.PersonalChatsStats()
+	if err != nil {
+		return nil, err
+	}
+
+	return &PersonalGroupStats{
+		TotalChats:             stats.Chats,
+		TotalContacts:          stats.Contacts,
+		TotalMessages:          stats.Messages,
+		OldestMessageTimestamp: stats.OldestMessageTimestamp,
+	}, nil
+}
+
+func (api *API) toAPIChats(messengerChats []*protocol.Chat) ([]*Chat, error) {
+	pubKey := types.EncodeHex(crypto.FromECDSAPub(api.s.messenger.IdentityPublicKey()))
+
+	chats := make([]*Chat, 0, len(messengerChats))
+	for _, messengerChat := range messengerChats {
+		var community *communities.Community
+		if messengerChat.CommunityID != "" {
+			var err error
+			community, err = api.getCommunityByID(messengerChat.CommunityID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		chat, err := api.toAPIChat(messengerChat, community, pubKey, false)
+		if err != nil {
+			return nil, err
+		}
+		chats = append(chats, chat)
+	}
+
+	return chats, nil
+}
+
 func (api *API) GetMembers(ctx context.Context, communityID types.HexBytes, chatID string) (map[string]Member, error) {
 	pubKey := types.EncodeHex(crypto.FromECDSAPub(api.s.messenger.IdentityPublicKey()))
 	messengerChat, community, err := api.getChatAndCommunity(pubKey, communityID, chatID)
@@ -427,6 +638,12 @@ func (api *API) toAPIChat(protocolChat *protocol.Chat, community *communities.Co
 	}
 
 	if !onlyChat {
+		syncCoverage, err := api.s.messenger.ChatSyncCoverage(protocolChat.ID)
+		if err != nil {
+			return nil, err
+		}
+		chat.SyncCoverage = syncCoverage
+
 		pinnedMessages, cursor, err := api.s.messenger.PinnedMessageByChatID(protocolChat.ID, "", -1)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,167 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol"
+)
+
+const groupChatInviteURLPrefix = "status-im://g/args"
+const groupChatInviteTTL = 24 * time.Hour
+
+var (
+	ErrNotGroupChat              = errors.New("not a group chat")
+	ErrNotGroupChatAdmin         = errors.New("only group chat admins can generate invite links")
+	ErrInvalidGroupChatInviteURL = errors.New("invalid group chat invite url")
+	ErrGroupChatInviteExpired    = errors.New("group chat invite expired")
+	ErrInvalidGroupChatInviteSig = errors.New("invalid group chat invite signature")
+)
+
+type groupChatInvite struct {
+	ChatID    string
+	Name      string
+	AdminPK   string
+	ExpiresAt int64
+}
+
+// signedPayload is the content signed by the admin, binding the chat, its admin and the expiry
+func (i *groupChatInvite) signedPayload() string {
+	return strings.Join([]string{i.ChatID, i.Name, i.AdminPK, strconv.FormatInt(i.ExpiresAt, 10)}, "|")
+}
+
+func buildGroupChatInviteURL(invite *groupChatInvite, sign func(string) ([]byte, error)) (string, error) {
+	signature, err := sign(invite.signedPayload())
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("a", invite.AdminPK)
+	params.Set("a1", invite.Name)
+	params.Set("a2", invite.ChatID)
+	params.Set("exp", strconv.FormatInt(invite.ExpiresAt, 10))
+	params.Set("sig", types.EncodeHex(signature))
+
+	return groupChatInviteURLPrefix + "?" + params.Encode(), nil
+}
+
+func parseGroupChatInviteURL(inviteURL string, now time.Time) (*groupChatInvite, error) {
+	if !strings.HasPrefix(inviteURL, groupChatInviteURLPrefix+"?") {
+		return nil, ErrInvalidGroupChatInviteURL
+	}
+
+	params, err := url.ParseQuery(strings.TrimPrefix(inviteURL, groupChatInviteURLPrefix+"?"))
+	if err != nil {
+		return nil, ErrInvalidGroupChatInviteURL
+	}
+
+	expiresAt, err := strconv.ParseInt(params.Get("exp"), 10, 64)
+	if err != nil {
+		return nil, ErrInvalidGroupChatInviteURL
+	}
+
+	invite := &groupChatInvite{
+		ChatID:    params.Get("a2"),
+		Name:      params.Get("a1"),
+		AdminPK:   params.Get("a"),
+		ExpiresAt: expiresAt,
+	}
+	if invite.ChatID == "" || invite.AdminPK == "" {
+		return nil, ErrInvalidGroupChatInviteURL
+	}
+
+	signature, err := types.DecodeHex(params.Get("sig"))
+	if err != nil {
+		return nil, ErrInvalidGroupChatInviteSig
+	}
+
+	signer, err := crypto.SigToPub(crypto.TextHash([]byte(invite.signedPayload())), signature)
+	if err != nil {
+		return nil, ErrInvalidGroupChatInviteSig
+	}
+
+	if types.EncodeHex(crypto.FromECDSAPub(signer)) != invite.AdminPK {
+		return nil, ErrInvalidGroupChatInviteSig
+	}
+
+	if now.Unix() > invite.ExpiresAt {
+		return nil, ErrGroupChatInviteExpired
+	}
+
+	return invite, nil
+}
+
+// GenerateGroupChatInviteURL returns a link that lets its holder join the group chat.
+// The link is signed by the admin generating it and expires after 24 hours.
+func (api *API) GenerateGroupChatInviteURL(ctx context.Context, chatID string) (string, error) {
+	chat := api.s.messenger.Chat(chatID)
+	if chat == nil {
+		return "", ErrChatNotFound
+	}
+
+	if !chat.PrivateGroupChat() {
+		return "", ErrNotGroupChat
+	}
+
+	pubKey := types.EncodeHex(crypto.FromECDSAPub(api.s.messenger.IdentityPublicKey()))
+	isAdmin := false
+	for _, member := range chat.Members {
+		if member.ID == pubKey && member.Admin {
+			isAdmin = true
+			break
+		}
+	}
+	if !isAdmin {
+		return "", ErrNotGroupChatAdmin
+	}
+
+	invite := &groupChatInvite{
+		ChatID:    chatID,
+		Name:      chat.Name,
+		AdminPK:   pubKey,
+		ExpiresAt: time.Now().Add(groupChatInviteTTL).Unix(),
+	}
+
+	return buildGroupChatInviteURL(invite, api.s.messenger.SignMessage)
+}
+
+// JoinGroupChatViaURL validates an invite link generated by GenerateGroupChatInviteURL
+// and requests the admin that signed it to be added to the group chat
+func (api *API) JoinGroupChatViaURL(ctx context.Context, inviteURL string) (*Chat, error) {
+	invite, err := parseGroupChatInviteURL(inviteURL, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey := types.EncodeHex(crypto.FromECDSAPub(api.s.messenger.IdentityPublicKey()))
+
+	if chat := api.s.messenger.Chat(invite.ChatID); chat != nil && chat.HasMember(pubKey) {
+		return api.toAPIChat(chat, nil, pubKey, false)
+	}
+
+	_, err = api.s.messenger.CreateGroupChatFromInvitation(invite.Name, invite.ChatID, invite.AdminPK)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := api.s.messenger.SendGroupChatInvitationRequest(ctx, invite.ChatID, invite.AdminPK, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var chat *protocol.Chat
+	if len(response.Chats()) > 0 {
+		chat = response.Chats()[0]
+	} else {
+		chat = api.s.messenger.Chat(invite.ChatID)
+	}
+
+	return api.toAPIChat(chat, nil, pubKey, false)
+}
@@ -0,0 +1,68 @@
+package chat
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+func buildTestGroupChatInvite(t *testing.T, expiresAt time.Time) string {
+	adminKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	invite := &groupChatInvite{
+		ChatID:    "group-chat-id",
+		Name:      "group chat",
+		AdminPK:   types.EncodeHex(crypto.FromECDSAPub(&adminKey.PublicKey)),
+		ExpiresAt: expiresAt.Unix(),
+	}
+	inviteURL, err := buildGroupChatInviteURL(invite, func(payload string) ([]byte, error) {
+		return crypto.Sign(crypto.TextHash([]byte(payload)), adminKey)
+	})
+	require.NoError(t, err)
+
+	return inviteURL
+}
+
+func TestParseGroupChatInviteURL(t *testing.T) {
+	now := time.Now()
+	inviteURL := buildTestGroupChatInvite(t, now.Add(groupChatInviteTTL))
+
+	invite, err := parseGroupChatInviteURL(inviteURL, now)
+	require.NoError(t, err)
+	require.Equal(t, "group-chat-id", invite.ChatID)
+	require.Equal(t, "group chat", invite.Name)
+}
+
+func TestParseGroupChatInviteURLExpired(t *testing.T) {
+	now := time.Now()
+	inviteURL := buildTestGroupChatInvite(t, now.Add(groupChatInviteTTL))
+
+	_, err := parseGroupChatInviteURL(inviteURL, now.Add(groupChatInviteTTL+time.Second))
+	require.Equal(t, ErrGroupChatInviteExpired, err)
+}
+
+func TestParseGroupChatInviteURLTampered(t *testing.T) {
+	now := time.Now()
+	inviteURL := buildTestGroupChatInvite(t, now.Add(groupChatInviteTTL))
+
+	// Chat ID changed
+	_, err := parseGroupChatInviteURL(strings.Replace(inviteURL, "a2=group-chat-id", "a2=other-chat-id", 1), now)
+	require.Equal(t, ErrInvalidGroupChatInviteSig, err)
+
+	// Expiry extended
+	extended := strconv.FormatInt(now.Add(2*groupChatInviteTTL).Unix(), 10)
+	original := strconv.FormatInt(now.Add(groupChatInviteTTL).Unix(), 10)
+	_, err = parseGroupChatInviteURL(strings.Replace(inviteURL, "exp="+original, "exp="+extended, 1), now)
+	require.Equal(t, ErrInvalidGroupChatInviteSig, err)
+
+	// Not an invite
+	_, err = parseGroupChatInviteURL("status-im://p/0x04", now)
+	require.Equal(t, ErrInvalidGroupChatInviteURL, err)
+}
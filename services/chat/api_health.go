@@ -0,0 +1,114 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+var (
+	ErrMessengerNotInitialized = errors.New("messenger is not initialized")
+	ErrDatabaseNotInitialized  = errors.New("database is not initialized")
+	ErrNoPeers                 = errors.New("not connected to any peer")
+)
+
+type ChatServiceHealth struct {
+	MessengerOK bool `json:"messengerOk"`
+	WakuOK      bool `json:"wakuOk"`
+	DatabaseOK  bool `json:"databaseOk"`
+	PeerCount   int  `json:"peerCount"`
+	// LastBackupAgo is 0 if a backup was never made
+	LastBackupAgo time.Duration `json:"lastBackupAgo"`
+	Errors        []string      `json:"errors,omitempty"`
+	// StatusCode is the HTTP status matching the health of the service,
+	// 503 if the messenger is not available
+	StatusCode int `json:"statusCode"`
+}
+
+// healthChecks are the checks run for each component of the service
+type healthChecks struct {
+	messenger  func() error
+	peerCount  func() (int, error)
+	database   func(ctx context.Context) error
+	lastBackup func() (uint64, error)
+}
+
+// HealthCheck reports the status of the components the chat service relies on.
+// A failing component doesn't prevent the others from being checked.
+func (api *API) HealthCheck(ctx context.Context) (*ChatServiceHealth, error) {
+	return checkHealth(ctx, api.s.healthChecks(), time.Now()), nil
+}
+
+func (s *Service) healthChecks() healthChecks {
+	return healthChecks{
+		messenger: func() error {
+			if s.messenger == nil {
+				return ErrMessengerNotInitialized
+			}
+			return nil
+		},
+		peerCount: func() (int, error) {
+			if s.messenger == nil {
+				return 0, ErrMessengerNotInitialized
+			}
+			return s.messenger.PeerCount(), nil
+		},
+		database: func(ctx context.Context) error {
+			if s.accountsDB == nil {
+				return ErrDatabaseNotInitialized
+			}
+			return s.accountsDB.DB().PingContext(ctx)
+		},
+		lastBackup: func() (uint64, error) {
+			if s.accountsDB == nil {
+				return 0, ErrDatabaseNotInitialized
+			}
+			return s.accountsDB.LastBackup()
+		},
+	}
+}
+
+func checkHealth(ctx context.Context, checks healthChecks, now time.Time) *ChatServiceHealth {
+	health := &ChatServiceHealth{}
+
+	addError := func(component string, err error) {
+		health.Errors = append(health.Errors, component+": "+err.Error())
+	}
+
+	if err := checks.messenger(); err != nil {
+		addError("messenger", err)
+	} else {
+		health.MessengerOK = true
+	}
+
+	peerCount, err := checks.peerCount()
+	if err != nil {
+		addError("waku", err)
+	} else if peerCount == 0 {
+		addError("waku", ErrNoPeers)
+	} else {
+		health.WakuOK = true
+	}
+	health.PeerCount = peerCount
+
+	if err := checks.database(ctx); err != nil {
+		addError("database", err)
+	} else {
+		health.DatabaseOK = true
+	}
+
+	lastBackup, err := checks.lastBackup()
+	if err != nil {
+		addError("backup", err)
+	} else if lastBackup != 0 {
+		health.LastBackupAgo = now.Sub(time.Unix(int64(lastBackup), 0))
+	}
+
+	health.StatusCode = http.StatusOK
+	if !health.MessengerOK {
+		health.StatusCode = http.StatusServiceUnavailable
+	}
+
+	return health
+}
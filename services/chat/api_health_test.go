@@ -0,0 +1,57 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckHealthHealthy(t *testing.T) {
+	now := time.Unix(10000, 0)
+	checks := healthChecks{
+		messenger:  func() error { return nil },
+		peerCount:  func() (int, error) { return 3, nil },
+		database:   func(ctx context.Context) error { return nil },
+		lastBackup: func() (uint64, error) { return 9940, nil },
+	}
+
+	health := checkHealth(context.Background(), checks, now)
+	require.True(t, health.MessengerOK)
+	require.True(t, health.WakuOK)
+	require.True(t, health.DatabaseOK)
+	require.Equal(t, 3, health.PeerCount)
+	require.Equal(t, time.Minute, health.LastBackupAgo)
+	require.Empty(t, health.Errors)
+	require.Equal(t, http.StatusOK, health.StatusCode)
+}
+
+func TestCheckHealthDegraded(t *testing.T) {
+	now := time.Unix(10000, 0)
+	checks := healthChecks{
+		messenger:  func() error { return nil },
+		peerCount:  func() (int, error) { return 0, nil },
+		database:   func(ctx context.Context) error { return errors.New("database is closed") },
+		lastBackup: func() (uint64, error) { return 0, nil },
+	}
+
+	health := checkHealth(context.Background(), checks, now)
+	require.True(t, health.MessengerOK)
+	require.False(t, health.WakuOK)
+	require.False(t, health.DatabaseOK)
+	require.Equal(t, time.Duration(0), health.LastBackupAgo)
+	require.Equal(t, []string{"waku: " + ErrNoPeers.Error(), "database: database is closed"}, health.Errors)
+	require.Equal(t, http.StatusOK, health.StatusCode)
+}
+
+func TestCheckHealthMessengerDown(t *testing.T) {
+	health := checkHealth(context.Background(), (&Service{}).healthChecks(), time.Now())
+	require.False(t, health.MessengerOK)
+	require.False(t, health.WakuOK)
+	require.False(t, health.DatabaseOK)
+	require.Len(t, health.Errors, 4)
+	require.Equal(t, http.StatusServiceUnavailable, health.StatusCode)
+}
@@ -0,0 +1,67 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/communities"
+)
+
+type MembershipRequestStatus string
+
+const (
+	MembershipRequestStatusPending  MembershipRequestStatus = "pending"
+	MembershipRequestStatusDeclined MembershipRequestStatus = "declined"
+	MembershipRequestStatusAccepted MembershipRequestStatus = "accepted"
+	MembershipRequestStatusCanceled MembershipRequestStatus = "canceled"
+	MembershipRequestStatusUnknown  MembershipRequestStatus = "unknown"
+)
+
+type MembershipRequest struct {
+	ID          string                  `json:"id"`
+	CommunityID string                  `json:"communityId"`
+	Requester   string                  `json:"requester"`
+	Status      MembershipRequestStatus `json:"status"`
+	// CreatedAt is the unix time the request was made at
+	CreatedAt int64 `json:"createdAt"`
+}
+
+// GetCommunityMembershipRequest returns our request to join the community,
+// nil if we haven't requested to join it
+func (api *API) GetCommunityMembershipRequest(ctx context.Context, communityID types.HexBytes) (*MembershipRequest, error) {
+	requestToJoin, err := api.s.messenger.MyRequestToJoin(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMembershipRequest(requestToJoin), nil
+}
+
+func toMembershipRequest(requestToJoin *communities.RequestToJoin) *MembershipRequest {
+	if requestToJoin == nil {
+		return nil
+	}
+
+	return &MembershipRequest{
+		ID:          requestToJoin.ID.String(),
+		CommunityID: requestToJoin.CommunityID.String(),
+		Requester:   requestToJoin.PublicKey,
+		Status:      toMembershipRequestStatus(requestToJoin.State),
+		CreatedAt:   int64(requestToJoin.Clock),
+	}
+}
+
+func toMembershipRequestStatus(state communities.RequestToJoinState) MembershipRequestStatus {
+	switch state {
+	case communities.RequestToJoinStatePending:
+		return MembershipRequestStatusPending
+	case communities.RequestToJoinStateDeclined:
+		return MembershipRequestStatusDeclined
+	case communities.RequestToJoinStateAccepted:
+		return MembershipRequestStatusAccepted
+	case communities.RequestToJoinStateCanceled:
+		return MembershipRequestStatusCanceled
+	default:
+		return MembershipRequestStatusUnknown
+	}
+}
@@ -0,0 +1,38 @@
+package chat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/communities"
+)
+
+func TestToMembershipRequest(t *testing.T) {
+	require.Nil(t, toMembershipRequest(nil))
+
+	requestToJoin := &communities.RequestToJoin{
+		ID:          types.HexBytes{0x01, 0x02},
+		PublicKey:   "0x04abcd",
+		Clock:       1679510000,
+		CommunityID: types.HexBytes{0x03, 0x04},
+		State:       communities.RequestToJoinStateDeclined,
+	}
+
+	require.Equal(t, &MembershipRequest{
+		ID:          "0x0102",
+		CommunityID: "0x0304",
+		Requester:   "0x04abcd",
+		Status:      MembershipRequestStatusDeclined,
+		CreatedAt:   1679510000,
+	}, toMembershipRequest(requestToJoin))
+}
+
+func TestToMembershipRequestStatus(t *testing.T) {
+	require.Equal(t, MembershipRequestStatusPending, toMembershipRequestStatus(communities.RequestToJoinStatePending))
+	require.Equal(t, MembershipRequestStatusDeclined, toMembershipRequestStatus(communities.RequestToJoinStateDeclined))
+	require.Equal(t, MembershipRequestStatusAccepted, toMembershipRequestStatus(communities.RequestToJoinStateAccepted))
+	require.Equal(t, MembershipRequestStatusCanceled, toMembershipRequestStatus(communities.RequestToJoinStateCanceled))
+	require.Equal(t, MembershipRequestStatusUnknown, toMembershipRequestStatus(0))
+}
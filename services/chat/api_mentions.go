@@ -0,0 +1,102 @@
+package chat
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol"
+	"github.com/status-im/status-go/protocol/identity/alias"
+)
+
+type MentionSuggestion struct {
+	PublicKey   string `json:"publicKey"`
+	DisplayName string `json:"displayName"`
+	Alias       string `json:"alias"`
+	EnsName     string `json:"ensName,omitempty"`
+}
+
+// GetMentionSuggestions returns the members of the chat whose display name, alias or
+// ens name start with `prefix`, sorted by display name. A limit <= 0 returns all of them
+func (api *API) GetMentionSuggestions(ctx context.Context, communityID types.HexBytes, chatID string, prefix string, limit int) ([]MentionSuggestion, error) {
+	pubKey := types.EncodeHex(crypto.FromECDSAPub(api.s.messenger.IdentityPublicKey()))
+	messengerChat, community, err := api.getChatAndCommunity(pubKey, communityID, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := getChatMembers(messengerChat, community, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs := make([]string, 0, len(members))
+	for id := range members {
+		if id != pubKey {
+			memberIDs = append(memberIDs, id)
+		}
+	}
+
+	return mentionSuggestions(memberIDs, api.s.messenger.GetContactByID, prefix, limit), nil
+}
+
+func mentionSuggestions(memberIDs []string, getContact func(string) *protocol.Contact, prefix string, limit int) []MentionSuggestion {
+	prefix = strings.ToLower(prefix)
+
+	result := make([]MentionSuggestion, 0)
+	for _, id := range memberIDs {
+		suggestion := MentionSuggestion{PublicKey: id}
+
+		if contact := getContact(id); contact != nil {
+			suggestion.DisplayName = contact.DisplayName
+			suggestion.Alias = contact.Alias
+			if contact.ENSVerified {
+				suggestion.EnsName = contact.EnsName
+			}
+		}
+
+		if suggestion.Alias == "" {
+			generatedAlias, err := alias.GenerateFromPublicKeyString(id)
+			if err != nil {
+				continue
+			}
+			suggestion.Alias = generatedAlias
+		}
+
+		if suggestion.DisplayName == "" {
+			suggestion.DisplayName = suggestion.Alias
+		}
+
+		if !suggestion.matches(prefix) {
+			continue
+		}
+
+		result = append(result, suggestion)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := strings.ToLower(result[i].DisplayName), strings.ToLower(result[j].DisplayName)
+		if a == b {
+			return result[i].PublicKey < result[j].PublicKey
+		}
+		return a < b
+	})
+
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result
+}
+
+// matches expects a lower-cased prefix
+func (s *MentionSuggestion) matches(prefix string) bool {
+	for _, name := range []string{s.DisplayName, s.Alias, s.EnsName} {
+		if name != "" && strings.HasPrefix(strings.ToLower(name), prefix) {
+			return true
+		}
+	}
+	return false
+}
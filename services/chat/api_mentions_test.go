@@ -0,0 +1,69 @@
+package chat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol"
+)
+
+func buildTestMentionMembers(t *testing.T, count int) ([]string, map[string]*protocol.Contact) {
+	var memberIDs []string
+	contacts := make(map[string]*protocol.Contact)
+
+	for i := 0; i < count; i++ {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		id := types.EncodeHex(crypto.FromECDSAPub(&key.PublicKey))
+		memberIDs = append(memberIDs, id)
+		contacts[id] = &protocol.Contact{
+			ID:          id,
+			DisplayName: fmt.Sprintf("member-%03d", i),
+			Alias:       fmt.Sprintf("Alias Member %03d", i),
+		}
+	}
+
+	return memberIDs, contacts
+}
+
+func TestMentionSuggestions(t *testing.T) {
+	memberIDs, contacts := buildTestMentionMembers(t, 200)
+	getContact := func(id string) *protocol.Contact {
+		return contacts[id]
+	}
+
+	contacts[memberIDs[150]].DisplayName = "Zed"
+	contacts[memberIDs[151]].DisplayName = "zelda"
+	contacts[memberIDs[152]].DisplayName = "ZEB"
+
+	suggestions := mentionSuggestions(memberIDs, getContact, "ze", 10)
+	require.Len(t, suggestions, 3)
+	require.Equal(t, "ZEB", suggestions[0].DisplayName)
+	require.Equal(t, "Zed", suggestions[1].DisplayName)
+	require.Equal(t, "zelda", suggestions[2].DisplayName)
+	require.Equal(t, memberIDs[152], suggestions[0].PublicKey)
+
+	suggestions = mentionSuggestions(memberIDs, getContact, "nobody", 10)
+	require.Len(t, suggestions, 0)
+
+	suggestions = mentionSuggestions(memberIDs, getContact, "", 10)
+	require.Len(t, suggestions, 10)
+	require.Equal(t, "member-000", suggestions[0].DisplayName)
+
+	suggestions = mentionSuggestions(memberIDs, getContact, "", 0)
+	require.Len(t, suggestions, 200)
+}
+
+func TestMentionSuggestionsUnknownContact(t *testing.T) {
+	memberIDs, _ := buildTestMentionMembers(t, 1)
+
+	suggestions := mentionSuggestions(memberIDs, func(string) *protocol.Contact { return nil }, "", 0)
+	require.Len(t, suggestions, 1)
+	require.NotEmpty(t, suggestions[0].Alias)
+	require.Equal(t, suggestions[0].Alias, suggestions[0].DisplayName)
+}
@@ -0,0 +1,92 @@
+package chat
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestFindOneToOneChat(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pubKey := types.EncodeHex(crypto.FromECDSAPub(&key.PublicKey))
+
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	chat := &protocol.Chat{ID: pubKey, ChatType: protocol.ChatTypeOneToOne}
+	chats := map[string]*protocol.Chat{
+		pubKey: chat,
+		// Not a one-to-one chat, even if named after a key
+		"public": {ID: "public", ChatType: protocol.ChatTypePublic},
+	}
+	getChat := func(chatID string) *protocol.Chat {
+		return chats[chatID]
+	}
+
+	found, err := findOneToOneChat(pubKey, getChat)
+	require.NoError(t, err)
+	require.Equal(t, chat, found)
+
+	// The key is normalized before looking up the chat
+	found, err = findOneToOneChat("0x"+strings.ToUpper(pubKey[2:]), getChat)
+	require.NoError(t, err)
+	require.Equal(t, chat, found)
+
+	_, err = findOneToOneChat(types.EncodeHex(crypto.FromECDSAPub(&otherKey.PublicKey)), getChat)
+	require.Equal(t, ErrChatNotFound, err)
+
+	_, err = findOneToOneChat("0x04abcd", getChat)
+	require.Equal(t, ErrInvalidPublicKey, err)
+
+	_, err = findOneToOneChat("public", getChat)
+	require.Equal(t, ErrInvalidPublicKey, err)
+}
+
+func TestSortChannelGroupsByActivity(t *testing.T) {
+	chats := []*protocol.Chat{
+		{ID: "active-1", CommunityID: "active", LastMessage: &common.Message{ChatMessage: protobuf.ChatMessage{Timestamp: 200}}},
+		{ID: "active-2", CommunityID: "active", LastMessage: &common.Message{ChatMessage: protobuf.ChatMessage{Timestamp: 300}}},
+		{ID: "quiet-1", CommunityID: "quiet"},
+		{ID: "personal", ChatType: protocol.ChatTypeOneToOne, Active: true, LastMessage: &common.Message{ChatMessage: protobuf.ChatMessage{Timestamp: 100}}},
+	}
+
+	require.Equal(t, int64(300), lastMessageTimestamp(chats, isCommunityChat("active")))
+	require.Equal(t, int64(0), lastMessageTimestamp(chats, isCommunityChat("quiet")))
+	require.Equal(t, int64(100), lastMessageTimestamp(chats, isPersonalChat))
+
+	channelGroups := map[string]ChannelGroup{
+		"quiet":    {ID: "quiet", LastActivity: lastMessageTimestamp(chats, isCommunityChat("quiet"))},
+		"empty":    {ID: "empty"},
+		"personal": {ID: "personal", LastActivity: lastMessageTimestamp(chats, isPersonalChat)},
+		"active":   {ID: "active", LastActivity: lastMessageTimestamp(chats, isCommunityChat("active"))},
+	}
+
+	sorted := sortChannelGroupsByActivity(channelGroups)
+	var ids []string
+	for _, channelGroup := range sorted {
+		ids = append(ids, channelGroup.ID)
+	}
+	require.Equal(t, []string{"active", "personal", "empty", "quiet"}, ids)
+}
+
+func TestActiveChatIDs(t *testing.T) {
+	chats := []*protocol.Chat{
+		{ID: "community-1", CommunityID: "community", Active: true},
+		{ID: "community-2", CommunityID: "community", Active: true},
+		{ID: "community-left", CommunityID: "community"},
+		{ID: "other-1", CommunityID: "other", Active: true},
+		{ID: "personal", ChatType: protocol.ChatTypeOneToOne, Active: true},
+	}
+
+	require.Equal(t, []string{"community-1", "community-2"}, activeChatIDs(chats, isCommunityChat("community")))
+	require.Equal(t, []string{"personal"}, activeChatIDs(chats, isPersonalChat))
+	require.Empty(t, activeChatIDs(chats, isCommunityChat("unknown")))
+}
@@ -0,0 +1,81 @@
+package chat
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/status-im/status-go/protocol"
+)
+
+// unreadCountsCacheTTL is how long the total unread counts are cached for,
+// unless a message is received in the meantime
+const unreadCountsCacheTTL = time.Second
+
+type TotalUnreadCounts struct {
+	Messages int `json:"messages"`
+	Mentions int `json:"mentions"`
+}
+
+type unreadCountsCache struct {
+	sync.Mutex
+	counts     TotalUnreadCounts
+	computedAt time.Time
+	// receivedMessagesCount is the number of messages received by the
+	// messenger when the counts were computed
+	receivedMessagesCount uint64
+	valid                 bool
+}
+
+// get returns the cached counts, if they are not older than unreadCountsCacheTTL
+// and no message has been received since they were computed
+func (c *unreadCountsCache) get(now time.Time, receivedMessagesCount uint64) (TotalUnreadCounts, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	if !c.valid || c.receivedMessagesCount != receivedMessagesCount || now.Sub(c.computedAt) >= unreadCountsCacheTTL {
+		return TotalUnreadCounts{}, false
+	}
+
+	return c.counts, true
+}
+
+func (c *unreadCountsCache) set(counts TotalUnreadCounts, now time.Time, receivedMessagesCount uint64) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.counts = counts
+	c.computedAt = now
+	c.receivedMessagesCount = receivedMessagesCount
+	c.valid = true
+}
+
+// totalUnreadCounts sums the unread messages and mentions of the active, non muted chats
+func totalUnreadCounts(chats []*protocol.Chat) TotalUnreadCounts {
+	var counts TotalUnreadCounts
+	for _, chat := range chats {
+		if !chat.Active || chat.Muted {
+			continue
+		}
+		counts.Messages += int(chat.UnviewedMessagesCount)
+		counts.Mentions += int(chat.UnviewedMentionsCount)
+	}
+
+	return counts
+}
+
+// GetTotalUnreadCount returns the number of unread messages and mentions
+// across all the active, non muted chats, to be displayed in the app badge
+func (api *API) GetTotalUnreadCount(ctx context.Context) (TotalUnreadCounts, error) {
+	receivedMessagesCount := api.s.messenger.ReceivedMessagesCount()
+	now := time.Now()
+
+	if counts, ok := api.s.unreadCounts.get(now, receivedMessagesCount); ok {
+		return counts, nil
+	}
+
+	counts := totalUnreadCounts(api.s.messenger.Chats())
+	api.s.unreadCounts.set(counts, now, receivedMessagesCount)
+
+	return counts, nil
+}
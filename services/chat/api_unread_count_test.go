@@ -0,0 +1,54 @@
+package chat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol"
+)
+
+func TestTotalUnreadCounts(t *testing.T) {
+	chats := []*protocol.Chat{
+		{ID: "1", Active: true, UnviewedMessagesCount: 3, UnviewedMentionsCount: 1},
+		{ID: "2", Active: true, UnviewedMessagesCount: 5},
+		{ID: "3", Active: true, UnviewedMessagesCount: 2, UnviewedMentionsCount: 2},
+		// Muted and inactive chats are not counted
+		{ID: "muted", Active: true, Muted: true, UnviewedMessagesCount: 7, UnviewedMentionsCount: 7},
+		{ID: "inactive", UnviewedMessagesCount: 11, UnviewedMentionsCount: 11},
+	}
+
+	var expected TotalUnreadCounts
+	for _, chat := range chats[:3] {
+		expected.Messages += int(chat.UnviewedMessagesCount)
+		expected.Mentions += int(chat.UnviewedMentionsCount)
+	}
+
+	require.Equal(t, expected, totalUnreadCounts(chats))
+	require.Equal(t, TotalUnreadCounts{Messages: 10, Mentions: 3}, totalUnreadCounts(chats))
+	require.Equal(t, TotalUnreadCounts{}, totalUnreadCounts(nil))
+}
+
+func TestUnreadCountsCache(t *testing.T) {
+	var cache unreadCountsCache
+	now := time.Now()
+	counts := TotalUnreadCounts{Messages: 4, Mentions: 1}
+
+	_, ok := cache.get(now, 0)
+	require.False(t, ok)
+
+	cache.set(counts, now, 10)
+
+	cached, ok := cache.get(now.Add(500*time.Millisecond), 10)
+	require.True(t, ok)
+	require.Equal(t, counts, cached)
+
+	// Expired
+	_, ok = cache.get(now.Add(unreadCountsCacheTTL), 10)
+	require.False(t, ok)
+
+	// Invalidated by an incoming message
+	_, ok = cache.get(now, 11)
+	require.False(t, ok)
+}
@@ -17,6 +17,8 @@ func NewService(accountsDB *accounts.Database) *Service {
 type Service struct {
 	messenger  *protocol.Messenger
 	accountsDB *accounts.Database
+
+	unreadCounts unreadCountsCache
 }
 
 func (s *Service) Init(messenger *protocol.Messenger) {
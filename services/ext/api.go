@@ -278,6 +278,18 @@ func (api *PublicAPI) Chats(parent context.Context) []*protocol.Chat {
 	return api.service.messenger.Chats()
 }
 
+func (api *PublicAPI) ChatsOrderedByLastActivity(limit int, offset int) ([]*protocol.Chat, error) {
+	return api.service.messenger.GetChatsOrderedByLastActivity(limit, offset)
+}
+
+func (api *PublicAPI) DirectMessageChats(parent context.Context) []*protocol.Chat {
+	return api.service.messenger.GetDirectMessageChats()
+}
+
+func (api *PublicAPI) DirectMessageChatWithContact(parent context.Context, contactID string) (*protocol.Chat, error) {
+	return api.service.messenger.GetDirectMessageChatWithContact(contactID)
+}
+
 func (api *PublicAPI) ChatsPreview(parent context.Context) []*protocol.ChatPreview {
 	return api.service.messenger.ChatsPreview()
 }
@@ -310,6 +322,15 @@ func (api *PublicAPI) UnmuteChat(parent context.Context, chatID string) error {
 	return api.service.messenger.UnmuteChat(chatID)
 }
 
+// SetChatNotificationLevel sets which messages of the chat we want to be notified of
+func (api *PublicAPI) SetChatNotificationLevel(ctx context.Context, chatID string, level protocol.NotificationLevel) error {
+	return api.service.messenger.SetChatNotificationLevel(ctx, chatID, level)
+}
+
+func (api *PublicAPI) GetChatNotificationLevel(chatID string) (protocol.NotificationLevel, error) {
+	return api.service.messenger.GetChatNotificationLevel(chatID)
+}
+
 func (api *PublicAPI) BlockContact(parent context.Context, contactID string) (*protocol.MessengerResponse, error) {
 	api.log.Info("blocking contact", "contact", contactID)
 	return api.service.messenger.BlockContact(contactID)
@@ -330,6 +351,11 @@ func (api *PublicAPI) Contacts(parent context.Context) []*protocol.Contact {
 	return api.service.messenger.Contacts()
 }
 
+// GetContactsWhoCanSeeMe returns the contacts our profile pictures are shown to
+func (api *PublicAPI) GetContactsWhoCanSeeMe() ([]*protocol.Contact, error) {
+	return api.service.messenger.GetContactsWhoCanSeeMe()
+}
+
 func (api *PublicAPI) GetContactByID(parent context.Context, id string) *protocol.Contact {
 	return api.service.messenger.GetContactByID(id)
 }
@@ -373,6 +399,11 @@ func (api *PublicAPI) JoinedCommunities(parent context.Context) ([]*communities.
 	return api.service.messenger.JoinedCommunities()
 }
 
+// ContactMutualCommunities returns the communities both us and the contact are members of
+func (api *PublicAPI) ContactMutualCommunities(ctx context.Context, contactPublicKey string) ([]*communities.Community, error) {
+	return api.service.messenger.GetContactMutualCommunities(ctx, contactPublicKey)
+}
+
 // CommunityTags return the list of possible community tags
 func (api *PublicAPI) CommunityTags(parent context.Context) map[string]string {
 	return requests.TagsEmojies
@@ -476,6 +507,36 @@ func (api *PublicAPI) BanUserFromCommunity(request *requests.BanUserFromCommunit
 	return api.service.messenger.BanUserFromCommunity(request)
 }
 
+// SetCommunityDNDSchedule sets the do not disturb schedule of the community, a nil schedule clears it
+func (api *PublicAPI) SetCommunityDNDSchedule(communityID types.HexBytes, schedule *communities.DNDSchedule) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetCommunityDNDSchedule(communityID, schedule)
+}
+
+// CreateCommunityWebhook registers a webhook notified of the given community events
+func (api *PublicAPI) CreateCommunityWebhook(config *communities.WebhookConfig) (*communities.WebhookConfig, error) {
+	return api.service.messenger.CreateCommunityWebhook(config)
+}
+
+// EditCommunityWebhook updates the url, secret and events of a community webhook
+func (api *PublicAPI) EditCommunityWebhook(config *communities.WebhookConfig) (*communities.WebhookConfig, error) {
+	return api.service.messenger.EditCommunityWebhook(config)
+}
+
+// DeleteCommunityWebhook removes a community webhook
+func (api *PublicAPI) DeleteCommunityWebhook(id string) error {
+	return api.service.messenger.DeleteCommunityWebhook(id)
+}
+
+// GetCommunityWebhooks returns the webhooks registered for the community
+func (api *PublicAPI) GetCommunityWebhooks(communityID types.HexBytes) ([]*communities.WebhookConfig, error) {
+	return api.service.messenger.GetCommunityWebhooks(communityID)
+}
+
+// GetMemberActivityReport returns the members that have been active in the community since the given time
+func (api *PublicAPI) GetMemberActivityReport(communityID string, since time.Time) ([]communities.MemberActivity, error) {
+	return api.service.messenger.GetMemberActivityReport(communityID, since)
+}
+
 // UnbanUserFromCommunity removes the user's pk from the community ban list
 func (api *PublicAPI) UnbanUserFromCommunity(request *requests.UnbanUserFromCommunity) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.UnbanUserFromCommunity(request)
@@ -561,6 +622,14 @@ func (api *PublicAPI) ReorderCommunityChat(request *requests.ReorderCommunityCha
 	return api.service.messenger.ReorderCommunityChat(request)
 }
 
+// ReorderCommunityChannels changes the position of several chats of a community at once
+func (api *PublicAPI) ReorderCommunityChannels(ctx context.Context, communityID types.HexBytes, channelPositions map[string]int32) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ReorderCommunityChannels(&requests.ReorderCommunityChannels{
+		CommunityID:      communityID,
+		ChannelPositions: channelPositions,
+	})
+}
+
 // EditCommunityCategory modifies a category within a particular community
 func (api *PublicAPI) EditCommunityCategory(request *requests.EditCommunityCategory) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.EditCommunityCategory(request)
@@ -606,6 +675,34 @@ func (api *PublicAPI) ChatMessages(chatID, cursor string, limit int) (*Applicati
 	}, nil
 }
 
+// ThreadMessages returns the messages of the reply thread started by `threadID`, oldest first
+func (api *PublicAPI) ThreadMessages(ctx context.Context, chatID, threadID, cursor string, limit int) (*ApplicationMessagesResponse, error) {
+	messages, cursor, err := api.service.messenger.GetThreadMessages(ctx, chatID, threadID, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationMessagesResponse{
+		Messages: messages,
+		Cursor:   cursor,
+	}, nil
+}
+
+// TopReactions returns the emojis used to react in the chat since the given unix time, most used first
+func (api *PublicAPI) TopReactions(ctx context.Context, chatID string, since int64) ([]protocol.ReactionCount, error) {
+	return api.service.messenger.GetTopReactions(ctx, chatID, time.Unix(since, 0))
+}
+
+// ReactionLeaderboard returns the members who reacted the most in the community since the given unix time
+func (api *PublicAPI) ReactionLeaderboard(ctx context.Context, communityID string, since int64, limit int) ([]protocol.ReactionLeader, error) {
+	return api.service.messenger.GetReactionLeaderboard(ctx, communityID, time.Unix(since, 0), limit)
+}
+
+// PurgeOldMessages deletes the messages of the chat sent before the given unix time, returning how many were deleted
+func (api *PublicAPI) PurgeOldMessages(ctx context.Context, chatID string, olderThan int64) (int, error) {
+	return api.service.messenger.PurgeOldMessages(ctx, chatID, time.Unix(olderThan, 0))
+}
+
 func (api *PublicAPI) MessageByMessageID(messageID string) (*common.Message, error) {
 	return api.service.messenger.MessageByID(messageID)
 }
@@ -659,6 +756,27 @@ func (api *PublicAPI) StatusUpdates() (*ApplicationStatusUpdatesResponse, error)
 	}, nil
 }
 
+func (api *PublicAPI) GetOnlineStatus(ctx context.Context, publicKeys []string) (map[string]protocol.OnlineStatus, error) {
+	return api.service.messenger.GetOnlineStatus(ctx, publicKeys)
+}
+
+type ApplicationMediaGalleryResponse struct {
+	Attachments []*protocol.MediaAttachment `json:"attachments"`
+	Cursor      string                      `json:"cursor"`
+}
+
+func (api *PublicAPI) ChatMediaGallery(ctx context.Context, chatID string, mediaType protocol.MediaType, cursor string, limit int) (*ApplicationMediaGalleryResponse, error) {
+	attachments, cursor, err := api.service.messenger.GetChatMediaGallery(ctx, chatID, mediaType, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationMediaGalleryResponse{
+		Attachments: attachments,
+		Cursor:      cursor,
+	}, nil
+}
+
 func (api *PublicAPI) UpsertSwitcherCard(request *requests.UpsertSwitcherCard) error {
 	return api.service.messenger.UpsertSwitcherCard(request)
 }
@@ -784,14 +902,37 @@ func (api *PublicAPI) EditMessage(ctx context.Context, request *requests.EditMes
 	return api.service.messenger.EditMessage(ctx, request)
 }
 
+// GetMessageEditHistory returns the versions of a message replaced by edits, oldest first
+func (api *PublicAPI) GetMessageEditHistory(ctx context.Context, messageID string) ([]*protocol.MessageEdit, error) {
+	return api.service.messenger.GetMessageEditHistory(ctx, messageID)
+}
+
 func (api *PublicAPI) DeleteMessageAndSend(ctx context.Context, messageID string) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.DeleteMessageAndSend(ctx, messageID)
 }
 
+// ReportMessage flags a community message for review by the community admins
+func (api *PublicAPI) ReportMessage(ctx context.Context, chatID string, messageID string, reason string) error {
+	return api.service.messenger.ReportMessage(ctx, chatID, messageID, reason)
+}
+
+func (api *PublicAPI) GetMessageReports(ctx context.Context, communityID string) ([]*protocol.MessageReport, error) {
+	return api.service.messenger.GetMessageReports(ctx, communityID)
+}
+
 func (api *PublicAPI) DeleteMessageForMeAndSync(ctx context.Context, chatID string, messageID string) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.DeleteMessageForMeAndSync(ctx, chatID, messageID)
 }
 
+// DeleteMessageForMe hides a message on this device only
+func (api *PublicAPI) DeleteMessageForMe(ctx context.Context, chatID string, messageID string) error {
+	return api.service.messenger.DeleteMessageForMe(ctx, chatID, messageID)
+}
+
+func (api *PublicAPI) GetMyHiddenMessages(ctx context.Context, chatID string) ([]*common.Message, error) {
+	return api.service.messenger.GetMyHiddenMessages(ctx, chatID)
+}
+
 func (api *PublicAPI) SendPinMessage(ctx context.Context, message *common.PinMessage) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.SendPinMessage(ctx, message)
 }
@@ -896,6 +1037,11 @@ func (api *PublicAPI) SyncDevices(ctx context.Context, name, picture string) err
 	return api.service.messenger.SyncDevices(ctx, name, picture, nil)
 }
 
+// SyncContactsWithInstallation sends all the contacts to a paired installation at once
+func (api *PublicAPI) SyncContactsWithInstallation(ctx context.Context, installationID string) error {
+	return api.service.messenger.SyncContactsWithInstallation(ctx, installationID)
+}
+
 func (api *PublicAPI) AddBookmark(ctx context.Context, bookmark browsers.Bookmark) error {
 	return api.service.messenger.AddBookmark(ctx, bookmark)
 }
@@ -1079,6 +1225,10 @@ func (api *PublicAPI) GetLinkPreviewData(link string) (previewData urls.LinkPrev
 	return urls.GetLinkPreviewData(link)
 }
 
+func (api *PublicAPI) UnfurlLinkPreview(ctx context.Context, link string) (*protocol.LinkPreview, error) {
+	return api.service.messenger.UnfurlLinkPreview(ctx, link)
+}
+
 func (api *PublicAPI) EnsVerified(pk, ensName string) error {
 	return api.service.messenger.ENSVerified(pk, ensName)
 }
@@ -1229,6 +1379,17 @@ func (api *PublicAPI) BackupData() (uint64, error) {
 	return api.service.messenger.BackupData(context.Background())
 }
 
+// ExportBackupData returns the backup data of the account, which can be checked
+// with ValidateBackupData
+func (api *PublicAPI) ExportBackupData(ctx context.Context) (types.HexBytes, error) {
+	return api.service.messenger.ExportBackupData(ctx)
+}
+
+// ValidateBackupData checks the integrity of backup data without restoring it
+func (api *PublicAPI) ValidateBackupData(ctx context.Context, data types.HexBytes) (*protocol.BackupValidationResult, error) {
+	return api.service.messenger.ValidateBackupData(ctx, data)
+}
+
 func (api *PublicAPI) ImageServerURL() string {
 	return api.service.messenger.ImageServerURL()
 }
@@ -1285,6 +1446,16 @@ func (api *PublicAPI) Messenger() *protocol.Messenger {
 	return api.service.messenger
 }
 
+// ExportKeystore writes the keystore files of an account to destPath, as an archive encrypted with password
+func (api *PublicAPI) ExportKeystore(ctx context.Context, keyUID string, password string, destPath string) error {
+	return api.service.messenger.ExportKeystore(ctx, keyUID, password, destPath)
+}
+
+// ImportKeystore restores the keystore files of an account from an archive written by ExportKeystore
+func (api *PublicAPI) ImportKeystore(ctx context.Context, archivePath string, password string) error {
+	return api.service.messenger.ImportKeystore(ctx, archivePath, password)
+}
+
 // -----
 // HELPER
 // -----
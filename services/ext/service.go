@@ -427,6 +427,12 @@ func buildMessengerOptions(
 		protocol.WithMessageCSV(config.OutputMessageCSVEnabled),
 	}
 
+	keystoreDir := config.KeyStoreDir
+	if account != nil && filepath.Base(keystoreDir) == account.KeyUID {
+		keystoreDir = filepath.Dir(keystoreDir)
+	}
+	options = append(options, protocol.WithKeystoreDir(keystoreDir))
+
 	if config.ShhextConfig.DataSyncEnabled {
 		options = append(options, protocol.WithDatasync())
 	}
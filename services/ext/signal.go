@@ -1,6 +1,8 @@
 package ext
 
 import (
+	"time"
+
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol"
 	"github.com/status-im/status-go/protocol/communities"
@@ -69,6 +71,11 @@ func (m MessengerSignalsHandler) CommunityInfoFound(community *communities.Commu
 	signal.SendCommunityInfoFound(community)
 }
 
+// CommunityDescriptionDiff passes the changes of a newer community description
+func (m MessengerSignalsHandler) CommunityDescriptionDiff(communityID string, diff communities.CommunityDiff) {
+	signal.SendCommunityDescriptionDiff(communityID, diff)
+}
+
 func (m *MessengerSignalsHandler) MessengerResponse(response *protocol.MessengerResponse) {
 	PublisherSignalHandler{}.NewMessages(response)
 }
@@ -160,3 +167,15 @@ func (m *MessengerSignalsHandler) SendWakuBackedUpSettings(response *wakusync.Wa
 func (m *MessengerSignalsHandler) SendWakuBackedUpKeycards(response *wakusync.WakuBackedUpDataResponse) {
 	signal.SendWakuBackedUpKeycards(response)
 }
+
+func (m *MessengerSignalsHandler) SendBackupRestorationProgress(response *wakusync.WakuBackedUpDataResponse) {
+	signal.SendBackupRestorationProgress(response)
+}
+
+func (m *MessengerSignalsHandler) ContactSyncProgress(progress *protocol.ContactSyncProgress) {
+	signal.SendContactSyncProgress(progress)
+}
+
+func (m *MessengerSignalsHandler) TypingIndicator(chatID string, publicKey string, typing bool, ttl time.Duration) {
+	signal.SendTypingIndicator(chatID, publicKey, typing, ttl)
+}
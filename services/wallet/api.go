@@ -329,7 +329,11 @@ func (api *API) GetOpenseaAssetsByNFTUniqueID(ctx context.Context, chainID uint6
 
 func (api *API) AddEthereumChain(ctx context.Context, network params.Network) error {
 	log.Debug("call to AddEthereumChain")
-	return api.s.rpcClient.NetworkManager.Upsert(&network)
+	err := api.s.rpcClient.NetworkManager.Upsert(&network)
+	if err != nil {
+		return err
+	}
+	return api.s.rpcClient.NetworkManager.RefreshNetworks()
 }
 
 func (api *API) DeleteEthereumChain(ctx context.Context, chainID uint64) error {
@@ -1,5 +1,7 @@
 package signal
 
+import "time"
+
 const (
 	// EventMediaServerStarted triggers when the media server successfully binds a new port
 	EventMediaServerStarted = "mediaserver.started"
@@ -11,8 +13,18 @@ const (
 	// retrieved it from mailserver
 	EventCommunityInfoFound = "community.found"
 
+	// EventCommunityDescriptionDiff triggered when a newer description of a known community
+	// is received, carrying only what changed
+	EventCommunityDescriptionDiff = "community.descriptionDiff"
+
 	// EventStatusUpdatesTimedOut Event Automatic Status Updates Timed out
 	EventStatusUpdatesTimedOut = "status.updates.timedout"
+
+	// EventTypingIndicator triggered when a member starts or stops typing in a chat
+	EventTypingIndicator = "messages.typing"
+
+	// EventContactSyncProgress triggered each time a contact is synced with an installation
+	EventContactSyncProgress = "contacts.sync.progress"
 )
 
 // MessageDeliveredSignal specifies chat and message that was delivered
@@ -21,6 +33,21 @@ type MessageDeliveredSignal struct {
 	MessageID string `json:"messageID"`
 }
 
+// CommunityDescriptionDiffSignal specifies what changed in a community description
+type CommunityDescriptionDiffSignal struct {
+	CommunityID string      `json:"communityId"`
+	Diff        interface{} `json:"diff"`
+}
+
+// TypingIndicatorSignal specifies who is typing in a chat
+type TypingIndicatorSignal struct {
+	ChatID    string `json:"chatId"`
+	PublicKey string `json:"publicKey"`
+	Typing    bool   `json:"typing"`
+	// TTL is how long in ms the indicator is shown for, unless refreshed
+	TTL int64 `json:"ttl"`
+}
+
 // MediaServerStarted specifies chat and message that was delivered
 type MediaServerStarted struct {
 	Port int `json:"port"`
@@ -49,6 +76,21 @@ func SendCommunityInfoFound(community interface{}) {
 	send(EventCommunityInfoFound, community)
 }
 
+// SendCommunityDescriptionDiff notifies about the changes in a community description
+func SendCommunityDescriptionDiff(communityID string, diff interface{}) {
+	send(EventCommunityDescriptionDiff, CommunityDescriptionDiffSignal{CommunityID: communityID, Diff: diff})
+}
+
+// SendTypingIndicator notifies that a member started or stopped typing in a chat
+func SendTypingIndicator(chatID string, publicKey string, typing bool, ttl time.Duration) {
+	send(EventTypingIndicator, TypingIndicatorSignal{ChatID: chatID, PublicKey: publicKey, Typing: typing, TTL: ttl.Milliseconds()})
+}
+
+// SendContactSyncProgress notifies about the progress of a contacts sync with an installation
+func SendContactSyncProgress(progress interface{}) {
+	send(EventContactSyncProgress, progress)
+}
+
 func SendStatusUpdatesTimedOut(statusUpdates interface{}) {
 	send(EventStatusUpdatesTimedOut, statusUpdates)
 }
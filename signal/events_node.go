@@ -19,6 +19,9 @@ const (
 
 	// EventLoggedIn is once node was injected with user account and ready to be used.
 	EventLoggedIn = "node.login"
+
+	// EventAccountDeleted is triggered once an account and its data have been deleted
+	EventAccountDeleted = "account.deleted"
 )
 
 // NodeCrashEvent is special kind of error, used to report node crashes
@@ -31,6 +34,11 @@ type NodeLoginEvent struct {
 	Error string `json:"error,omitempty"`
 }
 
+// AccountDeletedEvent identifies the deleted account
+type AccountDeletedEvent struct {
+	KeyUID string `json:"keyUid"`
+}
+
 // SendNodeCrashed emits a signal when status node has crashed, and
 // provides error description.
 func SendNodeCrashed(err error) {
@@ -69,3 +77,9 @@ func SendLoggedIn(err error) {
 	}
 	send(EventLoggedIn, event)
 }
+
+// SendAccountDeleted emits a signal when an account and its data have been
+// deleted.
+func SendAccountDeleted(keyUID string) {
+	send(EventAccountDeleted, AccountDeletedEvent{KeyUID: keyUID})
+}
@@ -14,6 +14,9 @@ const (
 
 	// EventWakuBackedUpKeycards is emitted while applying fetched keycard data from waku
 	EventWakuBackedUpKeycards = "waku.backedup.keycards"
+
+	// EventBackupRestorationProgress is emitted each time a batch of fetched data has been applied
+	EventBackupRestorationProgress = "waku.backup.restoration.progress"
 )
 
 func SendWakuFetchingBackupProgress(obj json.Marshaler) {
@@ -31,3 +34,7 @@ func SendWakuBackedUpSettings(obj json.Marshaler) {
 func SendWakuBackedUpKeycards(obj json.Marshaler) {
 	send(EventWakuBackedUpKeycards, obj)
 }
+
+func SendBackupRestorationProgress(obj json.Marshaler) {
+	send(EventBackupRestorationProgress, obj)
+}
@@ -1,11 +1,7 @@
 package telemetry
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"net/http"
-	"time"
 
 	"go.uber.org/zap"
 
@@ -15,29 +11,36 @@ import (
 )
 
 type Client struct {
-	serverURL  string
-	httpClient *http.Client
-	logger     *zap.Logger
-	keyUID     string
-	nodeName   string
+	serverURL                string
+	logger                   *zap.Logger
+	keyUID                   string
+	nodeName                 string
+	receivedMessagesReporter *TelemetryReporter
 }
 
 func NewClient(logger *zap.Logger, serverURL string, keyUID string, nodeName string) *Client {
 	return &Client{
-		serverURL:  serverURL,
-		httpClient: &http.Client{Timeout: time.Minute},
-		logger:     logger,
-		keyUID:     keyUID,
-		nodeName:   nodeName,
+		serverURL:                serverURL,
+		logger:                   logger,
+		keyUID:                   keyUID,
+		nodeName:                 nodeName,
+		receivedMessagesReporter: NewTelemetryReporter(logger, fmt.Sprintf("%s/received-messages", serverURL), DefaultReporterBufferSize),
 	}
 }
 
+// Start starts sending the pushed events to the telemetry server
+func (c *Client) Start() {
+	c.receivedMessagesReporter.Start()
+}
+
+func (c *Client) Stop() {
+	c.receivedMessagesReporter.Stop()
+}
+
 func (c *Client) PushReceivedMessages(filter transport.Filter, sshMessage *types.Message, messages []*v1protocol.StatusMessage) {
 	c.logger.Debug("Pushing received messages to telemetry server")
-	url := fmt.Sprintf("%s/received-messages", c.serverURL)
-	var postBody []map[string]interface{}
 	for _, message := range messages {
-		postBody = append(postBody, map[string]interface{}{
+		c.receivedMessagesReporter.Push(map[string]interface{}{
 			"chatId":         filter.ChatID,
 			"messageHash":    types.EncodeHex(sshMessage.Hash),
 			"messageId":      message.ID,
@@ -49,9 +52,4 @@ func (c *Client) PushReceivedMessages(filter transport.Filter, sshMessage *types
 			"messageSize":    len(sshMessage.Payload),
 		})
 	}
-	body, _ := json.Marshal(postBody)
-	_, err := c.httpClient.Post(url, "application/json", bytes.NewBuffer(body))
-	if err != nil {
-		c.logger.Error("Error sending message to telemetry server", zap.Error(err))
-	}
 }
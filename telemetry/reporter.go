@@ -0,0 +1,207 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultReporterBufferSize is the default number of events kept in
+	// memory until they are sent
+	DefaultReporterBufferSize = 1000
+
+	// reporterBatchSize is the maximum number of events sent at once
+	reporterBatchSize = 50
+
+	defaultReporterFlushInterval        = 10 * time.Second
+	defaultReporterRetryInitialInterval = time.Second
+	defaultReporterRetryMaxInterval     = time.Minute
+)
+
+// TelemetryReporter accumulates events and posts them in batches to a
+// telemetry server endpoint, retrying the batches which failed to be sent.
+// Once its buffer is full, the oldest events are dropped.
+type TelemetryReporter struct {
+	url        string
+	httpClient *http.Client
+	logger     *zap.Logger
+
+	flushInterval        time.Duration
+	retryInitialInterval time.Duration
+	retryMaxInterval     time.Duration
+
+	mu     sync.Mutex
+	buffer []interface{}
+	head   int
+	count  int
+
+	batchReady chan struct{}
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// NewTelemetryReporter creates a reporter posting events to url, keeping at
+// most bufferSize events in memory.
+func NewTelemetryReporter(logger *zap.Logger, url string, bufferSize int) *TelemetryReporter {
+	if bufferSize < 1 {
+		bufferSize = DefaultReporterBufferSize
+	}
+
+	return &TelemetryReporter{
+		url:                  url,
+		httpClient:           &http.Client{Timeout: time.Minute},
+		logger:               logger.Named("telemetry-reporter"),
+		flushInterval:        defaultReporterFlushInterval,
+		retryInitialInterval: defaultReporterRetryInitialInterval,
+		retryMaxInterval:     defaultReporterRetryMaxInterval,
+		buffer:               make([]interface{}, bufferSize),
+		batchReady:           make(chan struct{}, 1),
+	}
+}
+
+// Push adds an event to the ones to send, dropping the oldest one if the
+// buffer is full
+func (r *TelemetryReporter) Push(event interface{}) {
+	r.mu.Lock()
+	if r.count == len(r.buffer) {
+		r.buffer[r.head] = nil
+		r.head = (r.head + 1) % len(r.buffer)
+		r.count--
+		r.logger.Debug("telemetry buffer full, dropping oldest event")
+	}
+	r.buffer[(r.head+r.count)%len(r.buffer)] = event
+	r.count++
+	full := r.count >= reporterBatchSize
+	r.mu.Unlock()
+
+	if full {
+		select {
+		case r.batchReady <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Start starts sending events, the reporter can be started again once stopped
+func (r *TelemetryReporter) Start() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go r.loop(ctx)
+}
+
+// Stop stops sending events. The batch being sent is dropped, the other
+// pending events are sent once the reporter is started again
+func (r *TelemetryReporter) Stop() {
+	r.mu.Lock()
+	cancel := r.cancel
+	r.cancel = nil
+	r.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	r.wg.Wait()
+}
+
+func (r *TelemetryReporter) loop(ctx context.Context) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-r.batchReady:
+		case <-ctx.Done():
+			return
+		}
+
+		for batch := r.nextBatch(); len(batch) > 0; batch = r.nextBatch() {
+			err := r.sendWithRetry(ctx, batch)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				r.logger.Warn("dropping telemetry batch", zap.Int("events", len(batch)), zap.Error(err))
+			}
+		}
+	}
+}
+
+// nextBatch removes and returns the oldest events, up to reporterBatchSize
+func (r *TelemetryReporter) nextBatch() []interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := r.count
+	if n > reporterBatchSize {
+		n = reporterBatchSize
+	}
+
+	batch := make([]interface{}, n)
+	for i := range batch {
+		batch[i] = r.buffer[r.head]
+		r.buffer[r.head] = nil
+		r.head = (r.head + 1) % len(r.buffer)
+	}
+	r.count -= n
+
+	return batch
+}
+
+// sendWithRetry sends a batch until it succeeds or the reporter is stopped
+func (r *TelemetryReporter) sendWithRetry(ctx context.Context, batch []interface{}) error {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = r.retryInitialInterval
+	b.MaxInterval = r.retryMaxInterval
+	b.MaxElapsedTime = 0
+
+	return backoff.Retry(func() error {
+		err := r.send(ctx, batch)
+		if err != nil {
+			r.logger.Debug("failed to send telemetry batch", zap.Error(err))
+		}
+		return err
+	}, backoff.WithContext(b, ctx))
+}
+
+func (r *TelemetryReporter) send(ctx context.Context, batch []interface{}) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return backoff.Permanent(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewBuffer(body))
+	if err != nil {
+		return backoff.Permanent(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("telemetry server responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
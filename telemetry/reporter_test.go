@@ -0,0 +1,105 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/tt"
+)
+
+func TestTelemetryReporterRetriesFailedBatches(t *testing.T) {
+	var mu sync.Mutex
+	var requests int
+	var received []float64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		requests++
+		if requests <= 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var batch []float64
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+		require.LessOrEqual(t, len(batch), reporterBatchSize)
+		received = append(received, batch...)
+	}))
+	defer server.Close()
+
+	reporter := NewTelemetryReporter(tt.MustCreateTestLogger(), server.URL, DefaultReporterBufferSize)
+	reporter.flushInterval = 10 * time.Millisecond
+	reporter.retryInitialInterval = 10 * time.Millisecond
+	reporter.Start()
+	defer reporter.Stop()
+
+	const eventsCount = 120
+	for i := 0; i < eventsCount; i++ {
+		reporter.Push(i)
+	}
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == eventsCount
+	}, 5*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	for i, event := range received {
+		require.Equal(t, float64(i), event)
+	}
+}
+
+func TestTelemetryReporterDropsOldestEvents(t *testing.T) {
+	reporter := NewTelemetryReporter(tt.MustCreateTestLogger(), "", 3)
+	for i := 0; i < 5; i++ {
+		reporter.Push(i)
+	}
+
+	require.Equal(t, []interface{}{2, 3, 4}, reporter.nextBatch())
+	require.Empty(t, reporter.nextBatch())
+}
+
+func TestTelemetryReporterRestart(t *testing.T) {
+	var mu sync.Mutex
+	var received []float64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var batch []float64
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, batch...)
+	}))
+	defer server.Close()
+
+	reporter := NewTelemetryReporter(tt.MustCreateTestLogger(), server.URL, DefaultReporterBufferSize)
+	reporter.flushInterval = 10 * time.Millisecond
+
+	// Stopping a reporter which was never started is a no-op
+	reporter.Stop()
+
+	reporter.Start()
+	reporter.Stop()
+
+	// Events pushed while stopped are sent once the reporter is started again
+	reporter.Push(1)
+	reporter.Start()
+	defer reporter.Stop()
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -179,6 +179,8 @@ type NewMessage struct {
 	Padding    []byte           `json:"padding"`
 	TargetPeer string           `json:"targetPeer"`
 	Ephemeral  bool             `json:"ephemeral"`
+	// CommunityID is the ID of the community the message is sent to, if any
+	CommunityID string `json:"communityID,omitempty"`
 }
 
 // Post posts a message on the Waku network.
@@ -257,7 +259,7 @@ func (api *PublicWakuAPI) Post(ctx context.Context, req NewMessage) (hexutil.Byt
 		Ephemeral:    req.Ephemeral,
 	}
 
-	hash, err := api.w.Send(wakuMsg)
+	hash, err := api.w.SendToCommunity(wakuMsg, req.CommunityID)
 
 	if err != nil {
 		return nil, err
@@ -19,6 +19,8 @@
 package wakuv2
 
 import (
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+
 	"github.com/status-im/status-go/wakuv2/common"
 )
 
@@ -43,6 +45,14 @@ type Config struct {
 	StoreCapacity        int      `toml:",omitempty"`
 	StoreSeconds         int      `toml:",omitempty"`
 	TelemetryServerURL   string   `toml:",omitempty"`
+	CommunityShards      int      `toml:",omitempty"`
+	// GossipSubPeerScoreParams enables the scoring of relay peers, nil keeps the gossipsub defaults
+	GossipSubPeerScoreParams *pubsub.PeerScoreParams `toml:"-" json:"-"`
+	// GossipSubPeerScoreThresholds are the scores below which peers are penalized,
+	// DefaultGossipSubPeerScoreThresholds is used when nil
+	GossipSubPeerScoreThresholds *pubsub.PeerScoreThresholds `toml:"-" json:"-"`
+	// MessageVerifier, when set, drops the relayed messages it returns an error for
+	MessageVerifier MessageVerifier `toml:"-" json:"-"`
 }
 
 var DefaultConfig = Config{
@@ -55,6 +65,16 @@ var DefaultConfig = Config{
 	AutoUpdate:        false,
 }
 
+// DefaultGossipSubPeerScoreThresholds are the thresholds used when peer scoring is enabled without
+// providing any
+var DefaultGossipSubPeerScoreThresholds = pubsub.PeerScoreThresholds{
+	GossipThreshold:             -4000,
+	PublishThreshold:            -8000,
+	GraylistThreshold:           -16000,
+	AcceptPXThreshold:           100,
+	OpportunisticGraftThreshold: 5,
+}
+
 func setDefaults(cfg *Config) *Config {
 	if cfg == nil {
 		cfg = new(Config)
@@ -0,0 +1,157 @@
+package wakuv2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/waku-org/go-waku/waku/v2/protocol/store"
+)
+
+var ErrDebugServerRunning = errors.New("debug server is already running")
+
+type debugStoreInfo struct {
+	Peers     []string `json:"peers"`
+	Envelopes int      `json:"envelopes"`
+}
+
+type debugDiscV5Info struct {
+	Enabled        bool     `json:"enabled"`
+	Started        bool     `json:"started"`
+	ENR            string   `json:"enr"`
+	BootstrapNodes []string `json:"bootstrapNodes"`
+}
+
+// EnableDebugServer starts an HTTP server on the given port of the loopback
+// interface exposing the internal state of the node as JSON. The server is
+// stopped with the node.
+func (w *Waku) EnableDebugServer(port int) error {
+	w.debugServerMu.Lock()
+	defer w.debugServerMu.Unlock()
+
+	if w.debugServer != nil {
+		return ErrDebugServerRunning
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/peers", w.debugHandler(w.debugPeers))
+	mux.HandleFunc("/debug/topics", w.debugHandler(w.debugTopics))
+	mux.HandleFunc("/debug/store", w.debugHandler(w.debugStore))
+	mux.HandleFunc("/debug/gossip", w.debugHandler(w.debugGossip))
+	mux.HandleFunc("/debug/discv5/table", w.debugHandler(w.debugDiscV5))
+
+	w.debugServer = &http.Server{
+		ReadHeaderTimeout: 5 * time.Second,
+		Handler:           mux,
+	}
+
+	go func(server *http.Server) {
+		err := server.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			w.logger.Error("debug server stopped", zap.Error(err))
+		}
+	}(w.debugServer)
+
+	w.logger.Info("debug server started", zap.Int("port", port))
+	return nil
+}
+
+func (w *Waku) stopDebugServer() {
+	w.debugServerMu.Lock()
+	defer w.debugServerMu.Unlock()
+
+	if w.debugServer == nil {
+		return
+	}
+
+	if err := w.debugServer.Close(); err != nil {
+		w.logger.Warn("could not stop debug server", zap.Error(err))
+	}
+	w.debugServer = nil
+}
+
+func (w *Waku) debugHandler(fn func() interface{}) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(rw).Encode(fn()); err != nil {
+			w.logger.Warn("could not write debug response", zap.String("path", r.URL.Path), zap.Error(err))
+		}
+	}
+}
+
+func (w *Waku) debugPeers() interface{} {
+	return w.Peers()
+}
+
+func (w *Waku) debugTopics() interface{} {
+	topics := []string{}
+	if w.node.Relay() != nil {
+		topics = append(topics, w.node.Relay().Topics()...)
+	}
+	return topics
+}
+
+func (w *Waku) debugStore() interface{} {
+	info := debugStoreInfo{Peers: []string{}}
+	for peerID, p := range w.Peers() {
+		for _, protocol := range p.Protocols {
+			if protocol == store.StoreID_v20beta4 {
+				info.Peers = append(info.Peers, peerID)
+				break
+			}
+		}
+	}
+
+	w.poolMu.RLock()
+	info.Envelopes = len(w.envelopes)
+	w.poolMu.RUnlock()
+
+	return info
+}
+
+// debugGossip returns the peers in the gossipsub mesh of each relay topic
+func (w *Waku) debugGossip() interface{} {
+	gossip := make(map[string][]string)
+	if w.node.Relay() == nil {
+		return gossip
+	}
+
+	ps := w.node.Relay().PubSub()
+	for _, topic := range w.node.Relay().Topics() {
+		peers := []string{}
+		for _, peerID := range ps.ListPeers(topic) {
+			peers = append(peers, peerID.Pretty())
+		}
+		gossip[topic] = peers
+	}
+	return gossip
+}
+
+// debugDiscV5 returns the state of discv5. The routing table itself is not
+// exposed by go-waku, so only the local record and bootstrap nodes are returned.
+func (w *Waku) debugDiscV5() interface{} {
+	info := debugDiscV5Info{BootstrapNodes: w.discV5BootstrapNodes}
+	if info.BootstrapNodes == nil {
+		info.BootstrapNodes = []string{}
+	}
+
+	discV5 := w.node.DiscV5()
+	if discV5 == nil {
+		return info
+	}
+
+	info.Enabled = true
+	info.Started = discV5.IsStarted()
+	info.ENR = discV5.Node().String()
+	return info
+}
@@ -0,0 +1,80 @@
+package wakuv2
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
+)
+
+var ErrMessageNotSigned = errors.New("message not signed")
+var ErrInvalidMessageSignature = errors.New("invalid message signature")
+
+// MessageVerifier checks a relayed message, messages for which it returns an
+// error are neither delivered nor forwarded to other peers
+type MessageVerifier func(msg *pb.WakuMessage) error
+
+// messageSigningHash is the hash of the fields of a message covered by its signature
+func messageSigningHash(msg *pb.WakuMessage) []byte {
+	var version [4]byte
+	binary.BigEndian.PutUint32(version[:], msg.Version)
+	var timestamp [8]byte
+	binary.BigEndian.PutUint64(timestamp[:], uint64(msg.Timestamp))
+
+	return crypto.Keccak256(msg.Payload, []byte(msg.ContentTopic), version[:], timestamp[:])
+}
+
+// SignMessage stores in the meta field of a message the signature of its
+// payload, content topic, version and timestamp
+func SignMessage(msg *pb.WakuMessage, key *ecdsa.PrivateKey) error {
+	signature, err := crypto.Sign(messageSigningHash(msg), key)
+	if err != nil {
+		return err
+	}
+
+	msg.Meta = signature
+	return nil
+}
+
+// ECDSAMessageVerifier only accepts messages signed with SignMessage by the
+// private key of `pubKey`
+func ECDSAMessageVerifier(pubKey *ecdsa.PublicKey) MessageVerifier {
+	return func(msg *pb.WakuMessage) error {
+		if len(msg.Meta) == 0 {
+			return ErrMessageNotSigned
+		}
+
+		signer, err := crypto.SigToPub(messageSigningHash(msg), msg.Meta)
+		if err != nil || !signer.Equal(pubKey) {
+			return ErrInvalidMessageSignature
+		}
+
+		return nil
+	}
+}
+
+// validateMessage is a pubsub validator running the message verifier on the
+// relayed messages
+func (w *Waku) validateMessage(ctx context.Context, from peer.ID, msg *pubsub.Message) bool {
+	wakuMessage := &pb.WakuMessage{}
+	err := proto.Unmarshal(msg.Data, wakuMessage)
+	if err == nil {
+		err = w.messageVerifier(wakuMessage)
+	}
+
+	if err != nil {
+		w.logger.Debug("dropping message", zap.Stringer("from", from), zap.Error(err))
+		w.recordRelayRejected(msg.GetTopic())
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,147 @@
+package wakuv2
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// peerBlacklist is a libp2p connection gater refusing any connection with
+// the peers it holds
+type peerBlacklist struct {
+	sync.RWMutex
+	peers map[peer.ID]string
+}
+
+func newPeerBlacklist() *peerBlacklist {
+	return &peerBlacklist{peers: make(map[peer.ID]string)}
+}
+
+func (b *peerBlacklist) add(id peer.ID, reason string) {
+	b.Lock()
+	defer b.Unlock()
+	b.peers[id] = reason
+}
+
+func (b *peerBlacklist) remove(id peer.ID) {
+	b.Lock()
+	defer b.Unlock()
+	delete(b.peers, id)
+}
+
+func (b *peerBlacklist) contains(id peer.ID) bool {
+	b.RLock()
+	defer b.RUnlock()
+	_, ok := b.peers[id]
+	return ok
+}
+
+func (b *peerBlacklist) InterceptPeerDial(id peer.ID) bool {
+	return !b.contains(id)
+}
+
+func (b *peerBlacklist) InterceptAddrDial(id peer.ID, _ multiaddr.Multiaddr) bool {
+	return !b.contains(id)
+}
+
+func (b *peerBlacklist) InterceptAccept(network.ConnMultiaddrs) bool {
+	// The peer ID is only known once the connection is secured
+	return true
+}
+
+func (b *peerBlacklist) InterceptSecured(_ network.Direction, id peer.ID, _ network.ConnMultiaddrs) bool {
+	return !b.contains(id)
+}
+
+func (b *peerBlacklist) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
+
+// BlacklistPeer refuses any connection with a peer, closing the existing
+// ones. The blacklist is persisted, so the peer stays blacklisted across
+// restarts until UnblacklistPeer is called.
+func (w *Waku) BlacklistPeer(id peer.ID, reason string) error {
+	if err := id.Validate(); err != nil {
+		return err
+	}
+
+	if w.appDB != nil {
+		_, err := w.appDB.Exec(`INSERT OR REPLACE INTO blacklisted_peers (peer_id, reason, blacklisted_at) VALUES (?, ?, ?)`, id.String(), reason, time.Now().Unix())
+		if err != nil {
+			return err
+		}
+	}
+
+	w.peerBlacklist.add(id, reason)
+	return w.node.Host().Network().ClosePeer(id)
+}
+
+// UnblacklistPeer allows connections with a peer blacklisted with BlacklistPeer again
+func (w *Waku) UnblacklistPeer(id peer.ID) error {
+	if w.appDB != nil {
+		_, err := w.appDB.Exec(`DELETE FROM blacklisted_peers WHERE peer_id = ?`, id.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	w.peerBlacklist.remove(id)
+	return nil
+}
+
+// BlacklistedPeers returns the blacklisted peers with the reason they were blacklisted for
+func (w *Waku) BlacklistedPeers() map[peer.ID]string {
+	w.peerBlacklist.RLock()
+	defer w.peerBlacklist.RUnlock()
+
+	result := make(map[peer.ID]string, len(w.peerBlacklist.peers))
+	for id, reason := range w.peerBlacklist.peers {
+		result[id] = reason
+	}
+	return result
+}
+
+// loadPeerBlacklist applies the persisted blacklist, closing the connections
+// established with blacklisted peers before it was loaded
+func (w *Waku) loadPeerBlacklist() error {
+	if w.appDB == nil {
+		return nil
+	}
+
+	rows, err := w.appDB.Query(`SELECT peer_id, reason FROM blacklisted_peers`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var ids []peer.ID
+	for rows.Next() {
+		var rawID, reason string
+		if err := rows.Scan(&rawID, &reason); err != nil {
+			return err
+		}
+
+		id, err := peer.Decode(rawID)
+		if err != nil {
+			return err
+		}
+
+		w.peerBlacklist.add(id, reason)
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := w.node.Host().Network().ClosePeer(id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
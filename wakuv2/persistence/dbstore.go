@@ -20,6 +20,22 @@ import (
 )
 
 var ErrInvalidCursor = errors.New("invalid cursor")
+var ErrInvalidRetentionPolicy = errors.New("invalid retention policy")
+
+// RetentionPolicy limits the messages kept in the store for a content topic.
+// A zero MaxMessages or MaxAge means no limit.
+type RetentionPolicy struct {
+	ContentTopic string
+	MaxMessages  int
+	MaxAge       time.Duration
+}
+
+// RetentionStats reports the effect of the retention policy of a content topic
+type RetentionStats struct {
+	Messages        int       `json:"messages"`
+	DeletedMessages int64     `json:"deletedMessages"`
+	LastCleanup     time.Time `json:"lastCleanup"`
+}
 
 // DBStore is a MessageProvider that has a *sql.DB connection
 type DBStore struct {
@@ -29,6 +45,10 @@ type DBStore struct {
 	maxMessages int
 	maxDuration time.Duration
 
+	retentionPolicies map[string]RetentionPolicy
+	retentionStats    map[string]RetentionStats
+	retentionMu       sync.RWMutex // guards retentionPolicies and retentionStats
+
 	wg     sync.WaitGroup
 	cancel context.CancelFunc
 }
@@ -60,6 +80,8 @@ func WithRetentionPolicy(maxMessages int, maxDuration time.Duration) DBOption {
 func NewDBStore(log *zap.Logger, options ...DBOption) (*DBStore, error) {
 	result := new(DBStore)
 	result.log = log.Named("dbstore")
+	result.retentionPolicies = make(map[string]RetentionPolicy)
+	result.retentionStats = make(map[string]RetentionStats)
 
 	for _, opt := range options {
 		err := opt(result)
@@ -114,6 +136,91 @@ func (d *DBStore) cleanOlderRecords() error {
 		d.log.Debug("deleting excess records from the DB", zap.Duration("duration", elapsed))
 	}
 
+	return d.applyRetentionPolicies()
+}
+
+// SetRetentionPolicy sets the retention policy of the messages with the
+// given content topic, enforced along with the global one on each cleanup.
+func (d *DBStore) SetRetentionPolicy(topic string, policy RetentionPolicy) error {
+	if topic == "" || policy.MaxMessages < 0 || policy.MaxAge < 0 {
+		return ErrInvalidRetentionPolicy
+	}
+	policy.ContentTopic = topic
+
+	d.retentionMu.Lock()
+	defer d.retentionMu.Unlock()
+
+	d.retentionPolicies[topic] = policy
+	return nil
+}
+
+// GetRetentionStats returns the retention stats of the content topics
+// having a retention policy
+func (d *DBStore) GetRetentionStats() map[string]RetentionStats {
+	d.retentionMu.RLock()
+	defer d.retentionMu.RUnlock()
+
+	result := make(map[string]RetentionStats, len(d.retentionStats))
+	for topic, stats := range d.retentionStats {
+		result[topic] = stats
+	}
+	return result
+}
+
+func (d *DBStore) applyRetentionPolicies() error {
+	d.retentionMu.RLock()
+	policies := make([]RetentionPolicy, 0, len(d.retentionPolicies))
+	for _, policy := range d.retentionPolicies {
+		policies = append(policies, policy)
+	}
+	d.retentionMu.RUnlock()
+
+	for _, policy := range policies {
+		var deleted int64
+
+		if policy.MaxAge > 0 {
+			result, err := d.db.Exec(`DELETE FROM store_messages WHERE contentTopic = ? AND receiverTimestamp < ?`,
+				policy.ContentTopic, utils.GetUnixEpochFrom(time.Now().Add(-policy.MaxAge)))
+			if err != nil {
+				return err
+			}
+			rows, err := result.RowsAffected()
+			if err != nil {
+				return err
+			}
+			deleted += rows
+		}
+
+		if policy.MaxMessages > 0 {
+			result, err := d.db.Exec(`DELETE FROM store_messages WHERE id IN (SELECT id FROM store_messages WHERE contentTopic = ? ORDER BY receiverTimestamp DESC LIMIT -1 OFFSET ?)`,
+				policy.ContentTopic, policy.MaxMessages)
+			if err != nil {
+				return err
+			}
+			rows, err := result.RowsAffected()
+			if err != nil {
+				return err
+			}
+			deleted += rows
+		}
+
+		var count int
+		err := d.db.QueryRow(`SELECT COUNT(*) FROM store_messages WHERE contentTopic = ?`, policy.ContentTopic).Scan(&count)
+		if err != nil {
+			return err
+		}
+
+		d.retentionMu.Lock()
+		stats := d.retentionStats[policy.ContentTopic]
+		stats.Messages = count
+		stats.DeletedMessages += deleted
+		stats.LastCleanup = time.Now()
+		d.retentionStats[policy.ContentTopic] = stats
+		d.retentionMu.Unlock()
+
+		d.log.Debug("applied retention policy", zap.String("contentTopic", policy.ContentTopic), zap.Int64("deleted", deleted))
+	}
+
 	return nil
 }
 
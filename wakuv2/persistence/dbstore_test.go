@@ -0,0 +1,81 @@
+package persistence
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/waku-org/go-waku/waku/v2/protocol"
+	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
+	"github.com/waku-org/go-waku/waku/v2/protocol/relay"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/sqlite"
+)
+
+func newTestDBStore(t *testing.T) *DBStore {
+	tmpfile, err := ioutil.TempFile("", "dbstore-tests-")
+	require.NoError(t, err)
+	db, err := appdatabase.InitializeDB(tmpfile.Name(), "dbstore-tests", sqlite.ReducedKDFIterationsNumber)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.Remove(tmpfile.Name()))
+	})
+
+	store, err := NewDBStore(zap.NewNop(), WithDB(db))
+	require.NoError(t, err)
+	return store
+}
+
+func putMessages(t *testing.T, store *DBStore, contentTopic string, count int) {
+	now := time.Now().UnixNano()
+	for i := 0; i < count; i++ {
+		msg := &pb.WakuMessage{
+			Payload:      []byte{byte(i), byte(i >> 8)},
+			ContentTopic: contentTopic,
+			Timestamp:    now + int64(i),
+		}
+		require.NoError(t, store.Put(protocol.NewEnvelope(msg, now+int64(i), relay.DefaultWakuTopic)))
+	}
+}
+
+func TestRetentionPolicyMaxMessages(t *testing.T) {
+	store := newTestDBStore(t)
+
+	require.Equal(t, ErrInvalidRetentionPolicy, store.SetRetentionPolicy("", RetentionPolicy{MaxMessages: 100}))
+	require.Equal(t, ErrInvalidRetentionPolicy, store.SetRetentionPolicy("/test/1/limited/proto", RetentionPolicy{MaxMessages: -1}))
+	require.NoError(t, store.SetRetentionPolicy("/test/1/limited/proto", RetentionPolicy{MaxMessages: 100}))
+
+	putMessages(t, store, "/test/1/limited/proto", 200)
+	putMessages(t, store, "/test/1/other/proto", 150)
+
+	require.NoError(t, store.cleanOlderRecords())
+
+	count, err := store.Count()
+	require.NoError(t, err)
+	require.Equal(t, 250, count)
+
+	stats := store.GetRetentionStats()
+	require.Len(t, stats, 1)
+	require.Equal(t, 100, stats["/test/1/limited/proto"].Messages)
+	require.Equal(t, int64(100), stats["/test/1/limited/proto"].DeletedMessages)
+}
+
+func TestRetentionPolicyMaxAge(t *testing.T) {
+	store := newTestDBStore(t)
+
+	require.NoError(t, store.SetRetentionPolicy("/test/1/limited/proto", RetentionPolicy{MaxAge: time.Hour}))
+
+	old := time.Now().Add(-2 * time.Hour).UnixNano()
+	msg := &pb.WakuMessage{ContentTopic: "/test/1/limited/proto", Timestamp: old}
+	require.NoError(t, store.Put(protocol.NewEnvelope(msg, old, relay.DefaultWakuTopic)))
+	putMessages(t, store, "/test/1/limited/proto", 10)
+
+	require.NoError(t, store.cleanOlderRecords())
+	require.Equal(t, 10, store.GetRetentionStats()["/test/1/limited/proto"].Messages)
+}
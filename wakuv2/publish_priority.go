@@ -0,0 +1,91 @@
+package wakuv2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/waku-org/go-waku/waku/v2/protocol"
+	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
+	"github.com/waku-org/go-waku/waku/v2/protocol/relay"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/wakuv2/common"
+)
+
+// Priorities of the published messages. Messages with a higher priority
+// are published before the ones with a lower priority.
+const (
+	PriorityHigh = iota
+	PriorityNormal
+	PriorityLow
+
+	publishPriorities
+)
+
+var ErrInvalidPriority = errors.New("invalid publish priority")
+
+// PublishWithPriority queues a message to be distributed in the network,
+// ahead of the queued messages with a lower priority.
+func (w *Waku) PublishWithPriority(ctx context.Context, msg *pb.WakuMessage, priority int) error {
+	_, err := w.enqueue(ctx, msg, relay.DefaultWakuTopic, priority)
+	return err
+}
+
+// PendingPublishCount returns the number of messages waiting to be
+// published, by priority.
+func (w *Waku) PendingPublishCount() map[int]int {
+	result := make(map[int]int, publishPriorities)
+	for priority, queue := range w.sendQueues {
+		result[priority] = len(queue)
+	}
+	return result
+}
+
+func (w *Waku) enqueue(ctx context.Context, msg *pb.WakuMessage, pubsubTopic string, priority int) ([]byte, error) {
+	if priority < PriorityHigh || priority >= publishPriorities {
+		return nil, ErrInvalidPriority
+	}
+
+	envelope := protocol.NewEnvelope(msg, msg.Timestamp, pubsubTopic)
+
+	select {
+	case w.sendQueues[priority] <- envelope:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	w.poolMu.Lock()
+	_, alreadyCached := w.envelopes[gethcommon.BytesToHash(envelope.Hash())]
+	w.poolMu.Unlock()
+	if !alreadyCached {
+		recvMessage := common.NewReceivedMessage(envelope, common.RelayedMessageType)
+		w.postEvent(recvMessage) // notify the local node about the new message
+		w.addEnvelope(recvMessage)
+	}
+
+	return envelope.Hash(), nil
+}
+
+// nextEnvelope returns the next envelope to publish, draining the queues
+// with a higher priority first. It returns nil once waku is stopped.
+func (w *Waku) nextEnvelope() *protocol.Envelope {
+	for _, queue := range w.sendQueues {
+		select {
+		case envelope := <-queue:
+			return envelope
+		default:
+		}
+	}
+
+	select {
+	case envelope := <-w.sendQueues[PriorityHigh]:
+		return envelope
+	case envelope := <-w.sendQueues[PriorityNormal]:
+		return envelope
+	case envelope := <-w.sendQueues[PriorityLow]:
+		return envelope
+	case <-w.quit:
+		return nil
+	}
+}
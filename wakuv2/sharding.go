@@ -0,0 +1,161 @@
+package wakuv2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/waku-org/go-waku/waku/v2/protocol/relay"
+
+	"github.com/status-im/status-go/wakuv2/common"
+)
+
+// TopicShardingStrategy maps a community to the relay pubsub topic its
+// messages are relayed on.
+type TopicShardingStrategy interface {
+	TopicFor(communityID string) string
+}
+
+type hashShardingStrategy struct {
+	shards uint64
+}
+
+// HashShardingStrategy consistently maps community IDs to one of shards
+// pubsub topics, based on the hash of the community ID. All communities are
+// mapped to the default pubsub topic if shards is not positive.
+func HashShardingStrategy(shards int) TopicShardingStrategy {
+	if shards < 1 {
+		return &hashShardingStrategy{}
+	}
+	return &hashShardingStrategy{shards: uint64(shards)}
+}
+
+func (s *hashShardingStrategy) TopicFor(communityID string) string {
+	if s.shards == 0 {
+		return relay.DefaultWakuTopic
+	}
+
+	hash := sha256.Sum256([]byte(communityID))
+	shard := binary.BigEndian.Uint64(hash[:8]) % s.shards
+	return fmt.Sprintf("/waku/2/status-community-shard-%d/proto", shard)
+}
+
+// SetTopicShardingStrategy sets the strategy used to pick the relay topic
+// of communities. Communities are relayed on the default pubsub topic when
+// no strategy is set.
+func (w *Waku) SetTopicShardingStrategy(strategy TopicShardingStrategy) {
+	w.shardsMu.Lock()
+	defer w.shardsMu.Unlock()
+
+	w.shardingStrategy = strategy
+}
+
+// SubscribeToCommunityShard subscribes the relay to the pubsub topic the
+// community is sharded to, if not already subscribed.
+func (w *Waku) SubscribeToCommunityShard(communityID string) error {
+	if w.settings.LightClient {
+		return nil
+	}
+
+	w.shardsMu.Lock()
+	defer w.shardsMu.Unlock()
+
+	if w.shardingStrategy == nil {
+		return nil
+	}
+
+	topic := w.shardingStrategy.TopicFor(communityID)
+	if topic == relay.DefaultWakuTopic {
+		return nil
+	}
+	if _, ok := w.communityShards[communityID]; ok {
+		return nil
+	}
+
+	if !w.isSubscribedToShard(topic) {
+		sub, err := w.node.Relay().SubscribeToTopic(context.Background(), topic)
+		if err != nil {
+			return err
+		}
+
+		w.logger.Debug("subscribed to community shard", zap.String("communityID", communityID), zap.String("topic", topic))
+
+		w.wg.Add(1)
+		go w.runShardMsgLoop(sub)
+	}
+	w.communityShards[communityID] = topic
+
+	return nil
+}
+
+// UnsubscribeFromCommunityShard unsubscribes the relay from the pubsub topic
+// the community is sharded to, unless other communities are sharded to it.
+func (w *Waku) UnsubscribeFromCommunityShard(communityID string) error {
+	w.shardsMu.Lock()
+	defer w.shardsMu.Unlock()
+
+	topic, ok := w.communityShards[communityID]
+	if !ok {
+		return nil
+	}
+	delete(w.communityShards, communityID)
+
+	if w.isSubscribedToShard(topic) {
+		return nil
+	}
+
+	w.logger.Debug("unsubscribing from community shard", zap.String("communityID", communityID), zap.String("topic", topic))
+
+	return w.node.Relay().Unsubscribe(context.Background(), topic)
+}
+
+// isSubscribedToShard returns whether a community is sharded to topic, the
+// caller must hold shardsMu
+func (w *Waku) isSubscribedToShard(topic string) bool {
+	for _, t := range w.communityShards {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// pubsubTopicFor returns the pubsub topic the messages of the community are
+// published on, the default pubsub topic for messages not sent to a community
+func (w *Waku) pubsubTopicFor(communityID string) string {
+	if communityID == "" || w.settings.LightClient {
+		return relay.DefaultWakuTopic
+	}
+
+	w.shardsMu.Lock()
+	defer w.shardsMu.Unlock()
+
+	if w.shardingStrategy == nil {
+		return relay.DefaultWakuTopic
+	}
+	return w.shardingStrategy.TopicFor(communityID)
+}
+
+func (w *Waku) runShardMsgLoop(sub *relay.Subscription) {
+	defer w.wg.Done()
+
+	for {
+		select {
+		case <-w.quit:
+			sub.Unsubscribe()
+			return
+		case env, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			w.recordRelayReceived(env)
+			_, err := w.OnNewEnvelopes(env, common.RelayedMessageType)
+			if err != nil {
+				w.logger.Error("onNewEnvelope error", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"sort"
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	gowakuPersistence "github.com/waku-org/go-waku/waku/persistence"
+	"github.com/waku-org/go-waku/waku/v2/node"
+	"github.com/waku-org/go-waku/waku/v2/protocol"
+	storepb "github.com/waku-org/go-waku/waku/v2/protocol/store/pb"
+	"github.com/waku-org/go-waku/waku/v2/timesource"
+)
+
+type storedMessage struct {
+	key     []byte
+	message gowakuPersistence.StoredMessage
+}
+
+// MockMailserver is an in-memory mailserver serving its messages with the
+// waku store protocol over a local libp2p host. Messages relayed by its
+// peers are stored, so it can replace a real store node in tests.
+type MockMailserver struct {
+	node  *node.WakuNode
+	store *memoryStore
+}
+
+// memoryStore is an in-memory store.MessageProvider
+type memoryStore struct {
+	mu       sync.RWMutex
+	messages []storedMessage
+}
+
+// NewMockMailserver starts a mock mailserver listening on localhost.
+func NewMockMailserver() (*MockMailserver, error) {
+	m := &MockMailserver{store: &memoryStore{}}
+
+	hostAddr, err := net.ResolveTCPAddr("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	m.node, err = node.New(
+		node.WithHostAddress(hostAddr),
+		node.WithWakuRelay(),
+		node.WithWakuStore(),
+		node.WithMessageProvider(m.store),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.node.Start(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Stop stops the underlying waku node.
+func (m *MockMailserver) Stop() {
+	m.node.Stop()
+}
+
+// PeerID returns the peer ID of the mailserver.
+func (m *MockMailserver) PeerID() peer.ID {
+	return m.node.Host().ID()
+}
+
+// Address returns the multiaddress the mailserver can be dialed on.
+func (m *MockMailserver) Address() string {
+	return m.node.ListenAddresses()[0].String()
+}
+
+// Reset removes all the stored messages.
+func (m *MockMailserver) Reset() {
+	m.store.mu.Lock()
+	defer m.store.mu.Unlock()
+
+	m.store.messages = nil
+}
+
+// MessageCount returns the number of stored messages.
+func (m *MockMailserver) MessageCount() int {
+	count, _ := m.store.Count()
+	return count
+}
+
+func (m *memoryStore) Start(ctx context.Context, timesource timesource.Timesource) error {
+	return nil
+}
+
+func (m *memoryStore) Put(env *protocol.Envelope) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	index := env.Index()
+	msg := storedMessage{
+		key: gowakuPersistence.NewDBKey(uint64(index.SenderTime), uint64(index.ReceiverTime), index.PubsubTopic, index.Digest).Bytes(),
+		message: gowakuPersistence.StoredMessage{
+			ID:           index.Digest,
+			PubsubTopic:  env.PubsubTopic(),
+			ReceiverTime: index.ReceiverTime,
+			Message:      env.Message(),
+		},
+	}
+
+	for _, stored := range m.messages {
+		if bytes.Equal(stored.key, msg.key) {
+			return nil
+		}
+	}
+
+	m.messages = append(m.messages, msg)
+	sort.Slice(m.messages, func(i, j int) bool {
+		return bytes.Compare(m.messages[i].key, m.messages[j].key) < 0
+	})
+
+	return nil
+}
+
+func (m *memoryStore) Query(query *storepb.HistoryQuery) (*storepb.Index, []gowakuPersistence.StoredMessage, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	backward := query.PagingInfo.Direction == storepb.PagingInfo_BACKWARD
+
+	var cursorKey []byte
+	if cursor := query.PagingInfo.Cursor; cursor != nil {
+		cursorKey = gowakuPersistence.NewDBKey(uint64(cursor.SenderTime), uint64(cursor.ReceiverTime), cursor.PubsubTopic, cursor.Digest).Bytes()
+	}
+
+	var result []gowakuPersistence.StoredMessage
+	for i := range m.messages {
+		stored := m.messages[i]
+		if backward {
+			stored = m.messages[len(m.messages)-1-i]
+		}
+
+		if !matchesQuery(query, stored.message) {
+			continue
+		}
+
+		if cursorKey != nil {
+			cmp := bytes.Compare(stored.key, cursorKey)
+			if (backward && cmp >= 0) || (!backward && cmp <= 0) {
+				continue
+			}
+		}
+
+		result = append(result, stored.message)
+	}
+
+	var cursor *storepb.Index
+	if uint64(len(result)) > query.PagingInfo.PageSize {
+		result = result[:query.PagingInfo.PageSize]
+		last := result[len(result)-1]
+		cursor = protocol.NewEnvelope(last.Message, last.ReceiverTime, last.PubsubTopic).Index()
+	}
+
+	// The retrieved messages list should always be in chronological order
+	if backward {
+		for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+			result[i], result[j] = result[j], result[i]
+		}
+	}
+
+	return cursor, result, nil
+}
+
+func matchesQuery(query *storepb.HistoryQuery, msg gowakuPersistence.StoredMessage) bool {
+	if query.PubsubTopic != "" && query.PubsubTopic != msg.PubsubTopic {
+		return false
+	}
+
+	if len(query.ContentFilters) != 0 {
+		found := false
+		for _, cf := range query.ContentFilters {
+			if cf.ContentTopic == msg.Message.ContentTopic {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if query.StartTime != 0 && msg.Message.Timestamp < query.StartTime {
+		return false
+	}
+
+	if query.EndTime != 0 && msg.Message.Timestamp > query.EndTime {
+		return false
+	}
+
+	return true
+}
+
+func (m *memoryStore) MostRecentTimestamp() (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result int64
+	for _, stored := range m.messages {
+		if stored.message.Message.Timestamp > result {
+			result = stored.message.Message.Timestamp
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryStore) Count() (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.messages), nil
+}
+
+func (m *memoryStore) GetAll() ([]gowakuPersistence.StoredMessage, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]gowakuPersistence.StoredMessage, len(m.messages))
+	for i, stored := range m.messages {
+		result[i] = stored.message
+	}
+	return result, nil
+}
+
+func (m *memoryStore) Stop() {}
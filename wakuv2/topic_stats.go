@@ -0,0 +1,124 @@
+package wakuv2
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	"github.com/waku-org/go-waku/waku/v2/protocol"
+)
+
+var (
+	relayMessagesReceived = stats.Int64("relay_messages_received", "Number of messages received by relay", stats.UnitDimensionless)
+	relayMessagesSent     = stats.Int64("relay_messages_sent", "Number of messages published with relay", stats.UnitDimensionless)
+	relayBytesReceived    = stats.Int64("relay_bytes_received", "Size of the messages received by relay", stats.UnitBytes)
+	relayMessagesRejected = stats.Int64("relay_messages_rejected", "Number of messages rejected by the message verifier", stats.UnitDimensionless)
+
+	pubsubTopicKey, _ = tag.NewKey("pubsub_topic")
+)
+
+var (
+	RelayMessagesReceivedView = &view.View{
+		Name:        "statusgo_relay_messages_received",
+		Measure:     relayMessagesReceived,
+		Description: "The number of messages received by relay, by pubsub topic",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{pubsubTopicKey},
+	}
+	RelayMessagesSentView = &view.View{
+		Name:        "statusgo_relay_messages_sent",
+		Measure:     relayMessagesSent,
+		Description: "The number of messages published with relay, by pubsub topic",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{pubsubTopicKey},
+	}
+	RelayBytesReceivedView = &view.View{
+		Name:        "statusgo_relay_bytes_received",
+		Measure:     relayBytesReceived,
+		Description: "The size of the messages received by relay, by pubsub topic",
+		Aggregation: view.Sum(),
+		TagKeys:     []tag.Key{pubsubTopicKey},
+	}
+	RelayMessagesRejectedView = &view.View{
+		Name:        "statusgo_relay_messages_rejected",
+		Measure:     relayMessagesRejected,
+		Description: "The number of messages rejected by the message verifier, by pubsub topic",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{pubsubTopicKey},
+	}
+)
+
+// TopicStat holds the relay counters of a pubsub topic
+type TopicStat struct {
+	MessagesReceived uint64 `json:"messagesReceived"`
+	MessagesSent     uint64 `json:"messagesSent"`
+	BytesReceived    uint64 `json:"bytesReceived"`
+	MessagesRejected uint64 `json:"messagesRejected"`
+}
+
+// RegisterTopicViews registers the OpenCensus views of the relay counters
+// of each pubsub topic.
+func RegisterTopicViews() error {
+	return view.Register(RelayMessagesReceivedView, RelayMessagesSentView, RelayBytesReceivedView, RelayMessagesRejectedView)
+}
+
+// TopicStats returns the relay counters of each pubsub topic messages were
+// sent or received on.
+func (w *Waku) TopicStats() map[string]TopicStat {
+	w.topicStatsMu.Lock()
+	defer w.topicStatsMu.Unlock()
+
+	result := make(map[string]TopicStat, len(w.topicStats))
+	for topic, stat := range w.topicStats {
+		result[topic] = *stat
+	}
+	return result
+}
+
+// topicStat returns the counters of a topic, the caller needs to hold the lock
+func (w *Waku) topicStat(topic string) *TopicStat {
+	stat, ok := w.topicStats[topic]
+	if !ok {
+		stat = &TopicStat{}
+		w.topicStats[topic] = stat
+	}
+	return stat
+}
+
+func (w *Waku) recordRelayReceived(env *protocol.Envelope) {
+	size := len(env.Message().Payload)
+
+	w.topicStatsMu.Lock()
+	stat := w.topicStat(env.PubsubTopic())
+	stat.MessagesReceived++
+	stat.BytesReceived += uint64(size)
+	w.topicStatsMu.Unlock()
+
+	w.recordTopicMeasurements(env.PubsubTopic(), relayMessagesReceived.M(1), relayBytesReceived.M(int64(size)))
+}
+
+func (w *Waku) recordRelaySent(topic string) {
+	w.topicStatsMu.Lock()
+	w.topicStat(topic).MessagesSent++
+	w.topicStatsMu.Unlock()
+
+	w.recordTopicMeasurements(topic, relayMessagesSent.M(1))
+}
+
+func (w *Waku) recordRelayRejected(topic string) {
+	w.topicStatsMu.Lock()
+	w.topicStat(topic).MessagesRejected++
+	w.topicStatsMu.Unlock()
+
+	w.recordTopicMeasurements(topic, relayMessagesRejected.M(1))
+}
+
+func (w *Waku) recordTopicMeasurements(topic string, ms ...stats.Measurement) {
+	err := stats.RecordWithTags(context.Background(), []tag.Mutator{tag.Insert(pubsubTopicKey, topic)}, ms...)
+	if err != nil {
+		w.logger.Error("failed to record topic stats", zap.Error(err))
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"math"
 	"math/rand"
 	"net"
+	"net/http"
 	"runtime"
 	"strings"
 	"sync"
@@ -99,6 +100,8 @@ type Waku struct {
 	node            *node.WakuNode // reference to a libp2p waku node
 	identifyService identify.IDService
 	appDB           *sql.DB
+	peerBlacklist   *peerBlacklist
+	messageVerifier MessageVerifier
 
 	dnsAddressCache     map[string][]dnsdisc.DiscoveredNode // Map to store the multiaddresses returned by dns discovery
 	dnsAddressCacheLock *sync.RWMutex                       // lock to handle access to the map
@@ -116,10 +119,10 @@ type Waku struct {
 
 	bandwidthCounter *metrics.BandwidthCounter
 
-	sendQueue chan *protocol.Envelope
-	msgQueue  chan *common.ReceivedMessage // Message queue for waku messages that havent been decoded
-	quit      chan struct{}                // Channel used for graceful exit
-	wg        sync.WaitGroup
+	sendQueues [publishPriorities]chan *protocol.Envelope // Queues of the envelopes to publish, by priority
+	msgQueue   chan *common.ReceivedMessage               // Message queue for waku messages that havent been decoded
+	quit       chan struct{}                              // Channel used for graceful exit
+	wg         sync.WaitGroup
 
 	settings   settings     // Holds configuration settings that can be dynamically changed
 	settingsMu sync.RWMutex // Mutex to sync the settings access
@@ -151,6 +154,16 @@ type Waku struct {
 
 	// discV5BootstrapNodes is the ENR to be used to fetch bootstrap nodes for discovery
 	discV5BootstrapNodes []string
+
+	debugServer   *http.Server // HTTP server exposing the internal state of the node
+	debugServerMu sync.Mutex
+
+	shardingStrategy TopicShardingStrategy // Strategy used to pick the relay topic of communities
+	communityShards  map[string]string     // Shard topics of the communities the relay is subscribed for
+	shardsMu         sync.Mutex
+
+	topicStats   map[string]*TopicStat // Relay counters by pubsub topic
+	topicStatsMu sync.Mutex
 }
 
 func getUsableUDPPort() (int, error) {
@@ -193,7 +206,6 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 		envelopes:               make(map[gethcommon.Hash]*common.ReceivedMessage),
 		expirations:             make(map[uint32]mapset.Set),
 		msgQueue:                make(chan *common.ReceivedMessage, messageQueueLimit),
-		sendQueue:               make(chan *protocol.Envelope, 1000),
 		connStatusSubscriptions: make(map[string]*types.ConnStatusSubscription),
 		quit:                    make(chan struct{}),
 		connectionChanged:       make(chan struct{}),
@@ -205,6 +217,16 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 		timeSource:              time.Now,
 		logger:                  logger,
 		discV5BootstrapNodes:    cfg.DiscV5BootstrapNodes,
+		communityShards:         make(map[string]string),
+		topicStats:              make(map[string]*TopicStat),
+	}
+
+	if cfg.CommunityShards > 0 {
+		waku.shardingStrategy = HashShardingStrategy(cfg.CommunityShards)
+	}
+
+	for i := range waku.sendQueues {
+		waku.sendQueues[i] = make(chan *protocol.Envelope, 1000)
 	}
 
 	// Disabling light client mode if using status.prod or undefined
@@ -251,8 +273,11 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 		cfg.KeepAliveInterval = DefaultConfig.KeepAliveInterval
 	}
 
+	waku.peerBlacklist = newPeerBlacklist()
+
 	libp2pOpts := node.DefaultLibP2POptions
 	libp2pOpts = append(libp2pOpts, libp2p.BandwidthReporter(waku.bandwidthCounter))
+	libp2pOpts = append(libp2pOpts, libp2p.ConnectionGater(waku.peerBlacklist))
 	libp2pOpts = append(libp2pOpts, libp2p.NATPortMap())
 	libp2pOpts = append(libp2pOpts, libp2p.EnableHolePunching())
 	libp2pOpts = append(libp2pOpts, libp2p.EnableAutoRelayWithPeerSource(
@@ -293,6 +318,19 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 			pubsub.WithMaxMessageSize(int(waku.settings.MaxMsgSize)),
 		}
 
+		if cfg.GossipSubPeerScoreParams != nil {
+			thresholds := cfg.GossipSubPeerScoreThresholds
+			if thresholds == nil {
+				thresholds = &DefaultGossipSubPeerScoreThresholds
+			}
+			relayOpts = append(relayOpts, pubsub.WithPeerScore(cfg.GossipSubPeerScoreParams, thresholds))
+		}
+
+		if cfg.MessageVerifier != nil {
+			waku.messageVerifier = cfg.MessageVerifier
+			relayOpts = append(relayOpts, pubsub.WithDefaultValidator(waku.validateMessage))
+		}
+
 		opts = append(opts, node.WithWakuRelayAndMinPeers(waku.settings.MinPeersForRelay, relayOpts...))
 	}
 
@@ -695,6 +733,7 @@ func (w *Waku) runRelayMsgLoop() {
 			sub.Unsubscribe()
 			return
 		case env := <-sub.C:
+			w.recordRelayReceived(env)
 			envelopeErrors, err := w.OnNewEnvelopes(env, common.RelayedMessageType)
 			if err != nil {
 				w.logger.Error("onNewEnvelope error", zap.Error(err))
@@ -1092,57 +1131,52 @@ func (w *Waku) UnsubscribeMany(ids []string) error {
 
 func (w *Waku) broadcast() {
 	for {
-		select {
-		case envelope := <-w.sendQueue:
-			var err error
-			if w.settings.LightClient {
-				w.logger.Info("publishing message via lightpush", zap.String("envelopeHash", hexutil.Encode(envelope.Hash())))
-				_, err = w.node.Lightpush().Publish(context.Background(), envelope.Message())
-			} else {
-				w.logger.Info("publishing message via relay", zap.String("envelopeHash", hexutil.Encode(envelope.Hash())))
-				_, err = w.node.Relay().Publish(context.Background(), envelope.Message())
-			}
-
-			if err != nil {
-				w.logger.Error("could not send message", zap.String("envelopeHash", hexutil.Encode(envelope.Hash())), zap.Error(err))
-				w.envelopeFeed.Send(common.EnvelopeEvent{
-					Hash:  gethcommon.BytesToHash(envelope.Hash()),
-					Event: common.EventEnvelopeExpired,
-				})
+		envelope := w.nextEnvelope()
+		if envelope == nil {
+			return
+		}
 
-				continue
+		var err error
+		if w.settings.LightClient {
+			w.logger.Info("publishing message via lightpush", zap.String("envelopeHash", hexutil.Encode(envelope.Hash())))
+			_, err = w.node.Lightpush().Publish(context.Background(), envelope.Message())
+		} else {
+			w.logger.Info("publishing message via relay", zap.String("envelopeHash", hexutil.Encode(envelope.Hash())))
+			_, err = w.node.Relay().PublishToTopic(context.Background(), envelope.Message(), envelope.PubsubTopic())
+			if err == nil {
+				w.recordRelaySent(envelope.PubsubTopic())
 			}
+		}
 
-			event := common.EnvelopeEvent{
-				Event: common.EventEnvelopeSent,
+		if err != nil {
+			w.logger.Error("could not send message", zap.String("envelopeHash", hexutil.Encode(envelope.Hash())), zap.Error(err))
+			w.envelopeFeed.Send(common.EnvelopeEvent{
 				Hash:  gethcommon.BytesToHash(envelope.Hash()),
-			}
+				Event: common.EventEnvelopeExpired,
+			})
 
-			w.SendEnvelopeEvent(event)
+			continue
+		}
 
-		case <-w.quit:
-			return
+		event := common.EnvelopeEvent{
+			Event: common.EventEnvelopeSent,
+			Hash:  gethcommon.BytesToHash(envelope.Hash()),
 		}
+
+		w.SendEnvelopeEvent(event)
 	}
 }
 
 // Send injects a message into the waku send queue, to be distributed in the
 // network in the coming cycles.
 func (w *Waku) Send(msg *pb.WakuMessage) ([]byte, error) {
-	envelope := protocol.NewEnvelope(msg, msg.Timestamp, relay.DefaultWakuTopic) // TODO: once sharding is defined, use the correct pubsub topic
-
-	w.sendQueue <- envelope
-
-	w.poolMu.Lock()
-	_, alreadyCached := w.envelopes[gethcommon.BytesToHash(envelope.Hash())]
-	w.poolMu.Unlock()
-	if !alreadyCached {
-		recvMessage := common.NewReceivedMessage(envelope, common.RelayedMessageType)
-		w.postEvent(recvMessage) // notify the local node about the new message
-		w.addEnvelope(recvMessage)
-	}
+	return w.enqueue(context.Background(), msg, relay.DefaultWakuTopic, PriorityNormal)
+}
 
-	return envelope.Hash(), nil
+// SendToCommunity injects a message into the waku send queue, to be
+// distributed on the pubsub topic the community is sharded to.
+func (w *Waku) SendToCommunity(msg *pb.WakuMessage, communityID string) ([]byte, error) {
+	return w.enqueue(context.Background(), msg, w.pubsubTopicFor(communityID), PriorityNormal)
 }
 
 func (w *Waku) query(ctx context.Context, peerID peer.ID, topics []common.TopicType, from uint64, to uint64, opts []store.HistoryRequestOption) (*store.Result, error) {
@@ -1196,6 +1230,11 @@ func (w *Waku) Query(ctx context.Context, peerID peer.ID, topics []common.TopicT
 // Start implements node.Service, starting the background data propagation thread
 // of the Waku protocol.
 func (w *Waku) Start() error {
+	err := w.loadPeerBlacklist()
+	if err != nil {
+		return err
+	}
+
 	numCPU := runtime.NumCPU()
 	for i := 0; i < numCPU; i++ {
 		go w.processQueue()
@@ -1210,6 +1249,7 @@ func (w *Waku) Start() error {
 // Stop implements node.Service, stopping the background data propagation thread
 // of the Waku protocol.
 func (w *Waku) Stop() error {
+	w.stopDebugServer()
 	w.identifyService.Close()
 	w.node.Stop()
 	close(w.quit)
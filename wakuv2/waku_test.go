@@ -2,21 +2,40 @@ package wakuv2
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"crypto/rand"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io/ioutil"
 	"math/big"
+	"net"
+	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
-	"github.com/cenkalti/backoff/v3"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/waku-org/go-waku/waku/v2/dnsdisc"
+	"github.com/waku-org/go-waku/waku/v2/node"
+	"github.com/waku-org/go-waku/waku/v2/protocol"
 	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
+	"github.com/waku-org/go-waku/waku/v2/protocol/relay"
 	"github.com/waku-org/go-waku/waku/v2/protocol/store"
 
+	"github.com/status-im/status-go/appdatabase"
 	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/sqlite"
 	"github.com/status-im/status-go/wakuv2/common"
+	"github.com/status-im/status-go/wakuv2/testutil"
 )
 
 var testENRBootstrap = "enrtree://AOGECG2SPND25EEFMAJ5WF3KSGJNSGV356DSTL2YVLLZWIV6SAYBM@prod.nodes.status.im"
@@ -59,32 +78,24 @@ func TestRestartDiscoveryV5(t *testing.T) {
 
 	require.False(t, w.seededBootnodesForDiscV5)
 
-	options := func(b *backoff.ExponentialBackOff) {
-		b.MaxElapsedTime = 2 * time.Second
-	}
-
 	// Sanity check, not great, but it's probably helpful
 	err = tt.RetryWithBackOff(func() error {
 		if len(w.Peers()) == 0 {
 			return errors.New("no peers discovered")
 		}
 		return nil
-	}, options)
+	}, tt.WithMaxElapsedTime(2*time.Second))
 
 	require.Error(t, err)
 
 	w.discV5BootstrapNodes = []string{testENRBootstrap}
 
-	options = func(b *backoff.ExponentialBackOff) {
-		b.MaxElapsedTime = 30 * time.Second
-	}
-
 	err = tt.RetryWithBackOff(func() error {
 		if len(w.Peers()) == 0 {
 			return errors.New("no peers discovered")
 		}
 		return nil
-	}, options)
+	}, tt.WithMaxElapsedTime(30*time.Second))
 	require.NoError(t, err)
 
 	require.True(t, w.seededBootnodesForDiscV5)
@@ -93,41 +104,56 @@ func TestRestartDiscoveryV5(t *testing.T) {
 }
 
 func TestBasicWakuV2(t *testing.T) {
-	enrTreeAddress := testENRBootstrap
-	envEnrTreeAddress := os.Getenv("ENRTREE_ADDRESS")
-	if envEnrTreeAddress != "" {
-		enrTreeAddress = envEnrTreeAddress
-	}
-
 	config := &Config{}
 	config.Port = 0
-	config.EnableDiscV5 = true
-	config.DiscV5BootstrapNodes = []string{enrTreeAddress}
-	config.DiscoveryLimit = 20
-	config.UDPPort = 9001
-	config.WakuNodes = []string{enrTreeAddress}
-	w, err := New("", "", config, nil, nil, nil)
-	require.NoError(t, err)
-	require.NoError(t, w.Start())
 
-	// DNSDiscovery
-	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
-	defer cancel()
+	var storeNode peer.ID
+	var mailserver *testutil.MockMailserver
+	minPeers := 0
+	if os.Getenv("INTEGRATION_TESTS") != "" {
+		enrTreeAddress := testENRBootstrap
+		envEnrTreeAddress := os.Getenv("ENRTREE_ADDRESS")
+		if envEnrTreeAddress != "" {
+			enrTreeAddress = envEnrTreeAddress
+		}
 
-	discoveredNodes, err := dnsdisc.RetrieveNodes(ctx, enrTreeAddress)
-	require.NoError(t, err)
+		config.EnableDiscV5 = true
+		config.DiscV5BootstrapNodes = []string{enrTreeAddress}
+		config.DiscoveryLimit = 20
+		config.UDPPort = 9001
+		config.WakuNodes = []string{enrTreeAddress}
+
+		// DNSDiscovery
+		ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+		defer cancel()
+
+		discoveredNodes, err := dnsdisc.RetrieveNodes(ctx, enrTreeAddress)
+		require.NoError(t, err)
+
+		// Peer used for retrieving history
+		r, err := rand.Int(rand.Reader, big.NewInt(int64(len(discoveredNodes))))
+		require.NoError(t, err)
+
+		storeNode = discoveredNodes[int(r.Int64())].PeerID
+		minPeers = 3
+	} else {
+		var err error
+		mailserver, err = testutil.NewMockMailserver()
+		require.NoError(t, err)
+		defer mailserver.Stop()
+
+		config.WakuNodes = []string{mailserver.Address()}
+		storeNode = mailserver.PeerID()
+	}
 
-	// Peer used for retrieving history
-	r, err := rand.Int(rand.Reader, big.NewInt(int64(len(discoveredNodes))))
+	w, err := New("", "", config, nil, nil, nil)
 	require.NoError(t, err)
-
-	storeNode := discoveredNodes[int(r.Int64())]
+	require.NoError(t, w.Start())
 
 	// Wait for some peers to be discovered
 	time.Sleep(3 * time.Second)
 
-	// At least 3 peers should have been discovered
-	require.Greater(t, w.PeerCount(), 3)
+	require.Greater(t, w.PeerCount(), minPeers)
 
 	filter := &common.Filter{
 		Messages: common.NewMemoryMessageStore(),
@@ -156,7 +182,460 @@ func TestBasicWakuV2(t *testing.T) {
 	require.Len(t, messages, 1)
 
 	timestampInSeconds := msgTimestamp / int64(time.Second)
-	storeResult, err := w.query(context.Background(), storeNode.PeerID, []common.TopicType{contentTopic}, uint64(timestampInSeconds-20), uint64(timestampInSeconds+20), []store.HistoryRequestOption{})
+	storeResult, err := w.query(context.Background(), storeNode, []common.TopicType{contentTopic}, uint64(timestampInSeconds-20), uint64(timestampInSeconds+20), []store.HistoryRequestOption{})
 	require.NoError(t, err)
 	require.NotZero(t, len(storeResult.Messages))
+
+	if mailserver != nil {
+		require.Equal(t, 1, mailserver.MessageCount())
+		mailserver.Reset()
+		require.Equal(t, 0, mailserver.MessageCount())
+	}
+}
+
+func TestDebugServer(t *testing.T) {
+	config := &Config{}
+	w, err := New("", "", config, nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Start())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	require.NoError(t, w.EnableDebugServer(port))
+	require.Equal(t, ErrDebugServerRunning, w.EnableDebugServer(port))
+
+	endpoints := []string{
+		"/debug/peers",
+		"/debug/topics",
+		"/debug/store",
+		"/debug/gossip",
+		"/debug/discv5/table",
+	}
+	for _, endpoint := range endpoints {
+		resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d%s", port, endpoint))
+		require.NoError(t, err)
+
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, resp.Body.Close())
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, resp.StatusCode, endpoint)
+		require.True(t, json.Valid(body), endpoint)
+	}
+
+	require.NoError(t, w.Stop())
+}
+
+func TestPublishWithPriority(t *testing.T) {
+	config := &Config{}
+	w, err := New("", "", config, nil, nil, nil)
+	require.NoError(t, err)
+
+	newMessage := func(i int) *pb.WakuMessage {
+		return &pb.WakuMessage{
+			Payload:      []byte{byte(i)},
+			ContentTopic: common.BytesToTopic([]byte{1, 2, 3, 4}).ContentTopic(),
+			Timestamp:    w.timestamp(),
+		}
+	}
+
+	// Flood with low priority messages before the dispatcher runs
+	for i := 0; i < 20; i++ {
+		require.NoError(t, w.PublishWithPriority(context.Background(), newMessage(i), PriorityLow))
+	}
+	highMessage := newMessage(100)
+	require.NoError(t, w.PublishWithPriority(context.Background(), highMessage, PriorityHigh))
+	require.Equal(t, ErrInvalidPriority, w.PublishWithPriority(context.Background(), newMessage(101), 3))
+
+	require.Equal(t, map[int]int{PriorityHigh: 1, PriorityNormal: 0, PriorityLow: 20}, w.PendingPublishCount())
+
+	events := make(chan common.EnvelopeEvent, 100)
+	sub := w.SubscribeEnvelopeEvents(events)
+	defer sub.Unsubscribe()
+
+	require.NoError(t, w.Start())
+
+	// The first publish outcome must be the one of the high priority message
+	highHash := protocol.NewEnvelope(highMessage, highMessage.Timestamp, relay.DefaultWakuTopic).Hash()
+	for {
+		select {
+		case event := <-events:
+			if event.Event != common.EventEnvelopeSent && event.Event != common.EventEnvelopeExpired {
+				continue
+			}
+			require.Equal(t, gethcommon.BytesToHash(highHash), event.Hash)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no message published")
+		}
+		break
+	}
+
+	require.NoError(t, w.Stop())
+}
+
+func TestHashShardingStrategy(t *testing.T) {
+	strategy := HashShardingStrategy(8)
+
+	community1 := "0x02a2c2a0bdb1e2e4e3f1f1d0a6d3b1a9e8f5c4d3b2a1908f7e6d5c4b3a29180706"
+	community2 := "0x03b1d4e2c5a6f7e8d9c0b1a2f3e4d5c6b7a8f9e0d1c2b3a4f5e6d7c8b9a0f1e2d3"
+
+	topic1 := strategy.TopicFor(community1)
+	topic2 := strategy.TopicFor(community2)
+	require.NotEmpty(t, topic1)
+	require.NotEmpty(t, topic2)
+	require.NotEqual(t, relay.DefaultWakuTopic, topic1)
+
+	for i := 0; i < 10; i++ {
+		require.Equal(t, topic1, strategy.TopicFor(community1))
+		require.Equal(t, topic2, strategy.TopicFor(community2))
+	}
+	require.Equal(t, topic1, HashShardingStrategy(8).TopicFor(community1))
+
+	// Communities are spread over all the shards
+	topics := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		topics[strategy.TopicFor(fmt.Sprintf("community-%d", i))] = true
+	}
+	require.Len(t, topics, 8)
+
+	require.Equal(t, relay.DefaultWakuTopic, HashShardingStrategy(0).TopicFor(community1))
+}
+
+func TestSubscribeToCommunityShard(t *testing.T) {
+	config := &Config{CommunityShards: 4}
+	w, err := New("", "", config, nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Start())
+
+	communityID := "0x02a2c2a0bdb1e2e4e3f1f1d0a6d3b1a9e8f5c4d3b2a1908f7e6d5c4b3a29180706"
+	require.NoError(t, w.SubscribeToCommunityShard(communityID))
+	require.NoError(t, w.SubscribeToCommunityShard(communityID))
+
+	topic := HashShardingStrategy(4).TopicFor(communityID)
+	require.Contains(t, w.node.Relay().Topics(), topic)
+
+	// Messages of the community are published on its shard
+	require.Equal(t, topic, w.pubsubTopicFor(communityID))
+	require.Equal(t, relay.DefaultWakuTopic, w.pubsubTopicFor(""))
+
+	// The shard is kept while another community is sharded to it
+	var otherCommunityID string
+	for i := 0; otherCommunityID == ""; i++ {
+		if id := fmt.Sprintf("community-%d", i); HashShardingStrategy(4).TopicFor(id) == topic {
+			otherCommunityID = id
+		}
+	}
+	require.NoError(t, w.SubscribeToCommunityShard(otherCommunityID))
+	require.NoError(t, w.UnsubscribeFromCommunityShard(communityID))
+	require.Contains(t, w.node.Relay().Topics(), topic)
+
+	require.NoError(t, w.UnsubscribeFromCommunityShard(otherCommunityID))
+	require.NoError(t, w.UnsubscribeFromCommunityShard(otherCommunityID))
+	require.NotContains(t, w.node.Relay().Topics(), topic)
+
+	// Give the relay loop time to subscribe to the default topic before stopping
+	time.Sleep(500 * time.Millisecond)
+
+	require.NoError(t, w.Stop())
+}
+
+type fixedShardingStrategy map[string]string
+
+func (s fixedShardingStrategy) TopicFor(communityID string) string {
+	return s[communityID]
+}
+
+func TestTopicStats(t *testing.T) {
+	topics := fixedShardingStrategy{
+		"community-1": "/waku/2/test-topic-1/proto",
+		"community-2": "/waku/2/test-topic-2/proto",
+	}
+
+	require.NoError(t, RegisterTopicViews())
+	defer view.Unregister(RelayMessagesReceivedView, RelayMessagesSentView, RelayBytesReceivedView, RelayMessagesRejectedView)
+
+	sender, err := New("", "", &Config{}, nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, sender.Start())
+
+	receiver, err := New("", "", &Config{}, nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, receiver.Start())
+
+	receiver.SetTopicShardingStrategy(topics)
+	for communityID := range topics {
+		require.NoError(t, receiver.SubscribeToCommunityShard(communityID))
+	}
+
+	require.NoError(t, sender.DialPeer(receiver.ListenAddresses()[0]))
+	require.Eventually(t, func() bool {
+		for _, topic := range topics {
+			if !sender.node.Relay().EnoughPeersToPublishToTopic(topic) {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 100*time.Millisecond)
+
+	for _, topic := range topics {
+		for i := 0; i < 5; i++ {
+			msg := &pb.WakuMessage{
+				Payload:      []byte{1, 2, 3, byte(i)},
+				ContentTopic: common.BytesToTopic([]byte{1, 2, 3, 4}).ContentTopic(),
+				Timestamp:    sender.timestamp(),
+			}
+			_, err := sender.enqueue(context.Background(), msg, topic, PriorityNormal)
+			require.NoError(t, err)
+		}
+	}
+
+	require.Eventually(t, func() bool {
+		stats := receiver.TopicStats()
+		for _, topic := range topics {
+			if stats[topic].MessagesReceived != 5 {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 100*time.Millisecond)
+
+	senderStats := sender.TopicStats()
+	receiverStats := receiver.TopicStats()
+	for _, topic := range topics {
+		require.Equal(t, uint64(5), senderStats[topic].MessagesSent)
+		require.Equal(t, uint64(5), receiverStats[topic].MessagesReceived)
+		require.Equal(t, uint64(20), receiverStats[topic].BytesReceived)
+	}
+
+	rows, err := view.RetrieveData(RelayMessagesSentView.Name)
+	require.NoError(t, err)
+	sent := make(map[string]int64)
+	for _, row := range rows {
+		sent[row.Tags[0].Value] = row.Data.(*view.CountData).Value
+	}
+	for _, topic := range topics {
+		require.Equal(t, int64(5), sent[topic])
+	}
+
+	require.NoError(t, sender.Stop())
+	require.NoError(t, receiver.Stop())
+}
+
+func newRelayNode(t *testing.T) *node.WakuNode {
+	hostAddr, err := net.ResolveTCPAddr("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	n, err := node.New(node.WithHostAddress(hostAddr), node.WithWakuRelay())
+	require.NoError(t, err)
+	require.NoError(t, n.Start(context.Background()))
+	return n
+}
+
+func TestGossipSubPeerScore(t *testing.T) {
+	honest := newRelayNode(t)
+	defer honest.Stop()
+	misbehaving := newRelayNode(t)
+	defer misbehaving.Stop()
+
+	config := &Config{
+		WakuNodes: []string{honest.ListenAddresses()[0].String(), misbehaving.ListenAddresses()[0].String()},
+		GossipSubPeerScoreParams: &pubsub.PeerScoreParams{
+			AppSpecificScore: func(p peer.ID) float64 {
+				if p == misbehaving.Host().ID() {
+					return -1
+				}
+				return 0
+			},
+			AppSpecificWeight: 1000,
+			DecayInterval:     time.Second,
+			DecayToZero:       0.01,
+		},
+		GossipSubPeerScoreThresholds: &pubsub.PeerScoreThresholds{
+			GossipThreshold:   -10,
+			PublishThreshold:  -50,
+			GraylistThreshold: -100,
+		},
+	}
+	w, err := New("", "", config, nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Start())
+	defer func() {
+		require.NoError(t, w.Stop())
+	}()
+
+	filter := &common.Filter{
+		Messages: common.NewMemoryMessageStore(),
+		Topics:   [][]byte{{1, 2, 3, 4}},
+	}
+	_, err = w.Subscribe(filter)
+	require.NoError(t, err)
+
+	newMessage := func(payload byte) *pb.WakuMessage {
+		return &pb.WakuMessage{
+			Payload:      []byte{payload},
+			ContentTopic: common.BytesToTopic(filter.Topics[0]).ContentTopic(),
+			Timestamp:    w.timestamp(),
+		}
+	}
+
+	// Messages of the honest peer are relayed
+	received := 0
+	err = tt.RetryWithBackOff(func() error {
+		_, _ = misbehaving.Relay().Publish(context.Background(), newMessage(0))
+		_, _ = honest.Relay().Publish(context.Background(), newMessage(1))
+		time.Sleep(200 * time.Millisecond)
+
+		messages := filter.Retrieve()
+		received += len(messages)
+		for _, message := range messages {
+			require.Equal(t, []byte{1}, message.Data)
+		}
+		if received == 0 {
+			return errors.New("no message received")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	// The misbehaving peer is connected, but graylisted so its messages are ignored
+	require.Contains(t, w.Peers(), misbehaving.Host().ID().Pretty())
+	for i := 0; i < 5; i++ {
+		_, err = misbehaving.Relay().Publish(context.Background(), newMessage(0))
+		require.NoError(t, err)
+	}
+	time.Sleep(500 * time.Millisecond)
+	require.Empty(t, filter.Retrieve())
+}
+
+// stopAfterRelaySubscription gives the relay loop time to subscribe to the default topic before stopping
+func stopAfterRelaySubscription(t *testing.T, w *Waku) {
+	require.Eventually(t, func() bool { return len(w.node.Relay().Topics()) > 0 }, 2*time.Second, 50*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, w.Stop())
+}
+
+func TestBlacklistPeer(t *testing.T) {
+	appDB, err := appdatabase.InitializeDB(filepath.Join(t.TempDir(), "wakuv2-blacklist.db"), "password", sqlite.ReducedKDFIterationsNumber)
+	require.NoError(t, err)
+	defer appDB.Close()
+
+	misbehaving := newRelayNode(t)
+	defer misbehaving.Stop()
+	misbehavingID := misbehaving.Host().ID()
+
+	config := &Config{Host: "127.0.0.1"}
+	w, err := New("", "", config, nil, appDB, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Start())
+
+	require.NoError(t, misbehaving.DialPeer(context.Background(), w.ListenAddresses()[0]))
+	require.NoError(t, w.BlacklistPeer(misbehavingID, "malformed messages"))
+	require.Equal(t, map[peer.ID]string{misbehavingID: "malformed messages"}, w.BlacklistedPeers())
+
+	// The existing connection is closed and new ones are refused
+	require.NotContains(t, w.node.Host().Network().Peers(), misbehavingID)
+	// The dialer only notices the refusal once the connection is closed after the handshake
+	_ = misbehaving.DialPeer(context.Background(), w.ListenAddresses()[0])
+	require.Eventually(t, func() bool {
+		return misbehaving.Host().Network().Connectedness(w.node.Host().ID()) != network.Connected
+	}, 2*time.Second, 50*time.Millisecond)
+	require.NotEqual(t, network.Connected, w.node.Host().Network().Connectedness(misbehavingID))
+	require.Error(t, w.DialPeer(misbehaving.ListenAddresses()[0].String()))
+	stopAfterRelaySubscription(t, w)
+
+	// The blacklist is reloaded on start
+	w, err = New("", "", config, nil, appDB, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Start())
+	require.Equal(t, map[peer.ID]string{misbehavingID: "malformed messages"}, w.BlacklistedPeers())
+	require.Error(t, w.DialPeer(misbehaving.ListenAddresses()[0].String()))
+
+	require.NoError(t, w.UnblacklistPeer(misbehavingID))
+	require.Empty(t, w.BlacklistedPeers())
+	require.NoError(t, w.DialPeer(misbehaving.ListenAddresses()[0].String()))
+	stopAfterRelaySubscription(t, w)
+
+	w, err = New("", "", config, nil, appDB, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Start())
+	require.Empty(t, w.BlacklistedPeers())
+	stopAfterRelaySubscription(t, w)
+}
+
+func TestMessageVerifier(t *testing.T) {
+	signer, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		verifier         MessageVerifier
+		expectedPayloads map[byte]bool
+		expectRejected   bool
+	}{
+		{"no verifier", nil, map[byte]bool{1: true, 2: true, 3: true}, false},
+		{"ecdsa verifier", ECDSAMessageVerifier(&signer.PublicKey), map[byte]bool{1: true}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sender := newRelayNode(t)
+			defer sender.Stop()
+			_, err := sender.Relay().Subscribe(context.Background())
+			require.NoError(t, err)
+
+			config := &Config{
+				WakuNodes:       []string{sender.ListenAddresses()[0].String()},
+				MessageVerifier: tc.verifier,
+			}
+			w, err := New("", "", config, nil, nil, nil)
+			require.NoError(t, err)
+			require.NoError(t, w.Start())
+			defer stopAfterRelaySubscription(t, w)
+
+			filter := &common.Filter{
+				Messages: common.NewMemoryMessageStore(),
+				Topics:   [][]byte{{1, 2, 3, 4}},
+			}
+			_, err = w.Subscribe(filter)
+			require.NoError(t, err)
+
+			newMessage := func(payload byte, key *ecdsa.PrivateKey) *pb.WakuMessage {
+				msg := &pb.WakuMessage{
+					Payload:      []byte{payload},
+					ContentTopic: common.BytesToTopic(filter.Topics[0]).ContentTopic(),
+					Timestamp:    w.timestamp(),
+				}
+				if key != nil {
+					require.NoError(t, SignMessage(msg, key))
+				}
+				return msg
+			}
+
+			// Validly signed, signed by another key and unsigned messages are published
+			// until the expected ones are received, as the first ones can be sent
+			// before the relay mesh is formed
+			received := make(map[byte]bool)
+			require.Eventually(t, func() bool {
+				for _, msg := range []*pb.WakuMessage{newMessage(1, signer), newMessage(2, other), newMessage(3, nil)} {
+					_, _ = sender.Relay().Publish(context.Background(), msg)
+				}
+				for _, message := range filter.Retrieve() {
+					received[message.Data[0]] = true
+				}
+				return len(received) >= len(tc.expectedPayloads)
+			}, 10*time.Second, 200*time.Millisecond)
+
+			time.Sleep(200 * time.Millisecond)
+			for _, message := range filter.Retrieve() {
+				received[message.Data[0]] = true
+			}
+			require.Equal(t, tc.expectedPayloads, received)
+			require.Equal(t, tc.expectRejected, w.TopicStats()[relay.DefaultWakuTopic].MessagesRejected > 0)
+		})
+	}
 }